@@ -0,0 +1,304 @@
+package etherscan
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// ============================================================================
+// ABI Encoding
+// ============================================================================
+
+// abiKind is the kind of a supported ABI type
+type abiKind int
+
+const (
+	abiAddress abiKind = iota
+	abiBool
+	abiUint
+	abiInt
+	abiFixedBytes
+	abiString
+	abiBytes
+)
+
+// abiType is a parsed elementary ABI type
+type abiType struct {
+	kind abiKind
+	size int // bits of uintN/intN, bytes of bytesN
+}
+
+// dynamic reports whether values of t are encoded in the tail
+func (t abiType) dynamic() bool {
+	return t.kind == abiString || t.kind == abiBytes
+}
+
+// ABITypeSupported reports whether EncodeABICall and DecodeABIResult handle the ABI type
+//
+// Supported are the elementary types address, bool, uintN, intN, bytesN,
+// string and bytes; arrays and tuples are not.
+func ABITypeSupported(typ string) bool {
+	_, err := parseABIType(typ)
+	return err == nil
+}
+
+// parseABIType parses an elementary ABI type such as "uint256" or "bytes32"
+func parseABIType(typ string) (abiType, error) {
+	sized := func(prefix string, kind abiKind, def, step, limit int) (abiType, bool) {
+		rest, ok := strings.CutPrefix(typ, prefix)
+		if !ok {
+			return abiType{}, false
+		}
+		if rest == "" {
+			return abiType{kind: kind, size: def}, def > 0
+		}
+		n, err := strconv.Atoi(rest)
+		if err != nil || n <= 0 || n > limit || n%step != 0 || rest[0] == '0' {
+			return abiType{}, false
+		}
+		return abiType{kind: kind, size: n}, true
+	}
+
+	switch typ {
+	case "address":
+		return abiType{kind: abiAddress}, nil
+	case "bool":
+		return abiType{kind: abiBool}, nil
+	case "string":
+		return abiType{kind: abiString}, nil
+	case "bytes":
+		return abiType{kind: abiBytes}, nil
+	}
+	if t, ok := sized("uint", abiUint, 256, 8, 256); ok {
+		return t, nil
+	}
+	if t, ok := sized("int", abiInt, 256, 8, 256); ok {
+		return t, nil
+	}
+	if t, ok := sized("bytes", abiFixedBytes, 0, 1, 32); ok {
+		return t, nil
+	}
+	return abiType{}, fmt.Errorf("etherscan: unsupported ABI type %q", typ)
+}
+
+// EncodeABICall encodes a contract call as "0x" + selector + ABI-encoded args
+//
+// Args are matched to types by position. Go values per type:
+//   - address: string
+//   - bool: bool
+//   - uintN, intN: *big.Int, int, int64 or uint64
+//   - bytesN, bytes: []byte (bytesN values are right-padded to N bytes)
+//   - string: string
+//
+// Example:
+//
+//	data, err := EncodeABICall(MethodSelector("balanceOf(address)"), []string{"address"}, holder)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	result, err := client.RpcEthCall(ctx, tokenAddr, data, nil)
+func EncodeABICall(selector string, types []string, args ...any) (string, error) {
+	sel := strings.TrimPrefix(selector, "0x")
+	if len(sel) != 8 {
+		return "", fmt.Errorf("etherscan: invalid selector %q", selector)
+	}
+	if _, err := hex.DecodeString(sel); err != nil {
+		return "", fmt.Errorf("etherscan: invalid selector %q", selector)
+	}
+	if len(types) != len(args) {
+		return "", fmt.Errorf("etherscan: %d ABI types for %d arguments", len(types), len(args))
+	}
+
+	head := make([]string, len(types))
+	var tail strings.Builder
+	for i, typ := range types {
+		t, err := parseABIType(typ)
+		if err != nil {
+			return "", err
+		}
+		encoded, err := encodeABIValue(t, args[i])
+		if err != nil {
+			return "", fmt.Errorf("etherscan: argument %d (%s): %w", i, typ, err)
+		}
+		if !t.dynamic() {
+			head[i] = encoded
+			continue
+		}
+		head[i] = fmt.Sprintf("%064x", 32*len(types)+tail.Len()/2)
+		tail.WriteString(encoded)
+	}
+	return "0x" + strings.ToLower(sel) + strings.Join(head, "") + tail.String(), nil
+}
+
+// encodeABIValue encodes v as t, returning the head word of static types and the length-prefixed tail of dynamic ones
+func encodeABIValue(t abiType, v any) (string, error) {
+	switch t.kind {
+	case abiAddress:
+		s, ok := v.(string)
+		if !ok {
+			return "", fmt.Errorf("want string, got %T", v)
+		}
+		return addressWord(s)
+	case abiBool:
+		b, ok := v.(bool)
+		if !ok {
+			return "", fmt.Errorf("want bool, got %T", v)
+		}
+		if b {
+			return fmt.Sprintf("%064x", 1), nil
+		}
+		return fmt.Sprintf("%064x", 0), nil
+	case abiUint, abiInt:
+		n, err := abiInteger(v)
+		if err != nil {
+			return "", err
+		}
+		lower, upper := new(big.Int), new(big.Int).Lsh(big.NewInt(1), uint(t.size))
+		if t.kind == abiInt {
+			upper.Rsh(upper, 1)
+			lower.Neg(upper)
+		}
+		if n.Cmp(lower) < 0 || n.Cmp(upper) >= 0 {
+			return "", fmt.Errorf("%s out of range", n)
+		}
+		if n.Sign() < 0 {
+			n = new(big.Int).Add(n, new(big.Int).Lsh(big.NewInt(1), 256))
+		}
+		return fmt.Sprintf("%064x", n), nil
+	case abiFixedBytes:
+		b, ok := v.([]byte)
+		if !ok {
+			return "", fmt.Errorf("want []byte, got %T", v)
+		}
+		if len(b) > t.size {
+			return "", fmt.Errorf("%d bytes do not fit bytes%d", len(b), t.size)
+		}
+		return padRight(hex.EncodeToString(b)), nil
+	default:
+		var b []byte
+		switch data := v.(type) {
+		case string:
+			if t.kind != abiString {
+				return "", fmt.Errorf("want []byte, got %T", v)
+			}
+			b = []byte(data)
+		case []byte:
+			if t.kind != abiBytes {
+				return "", fmt.Errorf("want string, got %T", v)
+			}
+			b = data
+		default:
+			return "", fmt.Errorf("unsupported value %T", v)
+		}
+		return fmt.Sprintf("%064x", len(b)) + padRight(hex.EncodeToString(b)), nil
+	}
+}
+
+// abiInteger converts the supported integer argument types to a *big.Int
+func abiInteger(v any) (*big.Int, error) {
+	switch n := v.(type) {
+	case *big.Int:
+		if n == nil {
+			return nil, fmt.Errorf("nil *big.Int")
+		}
+		return n, nil
+	case int:
+		return big.NewInt(int64(n)), nil
+	case int64:
+		return big.NewInt(n), nil
+	case uint64:
+		return new(big.Int).SetUint64(n), nil
+	}
+	return nil, fmt.Errorf("want *big.Int, int, int64 or uint64, got %T", v)
+}
+
+// padRight pads hex data with zeros to whole 32-byte words
+func padRight(data string) string {
+	return data + strings.Repeat("0", (64-len(data)%64)%64)
+}
+
+// DecodeABIResult decodes the hex return value of an eth_call into one Go value per type
+//
+// Values are returned as address: string (lowercase hex), bool: bool,
+// uintN/intN: *big.Int, bytesN/bytes: []byte and string: string. An empty
+// result, as returned for calls to addresses without code, is an error.
+//
+// Example:
+//
+//	values, err := DecodeABIResult(result, []string{"uint256"})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	balance := values[0].(*big.Int)
+func DecodeABIResult(result string, types []string) ([]any, error) {
+	words := splitWords(result)
+	if len(types) > 0 && len(words) == 0 {
+		return nil, fmt.Errorf("etherscan: empty call result")
+	}
+	if len(words) < len(types) {
+		return nil, fmt.Errorf("etherscan: call result has %d words for %d values", len(words), len(types))
+	}
+
+	values := make([]any, len(types))
+	for i, typ := range types {
+		t, err := parseABIType(typ)
+		if err != nil {
+			return nil, err
+		}
+		word := words[i]
+		switch t.kind {
+		case abiAddress:
+			values[i] = "0x" + strings.ToLower(word[24:])
+		case abiBool:
+			n, _ := new(big.Int).SetString(word, 16)
+			values[i] = n != nil && n.Sign() != 0
+		case abiUint, abiInt:
+			n, ok := new(big.Int).SetString(word, 16)
+			if !ok {
+				return nil, fmt.Errorf("etherscan: invalid %s word %q", typ, word)
+			}
+			if t.kind == abiInt && n.Bit(255) == 1 {
+				n.Sub(n, new(big.Int).Lsh(big.NewInt(1), 256))
+			}
+			values[i] = n
+		case abiFixedBytes:
+			b, err := hex.DecodeString(word)
+			if err != nil {
+				return nil, fmt.Errorf("etherscan: invalid %s word %q", typ, word)
+			}
+			values[i] = b[:t.size]
+		default:
+			b, err := decodeABIDynamic(words, word)
+			if err != nil {
+				return nil, fmt.Errorf("etherscan: value %d (%s): %w", i, typ, err)
+			}
+			if t.kind == abiString {
+				values[i] = string(b)
+			} else {
+				values[i] = b
+			}
+		}
+	}
+	return values, nil
+}
+
+// decodeABIDynamic decodes the length-prefixed bytes that the head word offset points to
+func decodeABIDynamic(words []string, offset string) ([]byte, error) {
+	n, ok := new(big.Int).SetString(offset, 16)
+	if !ok || !n.IsInt64() || n.Int64()%32 != 0 || n.Int64()/32 >= int64(len(words)) {
+		return nil, fmt.Errorf("invalid offset %q", offset)
+	}
+	start := n.Int64() / 32
+	length, ok := new(big.Int).SetString(words[start], 16)
+	if !ok || !length.IsInt64() || length.Int64() > 32*(int64(len(words))-start-1) {
+		return nil, fmt.Errorf("invalid length %q", words[start])
+	}
+	data, err := hex.DecodeString(strings.Join(words[start+1:start+1+(length.Int64()+31)/32], ""))
+	if err != nil {
+		return nil, err
+	}
+	return data[:length.Int64()], nil
+}
@@ -0,0 +1,119 @@
+package etherscan
+
+import (
+	"bytes"
+	"crypto/sha3"
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func TestKeccak256(t *testing.T) {
+	cases := []struct {
+		input string
+		want  string
+	}{
+		{"", "c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470"},
+		{"abc", "4e03657aea45a94fc7d47ba826c8d667c0d1e6e33a64a036ec44f58fa12d6c45"},
+	}
+	for _, c := range cases {
+		if got := hex.EncodeToString(Keccak256([]byte(c.input))); got != c.want {
+			t.Errorf("Keccak256(%q) = %s, want %s", c.input, got, c.want)
+		}
+	}
+
+	// Input split across calls and across the 136-byte rate hashes the same
+	long := []byte(strings.Repeat("a", 200))
+	if !bytes.Equal(Keccak256(long), Keccak256(long[:100], long[100:136], long[136:])) {
+		t.Error("Keccak256 of split input differs")
+	}
+
+	// The permutation is shared with SHA3-256, which only differs in padding
+	for _, n := range []int{0, 135, 136, 137, 500} {
+		input := []byte(strings.Repeat("x", n))
+		if want := sha3.Sum256(input); !bytes.Equal(keccakSponge256(0x06, input), want[:]) {
+			t.Errorf("SHA3-256 of %d bytes differs from crypto/sha3", n)
+		}
+	}
+
+	if got := "0x" + hex.EncodeToString(Keccak256([]byte("Transfer(address,address,uint256)"))); got != TopicTransfer {
+		t.Errorf("Transfer topic = %s, want %s", got, TopicTransfer)
+	}
+	if got := MethodSelector("balanceOf(address)"); got != "0x"+selectorBalanceOf {
+		t.Errorf("MethodSelector(balanceOf) = %s", got)
+	}
+}
+
+func TestEncodeABICall(t *testing.T) {
+	holder := "0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb0"
+	data, err := EncodeABICall("0x70a08231", []string{"address"}, holder)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "0x70a08231000000000000000000000000742d35cc6634c0532925a3b844bc9e7595f0beb0"; data != want {
+		t.Errorf("data = %s, want %s", data, want)
+	}
+
+	// Dynamic arguments are placed in the tail after the head words
+	data, err = EncodeABICall("0x12345678", []string{"string", "int8", "bytes2"}, "hi", -1, []byte{0xab})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "0x12345678" +
+		"0000000000000000000000000000000000000000000000000000000000000060" +
+		strings.Repeat("f", 64) +
+		"ab" + strings.Repeat("0", 62) +
+		"0000000000000000000000000000000000000000000000000000000000000002" +
+		"6869" + strings.Repeat("0", 60)
+	if data != want {
+		t.Errorf("data = %s\nwant %s", data, want)
+	}
+
+	for name, call := range map[string]func() (string, error){
+		"type count":   func() (string, error) { return EncodeABICall("0x70a08231", []string{"address"}) },
+		"bad selector": func() (string, error) { return EncodeABICall("0x70a0", nil) },
+		"uint8 range":  func() (string, error) { return EncodeABICall("0x70a08231", []string{"uint8"}, 256) },
+		"negative":     func() (string, error) { return EncodeABICall("0x70a08231", []string{"uint256"}, -1) },
+		"wrong type":   func() (string, error) { return EncodeABICall("0x70a08231", []string{"bool"}, "true") },
+		"tuple":        func() (string, error) { return EncodeABICall("0x70a08231", []string{"tuple"}, 1) },
+	} {
+		if _, err := call(); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
+
+func TestDecodeABIResult(t *testing.T) {
+	result := "0x" +
+		"000000000000000000000000742d35cc6634c0532925a3b844bc9e7595f0beb0" +
+		"0000000000000000000000000000000000000000000000000000000000000001" +
+		strings.Repeat("f", 64) +
+		"0000000000000000000000000000000000000000000000000000000000000080" +
+		"0000000000000000000000000000000000000000000000000000000000000004" +
+		"55534454" + strings.Repeat("0", 56)
+	values, err := DecodeABIResult(result, []string{"address", "bool", "int256", "string"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if values[0] != "0x742d35cc6634c0532925a3b844bc9e7595f0beb0" {
+		t.Errorf("address = %v", values[0])
+	}
+	if values[1] != true {
+		t.Errorf("bool = %v", values[1])
+	}
+	if n := values[2].(*big.Int); n.Cmp(big.NewInt(-1)) != 0 {
+		t.Errorf("int256 = %s, want -1", n)
+	}
+	if values[3] != "USDT" {
+		t.Errorf("string = %v", values[3])
+	}
+
+	if _, err := DecodeABIResult("0x", []string{"uint256"}); err == nil {
+		t.Error("expected error for empty result")
+	}
+	oversized := "0x" + "0000000000000000000000000000000000000000000000000000000000000020" + strings.Repeat("f", 64)
+	if _, err := DecodeABIResult(oversized, []string{"bytes"}); err == nil {
+		t.Error("expected error for oversized length")
+	}
+}
@@ -2,7 +2,9 @@ package etherscan
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math/big"
 	"strconv"
 	"strings"
 )
@@ -48,13 +50,23 @@ type GetERC20TokenTransfersOpts struct {
 	// Options:
 	//   - "asc" (default): Sort by block number in ascending order (oldest first)
 	//   - "desc": Sort by block number in descending order (newest first)
-	Sort string `default:"asc" json:"sort"`
+	Sort SortOrder `default:"asc" json:"sort"`
 
 	// ChainID specifies which blockchain network to query
 	// Default: empty (uses client default)
 	// Supported chains: EthereumMainnet, PolygonMainnet, ArbitrumOneMainnet, etc.
 	ChainID int64 `json:"chainid"`
 
+	// ExcludeSpam drops transfers the client's SpamFilter marks as spam
+	// Default: false
+	ExcludeSpam bool `json:"-"`
+
+	// VerifyNonEmpty cross-checks an empty full-history first page of one token for one address
+	// against the address's token balance, retrying once after the client's RetryDelay if it holds some
+	// Default: false
+	// A result still empty after the retry fails with ErrUnexpectedlyEmpty
+	VerifyNonEmpty bool `json:"-"`
+
 	// OnLimitExceeded specifies behavior when rate limit is exceeded
 	// Default: RateLimitBlock (wait until a token is available)
 	// Options:
@@ -62,6 +74,14 @@ type GetERC20TokenTransfersOpts struct {
 	//   - RateLimitRaise: Return an error when rate limit is exceeded
 	//   - RateLimitSkip: Return false without executing when rate limit is exceeded
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
+
+	// PageInfo, if set, receives the pagination state of the returned page, see PageInfo
+	// Default: nil
+	PageInfo *PageInfo `json:"-"`
 }
 
 // GetERC20TokenTransfers returns list of ERC-20 token transfers by address and/or contract address
@@ -149,8 +169,12 @@ func (c *HTTPClient) GetERC20TokenTransfers(ctx context.Context, opts *GetERC20T
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
+	var pageInfo *PageInfo
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
+		pageInfo = opts.PageInfo
 	}
 
 	data, err := c.request(requestParams{
@@ -160,15 +184,35 @@ func (c *HTTPClient) GetERC20TokenTransfers(ctx context.Context, opts *GetERC20T
 		params:          params,
 		noFoundReturn:   []RespERC20TokenTransfer{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result []RespERC20TokenTransfer
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
+	recordPageInfo(pageInfo, params, result)
+	if opts.VerifyNonEmpty && len(result) == 0 && opts.Address != "" && opts.ContractAddress != "" &&
+		opts.Page <= 1 && opts.StartBlock == 0 && opts.EndBlock == defaultEndBlock {
+		result, err = verifyNonEmpty(ctx, c, "tokentx", func() (string, error) {
+			return c.tokenBalanceCheck(ctx, opts.ContractAddress, opts.Address, opts.ChainID, opts.OnLimitExceeded)
+		}, func() ([]RespERC20TokenTransfer, error) {
+			retry := *opts
+			retry.VerifyNonEmpty, retry.ExcludeSpam = false, false
+			return c.GetERC20TokenTransfers(ctx, &retry)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if opts.ExcludeSpam {
+		return excludeSpam(ctx, c, result, opts.Address, opts.ChainID, c.spamFilter.IsSpamERC20Transfer, func(t RespERC20TokenTransfer) (string, string, string) {
+			return t.ContractAddress, t.To, t.Hash
+		})
+	}
 	return result, nil
 }
 
@@ -201,15 +245,27 @@ type GetERC721TokenTransfersOpts struct {
 
 	// Sort order for the results
 	// Options: "asc" (default) or "desc"
-	Sort string `default:"asc" json:"sort"`
+	Sort SortOrder `default:"asc" json:"sort"`
 
 	// ChainID specifies which blockchain network to query
 	// Default: empty (uses client default)
 	ChainID int64 `json:"chainid"`
 
+	// ExcludeSpam drops transfers the client's SpamFilter marks as spam
+	// Default: false
+	ExcludeSpam bool `json:"-"`
+
 	// OnLimitExceeded specifies behavior when rate limit is exceeded
 	// Default: RateLimitBlock (wait until a token is available)
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
+
+	// PageInfo, if set, receives the pagination state of the returned page, see PageInfo
+	// Default: nil
+	PageInfo *PageInfo `json:"-"`
 }
 
 // GetERC721TokenTransfers returns list of ERC-721 (NFT) token transfers by address and/or contract address
@@ -267,8 +323,12 @@ func (c *HTTPClient) GetERC721TokenTransfers(ctx context.Context, opts *GetERC72
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
+	var pageInfo *PageInfo
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
+		pageInfo = opts.PageInfo
 	}
 
 	data, err := c.request(requestParams{
@@ -278,15 +338,22 @@ func (c *HTTPClient) GetERC721TokenTransfers(ctx context.Context, opts *GetERC72
 		params:          params,
 		noFoundReturn:   []RespERC721TokenTransfer{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result []RespERC721TokenTransfer
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
+	recordPageInfo(pageInfo, params, result)
+	if opts.ExcludeSpam {
+		return excludeSpam(ctx, c, result, opts.Address, opts.ChainID, c.spamFilter.IsSpamERC721Transfer, func(t RespERC721TokenTransfer) (string, string, string) {
+			return t.ContractAddress, t.To, t.Hash
+		})
+	}
 	return result, nil
 }
 
@@ -319,15 +386,27 @@ type GetERC1155TokenTransfersOpts struct {
 
 	// Sort order for the results
 	// Options: "asc" (default) or "desc"
-	Sort string `default:"asc" json:"sort"`
+	Sort SortOrder `default:"asc" json:"sort"`
 
 	// ChainID specifies which blockchain network to query
 	// Default: empty (uses client default)
 	ChainID int64 `json:"chainid"`
 
+	// ExcludeSpam drops transfers the client's SpamFilter marks as spam
+	// Default: false
+	ExcludeSpam bool `json:"-"`
+
 	// OnLimitExceeded specifies behavior when rate limit is exceeded
 	// Default: RateLimitBlock (wait until a token is available)
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
+
+	// PageInfo, if set, receives the pagination state of the returned page, see PageInfo
+	// Default: nil
+	PageInfo *PageInfo `json:"-"`
 }
 
 // GetERC1155TokenTransfers returns list of ERC-1155 (Multi Token Standard) token transfers by address and/or contract address
@@ -387,8 +466,12 @@ func (c *HTTPClient) GetERC1155TokenTransfers(ctx context.Context, opts *GetERC1
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
+	var pageInfo *PageInfo
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
+		pageInfo = opts.PageInfo
 	}
 
 	data, err := c.request(requestParams{
@@ -398,15 +481,22 @@ func (c *HTTPClient) GetERC1155TokenTransfers(ctx context.Context, opts *GetERC1
 		params:          params,
 		noFoundReturn:   []RespERC1155TokenTransfer{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result []RespERC1155TokenTransfer
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
+	recordPageInfo(pageInfo, params, result)
+	if opts.ExcludeSpam {
+		return excludeSpam(ctx, c, result, opts.Address, opts.ChainID, c.spamFilter.IsSpamERC1155Transfer, func(t RespERC1155TokenTransfer) (string, string, string) {
+			return t.ContractAddress, t.To, t.Hash
+		})
+	}
 	return result, nil
 }
 
@@ -428,6 +518,10 @@ type GetAddressFundedByOpts struct {
 	//   - RateLimitRaise: Return an error when rate limit is exceeded
 	//   - RateLimitSkip: Return false without executing when rate limit is exceeded
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // GetAddressFundedBy returns the address that funded the specified address and its relative age
@@ -485,8 +579,10 @@ func (c *HTTPClient) GetAddressFundedBy(ctx context.Context, address string, opt
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -496,25 +592,178 @@ func (c *HTTPClient) GetAddressFundedBy(ctx context.Context, address string, opt
 		params:          params,
 		noFoundReturn:   RespAddressFundedBy{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result RespAddressFundedBy
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
+// FundedAddress is an address whose first funding came from a given funder
+type FundedAddress struct {
+	Address     string `json:"address" bson:"address"`
+	FundingTxn  string `json:"fundingTxn" bson:"fundingTxn"`
+	BlockNumber int64  `json:"blockNumber" bson:"blockNumber"`
+	TimeStamp   string `json:"timeStamp" bson:"timeStamp"`
+	Value       string `json:"value" bson:"value"`
+}
+
+// GetAddressesFundedByOpts contains optional parameters for GetAddressesFundedBy
+type GetAddressesFundedByOpts struct {
+	// StartBlock is the first block of the funder's history to scan
+	// Default: 0 (genesis block)
+	StartBlock int64 `default:"0" json:"startblock"`
+
+	// EndBlock is the last block of the funder's history to scan
+	// Default: 999999999999 (latest block)
+	EndBlock int64 `default:"999999999999" json:"endblock"`
+
+	// MaxCandidates caps the number of recipients checked for first-touch funding
+	// Each candidate costs one eth_getTransactionCount call
+	// Default: 1000
+	MaxCandidates int64 `default:"1000" json:"max_candidates"`
+
+	// ChainID specifies which blockchain network to query
+	// Default: empty (uses client default)
+	ChainID int64 `json:"chainid"`
+
+	// OnLimitExceeded specifies behavior when rate limit is exceeded
+	// Default: RateLimitBlock (wait until a token is available)
+	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+}
+
+// GetAddressesFundedBy returns the addresses a funder brought on-chain
+//
+// This is the reverse of GetAddressFundedBy: it scans the funder's outgoing
+// normal transactions and keeps every recipient whose first transfer from the
+// funder arrived while the recipient still had a zero nonce, i.e. a fresh
+// address. This is the usual starting point for mapping sybil clusters.
+//
+// Args:
+//   - ctx: Context for request cancellation and timeout
+//   - funder: The address whose outgoing transfers are scanned
+//   - opts: Optional parameters (can be nil)
+//
+// Returns:
+//   - []FundedAddress: Freshly funded recipients, in funding order
+//   - error: Error if any request fails
+//
+// Example:
+//
+//	funded, err := client.GetAddressesFundedBy(ctx, funder, &GetAddressesFundedByOpts{
+//	    StartBlock: 18000000,
+//	})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, f := range funded {
+//	    fmt.Printf("%s funded in %s with %s wei\n", f.Address, f.FundingTxn, f.Value)
+//	}
+//
+// Note:
+//   - Costs one txlist call per 10000 funder transactions, plus one call per candidate
+//   - Only native value transfers are considered, token funding is ignored
+//   - A zero nonce only proves the recipient had not sent transactions; it may
+//     still have received funds from elsewhere earlier
+func (c *HTTPClient) GetAddressesFundedBy(ctx context.Context, funder string, opts *GetAddressesFundedByOpts) ([]FundedAddress, error) {
+	if opts == nil {
+		opts = &GetAddressesFundedByOpts{}
+	}
+	if err := ApplyDefaults(opts); err != nil {
+		return nil, err
+	}
+
+	// Collect the first positive transfer to each recipient
+	var candidates []FundedAddress
+	seen := make(map[string]bool)
+	seenTx := make(map[string]bool)
+	startBlock := opts.StartBlock
+	for int64(len(candidates)) < opts.MaxCandidates {
+		txs, err := c.GetNormalTxs(ctx, funder, &GetNormalTxsOpts{
+			StartBlock:      startBlock,
+			EndBlock:        opts.EndBlock,
+			Page:            1,
+			Offset:          10000,
+			Sort:            "asc",
+			ChainID:         opts.ChainID,
+			OnLimitExceeded: opts.OnLimitExceeded,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		newTxs := 0
+		for _, tx := range txs {
+			if seenTx[tx.Hash] {
+				continue
+			}
+			seenTx[tx.Hash] = true
+			newTxs++
+
+			if !strings.EqualFold(tx.From, funder) || tx.To == "" || TxFailed(tx) || TxValue(tx).Sign() <= 0 {
+				continue
+			}
+			recipient := strings.ToLower(tx.To)
+			if seen[recipient] {
+				continue
+			}
+			seen[recipient] = true
+			candidates = append(candidates, FundedAddress{
+				Address:     tx.To,
+				FundingTxn:  tx.Hash,
+				BlockNumber: TxBlockNumber(tx),
+				TimeStamp:   tx.TimeStamp,
+				Value:       tx.Value,
+			})
+			if int64(len(candidates)) >= opts.MaxCandidates {
+				break
+			}
+		}
+
+		// A short page is the end of the history; a page without new txs cannot advance
+		if len(txs) < 10000 || newTxs == 0 {
+			break
+		}
+		startBlock = TxBlockNumber(txs[len(txs)-1])
+	}
+
+	// Keep recipients that had never sent a transaction before being funded
+	var result []FundedAddress
+	for _, candidate := range candidates {
+		if candidate.BlockNumber <= 0 {
+			continue
+		}
+		count, err := c.RpcEthTxCount(ctx, candidate.Address, BlockNumberTag(candidate.BlockNumber-1), &RpcEthTxCountOpts{
+			ChainID:         opts.ChainID,
+			OnLimitExceeded: opts.OnLimitExceeded,
+		})
+		if err != nil {
+			return nil, err
+		}
+		nonce, err := parseHexUint64(count)
+		if err != nil {
+			return nil, fmt.Errorf("etherscan: invalid nonce %q for %s: %w", count, candidate.Address, err)
+		}
+		if nonce == 0 {
+			result = append(result, candidate)
+		}
+	}
+	return result, nil
+}
+
 // GetBlocksValidatedByAddressOpts contains optional parameters for GetBlocksValidatedByAddress
 type GetBlocksValidatedByAddressOpts struct {
 	// BlockType specifies the type of blocks to retrieve
 	// Options:
 	//   - "blocks" (default): Returns canonical blocks validated by the address
 	//   - "uncles": Returns uncle blocks validated by the address
-	BlockType string `default:"blocks" json:"blocktype"`
+	BlockType BlockType `default:"blocks" json:"blocktype"`
 
 	// Page number for pagination
 	// Default: 1
@@ -538,6 +787,14 @@ type GetBlocksValidatedByAddressOpts struct {
 	//   - RateLimitRaise: Return an error when rate limit is exceeded
 	//   - RateLimitSkip: Return false without executing when rate limit is exceeded
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
+
+	// PageInfo, if set, receives the pagination state of the returned page, see PageInfo
+	// Default: nil
+	PageInfo *PageInfo `json:"-"`
 }
 
 // GetBlocksValidatedByAddress returns list of blocks validated by an address
@@ -601,8 +858,12 @@ func (c *HTTPClient) GetBlocksValidatedByAddress(ctx context.Context, address st
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
+	var pageInfo *PageInfo
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
+		pageInfo = opts.PageInfo
 	}
 
 	data, err := c.request(requestParams{
@@ -612,15 +873,17 @@ func (c *HTTPClient) GetBlocksValidatedByAddress(ctx context.Context, address st
 		params:          params,
 		noFoundReturn:   []RespBlockValidated{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result []RespBlockValidated
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
+	recordPageInfo(pageInfo, params, result)
 	return result, nil
 }
 
@@ -644,7 +907,7 @@ type GetBeaconChainWithdrawalsOpts struct {
 
 	// Sort order for the results
 	// Default: "asc" (ascending)
-	Sort string `default:"asc" json:"sort"`
+	Sort SortOrder `default:"asc" json:"sort"`
 
 	// ChainID specifies which blockchain network to query
 	// Default: empty (uses client default)
@@ -653,6 +916,14 @@ type GetBeaconChainWithdrawalsOpts struct {
 	// OnLimitExceeded specifies behavior when rate limit is exceeded
 	// Default: RateLimitBlock (wait until a token is available)
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
+
+	// PageInfo, if set, receives the pagination state of the returned page, see PageInfo
+	// Default: nil
+	PageInfo *PageInfo `json:"-"`
 }
 
 // GetBeaconChainWithdrawals returns list of beacon chain withdrawals made to an address
@@ -713,8 +984,12 @@ func (c *HTTPClient) GetBeaconChainWithdrawals(ctx context.Context, address stri
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
+	var pageInfo *PageInfo
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
+		pageInfo = opts.PageInfo
 	}
 
 	data, err := c.request(requestParams{
@@ -724,15 +999,17 @@ func (c *HTTPClient) GetBeaconChainWithdrawals(ctx context.Context, address stri
 		params:          params,
 		noFoundReturn:   []RespBeaconChainWithdrawal{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result []RespBeaconChainWithdrawal
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
+	recordPageInfo(pageInfo, params, result)
 	return result, nil
 }
 
@@ -750,6 +1027,10 @@ type GetEthBalanceByBlockNumberOpts struct {
 	//   - RateLimitRaise: Return an error when rate limit is exceeded
 	//   - RateLimitSkip: Return false without executing when rate limit is exceeded
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // GetEthBalanceByBlockNumber returns historical Eth balance for a single address at a specific block number
@@ -809,8 +1090,10 @@ func (c *HTTPClient) GetEthBalanceByBlockNumber(ctx context.Context, address str
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -820,6 +1103,7 @@ func (c *HTTPClient) GetEthBalanceByBlockNumber(ctx context.Context, address str
 		params:          params,
 		noFoundReturn:   "0",
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return "", err
@@ -831,6 +1115,141 @@ func (c *HTTPClient) GetEthBalanceByBlockNumber(ctx context.Context, address str
 	return fmt.Sprintf("%v", data), nil
 }
 
+// balanceHistoryRateLimit is the throttle Etherscan applies to balancehistory regardless of tier
+const balanceHistoryRateLimit = 2
+
+// ErrNoBalanceCrossing is returned by FindBalanceCrossing when the balance at hi is still below the threshold
+var ErrNoBalanceCrossing = errors.New("balance does not reach threshold in block range")
+
+// FindBalanceCrossingOpts contains optional parameters for FindBalanceCrossing
+type FindBalanceCrossingOpts struct {
+	// ChainID specifies which blockchain network to query
+	// Default: empty (uses client default)
+	ChainID int64 `json:"chainid"`
+
+	// OnLimitExceeded specifies behavior when rate limit is exceeded
+	// Default: RateLimitBlock (wait until a token is available)
+	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+}
+
+// FindBalanceCrossing returns the first block in [lo, hi] at which the ETH balance of address is >= threshold
+//
+// The block range is binary-searched with GetEthBalanceByBlockNumber, so only
+// about log2(hi-lo) calls are made. Calls are paced to 2 per second to respect
+// the balancehistory throttle; the pacing is shared by all searches of the client.
+//
+// Args:
+//   - ctx: Context for request cancellation and timeout
+//   - address: Address whose balance is tracked
+//   - threshold: Balance in wei to look for
+//   - lo: First block of the search range
+//   - hi: Last block of the search range
+//   - opts: Optional parameters (can be nil)
+//
+// Returns:
+//   - int64: The first block with balance >= threshold (lo if already above at lo)
+//   - error: ErrNoBalanceCrossing if the balance at hi is below threshold, an error for a nil or negative threshold, or a request error
+//
+// Example:
+//
+//	// When did the address first hold 100 ETH?
+//	threshold, _ := new(big.Int).SetString("100000000000000000000", 10)
+//	block, err := client.FindBalanceCrossing(ctx, addr, threshold, 15000000, 18000000, nil)
+//	if errors.Is(err, etherscan.ErrNoBalanceCrossing) {
+//	    fmt.Println("never reached")
+//	}
+//
+// Note:
+//   - Requires API Pro (balancehistory is a Pro endpoint)
+//   - Assumes the balance crosses the threshold once in the range; if it dips
+//     below and recovers, one of the crossing blocks is returned, not necessarily the first
+func (c *HTTPClient) FindBalanceCrossing(ctx context.Context, address string, threshold *big.Int, lo, hi int64, opts *FindBalanceCrossingOpts) (int64, error) {
+	if lo > hi {
+		return 0, fmt.Errorf("etherscan: invalid block range %d-%d", lo, hi)
+	}
+	if threshold == nil || threshold.Sign() < 0 {
+		return 0, fmt.Errorf("etherscan: invalid balance threshold %v", threshold)
+	}
+	if opts == nil {
+		opts = &FindBalanceCrossingOpts{}
+	}
+	if err := ApplyDefaults(opts); err != nil {
+		return 0, err
+	}
+
+	reached := func(blockNo int64) (bool, error) {
+		if err := c.waitBalanceHistory(ctx); err != nil {
+			return false, err
+		}
+
+		balance, err := c.GetEthBalanceByBlockNumber(ctx, address, blockNo, &GetEthBalanceByBlockNumberOpts{
+			ChainID:         opts.ChainID,
+			OnLimitExceeded: opts.OnLimitExceeded,
+		})
+		if err != nil {
+			return false, err
+		}
+		wei, ok := new(big.Int).SetString(balance, 10)
+		if !ok {
+			return false, fmt.Errorf("etherscan: invalid balance %q at block %d", balance, blockNo)
+		}
+		return wei.Cmp(threshold) >= 0, nil
+	}
+
+	return searchFirstBlock(lo, hi, reached)
+}
+
+// waitBalanceHistory blocks until a balancehistory call fits the throttle shared by the client's helpers
+func (c *HTTPClient) waitBalanceHistory(ctx context.Context) error {
+	return waitThrottle(ctx, c.balanceHistoryLimiter)
+}
+
+// waitThrottle blocks until limiter, the pacing of an endpoint throttled regardless of tier, lets one call through
+func waitThrottle(ctx context.Context, limiter *RateLimiter) error {
+	for {
+		acquired, err := limiter.Acquire(ctx, 1, nil)
+		if err != nil {
+			return err
+		}
+		if acquired {
+			return nil
+		}
+	}
+}
+
+// searchFirstBlock returns the smallest block in [lo, hi] for which reached is true, assuming reached is monotonic
+func searchFirstBlock(lo, hi int64, reached func(blockNo int64) (bool, error)) (int64, error) {
+	ok, err := reached(hi)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return 0, ErrNoBalanceCrossing
+	}
+	ok, err = reached(lo)
+	if err != nil {
+		return 0, err
+	}
+	if ok {
+		return lo, nil
+	}
+
+	// Invariant: reached(lo) is false, reached(hi) is true
+	for hi-lo > 1 {
+		mid := lo + (hi-lo)/2
+		ok, err := reached(mid)
+		if err != nil {
+			return 0, err
+		}
+		if ok {
+			hi = mid
+		} else {
+			lo = mid
+		}
+	}
+	return hi, nil
+}
+
 // GetContractCreatorAndCreationOpts contains optional parameters for GetContractCreatorAndCreation
 type GetContractCreatorAndCreationOpts struct {
 	// ChainID specifies which blockchain network to query
@@ -845,8 +1264,19 @@ type GetContractCreatorAndCreationOpts struct {
 	//   - RateLimitRaise: Return an error when rate limit is exceeded
 	//   - RateLimitSkip: Return false without executing when rate limit is exceeded
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
+
+	// Strict rejects more than 5 addresses instead of splitting them into several calls
+	// Default: false
+	Strict bool `json:"-"`
 }
 
+// contractCreationsPerCall is the maximum number of addresses of one getcontractcreation call
+const contractCreationsPerCall = 5
+
 // GetContractCreatorAndCreation returns the creator address and creation transaction hash for contracts
 //
 // This endpoint returns information about who created specific contracts and when they were created.
@@ -855,12 +1285,12 @@ type GetContractCreatorAndCreationOpts struct {
 //
 // Args:
 //   - ctx: Context for request cancellation and timeout
-//   - contractAddresses: List of contract addresses to check (maximum 5 addresses)
+//   - contractAddresses: List of contract addresses to check
 //   - opts: Optional parameters (can be nil)
 //
 // Returns:
 //   - []RespContractCreationAndCreation: List of contract creation details
-//   - error: Error if the request fails or more than 5 addresses provided
+//   - error: Error if a request fails, or more than 5 addresses are provided with Strict set
 //
 // Example:
 //
@@ -887,7 +1317,8 @@ type GetContractCreatorAndCreationOpts struct {
 //	})
 //
 // Note:
-//   - Maximum 5 contract addresses per call
+//   - The API takes at most 5 addresses per call; longer lists are split into
+//     batches of 5, each a separate call under the rate limiter, and the results merged in order
 //   - Returns empty slice if no creation info found
 //   - Useful for tracking contract deployment history
 //   - Helps identify contract relationships and developer activity
@@ -898,8 +1329,23 @@ func (c *HTTPClient) GetContractCreatorAndCreation(ctx context.Context, contract
 		return nil, err
 	}
 
-	if len(contractAddresses) > 5 {
-		return nil, fmt.Errorf("maximum 5 contract addresses allowed")
+	if len(contractAddresses) > contractCreationsPerCall {
+		if opts != nil && opts.Strict {
+			return nil, fmt.Errorf("maximum 5 contract addresses allowed")
+		}
+		var all []RespContractCreationAndCreation
+		for start := 0; start < len(contractAddresses); start += contractCreationsPerCall {
+			end := start + contractCreationsPerCall
+			if end > len(contractAddresses) {
+				end = len(contractAddresses)
+			}
+			creations, err := c.GetContractCreatorAndCreation(ctx, contractAddresses[start:end], opts)
+			if err != nil {
+				return nil, err
+			}
+			all = append(all, creations...)
+		}
+		return all, nil
 	}
 
 	// Add required parameters
@@ -907,8 +1353,10 @@ func (c *HTTPClient) GetContractCreatorAndCreation(ctx context.Context, contract
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -918,14 +1366,187 @@ func (c *HTTPClient) GetContractCreatorAndCreation(ctx context.Context, contract
 		params:          params,
 		noFoundReturn:   []RespContractCreationAndCreation{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result []RespContractCreationAndCreation
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
 	return result, nil
 }
+
+// ============================================================================
+// Account Module - Address Activity
+// ============================================================================
+
+// AddressActivity is the breakdown returned by IsAddressActive
+//
+// Checks are run cheapest first and, unless IsAddressActiveOpts.Full is set,
+// stop at the first sign of activity; fields of checks that did not run are
+// false. Checked lists the checks that ran.
+type AddressActivity struct {
+	Address string
+
+	// Nonce is the number of transactions sent by the address
+	Nonce uint64
+
+	// HasCode reports whether the address is a contract (or a delegated EOA)
+	HasCode bool
+
+	// HasBalance reports whether the native balance is non-zero
+	HasBalance bool
+
+	// HasNormalTxs reports whether the address sent or received a normal transaction
+	HasNormalTxs bool
+
+	// HasERC20Transfers reports whether the address sent or received an ERC-20 token
+	HasERC20Transfers bool
+
+	// HasNFTTransfers reports whether the address sent or received an ERC-721 or ERC-1155 token
+	HasNFTTransfers bool
+
+	// Checked lists the checks that ran, e.g. "nonce", "code", "tokentx"
+	Checked []string
+}
+
+// Active reports whether any check found history
+func (a AddressActivity) Active() bool {
+	return a.Nonce > 0 || a.HasCode || a.HasBalance || a.HasNormalTxs || a.HasERC20Transfers || a.HasNFTTransfers
+}
+
+// IsAddressActiveOpts contains optional parameters for IsAddressActive
+type IsAddressActiveOpts struct {
+	// Full runs every check instead of stopping at the first sign of activity
+	// Default: false
+	Full bool
+
+	// ChainID specifies which blockchain network to query
+	// Default: empty (uses client default)
+	ChainID int64
+
+	// OnLimitExceeded specifies behavior when rate limit is exceeded
+	// Default: RateLimitBlock (wait until a token is available)
+	OnLimitExceeded RateLimitBehavior `default:""`
+}
+
+// IsAddressActive cheaply determines whether an address has any on-chain history
+//
+// It checks, in order: nonce (eth_getTransactionCount), code (eth_getCode),
+// native balance, then the first entry of the normal, ERC-20, ERC-721 and
+// ERC-1155 transfer lists (offset=1). For a fresh address all seven calls run;
+// for a used one usually only the first.
+//
+// Args:
+//   - ctx: Context for request cancellation and timeout
+//   - address: Address to check
+//   - opts: Optional parameters (can be nil)
+//
+// Returns:
+//   - *AddressActivity: Breakdown of the checks that ran
+//   - error: Error if any request fails
+//
+// Example:
+//
+//	activity, err := client.IsAddressActive(ctx, candidate, nil)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	if !activity.Active() {
+//	    fmt.Println("Address is unused")
+//	}
+func (c *HTTPClient) IsAddressActive(ctx context.Context, address string, opts *IsAddressActiveOpts) (*AddressActivity, error) {
+	if opts == nil {
+		opts = &IsAddressActiveOpts{}
+	}
+	if err := ApplyDefaults(opts); err != nil {
+		return nil, err
+	}
+
+	activity := &AddressActivity{Address: address}
+	checks := []struct {
+		name string
+		run  func() error
+	}{
+		{"nonce", func() error {
+			count, err := c.RpcEthTxCount(ctx, address, BlockTagLatest, &RpcEthTxCountOpts{
+				ChainID:         opts.ChainID,
+				OnLimitExceeded: opts.OnLimitExceeded,
+			})
+			if err != nil {
+				return err
+			}
+			activity.Nonce, err = parseHexUint64(count)
+			return err
+		}},
+		{"code", func() error {
+			code, err := c.RpcEthGetCode(ctx, address, &RpcEthGetCodeOpts{
+				ChainID:         opts.ChainID,
+				OnLimitExceeded: opts.OnLimitExceeded,
+			})
+			activity.HasCode = code != "" && code != "0x"
+			return err
+		}},
+		{"balance", func() error {
+			balance, err := c.GetEthBalance(ctx, address, &GetEthBalanceOpts{
+				ChainID:         opts.ChainID,
+				OnLimitExceeded: opts.OnLimitExceeded,
+			})
+			activity.HasBalance = balance != "" && strings.TrimLeft(balance, "0") != ""
+			return err
+		}},
+		{"txlist", func() error {
+			txs, err := c.GetNormalTxs(ctx, address, &GetNormalTxsOpts{
+				Offset:          1,
+				ChainID:         opts.ChainID,
+				OnLimitExceeded: opts.OnLimitExceeded,
+			})
+			activity.HasNormalTxs = len(txs) > 0
+			return err
+		}},
+		{"tokentx", func() error {
+			txs, err := c.GetERC20TokenTransfers(ctx, &GetERC20TokenTransfersOpts{
+				Address:         address,
+				Offset:          1,
+				ChainID:         opts.ChainID,
+				OnLimitExceeded: opts.OnLimitExceeded,
+			})
+			activity.HasERC20Transfers = len(txs) > 0
+			return err
+		}},
+		{"tokennfttx", func() error {
+			txs, err := c.GetERC721TokenTransfers(ctx, &GetERC721TokenTransfersOpts{
+				Address:         address,
+				Offset:          1,
+				ChainID:         opts.ChainID,
+				OnLimitExceeded: opts.OnLimitExceeded,
+			})
+			activity.HasNFTTransfers = activity.HasNFTTransfers || len(txs) > 0
+			return err
+		}},
+		{"token1155tx", func() error {
+			txs, err := c.GetERC1155TokenTransfers(ctx, &GetERC1155TokenTransfersOpts{
+				Address:         address,
+				Offset:          1,
+				ChainID:         opts.ChainID,
+				OnLimitExceeded: opts.OnLimitExceeded,
+			})
+			activity.HasNFTTransfers = activity.HasNFTTransfers || len(txs) > 0
+			return err
+		}},
+	}
+
+	for _, check := range checks {
+		if activity.Active() && !opts.Full {
+			break
+		}
+		if err := check.run(); err != nil {
+			return nil, fmt.Errorf("etherscan: %s check of %s: %w", check.name, address, err)
+		}
+		activity.Checked = append(activity.Checked, check.name)
+	}
+	return activity, nil
+}
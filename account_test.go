@@ -2,6 +2,13 @@ package etherscan
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -133,6 +140,29 @@ func TestGetAddressFundedBy(t *testing.T) {
 	}
 }
 
+func TestGetAddressesFundedBy(t *testing.T) {
+	config := GetTestConfig(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	funded, err := config.Client.GetAddressesFundedBy(ctx, TestAddresses.VitalikButerin, &GetAddressesFundedByOpts{
+		StartBlock:    TestBlocks.RecentBlock - 100000,
+		EndBlock:      TestBlocks.RecentBlock,
+		MaxCandidates: 5,
+		ChainID:       1, // Ethereum mainnet
+	})
+	if err != nil {
+		t.Fatalf("GetAddressesFundedBy failed: %v", err)
+	}
+
+	t.Logf("Found %d freshly funded addresses", len(funded))
+	for _, f := range funded {
+		if f.FundingTxn == "" {
+			t.Error("FundingTxn field is empty")
+		}
+	}
+}
+
 func TestGetBlocksValidatedByAddress(t *testing.T) {
 	config := GetTestConfig(t)
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
@@ -214,6 +244,83 @@ func TestGetEthBalanceByBlockNumber(t *testing.T) {
 	}
 }
 
+func TestSearchFirstBlock(t *testing.T) {
+	calls := 0
+	reached := func(blockNo int64) (bool, error) {
+		calls++
+		return blockNo >= 123456, nil
+	}
+
+	block, err := searchFirstBlock(100000, 200000, reached)
+	if err != nil {
+		t.Fatalf("searchFirstBlock failed: %v", err)
+	}
+	if block != 123456 {
+		t.Errorf("expected block 123456, got %d", block)
+	}
+	if calls > 20 {
+		t.Errorf("expected a logarithmic number of calls, got %d", calls)
+	}
+
+	if block, _ := searchFirstBlock(200000, 300000, reached); block != 200000 {
+		t.Errorf("expected lo when already above threshold, got %d", block)
+	}
+	if _, err := searchFirstBlock(0, 1000, reached); !errors.Is(err, ErrNoBalanceCrossing) {
+		t.Errorf("expected ErrNoBalanceCrossing, got %v", err)
+	}
+}
+
+func TestFindBalanceCrossing(t *testing.T) {
+	config := GetTestConfig(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	// 1 wei: the first block at which the address held any ETH
+	block, err := config.Client.FindBalanceCrossing(ctx, TestAddresses.VitalikButerin, big.NewInt(1), 0, TestBlocks.RecentBlock, &FindBalanceCrossingOpts{
+		ChainID: 1, // Ethereum mainnet
+	})
+	if err != nil {
+		t.Fatalf("FindBalanceCrossing failed: %v", err)
+	}
+
+	t.Logf("Balance first reached 1 wei at block %d", block)
+}
+
+func TestFindBalanceCrossingThrottle(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Write([]byte(`{"status":"1","message":"OK","result":"5"}`))
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+	})
+	ctx := context.Background()
+
+	for _, threshold := range []*big.Int{nil, big.NewInt(-1)} {
+		if _, err := client.FindBalanceCrossing(ctx, testAddr, threshold, 0, 10, nil); err == nil {
+			t.Errorf("expected an error for threshold %v", threshold)
+		}
+	}
+	if calls.Load() != 0 {
+		t.Fatalf("expected no requests for invalid thresholds, got %d", calls.Load())
+	}
+
+	// Each search costs two calls (hi, then lo); the 2/s throttle spans both searches
+	start := time.Now()
+	for range 2 {
+		if block, err := client.FindBalanceCrossing(ctx, testAddr, big.NewInt(1), 5, 10, nil); err != nil || block != 5 {
+			t.Fatalf("expected block 5, got %d, %v", block, err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 900*time.Millisecond {
+		t.Errorf("expected consecutive searches to share the throttle, took %s", elapsed)
+	}
+}
+
 func TestGetContractCreatorAndCreation(t *testing.T) {
 	config := GetTestConfig(t)
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
@@ -241,3 +348,97 @@ func TestGetContractCreatorAndCreation(t *testing.T) {
 		}
 	}
 }
+
+func TestGetContractCreatorAndCreationChunks(t *testing.T) {
+	var batches []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contracts := strings.Split(r.URL.Query().Get("contractaddresses"), ",")
+		batches = append(batches, r.URL.Query().Get("contractaddresses"))
+		var creations []string
+		for _, contract := range contracts {
+			creations = append(creations, `{"contractAddress":"`+contract+`","contractCreator":"0xcreator"}`)
+		}
+		w.Write([]byte(`{"status":"1","message":"OK","result":[` + strings.Join(creations, ",") + `]}`))
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+		MaxRetries: -1,
+	})
+	ctx := context.Background()
+
+	var contracts []string
+	for i := range 12 {
+		contracts = append(contracts, fmt.Sprintf("0x%040x", i+1))
+	}
+	creations, err := client.GetContractCreatorAndCreation(ctx, contracts, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(batches) != 3 || len(creations) != 12 {
+		t.Fatalf("expected 12 creations from 3 calls, got %d from %v", len(creations), batches)
+	}
+	for i, creation := range creations {
+		if creation.ContractAddress != contracts[i] {
+			t.Errorf("expected creation %d for %s, got %s", i, contracts[i], creation.ContractAddress)
+		}
+	}
+
+	batches = nil
+	if _, err := client.GetContractCreatorAndCreation(ctx, contracts, &GetContractCreatorAndCreationOpts{Strict: true}); err == nil || len(batches) != 0 {
+		t.Errorf("expected Strict to reject 12 addresses without a call, got %v after %d calls", err, len(batches))
+	}
+}
+
+func newActivityTestClient(t *testing.T, responses map[string]string) (*HTTPClient, *[]string) {
+	t.Helper()
+	var actions []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		action := r.URL.Query().Get("action")
+		actions = append(actions, action)
+		if body, ok := responses[action]; ok {
+			w.Write([]byte(body))
+			return
+		}
+		w.Write([]byte(`{"status":"0","message":"No transactions found","result":[]}`))
+	}))
+	t.Cleanup(server.Close)
+
+	return NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+	}), &actions
+}
+
+func TestIsAddressActive(t *testing.T) {
+	fresh := map[string]string{
+		"eth_getTransactionCount": `{"jsonrpc":"2.0","id":1,"result":"0x0"}`,
+		"eth_getCode":             `{"jsonrpc":"2.0","id":1,"result":"0x"}`,
+		"balance":                 `{"status":"1","message":"OK","result":"0"}`,
+	}
+	client, actions := newActivityTestClient(t, fresh)
+	activity, err := client.IsAddressActive(context.Background(), TestAddresses.VitalikButerin, nil)
+	if err != nil {
+		t.Fatalf("IsAddressActive failed: %v", err)
+	}
+	if activity.Active() || len(activity.Checked) != 7 || len(*actions) != 7 {
+		t.Errorf("expected a fresh address after 7 checks, got %+v (calls %v)", activity, *actions)
+	}
+
+	used := map[string]string{
+		"eth_getTransactionCount": `{"jsonrpc":"2.0","id":1,"result":"0x0"}`,
+		"eth_getCode":             `{"jsonrpc":"2.0","id":1,"result":"0x"}`,
+		"balance":                 `{"status":"1","message":"OK","result":"0"}`,
+		"txlist":                  `{"status":"1","message":"OK","result":[{"hash":"0x1"}]}`,
+	}
+	client, actions = newActivityTestClient(t, used)
+	activity, err = client.IsAddressActive(context.Background(), TestAddresses.VitalikButerin, nil)
+	if err != nil {
+		t.Fatalf("IsAddressActive failed: %v", err)
+	}
+	if !activity.Active() || !activity.HasNormalTxs || len(*actions) != 4 {
+		t.Errorf("expected to stop after the txlist check, got %+v (calls %v)", activity, *actions)
+	}
+}
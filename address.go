@@ -0,0 +1,135 @@
+package etherscan
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ============================================================================
+// Address Validation
+// ============================================================================
+
+// ErrInvalidAddress is returned when an address is rejected before being sent
+var ErrInvalidAddress = errors.New("invalid address")
+
+// AddressError describes a rejected address
+//
+// It matches ErrInvalidAddress with errors.Is.
+type AddressError struct {
+	Address string
+	Reason  string
+}
+
+// Error implements the error interface
+func (e *AddressError) Error() string {
+	return fmt.Sprintf("etherscan: invalid address %q: %s", e.Address, e.Reason)
+}
+
+// Unwrap returns ErrInvalidAddress
+func (e *AddressError) Unwrap() error {
+	return ErrInvalidAddress
+}
+
+// addressParams lists the request parameters holding addresses, possibly comma-separated
+var addressParams = []string{"address", "contractaddress", "contractaddresses", "from", "to"}
+
+// NormalizeAddress validates an address and returns it in lowercase
+//
+// The address must be 0x followed by 40 hex digits. All-lowercase and
+// all-uppercase addresses carry no checksum and are accepted as they are;
+// mixed-case addresses must match their EIP-55 checksum, so a mistyped
+// character is caught instead of silently querying an empty account.
+//
+// Args:
+//   - s: The address, surrounding whitespace ignored
+//
+// Returns:
+//   - string: The address in lowercase, as every API method sends it
+//   - error: *AddressError (matching ErrInvalidAddress) if the address is malformed or its checksum is wrong
+//
+// Example:
+//
+//	addr, err := etherscan.NormalizeAddress("0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045")
+//	if errors.Is(err, etherscan.ErrInvalidAddress) {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(addr) // 0xd8da6bf26964af9d7eed9e03e53415d37aa96045
+func NormalizeAddress(s string) (string, error) {
+	s = strings.TrimSpace(s)
+	digits, ok := strings.CutPrefix(s, "0x")
+	if !ok {
+		digits, ok = strings.CutPrefix(s, "0X")
+	}
+	if !ok {
+		return "", &AddressError{Address: s, Reason: "missing 0x prefix"}
+	}
+	if len(digits) != 40 {
+		return "", &AddressError{Address: s, Reason: fmt.Sprintf("expected 40 hex digits, got %d", len(digits))}
+	}
+	if _, err := hex.DecodeString(digits); err != nil {
+		return "", &AddressError{Address: s, Reason: "not hexadecimal"}
+	}
+
+	lower := strings.ToLower(digits)
+	if digits != lower && digits != strings.ToUpper(digits) && digits != checksumDigits(lower) {
+		return "", &AddressError{Address: s, Reason: "EIP-55 checksum mismatch"}
+	}
+	return "0x" + lower, nil
+}
+
+// ChecksumAddress validates an address and returns it in its EIP-55 checksummed form
+//
+// Args:
+//   - s: The address in any case
+//
+// Returns:
+//   - string: The checksummed address, e.g. for display or for tools requiring it
+//   - error: *AddressError (matching ErrInvalidAddress) if NormalizeAddress rejects the address
+func ChecksumAddress(s string) (string, error) {
+	addr, err := NormalizeAddress(s)
+	if err != nil {
+		return "", err
+	}
+	return "0x" + checksumDigits(addr[2:]), nil
+}
+
+// checksumDigits applies EIP-55 to 40 lowercase hex digits
+//
+// A letter is uppercased when the matching nibble of the Keccak-256 hash of
+// the lowercase digits is 8 or more.
+func checksumDigits(lower string) string {
+	hash := Keccak256([]byte(lower))
+	out := []byte(lower)
+	for i, ch := range out {
+		nibble := hash[i/2] >> 4
+		if i%2 == 1 {
+			nibble = hash[i/2] & 0x0f
+		}
+		if ch >= 'a' && nibble >= 8 {
+			out[i] = ch - 'a' + 'A'
+		}
+	}
+	return string(out)
+}
+
+// normalizeAddressParams validates and lowercases the address parameters of a request in place
+func normalizeAddressParams(params map[string]string) error {
+	for _, name := range addressParams {
+		value := params[name]
+		if value == "" {
+			continue
+		}
+		addresses := strings.Split(value, ",")
+		for i, address := range addresses {
+			normalized, err := NormalizeAddress(address)
+			if err != nil {
+				return err
+			}
+			addresses[i] = normalized
+		}
+		params[name] = strings.Join(addresses, ",")
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package etherscan
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// Placeholder addresses of the offline tests, valid and lowercase as requests send them
+const (
+	testAddr     = "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	testContract = "0xcccccccccccccccccccccccccccccccccccccccc"
+	testPeer     = "0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"
+)
+
+func TestChecksumAddress(t *testing.T) {
+	// Test vectors from EIP-55
+	vectors := []string{
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		"0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+		"0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB",
+		"0xD1220A0cf47c7B9Be7A2E6BA89F429762e7b9aDb",
+	}
+	for _, want := range vectors {
+		for _, input := range []string{want, strings.ToLower(want), "0x" + strings.ToUpper(want[2:])} {
+			got, err := ChecksumAddress(input)
+			if err != nil || got != want {
+				t.Errorf("%s: expected %s, got %s (%v)", input, want, got, err)
+			}
+		}
+		if got, err := NormalizeAddress(" " + want + "\n"); err != nil || got != strings.ToLower(want) {
+			t.Errorf("%s: expected the lowercase address, got %s (%v)", want, got, err)
+		}
+	}
+}
+
+func TestNormalizeAddressRejects(t *testing.T) {
+	cases := map[string]string{
+		"no prefix":    "5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		"too short":    "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeA",
+		"too long":     "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed00",
+		"not hex":      "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeg",
+		"bad checksum": "0x5AAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		"empty":        "",
+	}
+	for name, input := range cases {
+		_, err := NormalizeAddress(input)
+		var addrErr *AddressError
+		if !errors.Is(err, ErrInvalidAddress) || !errors.As(err, &addrErr) {
+			t.Errorf("%s: expected an AddressError, got %v", name, err)
+		}
+	}
+}
+
+func TestRequestAddressNormalization(t *testing.T) {
+	var calls int
+	var sent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		sent = r.URL.Query().Get("address")
+		w.Write([]byte(`{"status":"1","message":"OK","result":[]}`))
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+		MaxRetries: -1,
+	})
+	ctx := context.Background()
+	if _, err := client.GetEthBalances(ctx, []string{"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", "0xFB6916095CA1DF60BB79CE92CE3EA74C37C5D359"}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if sent != "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed,0xfb6916095ca1df60bb79ce92ce3ea74c37c5d359" {
+		t.Errorf("expected lowercase addresses to be sent, got %q", sent)
+	}
+
+	if _, err := client.GetNormalTxs(ctx, "0x5AAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", nil); !errors.Is(err, ErrInvalidAddress) {
+		t.Errorf("expected ErrInvalidAddress, got %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("expected the invalid address not to be sent, got %d calls", calls)
+	}
+
+	// The bypass sends addresses as given
+	raw := NewHTTPClient(HTTPClientConfig{
+		APIVersion:            APIVersionV1,
+		V1BaseURLs:            map[int]string{EthereumMainnet: server.URL},
+		MaxRetries:            -1,
+		SkipAddressValidation: true,
+	})
+	if _, err := raw.GetEthBalances(ctx, []string{"0xnotanaddress"}, nil); err != nil || sent != "0xnotanaddress" {
+		t.Errorf("expected the address to be sent unchanged, got %q, %v", sent, err)
+	}
+}
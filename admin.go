@@ -26,6 +26,10 @@ type GetAddressTagOpts struct {
 	//   - RateLimitRaise: Return an error when rate limit is exceeded
 	//   - RateLimitSkip: Return false without executing when rate limit is exceeded
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // GetAddressTag returns address name tag and metadata
@@ -88,8 +92,10 @@ func (c *HTTPClient) GetAddressTag(ctx context.Context, addresses []string, opts
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -99,13 +105,14 @@ func (c *HTTPClient) GetAddressTag(ctx context.Context, addresses []string, opts
 		params:          params,
 		noFoundReturn:   []RespAddressTag{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result []RespAddressTag
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
 	return result, nil
@@ -125,6 +132,10 @@ type GetLabelMasterlistOpts struct {
 	//   - RateLimitRaise: Return an error when rate limit is exceeded
 	//   - RateLimitSkip: Return false without executing when rate limit is exceeded
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // GetLabelMasterlist returns the masterlist of available label groupings
@@ -173,8 +184,10 @@ func (c *HTTPClient) GetLabelMasterlist(ctx context.Context, opts *GetLabelMaste
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -185,13 +198,14 @@ func (c *HTTPClient) GetLabelMasterlist(ctx context.Context, opts *GetLabelMaste
 		baseURL:         APIAshx,
 		noFoundReturn:   []RespLabelMaster{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result []RespLabelMaster
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
 	return result, nil
@@ -360,6 +374,10 @@ type GetLatestCSVBatchNumberOpts struct {
 	//   - RateLimitRaise: Return an error when rate limit is exceeded
 	//   - RateLimitSkip: Return false without executing when rate limit is exceeded
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // GetLatestCSVBatchNumber gets the latest running number for CSV Export
@@ -399,8 +417,10 @@ func (c *HTTPClient) GetLatestCSVBatchNumber(ctx context.Context, opts *GetLates
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -410,13 +430,14 @@ func (c *HTTPClient) GetLatestCSVBatchNumber(ctx context.Context, opts *GetLates
 		params:          params,
 		noFoundReturn:   []RespLatestCSVBatchNumber{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result []RespLatestCSVBatchNumber
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
 	return result, nil
@@ -440,6 +461,10 @@ type CheckCreditUsageOpts struct {
 	//   - RateLimitRaise: Return an error when rate limit is exceeded
 	//   - RateLimitSkip: Return false without executing when rate limit is exceeded
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // CheckCreditUsage returns information about API credit usage and limits
@@ -490,8 +515,10 @@ func (c *HTTPClient) CheckCreditUsage(ctx context.Context, opts *CheckCreditUsag
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -501,13 +528,14 @@ func (c *HTTPClient) CheckCreditUsage(ctx context.Context, opts *CheckCreditUsag
 		params:          params,
 		noFoundReturn:   RespCreditUsage{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result RespCreditUsage
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
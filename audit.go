@@ -0,0 +1,174 @@
+package etherscan
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// ============================================================================
+// Audit Trail
+// ============================================================================
+
+// auditKey is the context key of the audit trail
+type auditKey struct{}
+
+// AuditRecord proves what one API request returned and when
+type AuditRecord struct {
+	Module  string `json:"module" bson:"module"`
+	Action  string `json:"action" bson:"action"`
+	ChainID int64  `json:"chainId" bson:"chainId"`
+
+	// Tag is the WithRequestTag tag of the request, "" if none
+	Tag string `json:"tag,omitempty" bson:"tag,omitempty"`
+
+	// Params are the request parameters, without the API key
+	Params map[string]string `json:"params" bson:"params"`
+
+	// FetchedAt is when the response arrived, in UTC
+	FetchedAt time.Time `json:"fetchedAt" bson:"fetchedAt"`
+
+	// BlockHead is the chain head right after the fetch, 0 unless AuditOpts.BlockHead is set
+	BlockHead int64 `json:"blockHead" bson:"blockHead"`
+
+	// Result is the canonical JSON of the result and ContentHash its hex SHA-256
+	Result      json.RawMessage `json:"result" bson:"result"`
+	ContentHash string          `json:"contentHash" bson:"contentHash"`
+}
+
+// AuditOpts contains optional parameters for WithAuditTrail
+type AuditOpts struct {
+	// BlockHead records the chain head after every request, at the cost of one proxy call each
+	// Default: false
+	BlockHead bool
+}
+
+// AuditTrail collects an AuditRecord per request made with its context
+//
+// AuditTrail is safe for concurrent use.
+type AuditTrail struct {
+	opts AuditOpts
+
+	mu      sync.Mutex
+	records []AuditRecord
+}
+
+// WithAuditTrail returns a context whose requests are recorded in the returned trail
+//
+// Every successful request made with the returned context, or a context
+// derived from it, adds a record holding the canonical JSON of its result,
+// the SHA-256 of it, the fetch time and optionally the block head, so a
+// compliance pipeline can prove what data was retrieved and when. Pages of a
+// paginated helper are recorded one by one. Canonical JSON has sorted object
+// keys and no insignificant whitespace; CanonicalHash recomputes the hash of
+// an archived result.
+//
+// Example:
+//
+//	ctx, trail := etherscan.WithAuditTrail(ctx, &etherscan.AuditOpts{BlockHead: true})
+//	txs, err := client.GetNormalTxs(ctx, addr, nil)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, r := range trail.Records() {
+//	    fmt.Printf("%s %s at %s (head %d): sha256 %s\n", r.Module, r.Action, r.FetchedAt, r.BlockHead, r.ContentHash)
+//	}
+func WithAuditTrail(ctx context.Context, opts *AuditOpts) (context.Context, *AuditTrail) {
+	trail := &AuditTrail{}
+	if opts != nil {
+		trail.opts = *opts
+	}
+	return context.WithValue(ctx, auditKey{}, trail), trail
+}
+
+// Records returns the records so far, in the order the responses arrived
+func (t *AuditTrail) Records() []AuditRecord {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	records := make([]AuditRecord, len(t.records))
+	copy(records, t.records)
+	return records
+}
+
+// CanonicalHash returns the canonical JSON of data and its hex SHA-256
+//
+// data is re-encoded with sorted object keys, compact separators and numbers
+// kept as written, so equal JSON values hash equally however they were
+// formatted.
+func CanonicalHash(data []byte) (json.RawMessage, string, error) {
+	value, err := decodeJSONValue(data)
+	if err != nil {
+		return nil, "", err
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(value); err != nil {
+		return nil, "", err
+	}
+	canonical := bytes.TrimSuffix(buf.Bytes(), []byte("\n"))
+	sum := sha256.Sum256(canonical)
+	return canonical, hex.EncodeToString(sum[:]), nil
+}
+
+// recordAudit adds the result of a successful request to the audit trail of ctx, if any
+func (c *HTTPClient) recordAudit(params requestParams, data any) {
+	trail, _ := params.ctx.Value(auditKey{}).(*AuditTrail)
+	if trail == nil {
+		return
+	}
+	fetchedAt := time.Now().UTC()
+
+	// JSON-RPC responses keep their envelope; only the result is data
+	if envelope, ok := data.(map[string]any); ok {
+		if _, isRPC := envelope["jsonrpc"]; isRPC {
+			data = envelope["result"]
+		}
+	}
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return
+	}
+	canonical, hash, err := CanonicalHash(encoded)
+	if err != nil {
+		return
+	}
+	record := AuditRecord{
+		Module:      params.module,
+		Action:      params.action,
+		ChainID:     int64(c.defaultChainID),
+		Tag:         RequestTag(params.ctx),
+		Params:      make(map[string]string, len(params.params)),
+		FetchedAt:   fetchedAt,
+		Result:      canonical,
+		ContentHash: hash,
+	}
+	for k, v := range params.params {
+		if v != "" {
+			record.Params[k] = v
+		}
+	}
+	if id, err := strconv.ParseInt(params.params["chainid"], 10, 64); err == nil && id != 0 {
+		record.ChainID = id
+	}
+	delete(record.Params, "chainid")
+
+	if trail.opts.BlockHead {
+		// The head lookup itself is not recorded
+		ctx := context.WithValue(params.ctx, auditKey{}, (*AuditTrail)(nil))
+		if head, err := c.RpcEthBlockNumber(ctx, &RpcEthBlockNumberOpts{ChainID: record.ChainID}); err == nil {
+			if block, err := parseHexUint64(head); err == nil {
+				record.BlockHead = int64(block)
+			}
+		}
+	}
+
+	trail.mu.Lock()
+	trail.records = append(trail.records, record)
+	trail.mu.Unlock()
+}
@@ -0,0 +1,82 @@
+package etherscan
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestCanonicalHash(t *testing.T) {
+	a, hashA, err := CanonicalHash([]byte(`{ "b": [1, 2.50, "x<y"], "a": {"d": null, "c": true} }`))
+	if err != nil {
+		t.Fatalf("CanonicalHash failed: %v", err)
+	}
+	if string(a) != `{"a":{"c":true,"d":null},"b":[1,2.50,"x<y"]}` {
+		t.Errorf("unexpected canonical JSON %s", a)
+	}
+	_, hashB, _ := CanonicalHash([]byte(`{"a":{"c":true,"d":null},"b":[1,2.50,"x<y"]}`))
+	if hashA != hashB || len(hashA) != 64 {
+		t.Errorf("expected equal hashes, got %s and %s", hashA, hashB)
+	}
+	if _, _, err := CanonicalHash([]byte(`{"a":`)); err == nil {
+		t.Error("expected an error for invalid JSON")
+	}
+}
+
+func TestWithAuditTrail(t *testing.T) {
+	var rateLimited bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("action") {
+		case "balance":
+			if !rateLimited {
+				rateLimited = true
+				w.Write([]byte(`{"status":"0","message":"Maximum rate limit reached","result":null}`))
+				return
+			}
+			w.Write([]byte(`{"status":"1","message":"OK","result":"1000"}`))
+		case "eth_blockNumber":
+			w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x10"}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIKey:     "SECRET",
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+		RetryDelay: time.Millisecond,
+	})
+
+	if _, err := client.GetEthBalance(context.Background(), TestAddresses.VitalikButerin, nil); err != nil {
+		t.Fatalf("unaudited request failed: %v", err)
+	}
+
+	ctx, trail := WithAuditTrail(context.Background(), &AuditOpts{BlockHead: true})
+	rateLimited = false
+	if _, err := client.GetEthBalance(ctx, TestAddresses.VitalikButerin, nil); err != nil {
+		t.Fatalf("audited request failed: %v", err)
+	}
+	if _, err := client.RpcEthBlockNumber(ctx, nil); err != nil {
+		t.Fatalf("audited proxy request failed: %v", err)
+	}
+
+	records := trail.Records()
+	if len(records) != 2 {
+		t.Fatalf("expected one record per request despite the retry and head lookups, got %+v", records)
+	}
+	r := records[0]
+	if r.Module != "account" || r.Action != "balance" || r.ChainID != EthereumMainnet || r.BlockHead != 16 {
+		t.Errorf("unexpected record %+v", r)
+	}
+	if r.Params["address"] != TestAddresses.VitalikButerin || r.Params["apikey"] != "" || r.FetchedAt.Location() != time.UTC {
+		t.Errorf("unexpected params or time %+v", r)
+	}
+	if _, hash, _ := CanonicalHash([]byte(`"1000"`)); string(r.Result) != `"1000"` || r.ContentHash != hash {
+		t.Errorf("unexpected result hash %s of %s", r.ContentHash, r.Result)
+	}
+	if string(records[1].Result) != `"0x10"` {
+		t.Errorf("expected the JSON-RPC result without its envelope, got %s", records[1].Result)
+	}
+}
@@ -0,0 +1,299 @@
+package etherscan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/dwdwow/etherscan-go/parallel"
+)
+
+// ============================================================================
+// Event Backfill
+// ============================================================================
+
+// BackfillProgress reports how far a BackfillEvents run has come
+type BackfillProgress struct {
+	// FromBlock and ToBlock are the range being backfilled
+	FromBlock int64 `json:"fromBlock"`
+	ToBlock   int64 `json:"toBlock"`
+
+	// Next is the first block not delivered to the sink yet
+	Next int64 `json:"next"`
+
+	// Logs counts the logs delivered to the sink
+	Logs int64 `json:"logs"`
+
+	// Calls counts getLogs requests; FullPages those that hit the 1000-log cap
+	// and had their range split or their block paged
+	Calls     int64 `json:"calls"`
+	FullPages int64 `json:"fullPages"`
+
+	// Retries counts requests repeated after a transient error
+	Retries int64 `json:"retries"`
+
+	Elapsed time.Duration `json:"elapsed"`
+}
+
+// Fraction returns the share of the block range delivered, between 0 and 1
+func (p BackfillProgress) Fraction() float64 {
+	total := p.ToBlock - p.FromBlock + 1
+	if total <= 0 {
+		return 1
+	}
+	return float64(p.Next-p.FromBlock) / float64(total)
+}
+
+// BackfillEventsOpts contains optional parameters for BackfillEvents
+type BackfillEventsOpts struct {
+	// ChunkSize is the number of blocks fetched by one worker at a time
+	// Default: 50000
+	// Chunks still holding more than 1000 logs are bisected further
+	ChunkSize int64 `default:"50000"`
+
+	// Concurrency is the number of chunks fetched at once
+	// Default: 2
+	Concurrency int `default:"2"`
+
+	// MaxRetries is the number of times a request failing with a transient error is repeated
+	// Default: 3
+	MaxRetries int `default:"3"`
+
+	// RetryDelay is the pause before repeating a request
+	// Default: 0 (2 seconds)
+	RetryDelay time.Duration
+
+	// Resume continues a backfill from a checkpoint passed to the sink
+	// Default: nil (start at from)
+	Resume *Checkpoint
+
+	// ChainID specifies which blockchain network to query
+	// Default: empty (uses client default)
+	ChainID int64
+
+	// OnLimitExceeded specifies behavior when rate limit is exceeded
+	// Default: RateLimitBlock (wait until a token is available)
+	OnLimitExceeded RateLimitBehavior `default:""`
+}
+
+// backfillBatch is a batch of logs emitted while walking a chunk, with the checkpoint after it
+type backfillBatch struct {
+	logs []RespEventLogByAddressFilteredByTopics
+	next Checkpoint
+}
+
+// backfillChunk is the outcome of fetching one chunk: the batches in block order and the error that stopped it
+type backfillChunk struct {
+	batches []backfillBatch
+	err     error
+}
+
+// BackfillEvents fetches every log of a contract in a block range into sink
+//
+// The range is cut into chunks of ChunkSize blocks fetched by Concurrency
+// workers. A request returning the 1000-log cap has its range bisected, and a
+// single block over the cap is paged through, so no log is silently dropped.
+// Transient errors are retried MaxRetries times. The sink receives the logs
+// strictly in block order, in batches that each end on a block boundary,
+// together with the checkpoint after them: saving it (SaveCheckpoint) and
+// passing it back as Resume continues an interrupted backfill without gaps or
+// duplicates. If sink returns an error the backfill stops with it.
+//
+// Args:
+//   - ctx: Context for request cancellation and timeout
+//   - contract: The contract address emitting the events
+//   - topics: Up to four topic filters by position, "" matching any topic (can be nil)
+//   - from: The first block
+//   - to: The last block, or 0 for the chain head
+//   - sink: Receives the logs and the checkpoint after them
+//   - progress: Receives a BackfillProgress after every batch and is closed on return (can be nil)
+//   - opts: Optional parameters (can be nil)
+//
+// Returns:
+//   - error: Error if a request fails after retries, the sink fails or ctx ends; everything before it was delivered
+//
+// Example:
+//
+//	resume, _ := etherscan.LoadCheckpoint("transfers.checkpoint")
+//	progress := make(chan etherscan.BackfillProgress, 16)
+//	go func() {
+//	    for p := range progress {
+//	        log.Printf("%.1f%% (%d logs, %d calls)", p.Fraction()*100, p.Logs, p.Calls)
+//	    }
+//	}()
+//	err := client.BackfillEvents(ctx, token, []string{etherscan.TopicTransfer}, 18000000, 0,
+//	    func(logs []etherscan.RespEventLogByAddressFilteredByTopics, next etherscan.Checkpoint) error {
+//	        if err := store(logs); err != nil {
+//	            return err
+//	        }
+//	        return etherscan.SaveCheckpoint("transfers.checkpoint", next)
+//	    }, progress, &etherscan.BackfillEventsOpts{Resume: resume, Concurrency: 3})
+//
+// Note:
+//   - Costs roughly one call per 1000 logs plus one per split; chunks are fetched in waves of Concurrency
+//   - Progress is sent blocking, so the reader must keep up or use a buffered channel
+func (c *HTTPClient) BackfillEvents(ctx context.Context, contract string, topics []string, from, to int64, sink func(logs []RespEventLogByAddressFilteredByTopics, next Checkpoint) error, progress chan<- BackfillProgress, opts *BackfillEventsOpts) error {
+	if progress != nil {
+		defer close(progress)
+	}
+	if opts == nil {
+		opts = &BackfillEventsOpts{}
+	}
+	if err := ApplyDefaults(opts); err != nil {
+		return err
+	}
+	if sink == nil {
+		return errors.New("etherscan: backfill sink is required")
+	}
+	if len(topics) > 4 {
+		return fmt.Errorf("etherscan: at most 4 topics can be filtered, got %d", len(topics))
+	}
+	if opts.ChunkSize <= 0 {
+		opts.ChunkSize = 50000
+	}
+	if opts.RetryDelay <= 0 {
+		opts.RetryDelay = 2 * time.Second
+	}
+
+	if to <= 0 {
+		latest, err := c.RpcEthBlockNumber(ctx, &RpcEthBlockNumberOpts{
+			ChainID:         opts.ChainID,
+			OnLimitExceeded: opts.OnLimitExceeded,
+		})
+		if err != nil {
+			return err
+		}
+		head, err := parseHexUint64(latest)
+		if err != nil {
+			return fmt.Errorf("etherscan: invalid block number %q: %w", latest, err)
+		}
+		to = int64(head)
+	}
+	if from < 0 || from > to {
+		return fmt.Errorf("etherscan: invalid block range %d-%d", from, to)
+	}
+
+	var topic [4]string
+	copy(topic[:], topics)
+	var calls, fullPages, retries atomic.Int64
+	fetch := func(ctx context.Context, fromBlock, toBlock, page int64) ([]RespEventLogByAddressFilteredByTopics, error) {
+		for attempt := 0; ; attempt++ {
+			calls.Add(1)
+			logs, err := c.GetEventLogsByAddressFilteredByTopics(ctx, contract, &GetEventLogsByAddressFilteredByTopicsOpts{
+				FromBlock:       fromBlock,
+				ToBlock:         toBlock,
+				Page:            page,
+				Offset:          logsPerCall,
+				Topic0:          topic[0],
+				Topic1:          topic[1],
+				Topic2:          topic[2],
+				Topic3:          topic[3],
+				ChainID:         opts.ChainID,
+				OnLimitExceeded: opts.OnLimitExceeded,
+			})
+			if err == nil {
+				if len(logs) >= logsPerCall {
+					fullPages.Add(1)
+				}
+				return logs, nil
+			}
+			if !IsTransientError(err) || attempt >= opts.MaxRetries {
+				return nil, err
+			}
+			retries.Add(1)
+			timer := time.NewTimer(opts.RetryDelay)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return nil, ctx.Err()
+			case <-timer.C:
+			}
+		}
+	}
+
+	start := time.Now()
+	report := BackfillProgress{FromBlock: from, ToBlock: to, Next: from}
+	send := func() error {
+		if progress == nil {
+			return nil
+		}
+		report.Calls, report.FullPages, report.Retries = calls.Load(), fullPages.Load(), retries.Load()
+		report.Elapsed = time.Since(start)
+		select {
+		case progress <- report:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	// A checkpoint from GetAllEventLogs may point into a block; that block is refetched and its delivered logs skipped
+	resume := opts.Resume
+	next := from
+	if resume != nil && resume.Block > next {
+		next = resume.Block
+	}
+	report.Next = next
+
+	var pending []RespEventLogByAddressFilteredByTopics
+	deliver := func(batch backfillBatch) error {
+		for _, log := range batch.logs {
+			if block, err := parseHexUint64(log.BlockNumber); err == nil && resume.Seen(int64(block), log.TransactionHash+":"+log.LogIndex) {
+				continue
+			}
+			pending = append(pending, log)
+		}
+		// Checkpoints inside a paged block are not handed out; the block is delivered whole
+		if batch.next.Page > 0 {
+			return nil
+		}
+		if err := sink(pending, batch.next); err != nil {
+			return err
+		}
+		report.Logs += int64(len(pending))
+		report.Next = batch.next.Block
+		pending = nil
+		return send()
+	}
+
+	for next <= to {
+		var chunks [][2]int64
+		for len(chunks) < max(opts.Concurrency, 1) && next <= to {
+			end := next + opts.ChunkSize - 1
+			if end > to {
+				end = to
+			}
+			chunks = append(chunks, [2]int64{next, end})
+			next = end + 1
+		}
+
+		results, err := parallel.Map(ctx, chunks, opts.Concurrency, func(ctx context.Context, chunk [2]int64) (backfillChunk, error) {
+			var result backfillChunk
+			result.err = walkLogsByRange(ctx, chunk[0], chunk[1], func(fromBlock, toBlock, page int64) ([]RespEventLogByAddressFilteredByTopics, error) {
+				return fetch(ctx, fromBlock, toBlock, page)
+			}, func(logs []RespEventLogByAddressFilteredByTopics, next Checkpoint) error {
+				result.batches = append(result.batches, backfillBatch{logs: logs, next: next})
+				return nil
+			})
+			return result, nil
+		})
+		if err != nil {
+			return err
+		}
+
+		// Deliver in block order up to the first chunk that failed
+		for _, result := range results {
+			for _, batch := range result.batches {
+				if err := deliver(batch); err != nil {
+					return err
+				}
+			}
+			if result.err != nil {
+				return result.err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+package etherscan
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// newBackfillServer serves 3 logs per block in [0, 199] and 1500 in block 120, failing the first request with a 502
+func newBackfillServer(t *testing.T) *httptest.Server {
+	var mu sync.Mutex
+	failed := false
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		if !failed {
+			failed = true
+			mu.Unlock()
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		mu.Unlock()
+
+		query := r.URL.Query()
+		if query.Get("topic0") != TopicTransfer {
+			t.Errorf("expected topic0 to be sent, got %q", query.Get("topic0"))
+		}
+		fromBlock, _ := strconv.ParseInt(query.Get("fromblock"), 10, 64)
+		toBlock, _ := strconv.ParseInt(query.Get("toblock"), 10, 64)
+		page, _ := strconv.ParseInt(query.Get("page"), 10, 64)
+		offset, _ := strconv.ParseInt(query.Get("offset"), 10, 64)
+
+		var logs []RespEventLogByAddressFilteredByTopics
+		for b := fromBlock; b <= toBlock && b < 200; b++ {
+			n := 3
+			if b == 120 {
+				n = 1500
+			}
+			for i := 0; i < n; i++ {
+				logs = append(logs, RespEventLogByAddressFilteredByTopics{
+					BlockNumber:     fmt.Sprintf("0x%x", b),
+					TransactionHash: fmt.Sprintf("0x%x", b),
+					LogIndex:        fmt.Sprintf("0x%x", i),
+				})
+			}
+		}
+		start := (page - 1) * offset
+		if start >= int64(len(logs)) {
+			w.Write([]byte(`{"status":"0","message":"No records found","result":[]}`))
+			return
+		}
+		end := start + offset
+		if end > int64(len(logs)) {
+			end = int64(len(logs))
+		}
+		data, _ := json.Marshal(logs[start:end])
+		w.Write([]byte(`{"status":"1","message":"OK","result":` + string(data) + `}`))
+	}))
+}
+
+func TestBackfillEvents(t *testing.T) {
+	server := newBackfillServer(t)
+	defer server.Close()
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+		MaxRetries: -1,
+	})
+
+	seen := map[string]bool{}
+	lastBlock := int64(-1)
+	sink := func(logs []RespEventLogByAddressFilteredByTopics, next Checkpoint) error {
+		for _, log := range logs {
+			block, _ := parseHexUint64(log.BlockNumber)
+			if int64(block) < lastBlock {
+				t.Fatalf("log of block %d after block %d", block, lastBlock)
+			}
+			lastBlock = int64(block)
+			key := log.TransactionHash + ":" + log.LogIndex
+			if seen[key] {
+				t.Fatalf("duplicate log %s", key)
+			}
+			seen[key] = true
+		}
+		if next.Page != 0 || next.Block <= lastBlock {
+			t.Errorf("unexpected checkpoint %+v after block %d", next, lastBlock)
+		}
+		return nil
+	}
+
+	progress := make(chan BackfillProgress, 1000)
+	err := client.BackfillEvents(context.Background(), testContract, []string{TopicTransfer}, 0, 199, sink, progress, &BackfillEventsOpts{
+		ChunkSize:   50,
+		Concurrency: 3,
+		RetryDelay:  time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("BackfillEvents failed: %v", err)
+	}
+	if want := 199*3 + 1500; len(seen) != want {
+		t.Errorf("expected %d logs, got %d", want, len(seen))
+	}
+
+	var last BackfillProgress
+	for p := range progress {
+		last = p
+	}
+	if last.Next != 200 || last.Fraction() != 1 || last.Logs != int64(len(seen)) || last.Retries != 1 || last.FullPages == 0 {
+		t.Errorf("unexpected final progress: %+v", last)
+	}
+}
+
+func TestBackfillEventsResume(t *testing.T) {
+	server := newBackfillServer(t)
+	defer server.Close()
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+		MaxRetries: -1,
+	})
+	opts := &BackfillEventsOpts{ChunkSize: 40, Concurrency: 2, RetryDelay: time.Millisecond}
+
+	// Crash once block 100 has been passed
+	errCrash := errors.New("crash")
+	var saved *Checkpoint
+	total := 0
+	err := client.BackfillEvents(context.Background(), testContract, []string{TopicTransfer}, 0, 199, func(logs []RespEventLogByAddressFilteredByTopics, next Checkpoint) error {
+		if next.Block > 100 {
+			return errCrash
+		}
+		total += len(logs)
+		saved = &next
+		return nil
+	}, nil, opts)
+	if !errors.Is(err, errCrash) || saved == nil {
+		t.Fatalf("expected the sink error, got %v (checkpoint %v)", err, saved)
+	}
+
+	opts.Resume = saved
+	err = client.BackfillEvents(context.Background(), testContract, []string{TopicTransfer}, 0, 199, func(logs []RespEventLogByAddressFilteredByTopics, next Checkpoint) error {
+		total += len(logs)
+		return nil
+	}, nil, opts)
+	if err != nil {
+		t.Fatalf("resumed BackfillEvents failed: %v", err)
+	}
+	if want := 199*3 + 1500; total != want {
+		t.Errorf("expected %d logs across both runs, got %d", want, total)
+	}
+}
@@ -14,7 +14,7 @@ type GetEthBalanceOpts struct {
 	//   - "latest": Get balance at the most recent block (default)
 	//   - "earliest": Get balance at the earliest block
 	//   - "pending": Get balance at the pending block
-	Tag string `default:"latest" json:"tag"`
+	Tag BlockTag `default:"latest" json:"tag"`
 
 	// ChainID specifies which blockchain network to query
 	// Default: empty (uses client default)
@@ -28,6 +28,10 @@ type GetEthBalanceOpts struct {
 	//   - RateLimitRaise: Return an error when rate limit is exceeded
 	//   - RateLimitSkip: Return false without executing when rate limit is exceeded
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // GetEthBalance returns the Ether balance of a given address
@@ -53,23 +57,21 @@ type GetEthBalanceOpts struct {
 //	}
 //	fmt.Printf("Balance: %s wei\n", balance)
 //
-//	// With custom options
-//	tag := "latest"
-//	chainID := etherscan.EthereumMainnet
+//	// Balance including pending transactions
 //	balance, err := client.GetEthBalance(ctx, "0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb", &GetEthBalanceOpts{
-//	    Tag:     &tag,
-//	    ChainID: &chainID,
+//	    Tag:     etherscan.BlockTagPending,
+//	    ChainID: etherscan.EthereumMainnet,
 //	})
 //
 //	// Query different chain
-//	polygonChainID := etherscan.PolygonMainnet
 //	balance, err := client.GetEthBalance(ctx, address, &GetEthBalanceOpts{
-//	    ChainID: &polygonChainID,
+//	    ChainID: etherscan.PolygonMainnet,
 //	})
 //
 // Note:
 //   - The balance is returned in Wei (1 ETH = 10^18 Wei)
 //   - For addresses with no balance, returns "0"
+//   - Tag must be latest, earliest or pending; use GetEthBalanceByBlockNumber for a past block
 //   - This endpoint is not rate limited for free tier users
 func (c *HTTPClient) GetEthBalance(ctx context.Context, address string, opts *GetEthBalanceOpts) (string, error) {
 	// Apply defaults and extract API parameters
@@ -77,14 +79,19 @@ func (c *HTTPClient) GetEthBalance(ctx context.Context, address string, opts *Ge
 	if err != nil {
 		return "", err
 	}
+	if err := checkBalanceTag(BlockTag(params["tag"])); err != nil {
+		return "", err
+	}
 
 	// Add required parameters
 	params["address"] = address
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -94,6 +101,7 @@ func (c *HTTPClient) GetEthBalance(ctx context.Context, address string, opts *Ge
 		params:          params,
 		noFoundReturn:   "0",
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return "", err
@@ -113,7 +121,7 @@ type GetEthBalancesOpts struct {
 	//   - "latest": Get balances at the most recent block (default)
 	//   - "earliest": Get balances at the earliest block
 	//   - "pending": Get balances at the pending block
-	Tag string `default:"latest" json:"tag"`
+	Tag BlockTag `default:"latest" json:"tag"`
 
 	// ChainID specifies which blockchain network to query
 	// Default: empty (uses client default)
@@ -127,6 +135,10 @@ type GetEthBalancesOpts struct {
 	//   - RateLimitRaise: Return an error when rate limit is exceeded
 	//   - RateLimitSkip: Return false without executing when rate limit is exceeded
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // GetEthBalances returns Ether balances for multiple addresses in a single call
@@ -162,12 +174,10 @@ type GetEthBalancesOpts struct {
 //	    fmt.Printf("Address: %s, Balance: %s wei\n", bal.Account, bal.Balance)
 //	}
 //
-//	// With custom options
-//	tag := "latest"
-//	chainID := etherscan.EthereumMainnet
+//	// Balances at genesis
 //	balances, err := client.GetEthBalances(ctx, addresses, &GetEthBalancesOpts{
-//	    Tag:     &tag,
-//	    ChainID: &chainID,
+//	    Tag:     etherscan.BlockTagEarliest,
+//	    ChainID: etherscan.EthereumMainnet,
 //	})
 //
 // Note:
@@ -176,20 +186,26 @@ type GetEthBalancesOpts struct {
 //   - For addresses with no balance, the balance will be "0"
 //   - This endpoint is not rate limited for free tier users
 //   - All addresses must be valid Ethereum address format
+//   - Tag must be latest, earliest or pending
 func (c *HTTPClient) GetEthBalances(ctx context.Context, addresses []string, opts *GetEthBalancesOpts) ([]RespEthBalanceEntry, error) {
 	// Apply defaults and extract API parameters
 	params, err := ApplyDefaultsAndExtractParams(opts)
 	if err != nil {
 		return nil, err
 	}
+	if err := checkBalanceTag(BlockTag(params["tag"])); err != nil {
+		return nil, err
+	}
 
 	// Add required parameters
 	params["address"] = strings.Join(addresses, ",")
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -199,14 +215,27 @@ func (c *HTTPClient) GetEthBalances(ctx context.Context, addresses []string, opt
 		params:          params,
 		noFoundReturn:   []RespEthBalanceEntry{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result []RespEthBalanceEntry
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
 	return result, nil
 }
+
+// checkBalanceTag returns an error if tag is not one of the named tags the balance actions accept
+//
+// The account module resolves only latest, earliest and pending; safe,
+// finalized and block numbers are proxy-only and would be rejected by the API.
+func checkBalanceTag(tag BlockTag) error {
+	switch tag {
+	case "", BlockTagLatest, BlockTagEarliest, BlockTagPending:
+		return nil
+	}
+	return fmt.Errorf("etherscan: block tag %q is not supported by balance actions, expected latest, earliest or pending", string(tag))
+}
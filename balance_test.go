@@ -2,6 +2,9 @@ package etherscan
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 )
@@ -167,3 +170,42 @@ func TestGetEthBalancesWithNilOpts(t *testing.T) {
 		t.Logf("Default balance for %s: %s", balance.Account, balance.Balance)
 	}
 }
+
+func TestBalanceTags(t *testing.T) {
+	var tags []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tags = append(tags, r.URL.Query().Get("action")+"="+r.URL.Query().Get("tag"))
+		w.Write([]byte(`{"status":"1","message":"OK","result":"0"}`))
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+	})
+	ctx := context.Background()
+	if _, err := client.GetEthBalance(ctx, testAddr, &GetEthBalanceOpts{Tag: BlockTagEarliest}); err != nil {
+		t.Fatalf("GetEthBalance failed: %v", err)
+	}
+	if _, err := client.GetERC20AccountBalance(ctx, testContract, testAddr, &GetERC20AccountBalanceOpts{Tag: BlockTagPending}); err != nil {
+		t.Fatalf("GetERC20AccountBalance failed: %v", err)
+	}
+	if _, err := client.GetERC20AccountBalance(ctx, testContract, testAddr, nil); err != nil {
+		t.Fatalf("GetERC20AccountBalance failed: %v", err)
+	}
+	if strings.Join(tags, " ") != "balance=earliest tokenbalance=pending tokenbalance=latest" {
+		t.Errorf("unexpected tags sent: %v", tags)
+	}
+
+	for _, tag := range []BlockTag{BlockTagSafe, BlockTagFinalized, BlockNumberTag(100)} {
+		if _, err := client.GetEthBalance(ctx, testAddr, &GetEthBalanceOpts{Tag: tag}); err == nil {
+			t.Errorf("expected tag %s to be rejected", tag)
+		}
+		if _, err := client.GetEthBalances(ctx, []string{testAddr}, &GetEthBalancesOpts{Tag: tag}); err == nil {
+			t.Errorf("expected tag %s to be rejected by balancemulti", tag)
+		}
+	}
+	if len(tags) != 3 {
+		t.Errorf("expected rejected tags not to be sent, got %v", tags)
+	}
+}
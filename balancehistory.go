@@ -0,0 +1,125 @@
+package etherscan
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// ============================================================================
+// Balance History Sampling
+// ============================================================================
+
+// BalanceSample is the ETH balance of an address at one point of a sampled series
+type BalanceSample struct {
+	// Time is the sampled time in UTC; Block is the last block mined at or before it
+	Time  time.Time `json:"time" bson:"time"`
+	Block int64     `json:"block" bson:"block"`
+
+	// Balance is in wei
+	Balance *big.Int `json:"balance" bson:"balance"`
+}
+
+// SampleBalanceHistoryOpts contains optional parameters for SampleBalanceHistory
+type SampleBalanceHistoryOpts struct {
+	// ChainID specifies which blockchain network to query
+	// Default: empty (uses client default)
+	ChainID int64 `json:"chainid"`
+
+	// OnLimitExceeded specifies behavior when rate limit is exceeded
+	// Default: RateLimitBlock (wait until a token is available)
+	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+}
+
+// SampleBalanceHistory returns the ETH balance of an address at evenly spaced times
+//
+// The points times run from from to to inclusive; each is resolved to the last
+// block mined before it with GetBlockNumberByTimestamp, and the balance at that
+// block is fetched with GetEthBalanceByBlockNumber. The result is ready to be
+// plotted as a time series.
+//
+// Args:
+//   - ctx: Context for request cancellation and timeout
+//   - address: Address whose balance is sampled
+//   - from: Time of the first sample
+//   - to: Time of the last sample
+//   - points: Number of samples; a single sample is taken at to
+//   - opts: Optional parameters (can be nil)
+//
+// Returns:
+//   - []BalanceSample: One sample per point, in time order
+//   - error: Error if the time range or points are invalid, or a request fails
+//
+// Example:
+//
+//	// Daily balance over the last 30 days
+//	to := time.Now()
+//	samples, err := client.SampleBalanceHistory(ctx, addr, to.AddDate(0, 0, -30), to, 31, nil)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, s := range samples {
+//	    fmt.Printf("%s %s wei\n", s.Time.Format(time.DateOnly), s.Balance)
+//	}
+//
+// Note:
+//   - Requires API Pro (balancehistory is a Pro endpoint)
+//   - Costs two calls per point; balancehistory calls are paced to 2 per second,
+//     shared with FindBalanceCrossing, and points resolving to the same block are fetched once
+func (c *HTTPClient) SampleBalanceHistory(ctx context.Context, address string, from, to time.Time, points int, opts *SampleBalanceHistoryOpts) ([]BalanceSample, error) {
+	if points < 1 {
+		return nil, fmt.Errorf("etherscan: invalid number of points %d", points)
+	}
+	if to.Before(from) {
+		return nil, fmt.Errorf("etherscan: invalid time range %s-%s", from, to)
+	}
+	if opts == nil {
+		opts = &SampleBalanceHistoryOpts{}
+	}
+	if err := ApplyDefaults(opts); err != nil {
+		return nil, err
+	}
+
+	samples := make([]BalanceSample, points)
+	balances := make(map[int64]*big.Int)
+	for i := range samples {
+		at := to
+		if points > 1 {
+			at = from.Add(to.Sub(from) * time.Duration(i) / time.Duration(points-1))
+		}
+
+		block, err := c.GetBlockNumberByTimestamp(ctx, at.Unix(), ClosestBefore, &GetBlockNumberByTimestampOpts{
+			ChainID:         opts.ChainID,
+			OnLimitExceeded: opts.OnLimitExceeded,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if block < 0 {
+			return nil, fmt.Errorf("etherscan: no block before %s", at)
+		}
+
+		blockNo := int64(block)
+		balance, ok := balances[blockNo]
+		if !ok {
+			if err := c.waitBalanceHistory(ctx); err != nil {
+				return nil, err
+			}
+			raw, err := c.GetEthBalanceByBlockNumber(ctx, address, blockNo, &GetEthBalanceByBlockNumberOpts{
+				ChainID:         opts.ChainID,
+				OnLimitExceeded: opts.OnLimitExceeded,
+			})
+			if err != nil {
+				return nil, err
+			}
+			if balance, ok = new(big.Int).SetString(strings.TrimSpace(raw), 10); !ok {
+				return nil, fmt.Errorf("etherscan: invalid balance %q at block %d", raw, blockNo)
+			}
+			balances[blockNo] = balance
+		}
+		samples[i] = BalanceSample{Time: at.UTC(), Block: blockNo, Balance: new(big.Int).Set(balance)}
+	}
+	return samples, nil
+}
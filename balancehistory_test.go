@@ -0,0 +1,66 @@
+package etherscan
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestSampleBalanceHistory(t *testing.T) {
+	var historyCalls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		switch q.Get("action") {
+		case "getblocknobytime":
+			// One block every 100 seconds
+			ts, _ := strconv.ParseInt(q.Get("timestamp"), 10, 64)
+			fmt.Fprintf(w, `{"status":"1","message":"OK","result":"%d"}`, ts/100)
+		case "balancehistory":
+			historyCalls++
+			block, _ := strconv.ParseInt(q.Get("blockno"), 10, 64)
+			fmt.Fprintf(w, `{"status":"1","message":"OK","result":"%d"}`, block*10)
+		default:
+			t.Errorf("unexpected action %q", q.Get("action"))
+		}
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+	})
+	ctx := context.Background()
+
+	from := time.Unix(10000, 0)
+	samples, err := client.SampleBalanceHistory(ctx, testAddr, from, from.Add(300*time.Second), 4, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, s := range samples {
+		wantBlock := int64(100 + i)
+		if s.Block != wantBlock || s.Balance.Int64() != wantBlock*10 || !s.Time.Equal(from.Add(time.Duration(i)*100*time.Second)) {
+			t.Errorf("sample %d = %+v", i, s)
+		}
+	}
+
+	// Points within one block share a single balancehistory call
+	historyCalls = 0
+	samples, err = client.SampleBalanceHistory(ctx, testAddr, from, from.Add(50*time.Second), 3, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(samples) != 3 || historyCalls != 1 {
+		t.Errorf("expected 3 samples from 1 balancehistory call, got %d from %d", len(samples), historyCalls)
+	}
+
+	if _, err := client.SampleBalanceHistory(ctx, testAddr, from, from.Add(-time.Second), 2, nil); err == nil {
+		t.Error("expected error for reversed time range")
+	}
+	if _, err := client.SampleBalanceHistory(ctx, testAddr, from, from, 0, nil); err == nil {
+		t.Error("expected error for zero points")
+	}
+}
@@ -0,0 +1,71 @@
+package bindgen
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"strings"
+	"testing"
+)
+
+const testABI = `[
+	{"type":"function","name":"balanceOf","stateMutability":"view","inputs":[{"name":"owner","type":"address"}],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"balanceOf","stateMutability":"view","inputs":[{"name":"owner","type":"address"},{"name":"id","type":"uint256"}],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"symbol","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"string"}]},
+	{"type":"function","name":"getReserves","stateMutability":"view","inputs":[],"outputs":[{"name":"r0","type":"uint112"},{"name":"r1","type":"uint112"},{"name":"ts","type":"uint32"}]},
+	{"type":"function","name":"decimals","constant":true,"inputs":[],"outputs":[{"name":"","type":"uint8"}]},
+	{"type":"function","name":"client","stateMutability":"pure","inputs":[{"name":"type","type":"bytes32"},{"name":"","type":"bool"}],"outputs":[{"name":"","type":"address"}]},
+	{"type":"function","name":"transfer","stateMutability":"nonpayable","inputs":[{"name":"to","type":"address"},{"name":"value","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"getPath","stateMutability":"view","inputs":[{"name":"ids","type":"uint256[]"}],"outputs":[{"name":"","type":"address[]"}]},
+	{"type":"event","name":"Transfer","inputs":[]}
+]`
+
+func TestGenerate(t *testing.T) {
+	src, err := Generate(testABI, &Options{Package: "token", Type: "Token"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	code := string(src)
+
+	for _, want := range []string{
+		"// Code generated by etherscan-bindgen. DO NOT EDIT.",
+		"//   - getPath(uint256[])",
+		"func NewToken(client *etherscan.HTTPClient, address string) *Token",
+		`func (b *Token) BalanceOf(ctx context.Context, owner string, opts *etherscan.RpcEthCallOpts) (*big.Int, error)`,
+		`etherscan.EncodeABICall("0x70a08231", []string{"address"}, owner)`,
+		`func (b *Token) BalanceOf0(ctx context.Context, owner string, id *big.Int, opts *etherscan.RpcEthCallOpts) (*big.Int, error)`,
+		`func (b *Token) GetReserves(ctx context.Context, opts *etherscan.RpcEthCallOpts) (*big.Int, *big.Int, *big.Int, error)`,
+		`func (b *Token) Symbol(ctx context.Context, opts *etherscan.RpcEthCallOpts) (string, error)`,
+		`func (b *Token) Decimals(`,
+		`func (b *Token) Client0(ctx context.Context, arg0 []byte, arg1 bool, opts *etherscan.RpcEthCallOpts) (string, error)`,
+	} {
+		if !strings.Contains(code, want) {
+			t.Errorf("generated code lacks %q", want)
+		}
+	}
+	if strings.Contains(code, "Transfer(") {
+		t.Error("state-changing function or event was bound")
+	}
+
+	// The generated file must compile against this module
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "token.go", src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	if _, err := config.Check("token", fset, []*ast.File{file}, nil); err != nil {
+		t.Errorf("generated code does not type-check: %v\n%s", err, code)
+	}
+}
+
+func TestGenerateInvalid(t *testing.T) {
+	if _, err := Generate("{", nil); err == nil {
+		t.Error("expected error for malformed ABI")
+	}
+	if _, err := Generate("[]", &Options{Type: "lower"}); err == nil {
+		t.Error("expected error for unexported type name")
+	}
+}
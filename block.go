@@ -24,6 +24,10 @@ type GetBlockAndUncleRewardsOpts struct {
 	//   - RateLimitRaise: Return an error when rate limit is exceeded
 	//   - RateLimitSkip: Return false without executing when rate limit is exceeded
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // GetBlockAndUncleRewards returns the block reward and uncle block rewards for a given block number
@@ -78,8 +82,10 @@ func (c *HTTPClient) GetBlockAndUncleRewards(ctx context.Context, blockNo int64,
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -89,13 +95,14 @@ func (c *HTTPClient) GetBlockAndUncleRewards(ctx context.Context, blockNo int64,
 		params:          params,
 		noFoundReturn:   RespBlockReward{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result RespBlockReward
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
@@ -115,6 +122,10 @@ type GetBlockTxsCountOpts struct {
 	//   - RateLimitRaise: Return an error when rate limit is exceeded
 	//   - RateLimitSkip: Return false without executing when rate limit is exceeded
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // GetBlockTxsCount returns the number of transactions in a specified block
@@ -130,7 +141,7 @@ type GetBlockTxsCountOpts struct {
 //
 // Returns:
 //   - *RespBlockTxsCountByBlockNo: Transaction count details including different transaction types
-//   - error: Error if the request fails or if chainid is not 1
+//   - error: Error if the request fails, or ErrUnsupportedOnChain if the chain is not Ethereum mainnet
 //
 // Example:
 //
@@ -162,14 +173,12 @@ func (c *HTTPClient) GetBlockTxsCount(ctx context.Context, blockNo int64, opts *
 	// Add required parameters
 	params["blockno"] = strconv.FormatInt(blockNo, 10)
 
-	if opts != nil && opts.ChainID != 1 {
-		return nil, fmt.Errorf("this endpoint is only supported on Ethereum mainnet (chainid=1)")
-	}
-
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -179,13 +188,14 @@ func (c *HTTPClient) GetBlockTxsCount(ctx context.Context, blockNo int64, opts *
 		params:          params,
 		noFoundReturn:   RespBlockTxsCountByBlockNo{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result RespBlockTxsCountByBlockNo
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
@@ -205,6 +215,10 @@ type GetBlockCountdownTimeOpts struct {
 	//   - RateLimitRaise: Return an error when rate limit is exceeded
 	//   - RateLimitSkip: Return false without executing when rate limit is exceeded
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // GetBlockCountdownTime returns estimated time remaining until a future block is mined
@@ -258,8 +272,10 @@ func (c *HTTPClient) GetBlockCountdownTime(ctx context.Context, blockNo int64, o
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -269,13 +285,14 @@ func (c *HTTPClient) GetBlockCountdownTime(ctx context.Context, blockNo int64, o
 		params:          params,
 		noFoundReturn:   RespEstimateBlockCountdownTimeByBlockNo{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result RespEstimateBlockCountdownTimeByBlockNo
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
@@ -285,6 +302,10 @@ func (c *HTTPClient) GetBlockCountdownTime(ctx context.Context, blockNo int64, o
 type GetBlockNumberByTimestampOpts struct {
 	ChainID         int64             `json:"chainid"`
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // GetBlockNumberByTimestamp returns the block number that was mined at a certain timestamp
@@ -323,7 +344,7 @@ type GetBlockNumberByTimestampOpts struct {
 //   - Timestamp must be in Unix seconds format
 //   - "before" returns the latest block before the timestamp
 //   - "after" returns the earliest block after the timestamp
-func (c *HTTPClient) GetBlockNumberByTimestamp(ctx context.Context, timestamp int64, closest string, opts *GetBlockNumberByTimestampOpts) (int, error) {
+func (c *HTTPClient) GetBlockNumberByTimestamp(ctx context.Context, timestamp int64, closest ClosestBlock, opts *GetBlockNumberByTimestampOpts) (int, error) {
 	// Apply defaults and extract API parameters
 	params, err := ApplyDefaultsAndExtractParams(opts)
 	if err != nil {
@@ -332,12 +353,17 @@ func (c *HTTPClient) GetBlockNumberByTimestamp(ctx context.Context, timestamp in
 
 	// Add required parameters
 	params["timestamp"] = strconv.FormatInt(timestamp, 10)
-	params["closest"] = closest
+	if err := closest.Validate(); err != nil {
+		return 0, err
+	}
+	params["closest"] = string(closest)
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -347,6 +373,7 @@ func (c *HTTPClient) GetBlockNumberByTimestamp(ctx context.Context, timestamp in
 		params:          params,
 		noFoundReturn:   -1,
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return 0, err
@@ -369,7 +396,7 @@ func (c *HTTPClient) GetBlockNumberByTimestamp(ctx context.Context, timestamp in
 type GetDailyAvgBlockSizesOpts struct {
 	// Sort order for the results
 	// Options: "asc" (default) or "desc"
-	Sort string `default:"asc" json:"sort"`
+	Sort SortOrder `default:"asc" json:"sort"`
 
 	// ChainID specifies which blockchain network to query
 	// If nil, uses the client's default chain ID
@@ -378,6 +405,10 @@ type GetDailyAvgBlockSizesOpts struct {
 	// OnLimitExceeded specifies behavior when rate limit is exceeded
 	// If nil, uses the client's default behavior (RateLimitBlock)
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // GetDailyAvgBlockSizes returns daily average block size within a date range
@@ -405,12 +436,12 @@ type GetDailyAvgBlockSizesOpts struct {
 //	    log.Fatal(err)
 //	}
 //	for _, size := range sizes {
-//	    fmt.Printf("Date: %s, Block Size: %s bytes\n", size.UTCDate, size.BlockSizeBytes)
+//	    fmt.Printf("Date: %s, Block Size: %d bytes\n", size.UTCDate, size.BlockSizeBytes)
 //	}
 //
 //	// With custom sort order
 //	sizes, err := client.GetDailyAvgBlockSizes(ctx, startDate, endDate, &GetDailyAvgBlockSizesOpts{
-//	    Sort: &[]string{"desc"}[0],
+//	    Sort: SortDesc,
 //	})
 //
 // Note:
@@ -430,8 +461,10 @@ func (c *HTTPClient) GetDailyAvgBlockSizes(ctx context.Context, startDate, endDa
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -441,13 +474,14 @@ func (c *HTTPClient) GetDailyAvgBlockSizes(ctx context.Context, startDate, endDa
 		params:          params,
 		noFoundReturn:   []RespDailyAvgBlockSize{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result []RespDailyAvgBlockSize
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
 	return result, nil
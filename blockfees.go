@@ -0,0 +1,207 @@
+package etherscan
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/dwdwow/etherscan-go/parallel"
+)
+
+// ============================================================================
+// Block Fee Breakdown
+// ============================================================================
+
+// BlockFeeBreakdown splits the transaction fees of a block into burned base fees and priority fees
+//
+// All amounts are in wei. Gas refunds are already deducted from the gas used
+// reported by receipts, so the fees are what the senders actually paid.
+type BlockFeeBreakdown struct {
+	Number int64 `json:"number" bson:"number"`
+
+	// Miner is the block's miner field: the fee recipient of the proposer since the merge
+	Miner string `json:"miner" bson:"miner"`
+
+	Transactions int    `json:"transactions" bson:"transactions"`
+	GasUsed      uint64 `json:"gasUsed" bson:"gasUsed"`
+	GasLimit     uint64 `json:"gasLimit" bson:"gasLimit"`
+
+	// BaseFeePerGas is nil before EIP-1559 (London)
+	BaseFeePerGas *big.Int `json:"baseFeePerGas" bson:"baseFeePerGas"`
+
+	// BurnedFees is the base fee times the gas used of every transaction
+	BurnedFees *big.Int `json:"burnedFees" bson:"burnedFees"`
+
+	// PriorityFees is what the transactions paid above the base fee, received by Miner
+	PriorityFees *big.Int `json:"priorityFees" bson:"priorityFees"`
+
+	// TotalFees is BurnedFees plus PriorityFees: the effective gas price times the gas used, summed
+	TotalFees *big.Int `json:"totalFees" bson:"totalFees"`
+}
+
+// BlockFeeSummary aggregates the fee breakdowns of a block range
+type BlockFeeSummary struct {
+	FromBlock int64 `json:"fromBlock" bson:"fromBlock"`
+	ToBlock   int64 `json:"toBlock" bson:"toBlock"`
+
+	GasUsed      uint64   `json:"gasUsed" bson:"gasUsed"`
+	BurnedFees   *big.Int `json:"burnedFees" bson:"burnedFees"`
+	PriorityFees *big.Int `json:"priorityFees" bson:"priorityFees"`
+	TotalFees    *big.Int `json:"totalFees" bson:"totalFees"`
+
+	// Blocks are the breakdowns in block order
+	Blocks []BlockFeeBreakdown `json:"blocks" bson:"blocks"`
+}
+
+// GetBlockFeeBreakdownOpts contains optional parameters for GetBlockFeeBreakdown and GetBlockFeeSummary
+type GetBlockFeeBreakdownOpts struct {
+	// Concurrency is the number of receipts fetched in parallel, or of blocks for GetBlockFeeSummary
+	// Default: 4
+	Concurrency int `default:"4"`
+
+	// ChainID specifies which blockchain network to query
+	// Default: empty (uses client default)
+	ChainID int64
+
+	// OnLimitExceeded specifies behavior when rate limit is exceeded
+	// Default: RateLimitBlock (wait until a token is available)
+	OnLimitExceeded RateLimitBehavior `default:""`
+}
+
+// BlockFees computes the fee breakdown of a block fetched with GetBlockFull
+//
+// It returns an error if a receipt lacks a valid gas used or effective gas price.
+func BlockFees(block *Block) (BlockFeeBreakdown, error) {
+	fees := BlockFeeBreakdown{
+		Number:        block.Number,
+		Miner:         block.Miner,
+		Transactions:  len(block.Transactions),
+		GasUsed:       block.GasUsed,
+		GasLimit:      block.GasLimit,
+		BaseFeePerGas: block.BaseFeePerGas,
+		BurnedFees:    new(big.Int),
+		PriorityFees:  new(big.Int),
+		TotalFees:     new(big.Int),
+	}
+	for _, tx := range block.Transactions {
+		gasUsed := parseHexBig(tx.Receipt.GasUsed)
+		price := parseHexBig(tx.Receipt.EffectiveGasPrice)
+		if gasUsed == nil || price == nil {
+			return BlockFeeBreakdown{}, fmt.Errorf("etherscan: receipt of tx %s has no gas used or effective gas price", tx.Tx.Hash)
+		}
+		paid := new(big.Int).Mul(gasUsed, price)
+		fees.TotalFees.Add(fees.TotalFees, paid)
+		if block.BaseFeePerGas != nil {
+			fees.BurnedFees.Add(fees.BurnedFees, new(big.Int).Mul(gasUsed, block.BaseFeePerGas))
+		}
+	}
+	fees.PriorityFees.Sub(fees.TotalFees, fees.BurnedFees)
+	return fees, nil
+}
+
+// GetBlockFeeBreakdown returns the burned base fees, priority fees and gas used of a block
+//
+// The block is fetched with GetBlockFull, so every receipt is read and the
+// fees reflect the gas actually used, refunds included, at each
+// transaction's effective gas price.
+//
+// Args:
+//   - ctx: Context for request cancellation and timeout
+//   - blockNumber: Block number to break down
+//   - opts: Optional parameters (can be nil)
+//
+// Returns:
+//   - *BlockFeeBreakdown: The fees of the block
+//   - error: Error if the block or a receipt cannot be fetched
+//
+// Example:
+//
+//	fees, err := client.GetBlockFeeBreakdown(ctx, 18000000, nil)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("burned %s wei, %s wei to %s\n", fees.BurnedFees, fees.PriorityFees, fees.Miner)
+//
+// Note:
+//   - Costs one call for the block plus one per transaction
+func (c *HTTPClient) GetBlockFeeBreakdown(ctx context.Context, blockNumber int64, opts *GetBlockFeeBreakdownOpts) (*BlockFeeBreakdown, error) {
+	if opts == nil {
+		opts = &GetBlockFeeBreakdownOpts{}
+	}
+	if err := ApplyDefaults(opts); err != nil {
+		return nil, err
+	}
+	return c.blockFeeBreakdown(ctx, blockNumber, opts.Concurrency, opts)
+}
+
+// blockFeeBreakdown fetches one block with up to concurrency receipts in flight and breaks down its fees
+func (c *HTTPClient) blockFeeBreakdown(ctx context.Context, blockNumber int64, concurrency int, opts *GetBlockFeeBreakdownOpts) (*BlockFeeBreakdown, error) {
+	block, err := c.GetBlockFull(ctx, blockNumber, &GetBlockFullOpts{
+		Concurrency:     concurrency,
+		ChainID:         opts.ChainID,
+		OnLimitExceeded: opts.OnLimitExceeded,
+	})
+	if err != nil {
+		return nil, err
+	}
+	fees, err := BlockFees(block)
+	if err != nil {
+		return nil, err
+	}
+	return &fees, nil
+}
+
+// GetBlockFeeSummary returns the fee breakdown of every block from from to to inclusive, with the totals
+//
+// Blocks are fetched Concurrency at a time, the receipts of each block one
+// after another, so at most Concurrency calls are in flight.
+//
+// Example:
+//
+//	summary, err := client.GetBlockFeeSummary(ctx, 18000000, 18000099, nil)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("burned %s wei over %d blocks\n", summary.BurnedFees, len(summary.Blocks))
+//
+// Note:
+//   - Costs one call per block plus one per transaction; keep ranges short
+func (c *HTTPClient) GetBlockFeeSummary(ctx context.Context, from, to int64, opts *GetBlockFeeBreakdownOpts) (*BlockFeeSummary, error) {
+	if opts == nil {
+		opts = &GetBlockFeeBreakdownOpts{}
+	}
+	if err := ApplyDefaults(opts); err != nil {
+		return nil, err
+	}
+	if from < 0 || to < from {
+		return nil, fmt.Errorf("etherscan: invalid block range %d-%d", from, to)
+	}
+
+	numbers := make([]int64, 0, to-from+1)
+	for block := from; block <= to; block++ {
+		numbers = append(numbers, block)
+	}
+	blocks, err := parallel.Map(ctx, numbers, opts.Concurrency, func(ctx context.Context, number int64) (*BlockFeeBreakdown, error) {
+		return c.blockFeeBreakdown(ctx, number, 1, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &BlockFeeSummary{
+		FromBlock:    from,
+		ToBlock:      to,
+		BurnedFees:   new(big.Int),
+		PriorityFees: new(big.Int),
+		TotalFees:    new(big.Int),
+		Blocks:       make([]BlockFeeBreakdown, len(blocks)),
+	}
+	for i, fees := range blocks {
+		summary.Blocks[i] = *fees
+		summary.GasUsed += fees.GasUsed
+		summary.BurnedFees.Add(summary.BurnedFees, fees.BurnedFees)
+		summary.PriorityFees.Add(summary.PriorityFees, fees.PriorityFees)
+		summary.TotalFees.Add(summary.TotalFees, fees.TotalFees)
+	}
+	return summary, nil
+}
@@ -0,0 +1,81 @@
+package etherscan
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+func TestGetBlockFeeBreakdown(t *testing.T) {
+	// Block n has base fee n gwei and two txs of 21000 and 50000 gas at base fee + 1 and + 2 gwei;
+	// block 99 predates EIP-1559
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		switch query.Get("action") {
+		case "eth_getBlockByNumber":
+			number, _ := strconv.ParseInt(query.Get("tag")[2:], 16, 64)
+			baseFee := fmt.Sprintf(`"baseFeePerGas":"0x%x",`, number*1e9)
+			if number == 99 {
+				baseFee = ""
+			}
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":{"number":"0x%x","hash":"0xblock%d","miner":"0xminer",%s
+				"gasUsed":"0x11558","gasLimit":"0x1c9c380","transactions":[{"hash":"0x%x01"},{"hash":"0x%x02"}]}}`, number, number, baseFee, number, number)
+		case "eth_getTransactionReceipt":
+			hash := query.Get("txhash")
+			number, _ := strconv.ParseInt(hash[2:len(hash)-2], 16, 64)
+			gasUsed, tip := int64(21000), int64(1e9)
+			if hash[len(hash)-1] == '2' {
+				gasUsed, tip = 50000, 2e9
+			}
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":{"transactionHash":"%s","status":"0x1","gasUsed":"0x%x","effectiveGasPrice":"0x%x","logs":[]}}`,
+				hash, gasUsed, number*1e9+tip)
+		}
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+		MaxRetries: -1,
+	})
+	ctx := context.Background()
+
+	fees, err := client.GetBlockFeeBreakdown(ctx, 100, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	burned := int64(71000 * 100e9)
+	priority := int64(21000*1e9 + 50000*2e9)
+	if fees.Transactions != 2 || fees.GasUsed != 71000 || fees.Miner != "0xminer" ||
+		fees.BurnedFees.Int64() != burned || fees.PriorityFees.Int64() != priority || fees.TotalFees.Int64() != burned+priority {
+		t.Errorf("unexpected breakdown: %+v", fees)
+	}
+
+	// Before London nothing is burned and the whole fee goes to the miner
+	fees, err = client.GetBlockFeeBreakdown(ctx, 99, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fees.BaseFeePerGas != nil || fees.BurnedFees.Sign() != 0 || fees.PriorityFees.Cmp(fees.TotalFees) != 0 {
+		t.Errorf("unexpected pre-London breakdown: %+v", fees)
+	}
+
+	summary, err := client.GetBlockFeeSummary(ctx, 99, 101, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(summary.Blocks) != 3 || summary.Blocks[2].Number != 101 || summary.GasUsed != 3*71000 {
+		t.Fatalf("unexpected summary: %+v", summary)
+	}
+	wantBurned := int64(71000 * 201e9)
+	if summary.BurnedFees.Int64() != wantBurned || summary.TotalFees.Int64() != summary.BurnedFees.Int64()+summary.PriorityFees.Int64() {
+		t.Errorf("unexpected summary totals: %+v", summary)
+	}
+
+	if _, err := client.GetBlockFeeSummary(ctx, 10, 5, nil); err == nil {
+		t.Error("expected an invalid range to be rejected")
+	}
+}
@@ -0,0 +1,229 @@
+package etherscan
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/dwdwow/etherscan-go/parallel"
+)
+
+// ============================================================================
+// Block Hydration
+// ============================================================================
+
+// Block is a block normalized for block-by-block ingestion
+type Block struct {
+	Number        int64
+	Hash          string
+	ParentHash    string
+	Timestamp     int64
+	Miner         string
+	GasUsed       uint64
+	GasLimit      uint64
+	BaseFeePerGas *big.Int
+
+	// Time is Timestamp in UTC
+	Time time.Time
+
+	// Transactions are in block order, each with its receipt
+	Transactions []BlockTx
+
+	// Transfers are the token transfers extracted from the receipt logs, in log order
+	Transfers []TokenTransfer
+}
+
+// BlockTx is a transaction of a Block together with its receipt
+type BlockTx struct {
+	Tx      RespEthTxInfo
+	Receipt *RespEthTxReceiptInfo
+
+	// Success reports whether the transaction executed without reverting
+	Success bool
+}
+
+// TokenTransfer is a token transfer decoded from a receipt log
+type TokenTransfer struct {
+	TxHash   string
+	LogIndex int64
+
+	// Token is the token contract address
+	Token string
+
+	// Standard is "ERC-20", "ERC-721" or "ERC-1155"
+	Standard string
+
+	From string
+	To   string
+
+	// Value is the amount in the token's smallest unit; "1" for ERC-721
+	Value string
+
+	// TokenID is set for ERC-721 and ERC-1155 transfers
+	TokenID string
+}
+
+// GetBlockFullOpts contains optional parameters for GetBlockFull
+type GetBlockFullOpts struct {
+	// Concurrency is the number of receipts fetched in parallel
+	// Default: 4
+	Concurrency int `default:"4"`
+
+	// ChainID specifies which blockchain network to query
+	// Default: empty (uses client default)
+	ChainID int64
+
+	// OnLimitExceeded specifies behavior when rate limit is exceeded
+	// Default: RateLimitBlock (wait until a token is available)
+	OnLimitExceeded RateLimitBehavior `default:""`
+}
+
+// GetBlockFull returns a block with all transactions, their receipts and the token transfers they emitted
+//
+// Receipts are fetched concurrently (bounded by Concurrency) and share the
+// client's rate limiter. Transfers are extracted from the ERC-20/ERC-721
+// Transfer and ERC-1155 TransferSingle events of every successful transaction.
+//
+// Args:
+//   - ctx: Context for request cancellation and timeout
+//   - blockNumber: Block number to fetch
+//   - opts: Optional parameters (can be nil)
+//
+// Returns:
+//   - *Block: The hydrated block
+//   - error: Error if the block or any receipt cannot be fetched
+//
+// Example:
+//
+//	block, err := client.GetBlockFull(ctx, 18000000, nil)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, t := range block.Transfers {
+//	    fmt.Printf("%s %s %s -> %s: %s\n", t.Standard, t.Token, t.From, t.To, t.Value)
+//	}
+//
+// Note:
+//   - Costs one call for the block plus one per transaction
+func (c *HTTPClient) GetBlockFull(ctx context.Context, blockNumber int64, opts *GetBlockFullOpts) (*Block, error) {
+	if opts == nil {
+		opts = &GetBlockFullOpts{}
+	}
+	if err := ApplyDefaults(opts); err != nil {
+		return nil, err
+	}
+
+	info, err := c.RpcEthBlockByNumberWithFullTxs(ctx, BlockNumberTag(blockNumber), &RpcEthBlockByNumberOpts{
+		ChainID:         opts.ChainID,
+		OnLimitExceeded: opts.OnLimitExceeded,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if info == nil || info.Hash == "" {
+		return nil, fmt.Errorf("etherscan: block %d not found", blockNumber)
+	}
+
+	block := &Block{
+		Number:        blockNumber,
+		Hash:          info.Hash,
+		ParentHash:    info.ParentHash,
+		Miner:         info.Miner,
+		BaseFeePerGas: parseHexBig(info.BaseFeePerGas),
+		Transactions:  make([]BlockTx, len(info.Transactions)),
+	}
+	if at, err := ParseTimestamp(info.Timestamp); err == nil {
+		block.Timestamp = at.Unix()
+		block.Time = at
+	}
+	block.GasUsed, _ = parseHexUint64(info.GasUsed)
+	block.GasLimit, _ = parseHexUint64(info.GasLimit)
+
+	receipts, err := c.fetchReceipts(ctx, info.Transactions, opts)
+	if err != nil {
+		return nil, err
+	}
+	for i, tx := range info.Transactions {
+		receipt := receipts[i]
+		block.Transactions[i] = BlockTx{Tx: tx, Receipt: receipt, Success: receipt.Status == "0x1"}
+		if !block.Transactions[i].Success {
+			continue
+		}
+		for _, log := range receipt.Logs {
+			for _, transfer := range tokenTransfersFromLog(DecodeLog(log)) {
+				transfer.TxHash = tx.Hash
+				block.Transfers = append(block.Transfers, transfer)
+			}
+		}
+	}
+	return block, nil
+}
+
+// fetchReceipts returns the receipts of txs in the same order, fetching up to opts.Concurrency at a time
+func (c *HTTPClient) fetchReceipts(ctx context.Context, txs []RespEthTxInfo, opts *GetBlockFullOpts) ([]*RespEthTxReceiptInfo, error) {
+	return parallel.Map(ctx, txs, opts.Concurrency, func(ctx context.Context, tx RespEthTxInfo) (*RespEthTxReceiptInfo, error) {
+		receipt, err := c.RpcEthTxReceipt(ctx, tx.Hash, &RpcEthTxReceiptOpts{
+			ChainID:         opts.ChainID,
+			OnLimitExceeded: opts.OnLimitExceeded,
+		})
+		if err == nil && (receipt == nil || receipt.TransactionHash == "") {
+			err = fmt.Errorf("etherscan: receipt of tx %s not found", tx.Hash)
+		}
+		return receipt, err
+	})
+}
+
+// tokenTransfersFromLog converts a decoded Transfer, TransferSingle or TransferBatch event into TokenTransfers
+//
+// A TransferBatch yields one transfer per token ID, all with the log's index.
+func tokenTransfersFromLog(log DecodedLog) []TokenTransfer {
+	transfer := TokenTransfer{
+		TxHash:   log.TransactionHash,
+		Token:    log.Address,
+		Standard: log.Standard,
+		From:     log.Args["from"],
+		To:       log.Args["to"],
+	}
+	if index, err := parseHexUint64(log.LogIndex); err == nil {
+		transfer.LogIndex = int64(index)
+	}
+
+	switch {
+	case log.Event == "Transfer" && log.Standard == "ERC-20":
+		transfer.Value = log.Args["value"]
+	case log.Event == "Transfer" && log.Standard == "ERC-721":
+		transfer.Value = "1"
+		transfer.TokenID = log.Args["tokenId"]
+	case log.Event == "TransferSingle":
+		transfer.Value = log.Args["value"]
+		transfer.TokenID = log.Args["id"]
+	case log.Event == "TransferBatch":
+		if log.Args["ids"] == "" {
+			return nil
+		}
+		ids, values := strings.Split(log.Args["ids"], ","), strings.Split(log.Args["values"], ",")
+		transfers := make([]TokenTransfer, len(ids))
+		for i := range ids {
+			transfers[i] = transfer
+			transfers[i].TokenID, transfers[i].Value = ids[i], values[i]
+		}
+		return transfers
+	default:
+		return nil
+	}
+	return []TokenTransfer{transfer}
+}
+
+// parseHexBig parses a "0x"-prefixed hex quantity, returning nil if s is empty or invalid
+func parseHexBig(s string) *big.Int {
+	if len(s) < 3 {
+		return nil
+	}
+	n, ok := new(big.Int).SetString(s[2:], 16)
+	if !ok {
+		return nil
+	}
+	return n
+}
@@ -0,0 +1,58 @@
+package etherscan
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetBlockFull(t *testing.T) {
+	receipts := map[string]string{
+		"0x01": `{"jsonrpc":"2.0","id":1,"result":{"transactionHash":"0x01","status":"0x1","logs":[
+			{"address":"0xtoken","logIndex":"0x0","topics":["` + TopicTransfer + `","` + testTopicAlice + `","` + testTopicBob + `"],"data":"0x0000000000000000000000000000000000000000000000000000000000000064"},
+			{"address":"0xother","logIndex":"0x1","topics":["0x1234"],"data":"0x"}]}}`,
+		"0x02": `{"jsonrpc":"2.0","id":1,"result":{"transactionHash":"0x02","status":"0x0","logs":[
+			{"address":"0xtoken","logIndex":"0x2","topics":["` + TopicTransfer + `","` + testTopicAlice + `","` + testTopicBob + `"],"data":"0x0000000000000000000000000000000000000000000000000000000000000001"}]}}`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		switch query.Get("action") {
+		case "eth_getBlockByNumber":
+			if query.Get("tag") != "0x64" || query.Get("boolean") != "true" {
+				t.Errorf("unexpected block query: %v", query)
+			}
+			w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":{"number":"0x64","hash":"0xblock","parentHash":"0xparent",
+				"timestamp":"0x5f5e100","gasUsed":"0x5208","gasLimit":"0x1c9c380","baseFeePerGas":"0x3b9aca00",
+				"transactions":[{"hash":"0x01"},{"hash":"0x02"}]}}`))
+		case "eth_getTransactionReceipt":
+			w.Write([]byte(receipts[query.Get("txhash")]))
+		}
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+	})
+
+	block, err := client.GetBlockFull(context.Background(), 100, nil)
+	if err != nil {
+		t.Fatalf("GetBlockFull failed: %v", err)
+	}
+	if block.Hash != "0xblock" || block.Timestamp != 100000000 || block.GasUsed != 21000 || block.BaseFeePerGas.Int64() != 1e9 {
+		t.Errorf("unexpected block header: %+v", block)
+	}
+	if len(block.Transactions) != 2 || !block.Transactions[0].Success || block.Transactions[1].Success {
+		t.Fatalf("unexpected transactions: %+v", block.Transactions)
+	}
+
+	// The failed tx emits no transfers and unknown events are skipped
+	if len(block.Transfers) != 1 {
+		t.Fatalf("expected 1 transfer, got %+v", block.Transfers)
+	}
+	transfer := block.Transfers[0]
+	if transfer.TxHash != "0x01" || transfer.Token != "0xtoken" || transfer.Value != "100" || transfer.Standard != "ERC-20" {
+		t.Errorf("unexpected transfer: %+v", transfer)
+	}
+}
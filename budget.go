@@ -0,0 +1,86 @@
+package etherscan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+)
+
+// ============================================================================
+// Request Budget
+// ============================================================================
+
+// ErrBudgetExhausted is returned when a request would exceed the budget set with WithMaxRequests
+var ErrBudgetExhausted = errors.New("request budget exhausted")
+
+// budgetKey is the context key of the request budget
+type budgetKey struct{}
+
+// requestBudget is the number of requests left to a call tree
+type requestBudget struct {
+	remaining atomic.Int64
+
+	// parent is the budget of an enclosing WithMaxRequests, charged as well
+	parent *requestBudget
+}
+
+// WithMaxRequests returns a context that allows at most n API requests
+//
+// Every request made with the returned context, or a context derived from
+// it, is charged to the budget, including the retries made after a rate limit
+// response and proxy calls routed to RPCURLs. The request that would exceed
+// the budget is not sent and fails with ErrBudgetExhausted. A budget nested
+// inside another one charges both, so an inner call can never spend more than
+// its caller allowed.
+//
+// Aggregation helpers that walk an unbounded number of pages, such as
+// BuildInteractionGraph and TokenFlowByCounterparty, stop at the exhausted
+// budget and return what they collected so far together with the error.
+//
+// Example:
+//
+//	ctx := etherscan.WithMaxRequests(ctx, 200)
+//	graph, err := client.BuildInteractionGraph(ctx, []string{suspect}, 3, nil)
+//	if errors.Is(err, etherscan.ErrBudgetExhausted) {
+//	    log.Printf("graph truncated at %d nodes", len(graph.Nodes))
+//	} else if err != nil {
+//	    log.Fatal(err)
+//	}
+func WithMaxRequests(ctx context.Context, n int64) context.Context {
+	budget := &requestBudget{parent: budgetFrom(ctx)}
+	budget.remaining.Store(max(n, 0))
+	return context.WithValue(ctx, budgetKey{}, budget)
+}
+
+// RemainingRequests returns the number of requests left in the innermost budget of ctx
+//
+// Returns false if ctx has no budget.
+func RemainingRequests(ctx context.Context) (int64, bool) {
+	budget := budgetFrom(ctx)
+	if budget == nil {
+		return 0, false
+	}
+	return budget.remaining.Load(), true
+}
+
+// budgetFrom returns the innermost budget of ctx, nil if it has none
+func budgetFrom(ctx context.Context) *requestBudget {
+	budget, _ := ctx.Value(budgetKey{}).(*requestBudget)
+	return budget
+}
+
+// chargeBudget takes one request from every budget of ctx
+//
+// Nothing is charged if any of them is exhausted.
+func chargeBudget(ctx context.Context, module, action string) error {
+	for budget := budgetFrom(ctx); budget != nil; budget = budget.parent {
+		if budget.remaining.Add(-1) < 0 {
+			for refund := budgetFrom(ctx); refund != budget.parent; refund = refund.parent {
+				refund.remaining.Add(1)
+			}
+			return fmt.Errorf("etherscan: %s %s: %w", module, action, ErrBudgetExhausted)
+		}
+	}
+	return nil
+}
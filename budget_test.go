@@ -0,0 +1,95 @@
+package etherscan
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWithMaxRequests(t *testing.T) {
+	var served atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		served.Add(1)
+		w.Write([]byte(`{"status":"1","message":"OK","result":"1000"}`))
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+	})
+
+	outer := WithMaxRequests(context.Background(), 3)
+	inner := WithMaxRequests(outer, 5)
+	for i := 0; i < 2; i++ {
+		if _, err := client.GetEthBalance(inner, TestAddresses.VitalikButerin, nil); err != nil {
+			t.Fatalf("request %d failed: %v", i, err)
+		}
+	}
+	if left, _ := RemainingRequests(inner); left != 3 {
+		t.Errorf("expected 3 requests left in the inner budget, got %d", left)
+	}
+	if _, err := client.GetEthBalance(outer, TestAddresses.VitalikButerin, nil); err != nil {
+		t.Fatalf("last request of the outer budget failed: %v", err)
+	}
+
+	// The inner budget has room left but the outer one is spent
+	_, err := client.GetEthBalance(inner, TestAddresses.VitalikButerin, nil)
+	if !errors.Is(err, ErrBudgetExhausted) {
+		t.Fatalf("expected ErrBudgetExhausted, got %v", err)
+	}
+	if left, _ := RemainingRequests(inner); left != 3 {
+		t.Errorf("expected a refused request to leave the inner budget at 3, got %d", left)
+	}
+	if served.Load() != 3 {
+		t.Errorf("expected 3 requests to reach the server, got %d", served.Load())
+	}
+	if _, ok := RemainingRequests(context.Background()); ok {
+		t.Error("expected no budget on a plain context")
+	}
+}
+
+func TestTokenFlowByCounterpartyBudget(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		switch query.Get("action") {
+		case "getblocknobytime":
+			if query.Get("closest") == "after" {
+				w.Write([]byte(`{"status":"1","message":"OK","result":"0"}`))
+			} else {
+				w.Write([]byte(`{"status":"1","message":"OK","result":"999"}`))
+			}
+		case "tokentx":
+			// The whole range is a full page, each half holds two transfers
+			count := 2
+			if query.Get("startblock") == "0" && query.Get("endblock") == "999" {
+				count = logsPerCall
+			}
+			transfers := make([]string, count)
+			for i := range transfers {
+				transfers[i] = `{"hash":"0x1","from":"0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb","to":"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa","value":"1","tokenDecimal":"0"}`
+			}
+			w.Write([]byte(`{"status":"1","message":"OK","result":[` + strings.Join(transfers, ",") + `]}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+	})
+	ctx := WithMaxRequests(context.Background(), 4)
+	report, err := client.TokenFlowByCounterparty(ctx, testAddr, testContract, time.Unix(0, 0), time.Unix(1, 0), nil)
+	if !errors.Is(err, ErrBudgetExhausted) {
+		t.Fatalf("expected ErrBudgetExhausted, got %v", err)
+	}
+	// Two lookups and two tokentx calls fit; the second half of the range does not
+	if report == nil || report.TotalInflow != "2" || report.CounterpartyCount != 1 {
+		t.Fatalf("expected a partial report of the first half, got %+v", report)
+	}
+}
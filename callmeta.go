@@ -0,0 +1,100 @@
+package etherscan
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+// ============================================================================
+// Call Metadata
+// ============================================================================
+
+// CallMeta is the response metadata of one call, filled in through the Meta field of its opts
+//
+// It describes the last HTTP attempt of the call, so after a rate-limit retry
+// it holds the response that was finally decoded. Compare ServerTime with the
+// local clock, or the caching headers in Header, to tell Etherscan-side
+// throttling and caching from local behavior.
+type CallMeta struct {
+	// StatusCode and Proto are the HTTP status code and protocol of the response, e.g. 200 and "HTTP/2.0"
+	StatusCode int    `json:"statusCode" bson:"statusCode"`
+	Proto      string `json:"proto" bson:"proto"`
+
+	// Header is a copy of the response headers
+	Header http.Header `json:"header" bson:"header"`
+
+	// ContentLength is the size of the response body in bytes, as read
+	ContentLength int64 `json:"contentLength" bson:"contentLength"`
+
+	// ServerTime is the Date header of the response, zero if it is missing or invalid
+	ServerTime time.Time `json:"serverTime" bson:"serverTime"`
+
+	// ResultCount is the number of records in the result, -1 if the result is not a list
+	ResultCount int `json:"resultCount" bson:"resultCount"`
+
+	// Attempts is the number of HTTP requests sent for the call, retries included
+	Attempts int `json:"attempts" bson:"attempts"`
+
+	// Duration is the time from the request of the last attempt to its response body read
+	Duration time.Duration `json:"duration" bson:"duration"`
+}
+
+// reset clears m at the start of a call; a nil m is ignored
+func (m *CallMeta) reset() {
+	if m != nil {
+		*m = CallMeta{ResultCount: -1}
+	}
+}
+
+// recordAttempt counts one HTTP request sent; a nil m is ignored
+func (m *CallMeta) recordAttempt() {
+	if m != nil {
+		m.Attempts++
+	}
+}
+
+// recordResponse fills m from the response of the attempt sent at sent; a nil m is ignored
+func (m *CallMeta) recordResponse(resp *http.Response, sent time.Time) {
+	if m == nil {
+		return
+	}
+	m.StatusCode = resp.StatusCode
+	m.Proto = resp.Proto
+	m.Header = resp.Header.Clone()
+	m.ServerTime = time.Time{}
+	if date, err := http.ParseTime(resp.Header.Get("Date")); err == nil {
+		m.ServerTime = date
+	}
+	m.Duration = time.Since(sent)
+}
+
+// recordBody adds the body size and read time of the last attempt to m; a nil m is ignored
+func (m *CallMeta) recordBody(body []byte, sent time.Time) {
+	if m == nil {
+		return
+	}
+	m.ContentLength = int64(len(body))
+	m.Duration = time.Since(sent)
+}
+
+// recordResult sets the result count of m from the decoded result; a nil m is ignored
+func (m *CallMeta) recordResult(result any) {
+	if m == nil {
+		return
+	}
+	if rpc, ok := result.(map[string]any); ok {
+		result = rpc["result"]
+	}
+	m.ResultCount = -1
+	switch list := result.(type) {
+	case []any:
+		m.ResultCount = len(list)
+	case json.RawMessage:
+		// Decoding into empty structs counts the items without keeping them
+		var items []struct{}
+		if json.Unmarshal(list, &items) == nil && items != nil {
+			m.ResultCount = len(items)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package etherscan
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestCallMeta(t *testing.T) {
+	serverTime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Date", serverTime.Format(http.TimeFormat))
+		w.Header().Set("Cf-Cache-Status", "DYNAMIC")
+		switch r.URL.Query().Get("action") {
+		case "txlist":
+			if calls == 1 {
+				w.Write([]byte(`{"status":"0","message":"Maximum rate limit reached","result":null}`))
+				return
+			}
+			w.Write([]byte(`{"status":"1","message":"OK","result":[{"hash":"0x1"},{"hash":"0x2"}]}`))
+		case "eth_blockNumber":
+			w.Write([]byte(`{"jsonrpc":"2.0","id":83,"result":"0x10"}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+		RetryDelay: time.Millisecond,
+	})
+	ctx := context.Background()
+
+	var meta CallMeta
+	txs, err := client.GetNormalTxs(ctx, TestAddresses.VitalikButerin, &GetNormalTxsOpts{Meta: &meta})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(txs) != 2 || meta.ResultCount != 2 || meta.Attempts != 2 {
+		t.Errorf("expected 2 results after one retry, got %d txs and %+v", len(txs), meta)
+	}
+	if meta.StatusCode != http.StatusOK || meta.Proto != "HTTP/1.1" || !meta.ServerTime.Equal(serverTime) {
+		t.Errorf("unexpected response metadata: %+v", meta)
+	}
+	if meta.Header.Get("Cf-Cache-Status") != "DYNAMIC" || meta.ContentLength == 0 || meta.Duration <= 0 {
+		t.Errorf("unexpected headers or body metadata: %+v", meta)
+	}
+
+	// The same CallMeta is reset by the next call; a scalar result has no count
+	if _, err := client.RpcEthBlockNumber(ctx, &RpcEthBlockNumberOpts{Meta: &meta}); err != nil {
+		t.Fatal(err)
+	}
+	if meta.ResultCount != -1 || meta.Attempts != 1 {
+		t.Errorf("expected a fresh scalar call, got %+v", meta)
+	}
+
+	// Calls without Meta still work
+	if _, err := client.RpcEthBlockNumber(ctx, nil); err != nil {
+		t.Fatal(err)
+	}
+}
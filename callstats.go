@@ -0,0 +1,194 @@
+package etherscan
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"net/http/httptrace"
+	"sync"
+	"time"
+)
+
+// ============================================================================
+// Call Timing Breakdown
+// ============================================================================
+
+// CallStats is the timing breakdown of the calls made with a WithCallStats context
+//
+// Durations are summed over the calls and their retries. Server is the time
+// from the request being written to the first response byte: Etherscan's
+// processing plus one network round trip. Decode is the client's own JSON
+// work, parsing the body and mapping it onto the Resp types, so comparing
+// Server with Decode tells API latency from local decoding cost.
+type CallStats struct {
+	// Calls counts the HTTP requests sent, retries included
+	Calls int64 `json:"calls" bson:"calls"`
+
+	// ReusedConns counts the requests sent on a kept-alive connection, which skip DNS, Connect and TLS
+	ReusedConns int64 `json:"reusedConns" bson:"reusedConns"`
+
+	// RateLimitWait is the time spent waiting for the client's rate limiters
+	RateLimitWait time.Duration `json:"rateLimitWait" bson:"rateLimitWait"`
+
+	DNS     time.Duration `json:"dns" bson:"dns"`
+	Connect time.Duration `json:"connect" bson:"connect"`
+	TLS     time.Duration `json:"tls" bson:"tls"`
+
+	// Server is the time from the request written to the first response byte
+	Server time.Duration `json:"server" bson:"server"`
+
+	// Transfer is the time from the first response byte to the body read
+	Transfer time.Duration `json:"transfer" bson:"transfer"`
+
+	// Decode is the time parsing responses and unmarshalling them into results
+	Decode time.Duration `json:"decode" bson:"decode"`
+}
+
+// Network returns the time spent on the wire: DNS, Connect, TLS, Server and Transfer
+func (s CallStats) Network() time.Duration {
+	return s.DNS + s.Connect + s.TLS + s.Server + s.Transfer
+}
+
+// CallStatsCollector accumulates the CallStats of the calls made with its context
+//
+// It is safe for concurrent use, e.g. by the parallel calls of composite helpers.
+type CallStatsCollector struct {
+	mu    sync.Mutex
+	stats CallStats
+}
+
+// callStatsKey is the context key of the CallStatsCollector
+type callStatsKey struct{}
+
+// WithCallStats returns a context whose calls record their timing breakdown in the returned collector
+//
+// Every request made with the returned context, or a context derived from
+// it, adds to the collector; use a fresh context per call for per-call
+// numbers. A nested WithCallStats replaces the outer collector.
+//
+// Example:
+//
+//	ctx, stats := etherscan.WithCallStats(ctx)
+//	logs, err := client.GetAllEventLogs(ctx, token, nil)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	s := stats.Stats()
+//	fmt.Printf("%d calls: server %s, network %s, decode %s\n", s.Calls, s.Server, s.Network(), s.Decode)
+func WithCallStats(ctx context.Context) (context.Context, *CallStatsCollector) {
+	collector := &CallStatsCollector{}
+	return context.WithValue(ctx, callStatsKey{}, collector), collector
+}
+
+// Stats returns the breakdown recorded so far
+func (c *CallStatsCollector) Stats() CallStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stats
+}
+
+// add applies fn to the recorded stats; it is a no-op on a nil collector
+func (c *CallStatsCollector) add(fn func(*CallStats)) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	fn(&c.stats)
+}
+
+// callStatsFrom returns the collector of ctx, nil if none
+func callStatsFrom(ctx context.Context) *CallStatsCollector {
+	if ctx == nil {
+		return nil
+	}
+	collector, _ := ctx.Value(callStatsKey{}).(*CallStatsCollector)
+	return collector
+}
+
+// callTrace records the connection phases of one request into a collector
+type callTrace struct {
+	collector *CallStatsCollector
+
+	mu                               sync.Mutex
+	dnsStart, connectStart, tlsStart time.Time
+	wroteRequest, firstByte          time.Time
+}
+
+// traceCallStats returns req with httptrace hooks recording into collector, and the trace
+//
+// It returns req unchanged and a nil trace if collector is nil.
+func traceCallStats(req *http.Request, collector *CallStatsCollector) (*http.Request, *callTrace) {
+	if collector == nil {
+		return req, nil
+	}
+	t := &callTrace{collector: collector}
+	since := func(start *time.Time) time.Duration {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		if start.IsZero() {
+			return 0
+		}
+		return time.Since(*start)
+	}
+	mark := func(at *time.Time) {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		*at = time.Now()
+	}
+	trace := &httptrace.ClientTrace{
+		GetConn: func(string) {
+			collector.add(func(s *CallStats) { s.Calls++ })
+		},
+		GotConn: func(info httptrace.GotConnInfo) {
+			if info.Reused {
+				collector.add(func(s *CallStats) { s.ReusedConns++ })
+			}
+		},
+		DNSStart: func(httptrace.DNSStartInfo) { mark(&t.dnsStart) },
+		DNSDone: func(httptrace.DNSDoneInfo) {
+			d := since(&t.dnsStart)
+			collector.add(func(s *CallStats) { s.DNS += d })
+		},
+		ConnectStart: func(string, string) { mark(&t.connectStart) },
+		ConnectDone: func(string, string, error) {
+			d := since(&t.connectStart)
+			collector.add(func(s *CallStats) { s.Connect += d })
+		},
+		TLSHandshakeStart: func() { mark(&t.tlsStart) },
+		TLSHandshakeDone: func(tls.ConnectionState, error) {
+			d := since(&t.tlsStart)
+			collector.add(func(s *CallStats) { s.TLS += d })
+		},
+		WroteRequest: func(httptrace.WroteRequestInfo) { mark(&t.wroteRequest) },
+		GotFirstResponseByte: func() {
+			d := since(&t.wroteRequest)
+			mark(&t.firstByte)
+			collector.add(func(s *CallStats) { s.Server += d })
+		},
+	}
+	return req.WithContext(httptrace.WithClientTrace(req.Context(), trace)), t
+}
+
+// bodyRead records the transfer time of the response body, once it has been read
+func (t *callTrace) bodyRead() {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	firstByte := t.firstByte
+	t.mu.Unlock()
+	if firstByte.IsZero() {
+		return
+	}
+	d := time.Since(firstByte)
+	t.collector.add(func(s *CallStats) { s.Transfer += d })
+}
+
+// recordDecode adds the time since start to the Decode time of the collector of ctx
+func recordDecode(ctx context.Context, start time.Time) {
+	if collector := callStatsFrom(ctx); collector != nil {
+		d := time.Since(start)
+		collector.add(func(s *CallStats) { s.Decode += d })
+	}
+}
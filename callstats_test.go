@@ -0,0 +1,48 @@
+package etherscan
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWithCallStats(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(20 * time.Millisecond)
+		w.Write([]byte(`{"status":"1","message":"OK","result":"1000"}`))
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+		MaxRetries: -1,
+	})
+	ctx, stats := WithCallStats(context.Background())
+	for range 2 {
+		if _, err := client.GetEthBalance(ctx, TestAddresses.VitalikButerin, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	s := stats.Stats()
+	if s.Calls != 2 || s.ReusedConns != 1 {
+		t.Errorf("expected 2 calls, the second on a reused connection, got %+v", s)
+	}
+	if s.Server < 40*time.Millisecond {
+		t.Errorf("expected the server time to include both 20ms responses, got %s", s.Server)
+	}
+	if s.Connect <= 0 || s.Decode <= 0 || s.Network() < s.Server {
+		t.Errorf("expected connect and decode times, got %+v", s)
+	}
+
+	// Calls without a collector are not traced
+	if _, err := client.GetEthBalance(context.Background(), TestAddresses.VitalikButerin, nil); err != nil {
+		t.Fatal(err)
+	}
+	if stats.Stats().Calls != 2 {
+		t.Errorf("expected untraced calls not to be counted, got %d", stats.Stats().Calls)
+	}
+}
@@ -0,0 +1,83 @@
+package etherscan
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+)
+
+// ============================================================================
+// Chain Capabilities
+// ============================================================================
+
+// ErrUnsupportedOnChain is returned when an action is known not to exist on the requested chain
+var ErrUnsupportedOnChain = errors.New("action not supported on chain")
+
+// UnsupportedOnChainError carries the chain that was requested and the chains that support the action
+//
+// It matches ErrUnsupportedOnChain with errors.Is.
+type UnsupportedOnChainError struct {
+	Module    string
+	Action    string
+	ChainID   int
+	Supported []int
+}
+
+// Error implements the error interface
+func (e *UnsupportedOnChainError) Error() string {
+	return fmt.Sprintf("etherscan: %s %s is not supported on chain %d (supported chains: %v)", e.Module, e.Action, e.ChainID, e.Supported)
+}
+
+// Unwrap returns ErrUnsupportedOnChain
+func (e *UnsupportedOnChainError) Unwrap() error {
+	return ErrUnsupportedOnChain
+}
+
+// ChainCapabilities lists actions that only exist on a subset of chains, keyed by "module.action"
+//
+// Actions not listed here are assumed to be available on every chain. The table
+// can be edited at init time if Etherscan rolls an action out to more chains, or
+// the check can be disabled with HTTPClientConfig.SkipCapabilityCheck.
+var ChainCapabilities = map[string][]int{
+	// Beacon chain withdrawals only exist on Ethereum
+	"account.txsBeaconWithdrawal": {EthereumMainnet},
+
+	// Bridge transactions are indexed on a handful of sidechains only
+	"account.txnbridge": {Gnosis, PolygonMainnet, BittorrentChainMainnet},
+
+	// Top holders is a mainnet-only Pro endpoint
+	"token.topholders": {EthereumMainnet},
+
+	// Per-block transaction counts are only indexed on Ethereum
+	"block.getblocktxnscount": {EthereumMainnet},
+}
+
+// SupportedChains returns the chains that support module/action
+//
+// Returns false if the action is not restricted, i.e. it is assumed to be available everywhere.
+func SupportedChains(module, action string) ([]int, bool) {
+	chains, ok := ChainCapabilities[module+"."+action]
+	return chains, ok
+}
+
+// checkCapability returns an *UnsupportedOnChainError if module/action is known not to exist on chainID
+func checkCapability(module, action, chainID string) error {
+	supported, ok := SupportedChains(module, action)
+	if !ok {
+		return nil
+	}
+	id, err := strconv.Atoi(chainID)
+	if err != nil {
+		return nil
+	}
+	if slices.Contains(supported, id) {
+		return nil
+	}
+	return &UnsupportedOnChainError{
+		Module:    module,
+		Action:    action,
+		ChainID:   id,
+		Supported: slices.Clone(supported),
+	}
+}
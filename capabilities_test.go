@@ -0,0 +1,40 @@
+package etherscan
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestCheckCapability(t *testing.T) {
+	if err := checkCapability("account", "txlist", "137"); err != nil {
+		t.Errorf("unrestricted action should pass, got %v", err)
+	}
+	if err := checkCapability("token", "topholders", "1"); err != nil {
+		t.Errorf("topholders on mainnet should pass, got %v", err)
+	}
+
+	err := checkCapability("account", "txnbridge", "1")
+	if !errors.Is(err, ErrUnsupportedOnChain) {
+		t.Fatalf("expected ErrUnsupportedOnChain, got %v", err)
+	}
+	var unsupported *UnsupportedOnChainError
+	if !errors.As(err, &unsupported) {
+		t.Fatalf("expected *UnsupportedOnChainError, got %T", err)
+	}
+	if unsupported.ChainID != EthereumMainnet || len(unsupported.Supported) != 3 {
+		t.Errorf("unexpected error details: %+v", unsupported)
+	}
+}
+
+func TestRequestFailsFastOnUnsupportedChain(t *testing.T) {
+	client := NewHTTPClient(HTTPClientConfig{})
+
+	// No network access needed: the request must be rejected before it is sent
+	_, err := client.GetTopERC20Holders(context.Background(), TestAddresses.USDTContract, 10, &GetTopERC20HoldersOpts{
+		ChainID: PolygonMainnet,
+	})
+	if !errors.Is(err, ErrUnsupportedOnChain) {
+		t.Fatalf("expected ErrUnsupportedOnChain, got %v", err)
+	}
+}
@@ -0,0 +1,121 @@
+package etherscan
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// chainIDRecorder is an http.RoundTripper that records the chainid of every request
+type chainIDRecorder struct {
+	mu       sync.Mutex
+	chainIDs map[string]string
+}
+
+func (r *chainIDRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	query := req.URL.Query()
+	r.mu.Lock()
+	r.chainIDs[query.Get("module")+"."+query.Get("action")] = query.Get("chainid")
+	r.mu.Unlock()
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(`{"status":"0","message":"No data found","result":[]}`)),
+		Request:    req,
+	}, nil
+}
+
+func newChainIDTestClient(defaultChainID int) (*HTTPClient, *chainIDRecorder) {
+	recorder := &chainIDRecorder{chainIDs: make(map[string]string)}
+	client := NewHTTPClient(HTTPClientConfig{
+		APIKey:              "test",
+		DefaultChainID:      defaultChainID,
+		HTTPClient:          &http.Client{Transport: recorder},
+		SkipCapabilityCheck: true,
+	})
+	return client, recorder
+}
+
+// callEveryModule issues requests across all modules with the given ChainID in opts
+func callEveryModule(ctx context.Context, client *HTTPClient, chainID int64) {
+	addr := TestAddresses.VitalikButerin
+	client.GetNormalTxs(ctx, addr, &GetNormalTxsOpts{ChainID: chainID})
+	client.GetERC20TokenTransfers(ctx, &GetERC20TokenTransfersOpts{Address: addr, ChainID: chainID})
+	client.GetEthBalance(ctx, addr, &GetEthBalanceOpts{ChainID: chainID})
+	client.GetContractABI(ctx, addr, &GetContractABIOpts{ChainID: chainID})
+	client.GetContractSourceCode(ctx, addr, &GetContractSourceCodeOpts{ChainID: chainID})
+	client.GetBlockTxsCount(ctx, TestBlocks.RecentBlock, &GetBlockTxsCountOpts{ChainID: chainID})
+	client.GetDailyAvgBlockSizes(ctx, "2024-01-01", "2024-01-02", &GetDailyAvgBlockSizesOpts{ChainID: chainID})
+	client.GetEventLogsByAddress(ctx, addr, &GetEventLogsByAddressOpts{ChainID: chainID})
+	client.GetGasOracle(ctx, &GetGasOracleOpts{ChainID: chainID})
+	client.GetERC20TotalSupply(ctx, TestAddresses.USDTContract, &GetERC20TotalSupplyOpts{ChainID: chainID})
+	client.GetTotalEthSupply(ctx, &GetTotalEthSupplyOpts{ChainID: chainID})
+	client.GetEthPrice(ctx, &GetEthPriceOpts{ChainID: chainID})
+	client.GetEthereumNodesSize(ctx, "2024-01-01", "2024-01-02", "geth", "default", SortAsc, &GetEthereumNodesSizeOpts{ChainID: chainID})
+	client.GetNodeCount(ctx, &GetNodeCountOpts{ChainID: chainID})
+	client.RpcEthBlockNumber(ctx, &RpcEthBlockNumberOpts{ChainID: chainID})
+}
+
+func TestRequestsInheritDefaultChainID(t *testing.T) {
+	client, recorder := newChainIDTestClient(PolygonMainnet)
+	callEveryModule(context.Background(), client, 0)
+
+	if len(recorder.chainIDs) < 15 {
+		t.Fatalf("expected 15 distinct requests, got %d: %v", len(recorder.chainIDs), recorder.chainIDs)
+	}
+	for action, chainID := range recorder.chainIDs {
+		if chainID != "137" {
+			t.Errorf("%s: expected default chainid 137, got %q", action, chainID)
+		}
+	}
+}
+
+func TestRequestsWithNilOptsInheritDefaultChainID(t *testing.T) {
+	client, recorder := newChainIDTestClient(PolygonMainnet)
+	ctx := context.Background()
+
+	client.GetNormalTxs(ctx, TestAddresses.VitalikButerin, nil)
+	client.GetContractABI(ctx, TestAddresses.VitalikButerin, nil)
+	client.GetEthPrice(ctx, nil)
+	client.GetEthereumNodesSize(ctx, "2024-01-01", "2024-01-02", "geth", "default", SortAsc, nil)
+	client.GetBlockTxsCount(ctx, TestBlocks.RecentBlock, nil)
+
+	if len(recorder.chainIDs) != 5 {
+		t.Fatalf("expected 5 requests, got %v", recorder.chainIDs)
+	}
+	for action, chainID := range recorder.chainIDs {
+		if chainID != "137" {
+			t.Errorf("%s: expected default chainid 137, got %q", action, chainID)
+		}
+	}
+}
+
+func TestRequestsExplicitChainIDOverridesDefault(t *testing.T) {
+	client, recorder := newChainIDTestClient(PolygonMainnet)
+	callEveryModule(context.Background(), client, OPMainnet)
+
+	for action, chainID := range recorder.chainIDs {
+		if chainID != "10" {
+			t.Errorf("%s: expected explicit chainid 10, got %q", action, chainID)
+		}
+	}
+}
+
+func TestGetBlockTxsCountChainCheck(t *testing.T) {
+	transport := &http.Client{Transport: &chainIDRecorder{chainIDs: make(map[string]string)}}
+	mainnet := NewHTTPClient(HTTPClientConfig{APIKey: "test", HTTPClient: transport})
+	polygon := NewHTTPClient(HTTPClientConfig{APIKey: "test", DefaultChainID: PolygonMainnet, HTTPClient: transport})
+
+	// A zero ChainID inherits the mainnet default and must not be rejected
+	if _, err := mainnet.GetBlockTxsCount(context.Background(), TestBlocks.RecentBlock, &GetBlockTxsCountOpts{}); err != nil {
+		t.Errorf("expected mainnet default to be accepted, got %v", err)
+	}
+	if _, err := polygon.GetBlockTxsCount(context.Background(), TestBlocks.RecentBlock, nil); !errors.Is(err, ErrUnsupportedOnChain) {
+		t.Errorf("expected ErrUnsupportedOnChain for a Polygon client, got %v", err)
+	}
+}
@@ -0,0 +1,101 @@
+package etherscan
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+)
+
+// ============================================================================
+// Crawl Checkpoints
+// ============================================================================
+
+// Checkpoint records how far a long-running crawl has progressed
+//
+// Everything below Block has been processed. If Page is set, Block itself is
+// being read page by page and Page is the next page to request; LastKeys are
+// the keys of the items of Block already delivered, so a resumed crawl skips
+// them even if pages shifted. A Checkpoint is plain JSON and can be stored
+// anywhere; SaveCheckpoint and LoadCheckpoint cover the common file case.
+//
+// Checkpoints are emitted by the streaming crawl APIs, GetAllEventLogs and
+// BackfillEvents. Helpers that page internally to compute one aggregate
+// result, such as GasPriceHistogram, GetPortfolioValue or GetWithdrawalStatus,
+// have nothing to stream and are not resumable; split their input (block or
+// time range, addresses) to bound a restart instead.
+type Checkpoint struct {
+	Block    int64    `json:"block"`
+	Page     int64    `json:"page,omitempty"`
+	LastKeys []string `json:"last_keys,omitempty"`
+}
+
+// Seen reports whether the item identified by block and key was processed before this checkpoint
+//
+// A nil checkpoint has seen nothing.
+func (cp *Checkpoint) Seen(block int64, key string) bool {
+	if cp == nil {
+		return false
+	}
+	if block != cp.Block {
+		return block < cp.Block
+	}
+	return slices.Contains(cp.LastKeys, key)
+}
+
+// SaveCheckpoint atomically writes cp to path as JSON
+//
+// The checkpoint is written to a temporary file in the same directory and
+// renamed over path, so a crash never leaves a truncated checkpoint behind.
+func SaveCheckpoint(path string, cp Checkpoint) error {
+	data, err := json.Marshal(cp)
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(path, data, "save checkpoint")
+}
+
+// writeFileAtomic writes data to a temporary file next to path and renames it over path
+//
+// Errors are prefixed with op.
+func writeFileAtomic(path string, data []byte, op string) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp*")
+	if err != nil {
+		return fmt.Errorf("etherscan: %s: %w", op, err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("etherscan: %s: %w", op, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("etherscan: %s: %w", op, err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("etherscan: %s: %w", op, err)
+	}
+	return nil
+}
+
+// LoadCheckpoint reads a checkpoint written by SaveCheckpoint
+//
+// It returns nil and no error if path does not exist, so the result can be
+// passed straight to a Resume option on the first run.
+func LoadCheckpoint(path string) (*Checkpoint, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("etherscan: load checkpoint: %w", err)
+	}
+
+	var cp Checkpoint
+	if err := json.Unmarshal(data, &cp); err != nil {
+		return nil, fmt.Errorf("etherscan: decode checkpoint %s: %w", path, err)
+	}
+	return &cp, nil
+}
@@ -0,0 +1,156 @@
+package etherscan
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"testing"
+)
+
+// newLogsTestClient serves getLogs for blocks 0-99 with 3 logs per block and 2500 in block 50
+func newLogsTestClient(t *testing.T) *HTTPClient {
+	t.Helper()
+	logsInBlock := func(block int64) int {
+		if block == 50 {
+			return 2500
+		}
+		return 3
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		from, _ := strconv.ParseInt(query.Get("fromblock"), 10, 64)
+		to, _ := strconv.ParseInt(query.Get("toblock"), 10, 64)
+		page, _ := strconv.ParseInt(query.Get("page"), 10, 64)
+
+		var all []RespEventLogByAddress
+		for b := from; b <= to && b < 100; b++ {
+			for i := range logsInBlock(b) {
+				all = append(all, RespEventLogByAddress{
+					BlockNumber:     fmt.Sprintf("0x%x", b),
+					TransactionHash: fmt.Sprintf("0xtx%d", b),
+					LogIndex:        fmt.Sprintf("0x%x", i),
+				})
+			}
+		}
+		start := min(float64((page-1)*logsPerCall), float64(len(all)))
+		end := min(start+logsPerCall, float64(len(all)))
+		result, _ := json.Marshal(all[int(start):int(end)])
+		fmt.Fprintf(w, `{"status":"1","message":"OK","result":%s}`, result)
+	}))
+	t.Cleanup(server.Close)
+
+	return NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+	})
+}
+
+func TestGetAllEventLogsResume(t *testing.T) {
+	client := newLogsTestClient(t)
+	ctx := context.Background()
+	errCrash := errors.New("crash")
+	const total = 99*3 + 2500
+
+	// Crash after various numbers of batches, then resume from the saved checkpoint
+	crashAfter := 1
+	for ; ; crashAfter += 3 {
+		path := filepath.Join(t.TempDir(), "checkpoint.json")
+		seen := make(map[string]int)
+		batches := 0
+		crawl := func(crash bool) error {
+			resume, err := LoadCheckpoint(path)
+			if err != nil {
+				return err
+			}
+			_, err = client.GetAllEventLogs(ctx, testContract, &GetAllEventLogsOpts{
+				ToBlock: 99,
+				Resume:  resume,
+				OnBatch: func(logs []RespEventLogByAddress, next Checkpoint) error {
+					if crash && batches == crashAfter {
+						return errCrash
+					}
+					batches++
+					for _, log := range logs {
+						seen[log.BlockNumber+log.TransactionHash+log.LogIndex]++
+					}
+					return SaveCheckpoint(path, next)
+				},
+			})
+			return err
+		}
+
+		err := crawl(true)
+		if err == nil {
+			break // crashAfter exceeds the number of batches
+		}
+		if !errors.Is(err, errCrash) {
+			t.Fatalf("crashAfter=%d: first pass failed: %v", crashAfter, err)
+		}
+		if err := crawl(false); err != nil {
+			t.Fatalf("crashAfter=%d: resumed pass failed: %v", crashAfter, err)
+		}
+
+		if len(seen) != total {
+			t.Errorf("crashAfter=%d: expected %d distinct logs, got %d", crashAfter, total, len(seen))
+		}
+		for key, n := range seen {
+			if n > 1 {
+				t.Errorf("crashAfter=%d: log %s delivered %d times", crashAfter, key, n)
+				break
+			}
+		}
+	}
+	if crashAfter < 10 {
+		t.Errorf("expected the crawl to take many batches, got %d", crashAfter-1)
+	}
+}
+
+func TestGetAllEventLogsResumeCollect(t *testing.T) {
+	client := newLogsTestClient(t)
+
+	logs, err := client.GetAllEventLogs(context.Background(), testContract, &GetAllEventLogsOpts{
+		ToBlock: 99,
+		Resume:  &Checkpoint{Block: 50, Page: 2, LastKeys: []string{"0xtx50:0x3e8"}},
+	})
+	if err != nil {
+		t.Fatalf("GetAllEventLogs failed: %v", err)
+	}
+	// Block 50 from its second page minus one already seen log, then blocks 51-99
+	if expected := 1500 - 1 + 49*3; len(logs) != expected {
+		t.Errorf("expected %d logs, got %d", expected, len(logs))
+	}
+}
+
+func TestCheckpointSaveLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cp.json")
+
+	cp, err := LoadCheckpoint(path)
+	if err != nil || cp != nil {
+		t.Fatalf("expected nil checkpoint for missing file, got %v, %v", cp, err)
+	}
+
+	want := Checkpoint{Block: 42, Page: 3, LastKeys: []string{"a", "b"}}
+	if err := SaveCheckpoint(path, want); err != nil {
+		t.Fatalf("SaveCheckpoint failed: %v", err)
+	}
+	cp, err = LoadCheckpoint(path)
+	if err != nil {
+		t.Fatalf("LoadCheckpoint failed: %v", err)
+	}
+	if cp.Block != 42 || cp.Page != 3 || len(cp.LastKeys) != 2 {
+		t.Errorf("unexpected checkpoint: %+v", cp)
+	}
+
+	if !cp.Seen(41, "x") || !cp.Seen(42, "a") || cp.Seen(42, "c") || cp.Seen(43, "a") {
+		t.Errorf("unexpected Seen results for %+v", cp)
+	}
+	var none *Checkpoint
+	if none.Seen(0, "a") {
+		t.Error("nil checkpoint should have seen nothing")
+	}
+}
@@ -0,0 +1,216 @@
+package etherscan
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ============================================================================
+// Circuit Breaker
+// ============================================================================
+
+// ErrCircuitOpen is returned without a request while the circuit of an endpoint is open
+var ErrCircuitOpen = errors.New("circuit open")
+
+// defaultCircuitCooldown is how long a circuit stays open when HTTPClientConfig.CircuitBreakerCooldown is zero
+const defaultCircuitCooldown = 30 * time.Second
+
+// CircuitState is the state of the circuit breaker of one endpoint
+type CircuitState string
+
+const (
+	// CircuitClosed lets every request through
+	CircuitClosed CircuitState = "closed"
+
+	// CircuitOpen fails every request with ErrCircuitOpen until the cooldown ends
+	CircuitOpen CircuitState = "open"
+
+	// CircuitHalfOpen lets one trial request through after the cooldown; its outcome closes or reopens the circuit
+	CircuitHalfOpen CircuitState = "half-open"
+)
+
+// CircuitEvent reports a state change of the circuit of one endpoint
+type CircuitEvent struct {
+	// Endpoint is the "module.action" of the circuit, e.g. "account.txlist"
+	Endpoint string
+
+	From CircuitState
+	To   CircuitState
+
+	// Failures is the number of consecutive failures when the circuit opened
+	Failures int
+
+	// Err is the failure that opened the circuit, nil for other transitions
+	Err error
+
+	At time.Time
+}
+
+// circuitBreaker tracks consecutive failures per endpoint; a nil breaker lets everything through
+type circuitBreaker struct {
+	threshold int
+	cooldown  time.Duration
+	onChange  func(CircuitEvent)
+	now       func() time.Time
+
+	mu       sync.Mutex
+	circuits map[string]*circuit
+}
+
+// circuit is the state of one endpoint
+type circuit struct {
+	state    CircuitState
+	failures int
+	openedAt time.Time
+	trial    bool // a half-open trial request is in flight
+}
+
+// newCircuitBreaker returns a breaker opening after threshold consecutive failures, nil if threshold is not positive
+func newCircuitBreaker(threshold int, cooldown time.Duration, onChange func(CircuitEvent)) *circuitBreaker {
+	if threshold <= 0 {
+		return nil
+	}
+	if cooldown <= 0 {
+		cooldown = defaultCircuitCooldown
+	}
+	return &circuitBreaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+		onChange:  onChange,
+		now:       time.Now,
+		circuits:  make(map[string]*circuit),
+	}
+}
+
+// allow reports ErrCircuitOpen if endpoint is open, moving it to half-open once the cooldown has passed
+func (b *circuitBreaker) allow(endpoint string) error {
+	if b == nil {
+		return nil
+	}
+	b.mu.Lock()
+	c := b.circuit(endpoint)
+	var event *CircuitEvent
+	switch c.state {
+	case CircuitOpen:
+		if b.now().Sub(c.openedAt) < b.cooldown {
+			b.mu.Unlock()
+			return ErrCircuitOpen
+		}
+		event = b.transition(endpoint, c, CircuitHalfOpen, nil)
+		c.trial = true
+	case CircuitHalfOpen:
+		if c.trial {
+			b.mu.Unlock()
+			return ErrCircuitOpen
+		}
+		c.trial = true
+	}
+	b.mu.Unlock()
+	b.emit(event)
+	return nil
+}
+
+// record counts the outcome of a request let through by allow
+func (b *circuitBreaker) record(endpoint string, err error) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	c := b.circuit(endpoint)
+	trial := c.trial
+	c.trial = false
+	var event *CircuitEvent
+	switch {
+	case err == nil:
+		c.failures = 0
+		if c.state != CircuitClosed {
+			event = b.transition(endpoint, c, CircuitClosed, nil)
+		}
+	case circuitFailure(err):
+		c.failures++
+		if (c.state == CircuitHalfOpen && trial) || (c.state == CircuitClosed && c.failures >= b.threshold) {
+			event = b.transition(endpoint, c, CircuitOpen, err)
+			c.openedAt = b.now()
+		}
+	}
+	b.mu.Unlock()
+	b.emit(event)
+}
+
+// circuit returns the circuit of endpoint, creating a closed one; b.mu must be held
+func (b *circuitBreaker) circuit(endpoint string) *circuit {
+	c, ok := b.circuits[endpoint]
+	if !ok {
+		c = &circuit{state: CircuitClosed}
+		b.circuits[endpoint] = c
+	}
+	return c
+}
+
+// transition moves c to state and returns the event to emit once b.mu is released
+func (b *circuitBreaker) transition(endpoint string, c *circuit, state CircuitState, err error) *CircuitEvent {
+	event := &CircuitEvent{Endpoint: endpoint, From: c.state, To: state, Failures: c.failures, Err: err, At: b.now()}
+	c.state = state
+	return event
+}
+
+// emit passes event to the state change callback, if both are set
+func (b *circuitBreaker) emit(event *CircuitEvent) {
+	if event != nil && b.onChange != nil {
+		b.onChange(*event)
+	}
+}
+
+// states returns the state of every endpoint seen so far
+func (b *circuitBreaker) states() map[string]CircuitState {
+	if b == nil {
+		return nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	states := make(map[string]CircuitState, len(b.circuits))
+	for endpoint, c := range b.circuits {
+		states[endpoint] = c.state
+	}
+	return states
+}
+
+// circuitFailure reports whether err counts towards opening a circuit
+//
+// HTTP 5xx responses, NOTOK answers other than rate limits, undecodable
+// 5xx bodies and transport errors count. Errors raised before a request is
+// sent, such as invalid parameters, the local rate limiter or a cancelled
+// context, do not count either way.
+func circuitFailure(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		if apiErr.RateLimited() {
+			return false
+		}
+		// Etherscan reports some rate limits as NOTOK with the reason in the result
+		if result, ok := apiErr.Result.(string); ok && strings.Contains(strings.ToLower(result), "rate limit") {
+			return false
+		}
+		return apiErr.StatusCode >= 500 || apiErr.Message == "NOTOK"
+	}
+	var decodeErr *DecodeError
+	if errors.As(err, &decodeErr) {
+		return decodeErr.StatusCode >= 500
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// CircuitStates returns the circuit breaker state of every endpoint called so far, keyed by "module.action"
+//
+// It returns nil if HTTPClientConfig.CircuitBreakerThreshold is not set.
+func (c *HTTPClient) CircuitStates() map[string]CircuitState {
+	return c.circuitBreaker.states()
+}
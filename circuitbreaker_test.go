@@ -0,0 +1,119 @@
+package etherscan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	var mu sync.Mutex
+	calls := 0
+	failing := true
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls++
+		switch {
+		case r.URL.Query().Get("action") == "balance":
+			fmt.Fprint(w, `{"status":"1","message":"OK","result":"1"}`)
+		case failing && calls%2 == 0:
+			w.WriteHeader(http.StatusBadGateway)
+			fmt.Fprint(w, `{"status":"0","message":"Bad Gateway","result":null}`)
+		case failing:
+			fmt.Fprint(w, `{"status":"0","message":"NOTOK","result":"Unexpected error"}`)
+		default:
+			fmt.Fprint(w, `{"status":"1","message":"OK","result":[]}`)
+		}
+	}))
+	defer server.Close()
+
+	var events []CircuitEvent
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion:              APIVersionV1,
+		V1BaseURLs:              map[int]string{EthereumMainnet: server.URL},
+		MaxRetries:              -1,
+		CircuitBreakerThreshold: 3,
+		CircuitBreakerCooldown:  time.Minute,
+		OnCircuitStateChange:    func(e CircuitEvent) { events = append(events, e) },
+	})
+	now := time.Unix(1700000000, 0)
+	client.circuitBreaker.now = func() time.Time { return now }
+	ctx := context.Background()
+	wallet := TestAddresses.VitalikButerin
+
+	for i := 0; i < 3; i++ {
+		if _, err := client.GetNormalTxs(ctx, wallet, nil); err == nil || errors.Is(err, ErrCircuitOpen) {
+			t.Fatalf("call %d: expected an upstream failure, got %v", i, err)
+		}
+	}
+	if len(events) != 1 || events[0].Endpoint != "account.txlist" || events[0].To != CircuitOpen || events[0].Failures != 3 || events[0].Err == nil {
+		t.Fatalf("expected the circuit to open after 3 failures, got %+v", events)
+	}
+
+	// The open circuit fails fast without a request; other endpoints are unaffected
+	if _, err := client.GetNormalTxs(ctx, wallet, nil); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected ErrCircuitOpen, got %v", err)
+	}
+	if calls != 3 {
+		t.Errorf("expected no request while open, got %d calls", calls)
+	}
+	if _, err := client.GetEthBalance(ctx, wallet, nil); err != nil {
+		t.Fatalf("expected another endpoint to pass, got %v", err)
+	}
+	if states := client.CircuitStates(); states["account.txlist"] != CircuitOpen || states["account.balance"] != CircuitClosed {
+		t.Errorf("unexpected states: %v", states)
+	}
+
+	// After the cooldown a failed trial reopens the circuit at once
+	now = now.Add(time.Minute)
+	if _, err := client.GetNormalTxs(ctx, wallet, nil); err == nil || errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected the trial to fail upstream, got %v", err)
+	}
+	if len(events) != 3 || events[1].To != CircuitHalfOpen || events[2].From != CircuitHalfOpen || events[2].To != CircuitOpen {
+		t.Fatalf("expected half-open then open, got %+v", events)
+	}
+
+	// A successful trial closes it again
+	now = now.Add(time.Minute)
+	mu.Lock()
+	failing = false
+	mu.Unlock()
+	if _, err := client.GetNormalTxs(ctx, wallet, nil); err != nil {
+		t.Fatalf("expected the trial to pass, got %v", err)
+	}
+	if len(events) != 5 || events[4].To != CircuitClosed {
+		t.Fatalf("expected the circuit to close, got %+v", events)
+	}
+	if _, err := client.GetNormalTxs(ctx, wallet, nil); err != nil {
+		t.Fatalf("expected the closed circuit to pass, got %v", err)
+	}
+}
+
+func TestCircuitFailure(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{&APIError{StatusCode: 503}, true},
+		{&APIError{StatusCode: 200, Status: "0", Message: "NOTOK"}, true},
+		{&APIError{StatusCode: 200, Status: "0", Message: "NOTOK", Result: "Max rate limit reached"}, false},
+		{&APIError{StatusCode: 429, Message: "Too Many Requests"}, false},
+		{&APIError{StatusCode: 200, Status: "0", Message: "Maximum rate limit reached"}, false},
+		{&DecodeError{StatusCode: 502}, true},
+		{&DecodeError{StatusCode: 200}, false},
+		{ErrInvalidAddress, false},
+		{ErrRateLimitExceeded, false},
+		{fmt.Errorf("wrapped: %w", context.Canceled), false},
+	}
+	for _, tt := range tests {
+		if got := circuitFailure(tt.err); got != tt.want {
+			t.Errorf("circuitFailure(%v) = %v, want %v", tt.err, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,429 @@
+// Package cluster groups addresses likely controlled by the same entity using simple on-chain heuristics
+//
+// Three heuristics are applied to a set of seed addresses, with the data
+// fetched through an etherscan client:
+//
+//   - CommonFunder: seeds whose first funding came from the same address,
+//     or from another seed
+//   - BidirectionalTransfers: a seed and a counterparty that repeatedly sent
+//     native value to each other
+//   - CoDeployment: seed contracts created by the same deployer, or by
+//     another seed
+//
+// Every match is recorded as Evidence with a score between 0 and 1. Group
+// merges the addresses connected by evidence into Candidates, whose score
+// combines the scores of their evidence. The heuristics are cheap signals,
+// not proof: a common funder may be an exchange hot wallet and frequent
+// transfers may be a payment relationship, so review the evidence before
+// acting on a candidate and list known shared sources in ExcludeSources.
+//
+// Example:
+//
+//	candidates, err := cluster.Find(ctx, client, suspects, &cluster.Options{
+//	    ExcludeSources: []string{binanceHotWallet},
+//	})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, c := range candidates {
+//	    fmt.Printf("%.2f %v\n", c.Score, c.Addresses)
+//	    for _, e := range c.Evidence {
+//	        fmt.Printf("    %s %v via %s (%d)\n", e.Heuristic, e.Addresses, e.Via, e.Count)
+//	    }
+//	}
+package cluster
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/dwdwow/etherscan-go"
+	"github.com/dwdwow/etherscan-go/parallel"
+)
+
+// Heuristic names the signal an Evidence was derived from
+type Heuristic string
+
+const (
+	// CommonFunder links addresses whose first funding came from the same address
+	CommonFunder Heuristic = "common_funder"
+
+	// BidirectionalTransfers links two addresses that sent native value to each other
+	BidirectionalTransfers Heuristic = "bidirectional_transfers"
+
+	// CoDeployment links contracts created by the same deployer
+	CoDeployment Heuristic = "co_deployment"
+)
+
+// Scores of the heuristics; BidirectionalTransfers grows with the number of transfers
+const (
+	commonFunderScore = 0.5
+	coDeploymentScore = 0.7
+)
+
+// contractsPerCall is the maximum number of addresses of one getcontractcreation call
+const contractsPerCall = 5
+
+// Evidence is one heuristic match between addresses
+type Evidence struct {
+	Heuristic Heuristic `json:"heuristic" bson:"heuristic"`
+
+	// Addresses are the linked addresses in lowercase, sorted
+	Addresses []string `json:"addresses" bson:"addresses"`
+
+	// Via is the shared funder or deployer; "" for BidirectionalTransfers
+	Via string `json:"via,omitempty" bson:"via,omitempty"`
+
+	// Count is the number of transfers for BidirectionalTransfers, of linked addresses otherwise
+	Count int `json:"count" bson:"count"`
+
+	// Score is the confidence of the link, between 0 and 1
+	Score float64 `json:"score" bson:"score"`
+}
+
+// Candidate is a group of addresses connected by evidence
+type Candidate struct {
+	// Addresses are the members in lowercase, sorted
+	Addresses []string `json:"addresses" bson:"addresses"`
+
+	// Score combines the evidence scores as independent signals: 1 - Π(1 - score)
+	Score float64 `json:"score" bson:"score"`
+
+	Evidence []Evidence `json:"evidence" bson:"evidence"`
+}
+
+// Options contains optional parameters for Find
+type Options struct {
+	// MinTransfers is the number of transfers required in each direction for BidirectionalTransfers
+	// Default: 2
+	MinTransfers int `default:"2"`
+
+	// ExcludeSources are funders and deployers not taken as evidence, such as exchange hot wallets
+	// Default: nil
+	ExcludeSources []string
+
+	// SkipFunding, SkipTransfers and SkipDeployments turn off a heuristic and its calls
+	// Default: false
+	SkipFunding     bool
+	SkipTransfers   bool
+	SkipDeployments bool
+
+	// Concurrency is the number of seeds fetched in parallel
+	// Default: 4
+	Concurrency int `default:"4"`
+
+	// ChainID specifies which blockchain network to query
+	// Default: empty (uses client default)
+	ChainID int64
+
+	// OnLimitExceeded specifies behavior when rate limit is exceeded
+	// Default: RateLimitBlock (wait until a token is available)
+	OnLimitExceeded etherscan.RateLimitBehavior `default:""`
+}
+
+// seedData is what Find fetches for one seed
+type seedData struct {
+	funder string
+	txs    []etherscan.RespNormalTx
+}
+
+// Find applies the heuristics to seeds and groups the matches into candidates
+//
+// Per seed, Find fetches the first funding (fundedby) and the most recent
+// page of normal transactions (txlist); the seeds are also looked up in
+// batches of 5 with getcontractcreation. Counterparties found through
+// transfers may join a candidate without being seeds.
+//
+// Args:
+//   - ctx: Context for request cancellation and timeout
+//   - client: The client to fetch with
+//   - seeds: The addresses to cluster
+//   - opts: Optional parameters (can be nil)
+//
+// Returns:
+//   - []Candidate: Groups of two or more addresses, by score and then size
+//   - error: Error if an address is invalid or a request fails
+//
+// Note:
+//   - Costs up to two calls per seed plus one per 5 seeds
+//   - Only the transactions of the first txlist page are considered
+func Find(ctx context.Context, client *etherscan.HTTPClient, seeds []string, opts *Options) ([]Candidate, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+	if err := etherscan.ApplyDefaults(opts); err != nil {
+		return nil, err
+	}
+
+	normalized := make([]string, 0, len(seeds))
+	known := make(map[string]bool, len(seeds))
+	for _, seed := range seeds {
+		address, err := etherscan.NormalizeAddress(seed)
+		if err != nil {
+			return nil, err
+		}
+		address = strings.ToLower(address)
+		if !known[address] {
+			known[address] = true
+			normalized = append(normalized, address)
+		}
+	}
+
+	data, err := parallel.Map(ctx, normalized, opts.Concurrency, func(ctx context.Context, seed string) (seedData, error) {
+		return fetchSeed(ctx, client, seed, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	excluded := make(map[string]bool, len(opts.ExcludeSources))
+	for _, source := range opts.ExcludeSources {
+		excluded[strings.ToLower(source)] = true
+	}
+
+	var evidence []Evidence
+	if !opts.SkipFunding {
+		funders := make(map[string]string, len(normalized))
+		for i, seed := range normalized {
+			funders[seed] = data[i].funder
+		}
+		evidence = append(evidence, sharedSource(CommonFunder, funders, known, excluded, commonFunderScore)...)
+	}
+	if !opts.SkipTransfers {
+		evidence = append(evidence, bidirectional(normalized, data, opts.MinTransfers)...)
+	}
+	if !opts.SkipDeployments {
+		creators, err := fetchCreators(ctx, client, normalized, opts)
+		if err != nil {
+			return nil, err
+		}
+		evidence = append(evidence, sharedSource(CoDeployment, creators, known, excluded, coDeploymentScore)...)
+	}
+	return Group(evidence), nil
+}
+
+// fetchSeed fetches the funder and recent transactions of one seed, skipping what opts turns off
+func fetchSeed(ctx context.Context, client *etherscan.HTTPClient, seed string, opts *Options) (seedData, error) {
+	var data seedData
+	if !opts.SkipFunding {
+		funding, err := client.GetAddressFundedBy(ctx, seed, &etherscan.GetAddressFundedByOpts{
+			ChainID:         opts.ChainID,
+			OnLimitExceeded: opts.OnLimitExceeded,
+		})
+		if err != nil {
+			return data, err
+		}
+		if funding != nil {
+			data.funder = strings.ToLower(funding.FundingAddress)
+		}
+	}
+	if !opts.SkipTransfers {
+		txs, err := client.GetNormalTxs(ctx, seed, &etherscan.GetNormalTxsOpts{
+			ChainID:         opts.ChainID,
+			OnLimitExceeded: opts.OnLimitExceeded,
+		})
+		if err != nil {
+			return data, err
+		}
+		data.txs = txs
+	}
+	return data, nil
+}
+
+// fetchCreators returns the creator of every seed that is a contract
+func fetchCreators(ctx context.Context, client *etherscan.HTTPClient, seeds []string, opts *Options) (map[string]string, error) {
+	var chunks [][]string
+	for start := 0; start < len(seeds); start += contractsPerCall {
+		chunks = append(chunks, seeds[start:min(start+contractsPerCall, len(seeds))])
+	}
+	results, err := parallel.Map(ctx, chunks, opts.Concurrency, func(ctx context.Context, chunk []string) ([]etherscan.RespContractCreationAndCreation, error) {
+		return client.GetContractCreatorAndCreation(ctx, chunk, &etherscan.GetContractCreatorAndCreationOpts{
+			ChainID:         opts.ChainID,
+			OnLimitExceeded: opts.OnLimitExceeded,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	creators := make(map[string]string)
+	for _, creations := range results {
+		for _, creation := range creations {
+			creators[strings.ToLower(creation.ContractAddress)] = strings.ToLower(creation.ContractCreator)
+		}
+	}
+	return creators, nil
+}
+
+// sharedSource links the addresses with the same source, and each address with a source among the seeds
+func sharedSource(heuristic Heuristic, sources map[string]string, seeds, excluded map[string]bool, score float64) []Evidence {
+	bySource := make(map[string][]string)
+	for address, source := range sources {
+		if source == "" || source == address || excluded[source] {
+			continue
+		}
+		bySource[source] = append(bySource[source], address)
+	}
+
+	var evidence []Evidence
+	for source, addresses := range bySource {
+		if seeds[source] {
+			// A seed funding or deploying other seeds is linked to them directly
+			addresses = append(addresses, source)
+		}
+		if len(addresses) < 2 {
+			continue
+		}
+		sort.Strings(addresses)
+		evidence = append(evidence, Evidence{
+			Heuristic: heuristic,
+			Addresses: addresses,
+			Via:       source,
+			Count:     len(addresses),
+			Score:     score,
+		})
+	}
+	return evidence
+}
+
+// bidirectional links each seed with the counterparties it exchanged at least minTransfers transfers with each way
+func bidirectional(seeds []string, data []seedData, minTransfers int) []Evidence {
+	type pair struct{ a, b string }
+	type flow struct{ ab, ba int }
+	flows := make(map[pair]*flow)
+	seen := make(map[string]bool)
+
+	for i, seed := range seeds {
+		for _, tx := range data[i].txs {
+			// Both seeds of a pair list the same transaction
+			if seen[tx.Hash] || tx.Value == "" || tx.Value == "0" || etherscan.TxFailed(tx) {
+				continue
+			}
+			seen[tx.Hash] = true
+			from, to := strings.ToLower(tx.From), strings.ToLower(tx.To)
+			if from == to || (from != seed && to != seed) || to == "" {
+				continue
+			}
+			key, forward := pair{from, to}, true
+			if to < from {
+				key, forward = pair{to, from}, false
+			}
+			f, ok := flows[key]
+			if !ok {
+				f = &flow{}
+				flows[key] = f
+			}
+			if forward {
+				f.ab++
+			} else {
+				f.ba++
+			}
+		}
+	}
+
+	var evidence []Evidence
+	for key, f := range flows {
+		least := f.ab
+		if f.ba < least {
+			least = f.ba
+		}
+		if least < minTransfers {
+			continue
+		}
+		evidence = append(evidence, Evidence{
+			Heuristic: BidirectionalTransfers,
+			Addresses: []string{key.a, key.b},
+			Count:     f.ab + f.ba,
+			Score:     1 - 1/float64(1+least),
+		})
+	}
+	return evidence
+}
+
+// Group merges the addresses connected by evidence into candidates
+//
+// Addresses linked directly or through a chain of evidence end up in the
+// same candidate. Candidates are ordered by score, then by size, then by
+// their first address; evidence within a candidate by score. Evidence
+// linking fewer than two addresses is ignored.
+func Group(evidence []Evidence) []Candidate {
+	parent := make(map[string]string)
+	var find func(string) string
+	find = func(address string) string {
+		p, ok := parent[address]
+		if !ok {
+			parent[address] = address
+			return address
+		}
+		if p == address {
+			return p
+		}
+		root := find(p)
+		parent[address] = root
+		return root
+	}
+	var linked []Evidence
+	for _, e := range evidence {
+		if len(e.Addresses) < 2 {
+			continue
+		}
+		linked = append(linked, e)
+		for _, address := range e.Addresses[1:] {
+			ra, rb := find(e.Addresses[0]), find(address)
+			if ra != rb {
+				parent[rb] = ra
+			}
+		}
+	}
+
+	byRoot := make(map[string]*Candidate)
+	for address := range parent {
+		root := find(address)
+		c, ok := byRoot[root]
+		if !ok {
+			c = &Candidate{}
+			byRoot[root] = c
+		}
+		c.Addresses = append(c.Addresses, address)
+	}
+	for _, e := range linked {
+		c := byRoot[find(e.Addresses[0])]
+		c.Evidence = append(c.Evidence, e)
+	}
+
+	candidates := make([]Candidate, 0, len(byRoot))
+	for _, c := range byRoot {
+		if len(c.Addresses) < 2 {
+			continue
+		}
+		sort.Strings(c.Addresses)
+		sort.Slice(c.Evidence, func(i, j int) bool {
+			a, b := c.Evidence[i], c.Evidence[j]
+			if a.Score != b.Score {
+				return a.Score > b.Score
+			}
+			if a.Heuristic != b.Heuristic {
+				return a.Heuristic < b.Heuristic
+			}
+			return strings.Join(a.Addresses, ",") < strings.Join(b.Addresses, ",")
+		})
+		miss := 1.0
+		for _, e := range c.Evidence {
+			miss *= 1 - e.Score
+		}
+		c.Score = 1 - miss
+		candidates = append(candidates, *c)
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		if len(a.Addresses) != len(b.Addresses) {
+			return len(a.Addresses) > len(b.Addresses)
+		}
+		return a.Addresses[0] < b.Addresses[0]
+	})
+	return candidates
+}
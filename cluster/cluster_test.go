@@ -0,0 +1,121 @@
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/dwdwow/etherscan-go"
+)
+
+// addr returns a valid lowercase address made of one repeated hex digit
+func addr(digit string) string {
+	return "0x" + strings.Repeat(digit, 40)
+}
+
+func TestFind(t *testing.T) {
+	a, b, c, d, e, f := addr("a"), addr("b"), addr("c"), addr("d"), addr("e"), addr("f")
+	funder, exchange, peer := addr("1"), addr("2"), addr("3")
+
+	funders := map[string]string{a: funder, b: funder, c: exchange, d: exchange}
+	creators := map[string]string{e: c, f: c}
+	txs := map[string][]etherscan.RespNormalTx{
+		a: {
+			{Hash: "0x01", From: a, To: peer, Value: "10"},
+			{Hash: "0x02", From: peer, To: a, Value: "5"},
+			{Hash: "0x03", From: a, To: peer, Value: "10"},
+			{Hash: "0x04", From: peer, To: a, Value: "5"},
+			{Hash: "0x05", From: peer, To: a, Value: "5", IsError: "1"},
+			{Hash: "0x06", From: a, To: d, Value: "1"},
+		},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		var result any
+		switch q.Get("action") {
+		case "fundedby":
+			result = etherscan.RespAddressFundedBy{FundingAddress: funders[q.Get("address")]}
+		case "txlist":
+			result = txs[q.Get("address")]
+			if result == nil {
+				result = []etherscan.RespNormalTx{}
+			}
+		case "getcontractcreation":
+			creations := []etherscan.RespContractCreationAndCreation{}
+			for _, contract := range strings.Split(q.Get("contractaddresses"), ",") {
+				if creator, ok := creators[contract]; ok {
+					creations = append(creations, etherscan.RespContractCreationAndCreation{ContractAddress: contract, ContractCreator: creator})
+				}
+			}
+			result = creations
+		}
+		data, _ := json.Marshal(result)
+		fmt.Fprintf(w, `{"status":"1","message":"OK","result":%s}`, data)
+	}))
+	defer server.Close()
+
+	client := etherscan.NewHTTPClient(etherscan.HTTPClientConfig{
+		APIVersion: etherscan.APIVersionV1,
+		V1BaseURLs: map[int]string{etherscan.EthereumMainnet: server.URL},
+		MaxRetries: -1,
+	})
+
+	candidates, err := Find(context.Background(), client, []string{a, b, c, d, e, f}, &Options{ExcludeSources: []string{exchange}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(candidates) != 2 {
+		t.Fatalf("expected 2 candidates, got %+v", candidates)
+	}
+
+	first := candidates[0]
+	if !reflect.DeepEqual(first.Addresses, []string{peer, a, b}) || len(first.Evidence) != 2 {
+		t.Errorf("expected the funded pair joined by the transfer peer, got %+v", first)
+	}
+	if got := first.Evidence[0]; got.Heuristic != BidirectionalTransfers || got.Count != 4 || got.Score < 0.66 || got.Score > 0.67 {
+		t.Errorf("unexpected transfer evidence: %+v", got)
+	}
+	if got := first.Evidence[1]; got.Heuristic != CommonFunder || got.Via != funder {
+		t.Errorf("unexpected funding evidence: %+v", got)
+	}
+	if want := 1 - (1.0/3)*0.5; first.Score < want-1e-9 || first.Score > want+1e-9 {
+		t.Errorf("expected a combined score of %f, got %f", want, first.Score)
+	}
+
+	second := candidates[1]
+	if !reflect.DeepEqual(second.Addresses, []string{c, e, f}) || second.Score != coDeploymentScore ||
+		second.Evidence[0].Heuristic != CoDeployment || second.Evidence[0].Via != c {
+		t.Errorf("expected the deployer seed with its contracts, got %+v", second)
+	}
+
+	// Turning the heuristics off leaves nothing to group
+	candidates, err = Find(context.Background(), client, []string{a, b, c, d, e, f}, &Options{SkipFunding: true, SkipTransfers: true, SkipDeployments: true})
+	if err != nil || len(candidates) != 0 {
+		t.Errorf("expected no candidates, got %+v, %v", candidates, err)
+	}
+
+	if _, err := Find(context.Background(), client, []string{"0x1234"}, nil); err == nil {
+		t.Error("expected an invalid seed to be rejected")
+	}
+}
+
+func TestGroupMergesChains(t *testing.T) {
+	candidates := Group([]Evidence{
+		{Heuristic: BidirectionalTransfers, Addresses: []string{"a", "b"}, Score: 0.5},
+		{Heuristic: CommonFunder, Addresses: []string{"c", "d"}, Score: 0.5},
+		{Heuristic: CoDeployment, Addresses: []string{"b", "c"}, Score: 0.5},
+		{Heuristic: CommonFunder, Addresses: []string{"x"}, Score: 0.9},
+	})
+	if len(candidates) != 1 || !reflect.DeepEqual(candidates[0].Addresses, []string{"a", "b", "c", "d"}) {
+		t.Fatalf("expected one candidate through the chain of links, got %+v", candidates)
+	}
+	if candidates[0].Score != 1-0.125 || len(candidates[0].Evidence) != 3 {
+		t.Errorf("unexpected candidate: %+v", candidates[0])
+	}
+}
@@ -0,0 +1,66 @@
+// Command etherscan-bindgen generates read-only Go bindings for a verified contract
+//
+// Usage:
+//
+//	etherscan-bindgen -address 0x... [-chain 1] [-pkg bindings] [-type Contract] [-o file.go]
+//	etherscan-bindgen -abi contract.abi.json [-pkg bindings] [-type Contract] [-o file.go]
+//
+// With -address the ABI is fetched from Etherscan using a client configured
+// from the ETHERSCAN_* environment variables; with -abi it is read from a file.
+// The source is written to -o, or to stdout if -o is not set.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/dwdwow/etherscan-go"
+	"github.com/dwdwow/etherscan-go/bindgen"
+)
+
+func main() {
+	address := flag.String("address", "", "contract address to fetch the verified ABI of")
+	abiFile := flag.String("abi", "", "ABI JSON file to read instead of fetching")
+	chainID := flag.Int64("chain", 0, "chain ID to fetch the ABI from (default: the client's chain)")
+	pkg := flag.String("pkg", "bindings", "package name of the generated file")
+	typeName := flag.String("type", "Contract", "name of the generated binding type")
+	out := flag.String("o", "", "output file (default: stdout)")
+	flag.Parse()
+
+	if (*address == "") == (*abiFile == "") {
+		fmt.Fprintln(os.Stderr, "etherscan-bindgen: exactly one of -address and -abi is required")
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	opts := &bindgen.Options{Package: *pkg, Type: *typeName, ChainID: *chainID}
+	var src []byte
+	var err error
+	if *abiFile != "" {
+		var abi []byte
+		if abi, err = os.ReadFile(*abiFile); err == nil {
+			src, err = bindgen.Generate(string(abi), opts)
+		}
+	} else {
+		var client *etherscan.HTTPClient
+		if client, err = etherscan.NewClientFromEnv(); err == nil {
+			src, err = bindgen.FromContract(context.Background(), client, *address, opts)
+		}
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "etherscan-bindgen:", err)
+		os.Exit(1)
+	}
+
+	if *out == "" {
+		_, err = os.Stdout.Write(src)
+	} else {
+		err = os.WriteFile(*out, src, 0o644)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "etherscan-bindgen:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,41 @@
+// Command etherscan-migrate scans Go code for deprecated etherscan-go APIs and prints a Markdown migration guide
+//
+// Usage:
+//
+//	etherscan-migrate [path ...]
+//
+// Paths default to the current directory and may end in "/...". Only calls on an
+// etherscan HTTPClient are reported: each package is type-checked against this
+// module's import path. The exit status is 1 if any deprecated call is found,
+// so the command can gate CI.
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() {
+	paths := os.Args[1:]
+	if len(paths) == 0 {
+		paths = []string{"."}
+	}
+
+	var calls []deprecatedCall
+	for _, path := range paths {
+		found, err := scanDeprecatedCalls(path)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "etherscan-migrate:", err)
+			os.Exit(2)
+		}
+		calls = append(calls, found...)
+	}
+
+	if err := writeMigrationGuide(os.Stdout, calls); err != nil {
+		fmt.Fprintln(os.Stderr, "etherscan-migrate:", err)
+		os.Exit(2)
+	}
+	if len(calls) > 0 {
+		os.Exit(1)
+	}
+}
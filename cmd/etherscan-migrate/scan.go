@@ -0,0 +1,206 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	etherscan "github.com/dwdwow/etherscan-go"
+)
+
+// modulePath is the import path whose HTTPClient methods are checked
+const modulePath = "github.com/dwdwow/etherscan-go"
+
+// deprecatedCall is a use of a deprecated API found by scanDeprecatedCalls
+type deprecatedCall struct {
+	// Pos is the file, line and column of the call
+	Pos token.Position
+
+	Deprecation etherscan.Deprecation
+}
+
+// scanDeprecatedCalls finds uses of etherscan.Deprecations in the Go files under root
+//
+// Every directory is type-checked package by package against a stub of this
+// module, so only selectors whose receiver is an etherscan HTTPClient (directly,
+// through a pointer or an embedding struct) are reported; a GetEthPrice method of
+// an unrelated type is not. Other imports are not resolved: errors they cause are
+// ignored, as are files that fail to type-check for any other reason. Hidden
+// directories, vendor and testdata are skipped.
+func scanDeprecatedCalls(root string) ([]deprecatedCall, error) {
+	root = strings.TrimSuffix(root, "/...")
+	byName := make(map[string]etherscan.Deprecation, len(etherscan.Deprecations))
+	for _, d := range etherscan.Deprecations {
+		byName[d.Name] = d
+	}
+
+	// Files grouped by directory; packages are split by name when checked
+	dirs := make(map[string][]string)
+	info, err := os.Stat(root)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		dirs[filepath.Dir(root)] = []string{root}
+	} else {
+		err = filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if entry.IsDir() {
+				name := entry.Name()
+				if path != root && (strings.HasPrefix(name, ".") || name == "vendor" || name == "testdata") {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if strings.HasSuffix(path, ".go") {
+				dirs[filepath.Dir(path)] = append(dirs[filepath.Dir(path)], path)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	fset := token.NewFileSet()
+	imp := &stubImporter{stub: newModuleStub(), packages: make(map[string]*types.Package)}
+	var calls []deprecatedCall
+	for dir, paths := range dirs {
+		packages := make(map[string][]*ast.File)
+		for _, path := range paths {
+			file, err := parser.ParseFile(fset, path, nil, parser.SkipObjectResolution)
+			if err != nil {
+				return nil, err
+			}
+			packages[file.Name.Name] = append(packages[file.Name.Name], file)
+		}
+
+		for _, files := range packages {
+			typesInfo := &types.Info{Selections: make(map[*ast.SelectorExpr]*types.Selection)}
+			config := types.Config{Importer: imp, Error: func(error) {}}
+			config.Check(dir, fset, files, typesInfo)
+
+			for sel, selection := range typesInfo.Selections {
+				method, ok := selection.Obj().(*types.Func)
+				if !ok || method.Pkg() != imp.stub {
+					continue
+				}
+				if d, ok := byName[method.Name()]; ok {
+					calls = append(calls, deprecatedCall{Pos: fset.Position(sel.Sel.Pos()), Deprecation: d})
+				}
+			}
+		}
+	}
+
+	sort.Slice(calls, func(i, j int) bool {
+		a, b := calls[i].Pos, calls[j].Pos
+		if a.Filename != b.Filename {
+			return a.Filename < b.Filename
+		}
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		return a.Column < b.Column
+	})
+	return calls, nil
+}
+
+// stubImporter resolves modulePath to the module stub and every other import to an empty package
+type stubImporter struct {
+	stub     *types.Package
+	packages map[string]*types.Package
+}
+
+// Import implements types.Importer
+func (i *stubImporter) Import(importPath string) (*types.Package, error) {
+	if importPath == modulePath {
+		return i.stub, nil
+	}
+	if pkg, ok := i.packages[importPath]; ok {
+		return pkg, nil
+	}
+	pkg := types.NewPackage(importPath, path.Base(importPath))
+	pkg.MarkComplete()
+	i.packages[importPath] = pkg
+	return pkg, nil
+}
+
+// newModuleStub declares HTTPClient with the deprecated methods, plus the client constructors
+//
+// The deprecated methods take variadic any and return (any, error); the
+// constructors return *HTTPClient, so `client := etherscan.NewHTTPClient(config)`
+// keeps client typed and its method calls resolve.
+func newModuleStub() *types.Package {
+	pkg := types.NewPackage(modulePath, "etherscan")
+	anyType := types.Universe.Lookup("any").Type()
+	errorType := types.Universe.Lookup("error").Type()
+	param := func(t types.Type) *types.Var { return types.NewParam(token.NoPos, pkg, "", t) }
+	variadic := types.NewTuple(param(types.NewSlice(anyType)))
+
+	name := types.NewTypeName(token.NoPos, pkg, "HTTPClient", nil)
+	client := types.NewNamed(name, types.NewStruct(nil, nil), nil)
+	pkg.Scope().Insert(name)
+	clientPtr := types.NewPointer(client)
+
+	for _, d := range etherscan.Deprecations {
+		recv := types.NewVar(token.NoPos, pkg, "c", clientPtr)
+		results := types.NewTuple(param(anyType), param(errorType))
+		sig := types.NewSignatureType(recv, nil, nil, variadic, results, true)
+		client.AddMethod(types.NewFunc(token.NoPos, pkg, d.Name, sig))
+	}
+
+	constructors := map[string]*types.Tuple{
+		"NewHTTPClient":     types.NewTuple(param(clientPtr)),
+		"NewClientFromEnv":  types.NewTuple(param(clientPtr), param(errorType)),
+		"NewClientFromFile": types.NewTuple(param(clientPtr), param(errorType)),
+	}
+	for fn, results := range constructors {
+		sig := types.NewSignatureType(nil, nil, nil, variadic, results, true)
+		pkg.Scope().Insert(types.NewFunc(token.NoPos, pkg, fn, sig))
+	}
+
+	pkg.MarkComplete()
+	return pkg
+}
+
+// writeMigrationGuide writes a Markdown migration guide for calls, grouped by deprecated API
+func writeMigrationGuide(w io.Writer, calls []deprecatedCall) error {
+	if len(calls) == 0 {
+		_, err := fmt.Fprintln(w, "# Migration guide\n\nNo deprecated etherscan-go APIs are used.")
+		return err
+	}
+
+	grouped := make(map[string][]deprecatedCall)
+	var names []string
+	for _, call := range calls {
+		name := call.Deprecation.Name
+		if _, ok := grouped[name]; !ok {
+			names = append(names, name)
+		}
+		grouped[name] = append(grouped[name], call)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Migration guide\n\n%d deprecated call(s) found.\n", len(calls))
+	for _, name := range names {
+		d := grouped[name][0].Deprecation
+		fmt.Fprintf(&b, "\n## %s -> %s\n\n%s.\n\n```go\n%s\n```\n\n", d.Name, d.Replacement, d.Reason, d.Example)
+		for _, call := range grouped[name] {
+			fmt.Fprintf(&b, "- %s\n", call.Pos)
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
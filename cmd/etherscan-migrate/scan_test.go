@@ -0,0 +1,85 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const appSource = `package app
+
+import (
+	"context"
+
+	es "github.com/dwdwow/etherscan-go"
+)
+
+type service struct {
+	*es.HTTPClient
+}
+
+type pricer struct{}
+
+func (pricer) GetEthPrice(ctx context.Context, opts any) {}
+
+func price(ctx context.Context, client *es.HTTPClient) {
+	client.GetEthPrice(ctx, nil)
+	client.GetNativeTokenPrice(ctx, nil)
+	f := client.GetEthPrice
+	_ = f
+
+	fromEnv, _ := es.NewClientFromEnv()
+	fromEnv.GetEthPrice(ctx, nil)
+	service{}.GetEthPrice(ctx, nil)
+	pricer{}.GetEthPrice(ctx, nil)
+}
+`
+
+const unrelatedSource = `package other
+
+type client struct{}
+
+func (client) GetEthPrice() {}
+
+func run(c client) { c.GetEthPrice() }
+`
+
+func TestScanDeprecatedCalls(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, src string) {
+		t.Helper()
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(src), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("app.go", appSource)
+	write("other/other.go", unrelatedSource)
+	write("vendor/dep/dep.go", appSource)
+
+	calls, err := scanDeprecatedCalls(dir + "/...")
+	if err != nil {
+		t.Fatalf("scanDeprecatedCalls failed: %v", err)
+	}
+	var lines []int
+	for _, call := range calls {
+		lines = append(lines, call.Pos.Line)
+	}
+	if want := []int{18, 20, 24, 25}; len(lines) != len(want) || lines[0] != want[0] || lines[1] != want[1] || lines[2] != want[2] || lines[3] != want[3] {
+		t.Fatalf("expected calls on lines %v, got %v", want, lines)
+	}
+
+	var guide strings.Builder
+	if err := writeMigrationGuide(&guide, calls); err != nil {
+		t.Fatalf("writeMigrationGuide failed: %v", err)
+	}
+	for _, want := range []string{"4 deprecated call(s)", "## GetEthPrice -> GetNativeTokenPrice", "app.go:18:9"} {
+		if !strings.Contains(guide.String(), want) {
+			t.Errorf("guide missing %q:\n%s", want, guide.String())
+		}
+	}
+}
@@ -0,0 +1,344 @@
+package etherscan
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ============================================================================
+// Compiler Settings
+// ============================================================================
+
+// ErrContractNotVerified is returned for contracts without verified source code
+var ErrContractNotVerified = errors.New("etherscan: contract source code not verified")
+
+// CompilerSettings are the compiler inputs of a verified contract, enough for a rebuild
+type CompilerSettings struct {
+	ContractName string `json:"contractName" bson:"contractName"`
+
+	// CompilerVersion is as reported by Etherscan, e.g. "v0.8.19+commit.7dd6d404"
+	CompilerVersion string `json:"compilerVersion" bson:"compilerVersion"`
+
+	// Language is "Solidity" or "Vyper"
+	Language string `json:"language" bson:"language"`
+
+	Optimizer bool  `json:"optimizer" bson:"optimizer"`
+	Runs      int64 `json:"runs" bson:"runs"`
+
+	// EVMVersion is the target EVM, "" for the compiler's default
+	EVMVersion string `json:"evmVersion" bson:"evmVersion"`
+
+	ViaIR bool `json:"viaIR" bson:"viaIR"`
+
+	// Libraries maps linked libraries, "path:Name" or just "Name" when Etherscan
+	// does not name the file, to their lowercase addresses
+	Libraries map[string]string `json:"libraries" bson:"libraries"`
+
+	Remappings []string `json:"remappings" bson:"remappings"`
+
+	// Sources maps source paths to their content
+	Sources map[string]string `json:"sources" bson:"sources"`
+
+	ConstructorArguments string `json:"constructorArguments" bson:"constructorArguments"`
+
+	// RawSettings is the settings object of a standard-json submission, nil for
+	// single- and multi-file submissions; SolcInput reuses it verbatim
+	RawSettings json.RawMessage `json:"rawSettings,omitempty" bson:"rawSettings,omitempty"`
+}
+
+// solcStandardJSON is the part of a solc standard-json input that CompilerSettings reads
+type solcStandardJSON struct {
+	Language string                              `json:"language"`
+	Sources  map[string]struct{ Content string } `json:"sources"`
+	Settings json.RawMessage                     `json:"settings"`
+}
+
+// solcSettings is the part of a solc settings object that CompilerSettings reads
+type solcSettings struct {
+	Optimizer struct {
+		Enabled bool  `json:"enabled"`
+		Runs    int64 `json:"runs"`
+	} `json:"optimizer"`
+	EVMVersion string                       `json:"evmVersion"`
+	ViaIR      bool                         `json:"viaIR"`
+	Libraries  map[string]map[string]string `json:"libraries"`
+	Remappings []string                     `json:"remappings"`
+}
+
+// ParseCompilerSettings extracts the compiler settings of a GetContractSourceCode result
+//
+// Etherscan returns one of three SourceCode formats: a single source file, a
+// JSON object of files, or a solc standard-json input wrapped in double
+// braces. The settings of a standard-json input take precedence over the
+// OptimizationUsed, Runs, EVMVersion and Library fields, which are all the
+// other formats have.
+//
+// Example:
+//
+//	sources, _ := client.GetContractSourceCode(ctx, addr, nil)
+//	settings, err := etherscan.ParseCompilerSettings(sources[0])
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(settings.CompilerVersion, settings.Optimizer, settings.Runs)
+func ParseCompilerSettings(source RespContractSourceCode) (*CompilerSettings, error) {
+	if strings.TrimSpace(source.SourceCode) == "" {
+		return nil, ErrContractNotVerified
+	}
+
+	settings := &CompilerSettings{
+		ContractName:         source.ContractName,
+		CompilerVersion:      source.CompilerVersion,
+		Language:             "Solidity",
+		Optimizer:            strings.TrimSpace(source.OptimizationUsed) == "1",
+		Libraries:            map[string]string{},
+		Remappings:           []string{},
+		Sources:              map[string]string{},
+		ConstructorArguments: source.ConstructorArguments,
+	}
+	if strings.HasPrefix(strings.ToLower(source.CompilerVersion), "vyper") {
+		settings.Language = "Vyper"
+	}
+	if runs := strings.TrimSpace(source.Runs); runs != "" {
+		n, err := strconv.ParseInt(runs, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("etherscan: invalid optimizer runs %q", source.Runs)
+		}
+		settings.Runs = n
+	}
+	if evm := strings.TrimSpace(source.EVMVersion); !strings.EqualFold(evm, "default") {
+		settings.EVMVersion = strings.ToLower(evm)
+	}
+	for _, entry := range strings.FieldsFunc(source.Library, func(r rune) bool { return r == ';' || r == ',' }) {
+		name, address, ok := strings.Cut(strings.TrimSpace(entry), ":")
+		if !ok {
+			return nil, fmt.Errorf("etherscan: invalid library %q", entry)
+		}
+		settings.Libraries[strings.TrimSpace(name)] = strings.ToLower(strings.TrimSpace(address))
+	}
+
+	code := strings.TrimSpace(source.SourceCode)
+	if !strings.HasPrefix(code, "{") {
+		ext := ".sol"
+		if settings.Language == "Vyper" {
+			ext = ".vy"
+		}
+		settings.Sources[source.ContractName+ext] = source.SourceCode
+		return settings, nil
+	}
+
+	// Standard-json inputs come wrapped in an extra pair of braces
+	if strings.HasPrefix(code, "{{") && strings.HasSuffix(code, "}}") {
+		code = code[1 : len(code)-1]
+	}
+	var input solcStandardJSON
+	if err := json.Unmarshal([]byte(code), &input); err != nil {
+		return nil, fmt.Errorf("etherscan: invalid source code JSON: %w", err)
+	}
+	if input.Sources == nil {
+		// A multi-file submission: the object maps paths to {"content": ...}
+		var files map[string]struct{ Content string }
+		if err := json.Unmarshal([]byte(code), &files); err != nil {
+			return nil, fmt.Errorf("etherscan: invalid source files JSON: %w", err)
+		}
+		for path, file := range files {
+			settings.Sources[path] = file.Content
+		}
+		return settings, nil
+	}
+
+	if input.Language != "" {
+		settings.Language = input.Language
+	}
+	for path, file := range input.Sources {
+		settings.Sources[path] = file.Content
+	}
+	if len(input.Settings) == 0 {
+		return settings, nil
+	}
+	var parsed solcSettings
+	if err := json.Unmarshal(input.Settings, &parsed); err != nil {
+		return nil, fmt.Errorf("etherscan: invalid compiler settings: %w", err)
+	}
+	settings.RawSettings = input.Settings
+	settings.Optimizer = parsed.Optimizer.Enabled
+	settings.Runs = parsed.Optimizer.Runs
+	settings.EVMVersion = parsed.EVMVersion
+	settings.ViaIR = parsed.ViaIR
+	if parsed.Remappings != nil {
+		settings.Remappings = parsed.Remappings
+	}
+	if len(parsed.Libraries) > 0 {
+		settings.Libraries = map[string]string{}
+		for path, libs := range parsed.Libraries {
+			for name, address := range libs {
+				key := name
+				if path != "" {
+					key = path + ":" + name
+				}
+				settings.Libraries[key] = strings.ToLower(address)
+			}
+		}
+	}
+	return settings, nil
+}
+
+// SolcVersion returns the bare compiler version, e.g. "0.8.19" for "v0.8.19+commit.7dd6d404"
+func (s *CompilerSettings) SolcVersion() string {
+	version := strings.TrimPrefix(strings.TrimPrefix(s.CompilerVersion, "vyper:"), "v")
+	version, _, _ = strings.Cut(version, "+")
+	return version
+}
+
+// SolcInput returns a solc standard-json input that recompiles the contract
+//
+// A standard-json submission reuses its settings verbatim, so metadata and
+// output settings match the original build; other submissions get settings
+// built from the extracted fields.
+func (s *CompilerSettings) SolcInput() ([]byte, error) {
+	sources := make(map[string]map[string]string, len(s.Sources))
+	for path, content := range s.Sources {
+		sources[path] = map[string]string{"content": content}
+	}
+
+	var settings any = s.RawSettings
+	if s.RawSettings == nil {
+		built := map[string]any{
+			"optimizer": map[string]any{"enabled": s.Optimizer, "runs": s.Runs},
+			"outputSelection": map[string]any{
+				"*": map[string]any{"*": []string{"abi", "evm.bytecode", "evm.deployedBytecode", "metadata"}},
+			},
+		}
+		if s.EVMVersion != "" {
+			built["evmVersion"] = s.EVMVersion
+		}
+		if s.ViaIR {
+			built["viaIR"] = true
+		}
+		if len(s.Remappings) > 0 {
+			built["remappings"] = s.Remappings
+		}
+		if len(s.Libraries) > 0 {
+			libraries := map[string]map[string]string{}
+			for key, address := range s.Libraries {
+				path, name := libraryPathAndName(key)
+				if libraries[path] == nil {
+					libraries[path] = map[string]string{}
+				}
+				libraries[path][name] = address
+			}
+			built["libraries"] = libraries
+		}
+		settings = built
+	}
+
+	return json.MarshalIndent(map[string]any{
+		"language": s.Language,
+		"sources":  sources,
+		"settings": settings,
+	}, "", "  ")
+}
+
+// FoundryToml returns a foundry.toml default profile with the contract's compiler settings
+func (s *CompilerSettings) FoundryToml() string {
+	var b strings.Builder
+	b.WriteString("[profile.default]\n")
+	fmt.Fprintf(&b, "solc_version = %s\n", strconv.Quote(s.SolcVersion()))
+	fmt.Fprintf(&b, "optimizer = %t\n", s.Optimizer)
+	fmt.Fprintf(&b, "optimizer_runs = %d\n", s.Runs)
+	if s.EVMVersion != "" {
+		fmt.Fprintf(&b, "evm_version = %s\n", strconv.Quote(s.EVMVersion))
+	}
+	if s.ViaIR {
+		b.WriteString("via_ir = true\n")
+	}
+
+	writeList := func(key string, values []string) {
+		if len(values) == 0 {
+			return
+		}
+		fmt.Fprintf(&b, "%s = [\n", key)
+		for _, v := range values {
+			fmt.Fprintf(&b, "    %s,\n", strconv.Quote(v))
+		}
+		b.WriteString("]\n")
+	}
+	writeList("remappings", s.Remappings)
+
+	libraries := make([]string, 0, len(s.Libraries))
+	for key, address := range s.Libraries {
+		path, name := libraryPathAndName(key)
+		libraries = append(libraries, path+":"+name+":"+address)
+	}
+	sort.Strings(libraries)
+	writeList("libraries", libraries)
+	return b.String()
+}
+
+// libraryPathAndName splits a CompilerSettings.Libraries key into its source path and library name
+func libraryPathAndName(key string) (string, string) {
+	if i := strings.LastIndex(key, ":"); i >= 0 {
+		return key[:i], key[i+1:]
+	}
+	return "", key
+}
+
+// GetCompilerSettingsOpts contains optional parameters for GetCompilerSettings
+type GetCompilerSettingsOpts struct {
+	// ChainID specifies which blockchain network to query
+	// Default: empty (uses client default)
+	ChainID int64
+
+	// OnLimitExceeded specifies behavior when rate limit is exceeded
+	// Default: RateLimitBlock (wait until a token is available)
+	OnLimitExceeded RateLimitBehavior `default:""`
+}
+
+// GetCompilerSettings returns the compiler settings of a verified contract, for reproducible builds
+//
+// The source code is fetched with GetContractSourceCode and parsed with
+// ParseCompilerSettings. SolcInput and FoundryToml turn the result into a
+// solc standard-json input or a foundry.toml for byte-for-byte rebuild attempts.
+//
+// Args:
+//   - ctx: Context for request cancellation and timeout
+//   - address: The contract address
+//   - opts: Optional parameters (can be nil)
+//
+// Returns:
+//   - *CompilerSettings: The compiler inputs of the contract
+//   - error: ErrContractNotVerified if the contract has no verified source, or an error if the request fails
+//
+// Example:
+//
+//	settings, err := client.GetCompilerSettings(ctx, addr, nil)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	input, _ := settings.SolcInput()
+//	os.WriteFile("input.json", input, 0o644)
+//	os.WriteFile("foundry.toml", []byte(settings.FoundryToml()), 0o644)
+func (c *HTTPClient) GetCompilerSettings(ctx context.Context, address string, opts *GetCompilerSettingsOpts) (*CompilerSettings, error) {
+	if opts == nil {
+		opts = &GetCompilerSettingsOpts{}
+	}
+	if err := ApplyDefaults(opts); err != nil {
+		return nil, err
+	}
+	sources, err := c.GetContractSourceCode(ctx, address, &GetContractSourceCodeOpts{
+		ChainID:         opts.ChainID,
+		OnLimitExceeded: opts.OnLimitExceeded,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(sources) == 0 {
+		return nil, ErrContractNotVerified
+	}
+	return ParseCompilerSettings(sources[0])
+}
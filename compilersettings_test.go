@@ -0,0 +1,110 @@
+package etherscan
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestParseCompilerSettings(t *testing.T) {
+	// Standard-json inputs take their settings from the payload
+	standard := RespContractSourceCode{
+		ContractName:     "Vault",
+		CompilerVersion:  "v0.8.19+commit.7dd6d404",
+		OptimizationUsed: "0",
+		Runs:             "0",
+		SourceCode: `{{"language":"Solidity","sources":{"src/Vault.sol":{"content":"contract Vault {}"}},` +
+			`"settings":{"optimizer":{"enabled":true,"runs":10000},"evmVersion":"paris","viaIR":true,` +
+			`"remappings":["@oz/=lib/openzeppelin/"],"libraries":{"src/Math.sol":{"Math":"0xABCDEF0000000000000000000000000000000001"}}}}}`,
+	}
+	settings, err := ParseCompilerSettings(standard)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !settings.Optimizer || settings.Runs != 10000 || settings.EVMVersion != "paris" || !settings.ViaIR ||
+		len(settings.Remappings) != 1 || settings.Libraries["src/Math.sol:Math"] != "0xabcdef0000000000000000000000000000000001" ||
+		settings.Sources["src/Vault.sol"] != "contract Vault {}" || settings.SolcVersion() != "0.8.19" {
+		t.Errorf("unexpected standard-json settings: %+v", settings)
+	}
+
+	var input solcStandardJSON
+	raw, err := settings.SolcInput()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(raw, &input); err != nil {
+		t.Fatal(err)
+	}
+	var reused solcSettings
+	if err := json.Unmarshal(input.Settings, &reused); err != nil || reused.Optimizer.Runs != 10000 || !reused.ViaIR ||
+		input.Language != "Solidity" || input.Sources["src/Vault.sol"].Content != "contract Vault {}" {
+		t.Errorf("expected the original settings and sources, got %s", raw)
+	}
+
+	toml := settings.FoundryToml()
+	for _, want := range []string{
+		`solc_version = "0.8.19"`, "optimizer = true", "optimizer_runs = 10000", `evm_version = "paris"`, "via_ir = true",
+		`"@oz/=lib/openzeppelin/"`, `"src/Math.sol:Math:0xabcdef0000000000000000000000000000000001"`,
+	} {
+		if !strings.Contains(toml, want) {
+			t.Errorf("expected %s in foundry.toml:\n%s", want, toml)
+		}
+	}
+
+	// Single files take their settings from the response fields
+	single, err := ParseCompilerSettings(RespContractSourceCode{
+		ContractName:     "Token",
+		CompilerVersion:  "v0.6.12+commit.27d51765",
+		OptimizationUsed: "1",
+		Runs:             "200",
+		EVMVersion:       "Default",
+		Library:          "SafeMath:0x1111111111111111111111111111111111111111",
+		SourceCode:       "pragma solidity 0.6.12; contract Token {}",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !single.Optimizer || single.Runs != 200 || single.EVMVersion != "" || single.Sources["Token.sol"] == "" ||
+		single.Libraries["SafeMath"] != "0x1111111111111111111111111111111111111111" || single.RawSettings != nil {
+		t.Errorf("unexpected single-file settings: %+v", single)
+	}
+	if raw, err := single.SolcInput(); err != nil || !strings.Contains(string(raw), `"runs": 200`) || !strings.Contains(string(raw), `"SafeMath"`) {
+		t.Errorf("expected settings built from the fields, got %s %v", raw, err)
+	}
+
+	// Multi-file submissions are a plain object of files
+	multi, err := ParseCompilerSettings(RespContractSourceCode{
+		ContractName: "Pair",
+		SourceCode:   `{"Pair.sol":{"content":"contract Pair {}"},"Lib.sol":{"content":"library Lib {}"}}`,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(multi.Sources) != 2 || multi.Sources["Lib.sol"] != "library Lib {}" {
+		t.Errorf("unexpected multi-file sources: %+v", multi.Sources)
+	}
+
+	if _, err := ParseCompilerSettings(RespContractSourceCode{}); !errors.Is(err, ErrContractNotVerified) {
+		t.Errorf("expected ErrContractNotVerified, got %v", err)
+	}
+}
+
+func TestGetCompilerSettings(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":"1","message":"OK","result":[{"SourceCode":"","ABI":"Contract source code not verified"}]}`))
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+		MaxRetries: -1,
+	})
+	if _, err := client.GetCompilerSettings(context.Background(), TestAddresses.VitalikButerin, nil); !errors.Is(err, ErrContractNotVerified) {
+		t.Errorf("expected ErrContractNotVerified, got %v", err)
+	}
+}
@@ -0,0 +1,423 @@
+package etherscan
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ============================================================================
+// Config Loading
+// ============================================================================
+
+// EnvPrefix is the prefix of the environment variables read by HTTPClientConfigFromEnv
+const EnvPrefix = "ETHERSCAN_"
+
+// EnvConfigFile names a config file that HTTPClientConfigFromEnv loads before applying variables
+const EnvConfigFile = EnvPrefix + "CONFIG"
+
+// Config keys shared by config files and environment variables
+//
+// In files they are top-level keys; api_keys, base_urls and rpc_urls are tables
+// keyed by chain ID. In the environment they are upper-cased and prefixed with
+// EnvPrefix (ETHERSCAN_API_KEY, ETHERSCAN_API_TIER, ...), and the per-chain
+// tables become ETHERSCAN_API_KEY_<chainid>, ETHERSCAN_BASE_URL_<chainid> and
+// ETHERSCAN_RPC_URL_<chainid>.
+const (
+	configKeyAPIKey               = "api_key"
+	configKeyAPIKeys              = "api_keys"
+	configKeyChainID              = "chain_id"
+	configKeyAPITier              = "api_tier"
+	configKeyOnLimitExceeded      = "on_limit_exceeded"
+	configKeyAPIVersion           = "api_version"
+	configKeyBaseURLs             = "base_urls"
+	configKeyRPCURLs              = "rpc_urls"
+	configKeyTimeout              = "timeout"
+	configKeyDebugDumpDir         = "debug_dump_dir"
+	configKeySkipCapabilityCheck  = "skip_capability_check"
+	configKeySkipAddressCheck     = "skip_address_validation"
+	configKeyCaptureUnknownFields = "capture_unknown_fields"
+	configKeyRequireBlockRange    = "require_explicit_block_range"
+	configKeyRateLimitPerSecond   = "rate_limit_per_second"
+	configKeyDailyLimit           = "daily_limit"
+	configKeyMaxRetries           = "max_retries"
+	configKeyRetryDelay           = "retry_delay"
+	configKeyCircuitThreshold     = "circuit_breaker_threshold"
+	configKeyCircuitCooldown      = "circuit_breaker_cooldown"
+	configKeySchemaWarnings       = "schema_warnings"
+	configKeyResultMemoryBudget   = "result_memory_budget"
+	configKeySpillDir             = "spill_dir"
+)
+
+// NewClientFromEnv creates a client configured from environment variables
+//
+// If ETHERSCAN_CONFIG is set, that file is loaded first and the variables
+// override it. See HTTPClientConfigFromEnv for the supported variables.
+//
+// Example:
+//
+//	// ETHERSCAN_API_KEY=... ETHERSCAN_API_TIER=standard ./service
+//	client, err := NewClientFromEnv()
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+func NewClientFromEnv() (*HTTPClient, error) {
+	config, err := HTTPClientConfigFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	return NewHTTPClient(config), nil
+}
+
+// NewClientFromFile creates a client configured from a JSON, TOML or YAML file
+func NewClientFromFile(path string) (*HTTPClient, error) {
+	config, err := LoadHTTPClientConfig(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewHTTPClient(config), nil
+}
+
+// HTTPClientConfigFromEnv builds an HTTPClientConfig from environment variables
+//
+// Supported variables:
+//   - ETHERSCAN_CONFIG: config file loaded before the variables below
+//   - ETHERSCAN_API_KEY: default API key (required unless set in the file)
+//   - ETHERSCAN_API_KEY_<chainid>: API key for one chain
+//   - ETHERSCAN_CHAIN_ID: default chain ID
+//   - ETHERSCAN_API_TIER: free, standard, advanced, professional or pro_plus
+//   - ETHERSCAN_ON_LIMIT_EXCEEDED: block, raise or skip
+//   - ETHERSCAN_API_VERSION: v2 or v1
+//   - ETHERSCAN_BASE_URL_<chainid>: legacy V1 endpoint for one chain
+//   - ETHERSCAN_RPC_URL_<chainid>: JSON-RPC endpoint serving the proxy calls of one chain
+//   - ETHERSCAN_TIMEOUT: HTTP timeout as a Go duration, e.g. "15s"
+//   - ETHERSCAN_DEBUG_DUMP_DIR: directory for undecodable response bodies
+//   - ETHERSCAN_SKIP_CAPABILITY_CHECK: true to disable the chain capability check
+//   - ETHERSCAN_SKIP_ADDRESS_VALIDATION: true to send address parameters without NormalizeAddress
+//   - ETHERSCAN_CAPTURE_UNKNOWN_FIELDS: true to keep undeclared response fields in UnknownFields
+//   - ETHERSCAN_REQUIRE_EXPLICIT_BLOCK_RANGE: true to reject list calls left at the full-history range
+//   - ETHERSCAN_RATE_LIMIT_PER_SECOND, ETHERSCAN_DAILY_LIMIT: override the tier's call limits
+//   - ETHERSCAN_MAX_RETRIES: retries after a transport error or rate-limit response, -1 to disable
+//   - ETHERSCAN_RETRY_DELAY: pause before each retry as a Go duration, e.g. "500ms"
+//   - ETHERSCAN_CIRCUIT_BREAKER_THRESHOLD: consecutive failures that open the circuit of an endpoint
+//   - ETHERSCAN_CIRCUIT_BREAKER_COOLDOWN: how long an open circuit fails fast, as a Go duration
+//   - ETHERSCAN_SCHEMA_WARNINGS: true to log responses in a newer format than their endpoint targets
+//   - ETHERSCAN_RESULT_MEMORY_BUDGET: bytes of scanned rows aggregation helpers hold before spilling, -1 to disable
+//   - ETHERSCAN_SPILL_DIR: directory for the spilled rows
+//
+// The client has no response cache, so there are no cache settings; static
+// metadata can be kept on disk with MetadataCache.
+func HTTPClientConfigFromEnv() (HTTPClientConfig, error) {
+	var config HTTPClientConfig
+	if path := os.Getenv(EnvConfigFile); path != "" {
+		var err error
+		if config, err = LoadHTTPClientConfig(path); err != nil {
+			return HTTPClientConfig{}, err
+		}
+	}
+
+	values := make(map[string]string)
+	for _, env := range os.Environ() {
+		name, value, ok := strings.Cut(env, "=")
+		if !ok || name == EnvConfigFile {
+			continue
+		}
+		key, ok := strings.CutPrefix(name, EnvPrefix)
+		if !ok {
+			continue
+		}
+		key = strings.ToLower(key)
+		if chainID, ok := strings.CutPrefix(key, "api_key_"); ok && isDigits(chainID) {
+			key = configKeyAPIKeys + "." + chainID
+		} else if chainID, ok := strings.CutPrefix(key, "base_url_"); ok && isDigits(chainID) {
+			key = configKeyBaseURLs + "." + chainID
+		} else if chainID, ok := strings.CutPrefix(key, "rpc_url_"); ok && isDigits(chainID) {
+			key = configKeyRPCURLs + "." + chainID
+		}
+		values[key] = value
+	}
+	if err := applyConfigValues(&config, values, "environment"); err != nil {
+		return HTTPClientConfig{}, err
+	}
+
+	if config.APIKey == "" && len(config.ChainAPIKeys) == 0 {
+		return HTTPClientConfig{}, fmt.Errorf("etherscan: no API key configured, set %sAPI_KEY", EnvPrefix)
+	}
+	return config, nil
+}
+
+// LoadHTTPClientConfig reads an HTTPClientConfig from a JSON, TOML or YAML file
+//
+// The format is chosen by file extension (.json, .toml, .yaml/.yml). TOML and
+// YAML support the flat layout this config needs: top-level scalars plus the
+// api_keys, base_urls and rpc_urls tables keyed by chain ID.
+//
+// Example (TOML):
+//
+//	api_key = "YOUR_API_KEY"
+//	api_tier = "standard"
+//	timeout = "15s"
+//
+//	[api_keys]
+//	137 = "POLYGON_KEY"
+//
+// Example (YAML):
+//
+//	api_key: YOUR_API_KEY
+//	api_tier: standard
+//	api_keys:
+//	  137: POLYGON_KEY
+func LoadHTTPClientConfig(path string) (HTTPClientConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return HTTPClientConfig{}, err
+	}
+
+	var values map[string]string
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".json":
+		values, err = parseJSONConfig(data)
+	case ".toml":
+		values, err = parseTOMLConfig(data)
+	case ".yaml", ".yml":
+		values, err = parseYAMLConfig(data)
+	default:
+		return HTTPClientConfig{}, fmt.Errorf("etherscan: unsupported config file format %q", ext)
+	}
+	if err != nil {
+		return HTTPClientConfig{}, fmt.Errorf("etherscan: parse config %s: %w", path, err)
+	}
+
+	var config HTTPClientConfig
+	if err := applyConfigValues(&config, values, path); err != nil {
+		return HTTPClientConfig{}, err
+	}
+	return config, nil
+}
+
+// applyConfigValues sets the fields of config from flattened "key" / "table.chainid" values
+func applyConfigValues(config *HTTPClientConfig, values map[string]string, source string) error {
+	for key, value := range values {
+		var err error
+		switch table, chain, _ := strings.Cut(key, "."); table {
+		case configKeyAPIKey:
+			config.APIKey = value
+		case configKeyAPIKeys:
+			var chainID int
+			if chainID, err = strconv.Atoi(chain); err == nil {
+				if config.ChainAPIKeys == nil {
+					config.ChainAPIKeys = make(map[int]string)
+				}
+				config.ChainAPIKeys[chainID] = value
+			}
+		case configKeyBaseURLs:
+			var chainID int
+			if chainID, err = strconv.Atoi(chain); err == nil {
+				if config.V1BaseURLs == nil {
+					config.V1BaseURLs = make(map[int]string)
+				}
+				config.V1BaseURLs[chainID] = value
+			}
+		case configKeyRPCURLs:
+			var chainID int
+			if chainID, err = strconv.Atoi(chain); err == nil {
+				if config.RPCURLs == nil {
+					config.RPCURLs = make(map[int]string)
+				}
+				config.RPCURLs[chainID] = value
+			}
+		case configKeyChainID:
+			config.DefaultChainID, err = strconv.Atoi(value)
+		case configKeyAPITier:
+			switch value {
+			case FreeTier, StandardTier, AdvancedTier, ProfessionalTier, ProPlusTier:
+				config.APITier = value
+			default:
+				err = fmt.Errorf("unknown tier %q", value)
+			}
+		case configKeyOnLimitExceeded:
+			switch behavior := RateLimitBehavior(value); behavior {
+			case RateLimitBlock, RateLimitRaise, RateLimitSkip:
+				config.OnLimitExceeded = behavior
+			default:
+				err = fmt.Errorf("unknown behavior %q", value)
+			}
+		case configKeyAPIVersion:
+			switch version := APIVersion(value); version {
+			case APIVersionV1, APIVersionV2:
+				config.APIVersion = version
+			default:
+				err = fmt.Errorf("unknown version %q", value)
+			}
+		case configKeyTimeout:
+			var timeout time.Duration
+			if timeout, err = time.ParseDuration(value); err == nil {
+				config.HTTPClient = &http.Client{Timeout: timeout}
+			}
+		case configKeyDebugDumpDir:
+			config.DebugDumpDir = value
+		case configKeySkipCapabilityCheck:
+			config.SkipCapabilityCheck, err = strconv.ParseBool(value)
+		case configKeySkipAddressCheck:
+			config.SkipAddressValidation, err = strconv.ParseBool(value)
+		case configKeyCaptureUnknownFields:
+			config.CaptureUnknownFields, err = strconv.ParseBool(value)
+		case configKeyRequireBlockRange:
+			config.RequireExplicitBlockRange, err = strconv.ParseBool(value)
+		case configKeyRateLimitPerSecond:
+			config.RateLimitPerSecond, err = strconv.ParseInt(value, 10, 64)
+		case configKeyDailyLimit:
+			config.DailyLimit, err = strconv.ParseInt(value, 10, 64)
+		case configKeyMaxRetries:
+			config.MaxRetries, err = strconv.Atoi(value)
+		case configKeyRetryDelay:
+			config.RetryDelay, err = time.ParseDuration(value)
+		case configKeyCircuitThreshold:
+			config.CircuitBreakerThreshold, err = strconv.Atoi(value)
+		case configKeyCircuitCooldown:
+			config.CircuitBreakerCooldown, err = time.ParseDuration(value)
+		case configKeySchemaWarnings:
+			config.SchemaWarnings, err = strconv.ParseBool(value)
+		case configKeyResultMemoryBudget:
+			config.ResultMemoryBudget, err = strconv.ParseInt(value, 10, 64)
+		case configKeySpillDir:
+			config.SpillDir = value
+		default:
+			// Unknown keys are ignored so that shared config files and unrelated
+			// ETHERSCAN_* variables do not break the client
+		}
+		if err != nil {
+			return fmt.Errorf("etherscan: invalid %s in %s: %w", key, source, err)
+		}
+	}
+	return nil
+}
+
+// parseJSONConfig flattens a JSON config object into config values
+func parseJSONConfig(data []byte) (map[string]string, error) {
+	var raw map[string]any
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&raw); err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]string)
+	for key, value := range raw {
+		if table, ok := value.(map[string]any); ok {
+			for chainID, v := range table {
+				values[key+"."+chainID] = fmt.Sprint(v)
+			}
+			continue
+		}
+		values[key] = fmt.Sprint(value)
+	}
+	return values, nil
+}
+
+// parseTOMLConfig parses the flat TOML subset used by config files
+func parseTOMLConfig(data []byte) (map[string]string, error) {
+	values := make(map[string]string)
+	table := ""
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(stripConfigComment(scanner.Text()))
+		if line == "" {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			table = strings.TrimSpace(line[1 : len(line)-1])
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected key = value", lineNo)
+		}
+		key = unquoteConfigValue(strings.TrimSpace(key))
+		if table != "" {
+			key = table + "." + key
+		}
+		values[key] = unquoteConfigValue(strings.TrimSpace(value))
+	}
+	return values, scanner.Err()
+}
+
+// parseYAMLConfig parses the flat YAML subset used by config files
+func parseYAMLConfig(data []byte) (map[string]string, error) {
+	values := make(map[string]string)
+	table := ""
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		raw := stripConfigComment(scanner.Text())
+		line := strings.TrimSpace(raw)
+		if line == "" || line == "---" {
+			continue
+		}
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected key: value", lineNo)
+		}
+		key = unquoteConfigValue(strings.TrimSpace(key))
+		value = strings.TrimSpace(value)
+
+		indented := raw[0] == ' ' || raw[0] == '\t'
+		switch {
+		case !indented && value == "":
+			table = key
+		case !indented:
+			table = ""
+			values[key] = unquoteConfigValue(value)
+		case table != "":
+			values[table+"."+key] = unquoteConfigValue(value)
+		default:
+			return nil, fmt.Errorf("line %d: unexpected indentation", lineNo)
+		}
+	}
+	return values, scanner.Err()
+}
+
+// stripConfigComment removes a trailing # comment that is not inside quotes
+func stripConfigComment(line string) string {
+	var quote rune
+	for i, r := range line {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '"' || r == '\'':
+			quote = r
+		case r == '#':
+			return line[:i]
+		}
+	}
+	return line
+}
+
+// unquoteConfigValue removes matching single or double quotes around s
+func unquoteConfigValue(s string) string {
+	if len(s) >= 2 && (s[0] == '"' || s[0] == '\'') && s[len(s)-1] == s[0] {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
+
+// isDigits reports whether s is a non-empty string of ASCII digits
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
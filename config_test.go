@@ -0,0 +1,132 @@
+package etherscan
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func writeConfigFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	return path
+}
+
+func checkLoadedConfig(t *testing.T, config HTTPClientConfig) {
+	t.Helper()
+	if config.APIKey != "KEY" {
+		t.Errorf("expected api key KEY, got %q", config.APIKey)
+	}
+	if config.APITier != StandardTier {
+		t.Errorf("expected standard tier, got %q", config.APITier)
+	}
+	if config.DefaultChainID != PolygonMainnet {
+		t.Errorf("expected chain %d, got %d", PolygonMainnet, config.DefaultChainID)
+	}
+	if config.ChainAPIKeys[PolygonMainnet] != "POLYGON # KEY" {
+		t.Errorf("expected polygon key, got %v", config.ChainAPIKeys)
+	}
+	if config.HTTPClient == nil || config.HTTPClient.Timeout != 15*time.Second {
+		t.Errorf("expected 15s timeout, got %+v", config.HTTPClient)
+	}
+	if !config.SkipCapabilityCheck {
+		t.Error("expected capability check to be skipped")
+	}
+	if config.RateLimitPerSecond != 4 || config.DailyLimit != 50000 {
+		t.Errorf("expected 4/s and 50000/day, got %d and %d", config.RateLimitPerSecond, config.DailyLimit)
+	}
+	if config.MaxRetries != 5 || config.RetryDelay != 250*time.Millisecond {
+		t.Errorf("expected 5 retries after 250ms, got %d after %s", config.MaxRetries, config.RetryDelay)
+	}
+}
+
+func TestLoadHTTPClientConfig(t *testing.T) {
+	files := map[string]string{
+		"config.json": `{"api_key": "KEY", "api_tier": "standard", "chain_id": 137, "timeout": "15s",
+			"skip_capability_check": true, "rate_limit_per_second": 4, "daily_limit": 50000,
+			"max_retries": 5, "retry_delay": "250ms", "api_keys": {"137": "POLYGON # KEY"}}`,
+		"config.toml": `# etherscan client
+api_key = "KEY"
+api_tier = "standard" # rate limits
+chain_id = 137
+timeout = "15s"
+skip_capability_check = true
+rate_limit_per_second = 4
+daily_limit = 50000
+max_retries = 5
+retry_delay = "250ms"
+
+[api_keys]
+137 = "POLYGON # KEY"
+`,
+		"config.yaml": `api_key: KEY
+api_tier: standard
+chain_id: 137
+timeout: 15s
+skip_capability_check: true
+rate_limit_per_second: 4
+daily_limit: 50000
+max_retries: 5
+retry_delay: 250ms
+api_keys:
+  137: "POLYGON # KEY"
+`,
+	}
+	for name, content := range files {
+		t.Run(name, func(t *testing.T) {
+			config, err := LoadHTTPClientConfig(writeConfigFile(t, name, content))
+			if err != nil {
+				t.Fatalf("LoadHTTPClientConfig failed: %v", err)
+			}
+			checkLoadedConfig(t, config)
+		})
+	}
+}
+
+func TestLoadHTTPClientConfigInvalid(t *testing.T) {
+	if _, err := LoadHTTPClientConfig(writeConfigFile(t, "config.toml", `api_tier = "gold"`)); err == nil {
+		t.Error("expected error for unknown tier")
+	}
+	if _, err := LoadHTTPClientConfig(writeConfigFile(t, "config.ini", `api_key = KEY`)); err == nil {
+		t.Error("expected error for unsupported format")
+	}
+}
+
+func TestHTTPClientConfigFromEnv(t *testing.T) {
+	path := writeConfigFile(t, "config.toml", "api_key = \"FILE_KEY\"\napi_tier = \"free\"\n")
+	t.Setenv(EnvConfigFile, path)
+	t.Setenv("ETHERSCAN_API_KEY", "ENV_KEY")
+	t.Setenv("ETHERSCAN_API_KEY_137", "POLYGON_KEY")
+	t.Setenv("ETHERSCAN_BASE_URL_10", "http://localhost:8545")
+	t.Setenv("ETHERSCAN_RPC_URL_1", "http://localhost:8546")
+	t.Setenv("ETHERSCAN_API_KEY_FILE", "/ignored")
+
+	config, err := HTTPClientConfigFromEnv()
+	if err != nil {
+		t.Fatalf("HTTPClientConfigFromEnv failed: %v", err)
+	}
+	if config.APIKey != "ENV_KEY" {
+		t.Errorf("expected environment to override file, got %q", config.APIKey)
+	}
+	if config.APITier != FreeTier {
+		t.Errorf("expected tier from file, got %q", config.APITier)
+	}
+	if config.ChainAPIKeys[PolygonMainnet] != "POLYGON_KEY" || config.V1BaseURLs[OPMainnet] != "http://localhost:8545" {
+		t.Errorf("unexpected per-chain config: %v %v", config.ChainAPIKeys, config.V1BaseURLs)
+	}
+	if config.RPCURLs[EthereumMainnet] != "http://localhost:8546" {
+		t.Errorf("unexpected RPC endpoints: %v", config.RPCURLs)
+	}
+
+	client := NewHTTPClient(config)
+	if got := client.apiKeyFor("137"); got != "POLYGON_KEY" {
+		t.Errorf("expected polygon key for chain 137, got %q", got)
+	}
+	if got := client.apiKeyFor("1"); got != "ENV_KEY" {
+		t.Errorf("expected default key for chain 1, got %q", got)
+	}
+}
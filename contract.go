@@ -24,6 +24,10 @@ type GetContractABIOpts struct {
 	//   - RateLimitRaise: Return an error when rate limit is exceeded
 	//   - RateLimitSkip: Return false without executing when rate limit is exceeded
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // GetContractABI returns the Contract Application Binary Interface (ABI) of a verified smart contract
@@ -88,8 +92,10 @@ func (c *HTTPClient) GetContractABI(ctx context.Context, address string, opts *G
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -99,6 +105,7 @@ func (c *HTTPClient) GetContractABI(ctx context.Context, address string, opts *G
 		params:          params,
 		noFoundReturn:   "",
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return "", err
@@ -124,6 +131,10 @@ type GetContractSourceCodeOpts struct {
 	//   - RateLimitRaise: Return an error when rate limit is exceeded
 	//   - RateLimitSkip: Return false without executing when rate limit is exceeded
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // GetContractSourceCode returns the Solidity source code of a verified smart contract
@@ -182,8 +193,10 @@ func (c *HTTPClient) GetContractSourceCode(ctx context.Context, address string,
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -193,13 +206,14 @@ func (c *HTTPClient) GetContractSourceCode(ctx context.Context, address string,
 		params:          params,
 		noFoundReturn:   []RespContractSourceCode{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result []RespContractSourceCode
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
 	return result, nil
@@ -231,6 +245,10 @@ type VerifySourceCodeOpts struct {
 	//   - RateLimitRaise: Return an error when rate limit is exceeded
 	//   - RateLimitSkip: Return false without executing when rate limit is exceeded
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // VerifySourceCode submits contract source code for verification
@@ -245,12 +263,12 @@ type VerifySourceCodeOpts struct {
 //   - contractAddress: The deployed contract address
 //   - contractName: Contract name (e.g., "contracts/Verified.sol:Verified")
 //   - compilerVersion: Compiler version (e.g., "v0.8.24+commit.e11b9ed9")
-//   - codeFormat: Source code format ("solidity-single-file" or "solidity-standard-json-input")
+//   - codeFormat: Source code format (CodeFormatSoliditySingleFile or CodeFormatSolidityStandardJSON)
 //   - opts: Optional parameters (can be nil)
 //
 // Returns:
 //   - string: Verification GUID for tracking verification status
-//   - error: Error if the request fails
+//   - error: Error if codeFormat is unknown or the request fails
 //
 // Example:
 //
@@ -263,7 +281,7 @@ type VerifySourceCodeOpts struct {
 //	contractAddr := "0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb"
 //	contractName := "MyContract.sol:MyContract"
 //	compilerVer := "v0.8.24+commit.e11b9ed9"
-//	codeFormat := "solidity-single-file"
+//	codeFormat := etherscan.CodeFormatSoliditySingleFile
 //
 //	guid, err := client.VerifySourceCode(ctx, sourceCode, contractAddr, contractName, compilerVer, codeFormat, nil)
 //	if err != nil {
@@ -288,24 +306,29 @@ type VerifySourceCodeOpts struct {
 //   - Use CheckSourceCodeVerificationStatus to check verification progress
 //   - Constructor arguments must be ABI-encoded
 //   - Source code must match the deployed bytecode exactly
-func (c *HTTPClient) VerifySourceCode(ctx context.Context, sourceCode, contractAddress, contractName, compilerVersion, codeFormat string, opts *VerifySourceCodeOpts) (string, error) {
+func (c *HTTPClient) VerifySourceCode(ctx context.Context, sourceCode, contractAddress, contractName, compilerVersion string, codeFormat CodeFormat, opts *VerifySourceCodeOpts) (string, error) {
 	// Apply defaults and extract API parameters
 	params, err := ApplyDefaultsAndExtractParams(opts)
 	if err != nil {
 		return "", err
 	}
+	if err := codeFormat.Validate(); err != nil {
+		return "", err
+	}
 
 	// Add required parameters
 	params["sourceCode"] = sourceCode
 	params["contractaddress"] = contractAddress
 	params["contractname"] = contractName
 	params["compilerversion"] = compilerVersion
-	params["codeformat"] = codeFormat
+	params["codeformat"] = string(codeFormat)
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -316,6 +339,7 @@ func (c *HTTPClient) VerifySourceCode(ctx context.Context, sourceCode, contractA
 		method:          "POST",
 		noFoundReturn:   "",
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return "", err
@@ -349,6 +373,10 @@ type VerifyVyperSourceCodeOpts struct {
 	//   - RateLimitRaise: Return an error when rate limit is exceeded
 	//   - RateLimitSkip: Return false without executing when rate limit is exceeded
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // VerifyVyperSourceCode submits Vyper contract source code for verification
@@ -408,12 +436,14 @@ func (c *HTTPClient) VerifyVyperSourceCode(ctx context.Context, sourceCode, cont
 	params["contractaddress"] = contractAddress
 	params["contractname"] = contractName
 	params["compilerversion"] = compilerVersion
-	params["codeformat"] = "vyper-json"
+	params["codeformat"] = string(CodeFormatVyperJSON)
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -424,6 +454,7 @@ func (c *HTTPClient) VerifyVyperSourceCode(ctx context.Context, sourceCode, cont
 		method:          "POST",
 		noFoundReturn:   "",
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return "", err
@@ -439,6 +470,10 @@ func (c *HTTPClient) VerifyVyperSourceCode(ctx context.Context, sourceCode, cont
 type VerifyStylusSourceCodeOpts struct {
 	ChainID         int64             `json:"chainid"`
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // VerifyStylusSourceCode submits Stylus contract source code for verification
@@ -448,30 +483,35 @@ type VerifyStylusSourceCodeOpts struct {
 //   - contractAddress: The deployed contract address
 //   - contractName: Contract name (e.g. "stylus_hello_world")
 //   - compilerVersion: Stylus compiler version (e.g. "stylus:0.5.3")
-//   - licenseType: Open source license type (e.g. 3 for MIT)
+//   - licenseType: Open source license type (e.g. LicenseMIT)
 //
 // Example:
 //
-//	guid, err := client.VerifyStylusSourceCode(ctx, githubURL, contractAddr, contractName, compilerVer, 3, nil)
-func (c *HTTPClient) VerifyStylusSourceCode(ctx context.Context, sourceCode, contractAddress, contractName, compilerVersion string, licenseType int64, opts *VerifyStylusSourceCodeOpts) (string, error) {
+//	guid, err := client.VerifyStylusSourceCode(ctx, githubURL, contractAddr, contractName, compilerVer, etherscan.LicenseMIT, nil)
+func (c *HTTPClient) VerifyStylusSourceCode(ctx context.Context, sourceCode, contractAddress, contractName, compilerVersion string, licenseType LicenseType, opts *VerifyStylusSourceCodeOpts) (string, error) {
 	// Apply defaults and extract API parameters
 	params, err := ApplyDefaultsAndExtractParams(opts)
 	if err != nil {
 		return "", err
 	}
+	if err := licenseType.Validate(); err != nil {
+		return "", err
+	}
 
 	// Add required parameters
 	params["sourceCode"] = sourceCode
 	params["contractaddress"] = contractAddress
 	params["contractname"] = contractName
 	params["compilerversion"] = compilerVersion
-	params["licenseType"] = strconv.FormatInt(licenseType, 10)
-	params["codeformat"] = "stylus"
+	params["licenseType"] = strconv.FormatInt(int64(licenseType), 10)
+	params["codeformat"] = string(CodeFormatStylus)
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -482,6 +522,7 @@ func (c *HTTPClient) VerifyStylusSourceCode(ctx context.Context, sourceCode, con
 		method:          "POST",
 		noFoundReturn:   "",
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return "", err
@@ -507,6 +548,10 @@ type CheckSourceCodeVerificationStatusOpts struct {
 	//   - RateLimitRaise: Return an error when rate limit is exceeded
 	//   - RateLimitSkip: Return false without executing when rate limit is exceeded
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // CheckSourceCodeVerificationStatus checks the verification status of a submitted source code verification request
@@ -557,8 +602,10 @@ func (c *HTTPClient) CheckSourceCodeVerificationStatus(ctx context.Context, guid
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -568,6 +615,7 @@ func (c *HTTPClient) CheckSourceCodeVerificationStatus(ctx context.Context, guid
 		params:          params,
 		noFoundReturn:   "",
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return "", err
@@ -0,0 +1,199 @@
+package etherscan
+
+import (
+	"sort"
+	"time"
+)
+
+// ============================================================================
+// Credit Estimation
+// ============================================================================
+
+// ActionCost describes what a single call of an action costs
+type ActionCost struct {
+	// Credits consumed from the daily budget per call, before ProCreditMultiplier
+	// Default: 0 (one credit, as Etherscan bills every call once)
+	Credits int64
+
+	// Pro reports whether the action requires an API Pro plan
+	Pro bool
+
+	// MaxPerSecond is an action-specific throttle, 0 if only the tier limit applies
+	MaxPerSecond float64
+}
+
+// ProCreditMultiplier weights the credits of every Pro action
+//
+// Etherscan currently counts a Pro call like any other call against the daily
+// limit, so the multiplier is 1; plans that bill Pro endpoints at a premium
+// can raise it at init time.
+var ProCreditMultiplier int64 = 1
+
+// ActionCosts is the static cost table used by EstimateCredits, keyed by "module.action"
+//
+// Actions not listed are plain calls: one credit, any tier, no extra throttle.
+// The listed actions require an API Pro plan, so their credits are weighted by
+// ProCreditMultiplier, and most are throttled to 2 calls/second regardless of
+// tier, which lets EstimateCredits predict wall-clock time. The table can be
+// adjusted at init time to match a specific plan.
+var ActionCosts = map[string]ActionCost{
+	// Account
+	"account.balancehistory":           {Pro: true, MaxPerSecond: 2},
+	"account.addresstokenbalance":      {Pro: true, MaxPerSecond: 2},
+	"account.addresstokennftbalance":   {Pro: true, MaxPerSecond: 2},
+	"account.addresstokennftinventory": {Pro: true, MaxPerSecond: 2},
+
+	// Token
+	"token.tokensupplyhistory":  {Pro: true, MaxPerSecond: 2},
+	"token.tokenbalancehistory": {Pro: true, MaxPerSecond: 2},
+	"token.tokenholderlist":     {Pro: true, MaxPerSecond: 2},
+	"token.tokenholderchart":    {Pro: true},
+	"token.topholders":          {Pro: true},
+	"token.tokeninfo":           {Pro: true, MaxPerSecond: 2},
+
+	// Stats
+	"stats.dailyavgblocksize":     {Pro: true},
+	"stats.dailyblkcount":         {Pro: true},
+	"stats.dailyblockrewards":     {Pro: true},
+	"stats.dailyavgblocktime":     {Pro: true},
+	"stats.dailyuncleblkcount":    {Pro: true},
+	"stats.ethdailyprice":         {Pro: true},
+	"stats.ethdailymarketcap":     {Pro: true},
+	"stats.dailytxnfee":           {Pro: true},
+	"stats.dailynewaddress":       {Pro: true},
+	"stats.dailynetutilization":   {Pro: true},
+	"stats.dailyavghashrate":      {Pro: true},
+	"stats.dailytx":               {Pro: true},
+	"stats.dailyavgnetdifficulty": {Pro: true},
+
+	// Metadata
+	"nametag.getaddresstag": {Pro: true, MaxPerSecond: 2},
+}
+
+// PlannedCall is a batch of identical calls in a crawl plan
+type PlannedCall struct {
+	Module string
+	Action string
+	Count  int64
+}
+
+// CreditEstimate is the predicted cost of a crawl plan
+type CreditEstimate struct {
+	// TotalCalls is the number of API calls in the plan
+	TotalCalls int64
+
+	// TotalCredits is the number of credits the plan consumes
+	TotalCredits int64
+
+	// ProCalls is the number of calls to Pro-only actions
+	ProCalls int64
+
+	// PerAction is the credit cost per "module.action"
+	PerAction map[string]int64
+
+	// throttledSeconds is the minimum time spent in action-specific throttles
+	throttledSeconds float64
+}
+
+// EstimateCredits predicts the credit cost of plan using ActionCosts
+//
+// Example:
+//
+//	estimate := EstimateCredits([]PlannedCall{
+//	    {Module: "account", Action: "txlist", Count: 5000},
+//	    {Module: "token", Action: "tokeninfo", Count: 800},
+//	})
+//	if !estimate.FitsDailyBudget(StandardTier) {
+//	    log.Fatalf("plan needs %d credits", estimate.TotalCredits)
+//	}
+//	fmt.Printf("Takes at least %s\n", estimate.MinDuration(StandardTier))
+func EstimateCredits(plan []PlannedCall) CreditEstimate {
+	estimate := CreditEstimate{PerAction: make(map[string]int64)}
+	for _, call := range plan {
+		if call.Count <= 0 {
+			continue
+		}
+		key := call.Module + "." + call.Action
+		cost := ActionCosts[key]
+		credits := call.Count * actionCredits(key)
+		estimate.TotalCalls += call.Count
+		estimate.TotalCredits += credits
+		estimate.PerAction[key] += credits
+		if cost.Pro {
+			estimate.ProCalls += call.Count
+		}
+		if cost.MaxPerSecond > 0 {
+			estimate.throttledSeconds += float64(call.Count) / cost.MaxPerSecond
+		}
+	}
+	return estimate
+}
+
+// actionCredits returns the credits of one call of the "module.action" key
+func actionCredits(key string) int64 {
+	cost := ActionCosts[key]
+	credits := cost.Credits
+	if credits == 0 {
+		credits = 1
+	}
+	if cost.Pro {
+		credits *= ProCreditMultiplier
+	}
+	return credits
+}
+
+// FitsDailyBudget reports whether the plan fits in one day's credits of tier
+func (e CreditEstimate) FitsDailyBudget(tier string) bool {
+	_, daily := tierLimits(tier)
+	return e.TotalCredits <= daily
+}
+
+// Days returns the number of daily budgets of tier the plan needs
+func (e CreditEstimate) Days(tier string) int64 {
+	_, daily := tierLimits(tier)
+	return (e.TotalCredits + daily - 1) / daily
+}
+
+// MinDuration returns a lower bound for the run time of the plan on tier
+//
+// It accounts for the per-second tier limit and action-specific throttles, but
+// not for network latency or the daily cap (see Days).
+func (e CreditEstimate) MinDuration(tier string) time.Duration {
+	perSecond, _ := tierLimits(tier)
+	seconds := float64(e.TotalCalls) / float64(perSecond)
+	if e.throttledSeconds > seconds {
+		seconds = e.throttledSeconds
+	}
+	return time.Duration(seconds * float64(time.Second))
+}
+
+// TopActions returns the actions of the plan ordered by credit cost, most expensive first
+func (e CreditEstimate) TopActions() []string {
+	actions := make([]string, 0, len(e.PerAction))
+	for action := range e.PerAction {
+		actions = append(actions, action)
+	}
+	sort.Slice(actions, func(i, j int) bool {
+		if e.PerAction[actions[i]] != e.PerAction[actions[j]] {
+			return e.PerAction[actions[i]] > e.PerAction[actions[j]]
+		}
+		return actions[i] < actions[j]
+	})
+	return actions
+}
+
+// tierLimits returns the per-second and daily call limits of an API tier
+func tierLimits(tier string) (perSecond, daily int64) {
+	switch tier {
+	case StandardTier:
+		return StandardTierRateLimit, StandardTierDailyLimit
+	case AdvancedTier:
+		return AdvancedTierRateLimit, AdvancedTierDailyLimit
+	case ProfessionalTier:
+		return ProfessionalTierRateLimit, ProfessionalTierDailyLimit
+	case ProPlusTier:
+		return ProPlusTierRateLimit, ProPlusTierDailyLimit
+	default: // FreeTier
+		return FreeTierRateLimit, FreeTierDailyLimit
+	}
+}
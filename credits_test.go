@@ -0,0 +1,58 @@
+package etherscan
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEstimateCredits(t *testing.T) {
+	estimate := EstimateCredits([]PlannedCall{
+		{Module: "account", Action: "txlist", Count: 150_000},
+		{Module: "token", Action: "tokeninfo", Count: 1000},
+		{Module: "account", Action: "balance", Count: 0},
+	})
+
+	if estimate.TotalCalls != 151_000 || estimate.TotalCredits != 151_000 {
+		t.Errorf("unexpected totals: %+v", estimate)
+	}
+	if estimate.ProCalls != 1000 {
+		t.Errorf("expected 1000 Pro calls, got %d", estimate.ProCalls)
+	}
+	if estimate.FitsDailyBudget(FreeTier) {
+		t.Error("plan should not fit the free tier")
+	}
+	if !estimate.FitsDailyBudget(StandardTier) {
+		t.Error("plan should fit the standard tier")
+	}
+	if days := estimate.Days(FreeTier); days != 2 {
+		t.Errorf("expected 2 free-tier days, got %d", days)
+	}
+	if top := estimate.TopActions(); top[0] != "account.txlist" {
+		t.Errorf("unexpected top action: %v", top)
+	}
+
+	// 1000 tokeninfo calls at 2/s dominate a small plan on a fast tier
+	throttled := EstimateCredits([]PlannedCall{{Module: "token", Action: "tokeninfo", Count: 1000}})
+	if got := throttled.MinDuration(ProPlusTier); got != 500*time.Second {
+		t.Errorf("expected 500s, got %s", got)
+	}
+}
+
+func TestEstimateCreditsWeights(t *testing.T) {
+	defer func(multiplier int64) { ProCreditMultiplier = multiplier }(ProCreditMultiplier)
+	ProCreditMultiplier = 3
+	ActionCosts["test.heavy"] = ActionCost{Credits: 5}
+	defer delete(ActionCosts, "test.heavy")
+
+	estimate := EstimateCredits([]PlannedCall{
+		{Module: "account", Action: "txlist", Count: 10},
+		{Module: "token", Action: "tokeninfo", Count: 10},
+		{Module: "test", Action: "heavy", Count: 10},
+	})
+	if estimate.TotalCalls != 30 || estimate.TotalCredits != 10+30+50 {
+		t.Errorf("unexpected totals: %+v", estimate)
+	}
+	if estimate.PerAction["token.tokeninfo"] != 30 || estimate.PerAction["test.heavy"] != 50 {
+		t.Errorf("unexpected per-action credits: %v", estimate.PerAction)
+	}
+}
@@ -0,0 +1,196 @@
+package etherscan
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dwdwow/etherscan-go/parallel"
+)
+
+// ============================================================================
+// Cross-Chain Address Comparison
+// ============================================================================
+
+// ChainActivity is the activity of an address on one chain
+type ChainActivity struct {
+	ChainID int64 `json:"chainId" bson:"chainId"`
+
+	// ChainName is set when the chains came from GetSupportedChains
+	ChainName string `json:"chainName" bson:"chainName"`
+
+	// Nonce is the number of transactions sent by the address
+	Nonce uint64 `json:"nonce" bson:"nonce"`
+
+	// Balance is the native balance in wei
+	Balance *big.Int `json:"balance" bson:"balance"`
+
+	// FirstTx and LastTx are the oldest and newest normal transactions, nil if there are none
+	FirstTx *RespNormalTx `json:"firstTx" bson:"firstTx"`
+	LastTx  *RespNormalTx `json:"lastTx" bson:"lastTx"`
+
+	// FirstActivity and LastActivity are the times of FirstTx and LastTx
+	FirstActivity time.Time `json:"firstActivity" bson:"firstActivity"`
+	LastActivity  time.Time `json:"lastActivity" bson:"lastActivity"`
+
+	// Err is set if the chain could not be queried; the other fields are then incomplete
+	Err error `json:"-" bson:"-"`
+}
+
+// Active reports whether the address has sent, received or holds anything on the chain
+func (a ChainActivity) Active() bool {
+	return a.Nonce > 0 || a.FirstTx != nil || (a.Balance != nil && a.Balance.Sign() > 0)
+}
+
+// CrossChainActivity is the side-by-side activity of an address on several chains
+type CrossChainActivity struct {
+	Address string `json:"address" bson:"address"`
+
+	// Chains are in the order of the requested chain IDs
+	Chains []ChainActivity `json:"chains" bson:"chains"`
+}
+
+// ActiveChains returns the chains on which the address is active
+func (a *CrossChainActivity) ActiveChains() []ChainActivity {
+	var active []ChainActivity
+	for _, chain := range a.Chains {
+		if chain.Active() {
+			active = append(active, chain)
+		}
+	}
+	return active
+}
+
+// CompareAddressAcrossChainsOpts contains optional parameters for CompareAddressAcrossChains
+type CompareAddressAcrossChainsOpts struct {
+	// Concurrency is the number of chains queried in parallel
+	// Default: 4
+	Concurrency int `default:"4"`
+
+	// OnLimitExceeded specifies behavior when rate limit is exceeded
+	// Default: RateLimitBlock (wait until a token is available)
+	OnLimitExceeded RateLimitBehavior `default:""`
+}
+
+// CompareAddressAcrossChains returns the activity of an address on several chains side by side
+//
+// For every chain the nonce, native balance and the first and last normal
+// transactions are fetched, so first/last activity and transaction counts can
+// be compared across chains, e.g. to tell whether the same actor controls an
+// address on Base and Arbitrum. Chains are queried concurrently; a chain that
+// fails gets its ChainActivity.Err set instead of failing the comparison.
+//
+// Args:
+//   - ctx: Context for request cancellation and timeout
+//   - address: The address to compare
+//   - chainIDs: Chains to query; nil queries every chain listed by GetSupportedChains
+//   - opts: Optional parameters (can be nil)
+//
+// Returns:
+//   - *CrossChainActivity: One ChainActivity per chain
+//   - error: Error if the supported chains cannot be listed
+//
+// Example:
+//
+//	activity, err := client.CompareAddressAcrossChains(ctx, addr, []int64{BaseMainnet, ArbitrumOneMainnet}, nil)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, chain := range activity.Chains {
+//	    fmt.Printf("%d: nonce %d, first %s, last %s\n", chain.ChainID, chain.Nonce, chain.FirstActivity, chain.LastActivity)
+//	}
+//
+// Note:
+//   - Costs four calls per chain
+func (c *HTTPClient) CompareAddressAcrossChains(ctx context.Context, address string, chainIDs []int64, opts *CompareAddressAcrossChainsOpts) (*CrossChainActivity, error) {
+	if opts == nil {
+		opts = &CompareAddressAcrossChainsOpts{}
+	}
+	if err := ApplyDefaults(opts); err != nil {
+		return nil, err
+	}
+
+	result := &CrossChainActivity{Address: address}
+	if chainIDs == nil {
+		supported, err := c.GetSupportedChains(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, chain := range supported.Result {
+			id, err := strconv.ParseInt(chain.ChainID, 10, 64)
+			if err != nil {
+				continue
+			}
+			result.Chains = append(result.Chains, ChainActivity{ChainID: id, ChainName: chain.ChainName})
+		}
+	} else {
+		for _, id := range chainIDs {
+			result.Chains = append(result.Chains, ChainActivity{ChainID: id})
+		}
+	}
+
+	// Per-chain failures are recorded in ChainActivity.Err; only cancelling ctx fails the fan-out
+	chains, err := parallel.Map(ctx, result.Chains, opts.Concurrency, func(ctx context.Context, chain ChainActivity) (ChainActivity, error) {
+		chain.Err = c.fetchChainActivity(ctx, address, &chain, opts.OnLimitExceeded)
+		return chain, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	result.Chains = chains
+	return result, nil
+}
+
+// fetchChainActivity fills chain with the activity of address on chain.ChainID
+func (c *HTTPClient) fetchChainActivity(ctx context.Context, address string, chain *ChainActivity, onLimitExceeded RateLimitBehavior) error {
+	count, err := c.RpcEthTxCount(ctx, address, BlockTagLatest, &RpcEthTxCountOpts{
+		ChainID:         chain.ChainID,
+		OnLimitExceeded: onLimitExceeded,
+	})
+	if err != nil {
+		return err
+	}
+	if chain.Nonce, err = parseHexUint64(count); err != nil {
+		return err
+	}
+
+	balance, err := c.GetEthBalance(ctx, address, &GetEthBalanceOpts{
+		ChainID:         chain.ChainID,
+		OnLimitExceeded: onLimitExceeded,
+	})
+	if err != nil {
+		return err
+	}
+	var ok bool
+	if chain.Balance, ok = new(big.Int).SetString(strings.TrimSpace(balance), 10); !ok {
+		return fmt.Errorf("etherscan: invalid balance %q", balance)
+	}
+
+	for _, sort := range []SortOrder{SortAsc, SortDesc} {
+		txs, err := c.GetNormalTxs(ctx, address, &GetNormalTxsOpts{
+			Offset:          1,
+			Sort:            sort,
+			ChainID:         chain.ChainID,
+			OnLimitExceeded: onLimitExceeded,
+		})
+		if err != nil {
+			return err
+		}
+		if len(txs) == 0 {
+			return nil
+		}
+		at, err := parseUnixTime(txs[0].TimeStamp)
+		if err != nil {
+			return err
+		}
+		if sort == SortAsc {
+			chain.FirstTx, chain.FirstActivity = &txs[0], at
+		} else {
+			chain.LastTx, chain.LastActivity = &txs[0], at
+		}
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package etherscan
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestCompareAddressAcrossChains(t *testing.T) {
+	active := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		switch query.Get("action") {
+		case "eth_getTransactionCount":
+			w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x5"}`))
+		case "balance":
+			w.Write([]byte(`{"status":"1","message":"OK","result":"1000"}`))
+		case "txlist":
+			if query.Get("sort") == "asc" {
+				w.Write([]byte(`{"status":"1","message":"OK","result":[{"hash":"0xfirst","timeStamp":"1704067200"}]}`))
+			} else {
+				w.Write([]byte(`{"status":"1","message":"OK","result":[{"hash":"0xlast","timeStamp":"1706745600"}]}`))
+			}
+		}
+	}))
+	defer active.Close()
+	fresh := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("action") {
+		case "eth_getTransactionCount":
+			w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x0"}`))
+		case "balance":
+			w.Write([]byte(`{"status":"1","message":"OK","result":"0"}`))
+		case "txlist":
+			w.Write([]byte(`{"status":"0","message":"No transactions found","result":[]}`))
+		}
+	}))
+	defer fresh.Close()
+	broken := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer broken.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{BaseMainnet: active.URL, ArbitrumOneMainnet: fresh.URL, OPMainnet: broken.URL},
+	})
+	result, err := client.CompareAddressAcrossChains(context.Background(), testAddr, []int64{BaseMainnet, ArbitrumOneMainnet, OPMainnet}, nil)
+	if err != nil {
+		t.Fatalf("CompareAddressAcrossChains failed: %v", err)
+	}
+	if len(result.Chains) != 3 {
+		t.Fatalf("expected 3 chains, got %d", len(result.Chains))
+	}
+
+	base := result.Chains[0]
+	if base.Err != nil || base.ChainID != BaseMainnet || base.Nonce != 5 || base.Balance.Int64() != 1000 {
+		t.Errorf("unexpected Base activity: %+v", base)
+	}
+	if base.FirstTx.Hash != "0xfirst" || base.LastTx.Hash != "0xlast" ||
+		!base.FirstActivity.Equal(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)) || !base.LastActivity.Equal(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected Base first/last activity: %+v", base)
+	}
+
+	arbitrum := result.Chains[1]
+	if arbitrum.Err != nil || arbitrum.Active() || arbitrum.FirstTx != nil {
+		t.Errorf("expected inactive Arbitrum, got %+v", arbitrum)
+	}
+	if result.Chains[2].Err == nil {
+		t.Error("expected an error for the failing chain")
+	}
+	if active := result.ActiveChains(); len(active) != 1 || active[0].ChainID != BaseMainnet {
+		t.Errorf("expected only Base to be active, got %+v", active)
+	}
+}
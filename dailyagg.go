@@ -0,0 +1,303 @@
+package etherscan
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ============================================================================
+// Daily Aggregates
+// ============================================================================
+
+// DailyActivity is what an address did on one UTC day
+type DailyActivity struct {
+	// Date is the UTC day in yyyy-MM-dd format, see UTCDate
+	Date string `json:"date" bson:"date"`
+
+	// TxCount counts the transactions sent or received; a self-transfer counts once
+	TxCount  int `json:"txCount" bson:"txCount"`
+	Outgoing int `json:"outgoing" bson:"outgoing"`
+	Incoming int `json:"incoming" bson:"incoming"`
+	Failed   int `json:"failed" bson:"failed"`
+
+	// Fees is the gas paid by the address as sender, in wei, failed transactions included
+	Fees *big.Int `json:"fees" bson:"fees"`
+
+	// VolumeOut and VolumeIn are the native value sent and received by successful transactions, in wei
+	VolumeOut *big.Int `json:"volumeOut" bson:"volumeOut"`
+	VolumeIn  *big.Int `json:"volumeIn" bson:"volumeIn"`
+}
+
+// addressAggregates are the daily aggregates of one address and how far they reach
+type addressAggregates struct {
+	// Cursor is the highest block added and the transactions of it already counted
+	Cursor Checkpoint                `json:"cursor"`
+	Days   map[string]*DailyActivity `json:"days"`
+}
+
+// DailyAggregates materializes per-address daily activity from normal transactions
+//
+// Transactions are added as they are streamed, by RefreshDailyAggregates or
+// by the caller with Add, and each address remembers the highest block it
+// has seen together with the transactions of that block already counted. A
+// refresh therefore only fetches the blocks after the last one, and adding
+// the same transactions twice does not count them twice, so dashboards read
+// Days instead of re-scanning the full history on every refresh. Save and
+// LoadDailyAggregates persist the aggregates as one JSON file.
+//
+// A DailyAggregates is safe for concurrent use.
+type DailyAggregates struct {
+	mu        sync.Mutex
+	addresses map[string]*addressAggregates
+}
+
+// NewDailyAggregates creates an empty aggregate store
+func NewDailyAggregates() *DailyAggregates {
+	return &DailyAggregates{addresses: make(map[string]*addressAggregates)}
+}
+
+// LoadDailyAggregates reads aggregates written by Save
+//
+// It returns an empty store and no error if path does not exist.
+func LoadDailyAggregates(path string) (*DailyAggregates, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return NewDailyAggregates(), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("etherscan: load daily aggregates: %w", err)
+	}
+
+	a := NewDailyAggregates()
+	if err := json.Unmarshal(data, &a.addresses); err != nil {
+		return nil, fmt.Errorf("etherscan: decode daily aggregates %s: %w", path, err)
+	}
+	return a, nil
+}
+
+// Save atomically writes the aggregates to path as JSON
+func (a *DailyAggregates) Save(path string) error {
+	a.mu.Lock()
+	data, err := json.Marshal(a.addresses)
+	a.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(path, data, "save daily aggregates")
+}
+
+// Add counts the transactions of address not counted before
+//
+// Transactions must be added in ascending block order across calls, as
+// txlist returns them with SortAsc; within one call they are sorted by
+// block. Transactions below the highest block already added are taken as
+// counted, and those of that block are recognized by hash.
+func (a *DailyAggregates) Add(address string, txs []RespNormalTx) error {
+	address = strings.ToLower(address)
+	sorted := slices.Clone(txs)
+	blocks := make(map[string]int64, len(sorted))
+	for _, tx := range sorted {
+		block, err := strconv.ParseInt(tx.BlockNumber, 10, 64)
+		if err != nil {
+			return fmt.Errorf("etherscan: invalid block number %q of tx %s", tx.BlockNumber, tx.Hash)
+		}
+		blocks[tx.Hash] = block
+	}
+	sort.SliceStable(sorted, func(i, j int) bool { return blocks[sorted[i].Hash] < blocks[sorted[j].Hash] })
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	agg, ok := a.addresses[address]
+	if !ok {
+		agg = &addressAggregates{Days: make(map[string]*DailyActivity)}
+		a.addresses[address] = agg
+	}
+	for _, tx := range sorted {
+		block := blocks[tx.Hash]
+		if agg.Cursor.Seen(block, tx.Hash) {
+			continue
+		}
+		if err := agg.count(address, tx); err != nil {
+			return err
+		}
+		if block > agg.Cursor.Block {
+			agg.Cursor = Checkpoint{Block: block}
+		}
+		agg.Cursor.LastKeys = append(agg.Cursor.LastKeys, tx.Hash)
+	}
+	return nil
+}
+
+// count adds one transaction of address to its day
+func (agg *addressAggregates) count(address string, tx RespNormalTx) error {
+	at, err := ParseTimestamp(tx.TimeStamp)
+	if err != nil {
+		return fmt.Errorf("etherscan: invalid timestamp of tx %s: %w", tx.Hash, err)
+	}
+	value, ok := new(big.Int).SetString(tx.Value, 10)
+	if !ok {
+		return fmt.Errorf("etherscan: invalid value %q of tx %s", tx.Value, tx.Hash)
+	}
+	outgoing := strings.EqualFold(tx.From, address)
+	fee := new(big.Int)
+	if outgoing {
+		gasUsed, okGas := new(big.Int).SetString(tx.GasUsed, 10)
+		gasPrice, okPrice := new(big.Int).SetString(tx.GasPrice, 10)
+		if !okGas || !okPrice {
+			return fmt.Errorf("etherscan: invalid gas used %q or gas price %q of tx %s", tx.GasUsed, tx.GasPrice, tx.Hash)
+		}
+		fee.Mul(gasUsed, gasPrice)
+	}
+
+	date := UTCDate(at)
+	day, ok := agg.Days[date]
+	if !ok {
+		day = &DailyActivity{Date: date, Fees: new(big.Int), VolumeOut: new(big.Int), VolumeIn: new(big.Int)}
+		agg.Days[date] = day
+	}
+	failed := TxFailed(tx)
+	day.TxCount++
+	if failed {
+		day.Failed++
+	}
+	if outgoing {
+		day.Outgoing++
+		day.Fees.Add(day.Fees, fee)
+		if !failed {
+			day.VolumeOut.Add(day.VolumeOut, value)
+		}
+	}
+	if strings.EqualFold(tx.To, address) {
+		day.Incoming++
+		if !failed {
+			day.VolumeIn.Add(day.VolumeIn, value)
+		}
+	}
+	return nil
+}
+
+// Days returns the daily activity of address in date order, a copy safe to modify
+func (a *DailyAggregates) Days(address string) []DailyActivity {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	agg, ok := a.addresses[strings.ToLower(address)]
+	if !ok {
+		return nil
+	}
+
+	days := make([]DailyActivity, 0, len(agg.Days))
+	for _, day := range agg.Days {
+		c := *day
+		c.Fees = new(big.Int).Set(day.Fees)
+		c.VolumeOut = new(big.Int).Set(day.VolumeOut)
+		c.VolumeIn = new(big.Int).Set(day.VolumeIn)
+		days = append(days, c)
+	}
+	sort.Slice(days, func(i, j int) bool { return days[i].Date < days[j].Date })
+	return days
+}
+
+// NextBlock returns the block a refresh of address starts at, 0 if it was never added
+//
+// The block is the highest one added so far: it is fetched again in case it
+// was incomplete, and its transactions already counted are skipped.
+func (a *DailyAggregates) NextBlock(address string) int64 {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if agg, ok := a.addresses[strings.ToLower(address)]; ok {
+		return agg.Cursor.Block
+	}
+	return 0
+}
+
+// RefreshDailyAggregatesOpts contains optional parameters for RefreshDailyAggregates
+type RefreshDailyAggregatesOpts struct {
+	// ChainID specifies which blockchain network to query
+	// Default: empty (uses client default)
+	ChainID int64
+
+	// OnLimitExceeded specifies behavior when rate limit is exceeded
+	// Default: RateLimitBlock (wait until a token is available)
+	OnLimitExceeded RateLimitBehavior `default:""`
+}
+
+// RefreshDailyAggregates fetches the normal transactions of address added since the last refresh into agg
+//
+// The first refresh of an address reads its full history; later ones start
+// at agg.NextBlock(address) and read up to the latest block, bisecting busy
+// ranges past the 1000-record cap. Batches are added as they arrive, so a
+// failed refresh keeps what it fetched and the next one continues from
+// there. Save agg afterwards to keep the aggregates across restarts.
+//
+// Args:
+//   - ctx: Context for request cancellation and timeout
+//   - agg: The aggregate store to update
+//   - address: The address to refresh
+//   - opts: Optional parameters (can be nil)
+//
+// Returns:
+//   - error: Error if a request fails or a transaction cannot be parsed
+//
+// Example:
+//
+//	agg, err := etherscan.LoadDailyAggregates("aggregates.json")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	if err := client.RefreshDailyAggregates(ctx, agg, wallet, nil); err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, day := range agg.Days(wallet) {
+//	    fmt.Printf("%s %d txs, %s wei fees\n", day.Date, day.TxCount, day.Fees)
+//	}
+//	agg.Save("aggregates.json")
+//
+// Note:
+//   - Costs one eth_blockNumber call plus one txlist call per 1000 new transactions
+func (c *HTTPClient) RefreshDailyAggregates(ctx context.Context, agg *DailyAggregates, address string, opts *RefreshDailyAggregatesOpts) error {
+	if opts == nil {
+		opts = &RefreshDailyAggregatesOpts{}
+	}
+	if err := ApplyDefaults(opts); err != nil {
+		return err
+	}
+
+	latest, err := c.RpcEthBlockNumber(ctx, &RpcEthBlockNumberOpts{
+		ChainID:         opts.ChainID,
+		OnLimitExceeded: opts.OnLimitExceeded,
+	})
+	if err != nil {
+		return err
+	}
+	head, err := parseHexUint64(latest)
+	if err != nil {
+		return fmt.Errorf("etherscan: invalid block number %q: %w", latest, err)
+	}
+
+	from := agg.NextBlock(address)
+	if from > int64(head) {
+		return nil
+	}
+	return walkLogsByRange(ctx, from, int64(head), func(fromBlock, toBlock, page int64) ([]RespNormalTx, error) {
+		return c.GetNormalTxs(ctx, address, &GetNormalTxsOpts{
+			StartBlock:      fromBlock,
+			EndBlock:        toBlock,
+			Page:            page,
+			Offset:          logsPerCall,
+			Sort:            SortAsc,
+			ChainID:         opts.ChainID,
+			OnLimitExceeded: opts.OnLimitExceeded,
+		})
+	}, func(batch []RespNormalTx, _ Checkpoint) error {
+		return agg.Add(address, batch)
+	})
+}
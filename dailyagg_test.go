@@ -0,0 +1,114 @@
+package etherscan
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestRefreshDailyAggregates(t *testing.T) {
+	wallet := TestAddresses.VitalikButerin
+	other := "0x" + strings.Repeat("ab", 20)
+	const day = 86400
+
+	var mu sync.Mutex
+	head := int64(20)
+	var starts []string
+	txs := []RespNormalTx{
+		{Hash: "0x01", BlockNumber: "10", TimeStamp: strconv.Itoa(day), From: wallet, To: other, Value: "100", GasUsed: "21000", GasPrice: "2"},
+		{Hash: "0x02", BlockNumber: "11", TimeStamp: strconv.Itoa(day + 60), From: other, To: wallet, Value: "40", GasUsed: "21000", GasPrice: "2"},
+		{Hash: "0x03", BlockNumber: "20", TimeStamp: strconv.Itoa(2 * day), From: wallet, To: other, Value: "7", GasUsed: "30000", GasPrice: "1", IsError: "1"},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		mu.Lock()
+		defer mu.Unlock()
+		if q.Get("action") == "eth_blockNumber" {
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":"0x%x"}`, head)
+			return
+		}
+		starts = append(starts, q.Get("startblock"))
+		from, _ := strconv.ParseInt(q.Get("startblock"), 10, 64)
+		to, _ := strconv.ParseInt(q.Get("endblock"), 10, 64)
+		var page []RespNormalTx
+		for _, tx := range txs {
+			if block := TxBlockNumber(tx); block >= from && block <= to {
+				page = append(page, tx)
+			}
+		}
+		data, _ := json.Marshal(page)
+		fmt.Fprintf(w, `{"status":"1","message":"OK","result":%s}`, data)
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+		MaxRetries: -1,
+	})
+	ctx := context.Background()
+	agg := NewDailyAggregates()
+
+	if err := client.RefreshDailyAggregates(ctx, agg, wallet, nil); err != nil {
+		t.Fatal(err)
+	}
+	days := agg.Days(wallet)
+	if len(days) != 2 {
+		t.Fatalf("expected 2 days, got %+v", days)
+	}
+	first := days[0]
+	if first.Date != "1970-01-02" || first.TxCount != 2 || first.Outgoing != 1 || first.Incoming != 1 ||
+		first.Fees.String() != "42000" || first.VolumeOut.String() != "100" || first.VolumeIn.String() != "40" {
+		t.Errorf("unexpected first day: %+v", first)
+	}
+	if second := days[1]; second.Failed != 1 || second.Fees.String() != "30000" || second.VolumeOut.Sign() != 0 {
+		t.Errorf("expected the failed tx to cost fees without volume, got %+v", second)
+	}
+
+	// A late transaction in the last block and a new block are counted, the rest is not fetched or counted again
+	mu.Lock()
+	head = 25
+	starts = nil
+	txs = append(txs,
+		RespNormalTx{Hash: "0x04", BlockNumber: "20", TimeStamp: strconv.Itoa(2 * day), From: other, To: wallet, Value: "5", GasUsed: "21000", GasPrice: "1"},
+		RespNormalTx{Hash: "0x05", BlockNumber: "25", TimeStamp: strconv.Itoa(3 * day), From: wallet, To: wallet, Value: "1", GasUsed: "21000", GasPrice: "1"},
+	)
+	mu.Unlock()
+
+	path := filepath.Join(t.TempDir(), "aggregates.json")
+	if err := agg.Save(path); err != nil {
+		t.Fatal(err)
+	}
+	agg, err := LoadDailyAggregates(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := client.RefreshDailyAggregates(ctx, agg, wallet, nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(starts) != 1 || starts[0] != "20" {
+		t.Errorf("expected one call from the last block, got start blocks %v", starts)
+	}
+	days = agg.Days(wallet)
+	if len(days) != 3 || days[0].TxCount != 2 || days[1].TxCount != 2 || days[1].VolumeIn.String() != "5" {
+		t.Fatalf("unexpected days after the refresh: %+v", days)
+	}
+	if self := days[2]; self.TxCount != 1 || self.Outgoing != 1 || self.Incoming != 1 || self.Fees.String() != "21000" {
+		t.Errorf("unexpected self-transfer day: %+v", self)
+	}
+
+	// Adding the same transactions again changes nothing
+	if err := agg.Add(wallet, txs); err != nil {
+		t.Fatal(err)
+	}
+	if again := agg.Days(wallet); again[1].TxCount != 2 || again[2].TxCount != 1 {
+		t.Errorf("expected re-added transactions to be skipped, got %+v", again)
+	}
+}
@@ -0,0 +1,243 @@
+package etherscan
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+)
+
+// ============================================================================
+// Exact Decimal Arithmetic
+// ============================================================================
+
+// DecimalNumber is the method set valuation helpers need from an exact decimal type
+//
+// It is satisfied by github.com/shopspring/decimal.Decimal without this package
+// importing it, so callers who already depend on shopspring can get amounts in
+// their own type by passing decimal.NewFromString as the parse function. The
+// built-in RatDecimal satisfies it too.
+//
+// Example:
+//
+//	import "github.com/shopspring/decimal"
+//
+//	amount, err := ScaleAmount("1500000", 6, decimal.NewFromString) // decimal.Decimal 1.5
+type DecimalNumber[D any] interface {
+	Add(D) D
+	Mul(D) D
+	// Shift multiplies by 10^exp
+	Shift(exp int32) D
+	String() string
+}
+
+// ScaleAmount converts an integer amount in a token's smallest unit to whole tokens in D
+//
+// Args:
+//   - raw: Amount in the smallest unit, e.g. a balance in wei
+//   - decimals: Token decimals
+//   - parse: Parser of the decimal type, e.g. decimal.NewFromString or ParseRatDecimal
+//
+// Returns:
+//   - D: raw / 10^decimals, exact
+//   - error: Error if raw cannot be parsed or decimals is out of range
+func ScaleAmount[D DecimalNumber[D]](raw string, decimals int64, parse func(string) (D, error)) (D, error) {
+	var zero D
+	if decimals < 0 || decimals > math.MaxInt32 {
+		return zero, fmt.Errorf("etherscan: invalid decimals %d", decimals)
+	}
+	amount, err := parse(strings.TrimSpace(raw))
+	if err != nil {
+		return zero, fmt.Errorf("etherscan: invalid amount %q: %w", raw, err)
+	}
+	return amount.Shift(int32(-decimals)), nil
+}
+
+// PortfolioAssetDecimal is a PortfolioAsset with its amounts in decimal type D
+type PortfolioAssetDecimal[D any] struct {
+	Asset PortfolioAsset
+
+	Quantity D
+
+	// PriceUSD and ValueUSD are the zero value of D if the asset is unpriced
+	PriceUSD D
+	ValueUSD D
+}
+
+// PortfolioDecimal is a Portfolio with its amounts in decimal type D
+type PortfolioDecimal[D any] struct {
+	Native        PortfolioAssetDecimal[D]
+	Tokens        []PortfolioAssetDecimal[D]
+	TotalValueUSD D
+}
+
+// PortfolioDecimals recomputes the amounts of p in decimal type D
+//
+// Quantities are scaled from the raw integer balances and values are
+// multiplied out in D, so no precision is lost to the string formatting of
+// Portfolio (which rounds to 18 decimals).
+//
+// Example:
+//
+//	portfolio, _ := client.GetPortfolioValue(ctx, addr, nil)
+//	exact, err := PortfolioDecimals(portfolio, decimal.NewFromString)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(exact.TotalValueUSD.StringFixed(2))
+func PortfolioDecimals[D DecimalNumber[D]](p *Portfolio, parse func(string) (D, error)) (*PortfolioDecimal[D], error) {
+	total, err := parse("0")
+	if err != nil {
+		return nil, err
+	}
+
+	convert := func(asset PortfolioAsset) (PortfolioAssetDecimal[D], error) {
+		result := PortfolioAssetDecimal[D]{Asset: asset}
+		if asset.RawQuantity == "" {
+			return result, nil
+		}
+		if result.Quantity, err = ScaleAmount(asset.RawQuantity, asset.Decimals, parse); err != nil {
+			return result, err
+		}
+		if !asset.Priced {
+			return result, nil
+		}
+		if result.PriceUSD, err = parse(asset.PriceUSD); err != nil {
+			return result, fmt.Errorf("etherscan: invalid price %q of %s: %w", asset.PriceUSD, asset.TokenSymbol, err)
+		}
+		result.ValueUSD = result.Quantity.Mul(result.PriceUSD)
+		total = total.Add(result.ValueUSD)
+		return result, nil
+	}
+
+	result := &PortfolioDecimal[D]{}
+	if result.Native, err = convert(p.Native); err != nil {
+		return nil, err
+	}
+	for _, token := range p.Tokens {
+		converted, err := convert(token)
+		if err != nil {
+			return nil, err
+		}
+		result.Tokens = append(result.Tokens, converted)
+	}
+	result.TotalValueUSD = total
+	return result, nil
+}
+
+// RatDecimal is an exact decimal backed by big.Rat, the dependency-free DecimalNumber
+//
+// The zero value is 0.
+type RatDecimal struct {
+	r *big.Rat
+}
+
+// ParseRatDecimal parses a decimal string such as "1.25" or "-3"
+func ParseRatDecimal(s string) (RatDecimal, error) {
+	r, ok := new(big.Rat).SetString(strings.TrimSpace(s))
+	if !ok {
+		return RatDecimal{}, fmt.Errorf("etherscan: invalid decimal %q", s)
+	}
+	return RatDecimal{r: r}, nil
+}
+
+// rat returns d as a big.Rat, treating the zero value as 0
+func (d RatDecimal) rat() *big.Rat {
+	if d.r == nil {
+		return new(big.Rat)
+	}
+	return d.r
+}
+
+// Add returns d + other
+func (d RatDecimal) Add(other RatDecimal) RatDecimal {
+	return RatDecimal{r: new(big.Rat).Add(d.rat(), other.rat())}
+}
+
+// Mul returns d * other
+func (d RatDecimal) Mul(other RatDecimal) RatDecimal {
+	return RatDecimal{r: new(big.Rat).Mul(d.rat(), other.rat())}
+}
+
+// Shift returns d * 10^exp
+func (d RatDecimal) Shift(exp int32) RatDecimal {
+	scale := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(absInt32(exp))), nil))
+	if exp < 0 {
+		scale.Inv(scale)
+	}
+	return RatDecimal{r: new(big.Rat).Mul(d.rat(), scale)}
+}
+
+// Cmp compares d and other and returns -1, 0 or +1
+func (d RatDecimal) Cmp(other RatDecimal) int {
+	return d.rat().Cmp(other.rat())
+}
+
+// Rat returns a copy of d as a big.Rat
+func (d RatDecimal) Rat() *big.Rat {
+	return new(big.Rat).Set(d.rat())
+}
+
+// String formats d exactly, or with 18 decimals if d has no finite decimal expansion
+func (d RatDecimal) String() string {
+	r := d.rat()
+	s := r.FloatString(decimalPlaces(r.Denom()))
+	if strings.Contains(s, ".") {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+	if s == "-0" {
+		return "0"
+	}
+	return s
+}
+
+// MarshalText encodes d as its String form, so it appears as a JSON string
+func (d RatDecimal) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText parses a decimal written by MarshalText
+func (d *RatDecimal) UnmarshalText(text []byte) error {
+	parsed, err := ParseRatDecimal(string(text))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// decimalPlaces returns the number of decimals needed to print a fraction with denominator denom
+//
+// That is max(a, b) for denom = 2^a * 5^b, and 18 for denominators with other prime factors.
+func decimalPlaces(denom *big.Int) int {
+	n := new(big.Int).Set(denom)
+	two, five := big.NewInt(2), big.NewInt(5)
+	mod := new(big.Int)
+	count := func(p *big.Int) int {
+		k := 0
+		for {
+			q, m := new(big.Int).QuoRem(n, p, mod)
+			if m.Sign() != 0 {
+				return k
+			}
+			n = q
+			k++
+		}
+	}
+	a, b := count(two), count(five)
+	if n.Cmp(big.NewInt(1)) != 0 {
+		return 18
+	}
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// absInt32 returns the absolute value of n as an int64
+func absInt32(n int32) int64 {
+	if n < 0 {
+		return -int64(n)
+	}
+	return int64(n)
+}
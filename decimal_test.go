@@ -0,0 +1,66 @@
+package etherscan
+
+import (
+	"testing"
+)
+
+func TestRatDecimal(t *testing.T) {
+	a, _ := ParseRatDecimal("0.1")
+	b, _ := ParseRatDecimal("0.2")
+	if got := a.Add(b).String(); got != "0.3" {
+		t.Errorf("0.1 + 0.2 = %s, want 0.3", got)
+	}
+
+	wei, err := ScaleAmount("1", 18, ParseRatDecimal)
+	if err != nil {
+		t.Fatalf("ScaleAmount failed: %v", err)
+	}
+	price, _ := ParseRatDecimal("0.001")
+	if got := wei.Mul(price).String(); got != "0.000000000000000000001" {
+		t.Errorf("1 wei * 0.001 = %s, want 21 exact decimals", got)
+	}
+
+	third, _ := ParseRatDecimal("1/3")
+	if got := third.String(); got != "0.333333333333333333" {
+		t.Errorf("1/3 = %s, want 18 decimals", got)
+	}
+
+	var zero RatDecimal
+	if got := zero.Add(a).Shift(2).String(); got != "10" {
+		t.Errorf("zero value arithmetic = %s, want 10", got)
+	}
+
+	if _, err := ScaleAmount("12x", 6, ParseRatDecimal); err == nil {
+		t.Error("expected error for invalid amount")
+	}
+	if _, err := ScaleAmount("1", -1, ParseRatDecimal); err == nil {
+		t.Error("expected error for negative decimals")
+	}
+}
+
+func TestPortfolioDecimals(t *testing.T) {
+	portfolio := &Portfolio{
+		Native: PortfolioAsset{TokenSymbol: "ETH", Decimals: 18, RawQuantity: "1234567890123456789", PriceUSD: "3000.01", Priced: true},
+		Tokens: []PortfolioAsset{
+			{TokenSymbol: "USDC", Decimals: 6, RawQuantity: "1000000001", PriceUSD: "0.9999", Priced: true},
+			{TokenSymbol: "SPAM", Decimals: 0, RawQuantity: "5"},
+		},
+	}
+
+	exact, err := PortfolioDecimals(portfolio, ParseRatDecimal)
+	if err != nil {
+		t.Fatalf("PortfolioDecimals failed: %v", err)
+	}
+	if got := exact.Native.ValueUSD.String(); got != "3703.71601604927160156789" {
+		t.Errorf("ETH value = %s", got)
+	}
+	if got := exact.Tokens[0].Quantity.String(); got != "1000.000001" {
+		t.Errorf("USDC quantity = %s", got)
+	}
+	if got := exact.Tokens[1].ValueUSD.String(); got != "0" {
+		t.Errorf("unpriced value = %s, want 0", got)
+	}
+	if got := exact.TotalValueUSD.String(); got != "4703.61601704917160156789" {
+		t.Errorf("total = %s", got)
+	}
+}
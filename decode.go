@@ -0,0 +1,84 @@
+package etherscan
+
+import (
+	"bytes"
+	"encoding/json"
+	"sync"
+)
+
+// ============================================================================
+// Response Decoding
+// ============================================================================
+
+const (
+	// maxPooledBodySize bounds the response buffers kept for reuse, so one huge page does not pin its memory
+	maxPooledBodySize = 16 << 20
+
+	// maxBodyPrealloc bounds the buffer allocated up front from a Content-Length header
+	maxBodyPrealloc = 64 << 20
+)
+
+// bodyBuffers recycles the buffers response bodies are read into
+var bodyBuffers = sync.Pool{New: func() any { return new(bytes.Buffer) }}
+
+// getBodyBuffer returns an empty pooled buffer, grown to contentLength if it is known
+func getBodyBuffer(contentLength int64) *bytes.Buffer {
+	buf := bodyBuffers.Get().(*bytes.Buffer)
+	buf.Reset()
+	if contentLength > 0 && contentLength <= maxBodyPrealloc {
+		// One read past the body lets ReadFrom see EOF without growing
+		buf.Grow(int(contentLength) + bytes.MinRead)
+	}
+	return buf
+}
+
+// putBodyBuffer returns buf to the pool; nothing may reference its bytes afterwards
+func putBodyBuffer(buf *bytes.Buffer) {
+	if buf.Cap() <= maxPooledBodySize {
+		bodyBuffers.Put(buf)
+	}
+}
+
+// responseEnvelope is the part of a response body decoded before the result
+//
+// Result is copied out of the body undecoded, so a list is decoded only once,
+// straight into the Resp* slice of its endpoint.
+type responseEnvelope struct {
+	// Status and Message are strings, or numbers on some explorers
+	Status  any             `json:"status"`
+	Message any             `json:"message"`
+	Result  json.RawMessage `json:"result"`
+	JSONRPC json.RawMessage `json:"jsonrpc"`
+}
+
+// resultValue returns the value doRequest hands to the endpoints for a raw result
+//
+// Arrays and objects stay json.RawMessage for unmarshalResponse; scalars are
+// decoded, since the endpoints returning them read the string or number directly.
+func resultValue(raw json.RawMessage) (any, error) {
+	trimmed := bytes.TrimLeft(raw, " \t\r\n")
+	if len(trimmed) == 0 {
+		return nil, nil
+	}
+	if trimmed[0] == '[' || trimmed[0] == '{' {
+		return raw, nil
+	}
+	var value any
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// genericResult decodes a raw result into generic values for APIError.Result, which callers inspect directly
+func genericResult(data any) any {
+	raw, ok := data.(json.RawMessage)
+	if !ok {
+		return data
+	}
+	var value any
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return string(raw)
+	}
+	return value
+}
@@ -0,0 +1,87 @@
+package etherscan
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// listPageBody returns an account txlist response of n rows
+func listPageBody(n int) []byte {
+	rows := make([]string, n)
+	for i := range rows {
+		rows[i] = fmt.Sprintf(`{"blockNumber":"%d","timeStamp":"1700000000","hash":"0x%064x","nonce":"%d",`+
+			`"blockHash":"0x%064x","transactionIndex":"1","from":"0x%040x","to":"0x%040x","value":"1000000000000000000",`+
+			`"gas":"21000","gasPrice":"30000000000","isError":"0","txreceipt_status":"1","input":"0x",`+
+			`"contractAddress":"","cumulativeGasUsed":"21000","gasUsed":"21000","confirmations":"100",`+
+			`"methodId":"0x","functionName":""}`, 18000000+i, i, i, i, i, i+1)
+	}
+	return []byte(`{"status":"1","message":"OK","result":[` + strings.Join(rows, ",") + `]}`)
+}
+
+func TestResultValue(t *testing.T) {
+	cases := map[string]any{
+		`[{"a":1}]`: json.RawMessage(`[{"a":1}]`),
+		` {"a":1}`:  json.RawMessage(` {"a":1}`),
+		`"123"`:     "123",
+		`42`:        float64(42),
+		``:          nil,
+	}
+	for raw, want := range cases {
+		got, err := resultValue(json.RawMessage(raw))
+		if err != nil {
+			t.Fatalf("%q: %v", raw, err)
+		}
+		if fmt.Sprint(got) != fmt.Sprint(want) {
+			t.Errorf("%q: expected %v (%T), got %v (%T)", raw, want, want, got, got)
+		}
+	}
+
+	// Error results are decoded for APIError.Result
+	if result, ok := genericResult(json.RawMessage(`{"reason":"x"}`)).(map[string]any); !ok || result["reason"] != "x" {
+		t.Errorf("expected a decoded error result, got %v", result)
+	}
+}
+
+func BenchmarkDecodeListPage(b *testing.B) {
+	body := listPageBody(10000)
+	client := NewHTTPClient(HTTPClientConfig{})
+	ctx := context.Background()
+
+	b.Run("direct", func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetBytes(int64(len(body)))
+		for i := 0; i < b.N; i++ {
+			var envelope responseEnvelope
+			if err := json.Unmarshal(body, &envelope); err != nil {
+				b.Fatal(err)
+			}
+			data, err := resultValue(envelope.Result)
+			if err != nil {
+				b.Fatal(err)
+			}
+			var txs []RespNormalTx
+			if err := client.unmarshalResponse(ctx, data, &txs); err != nil || len(txs) != 10000 {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	// The generic decode and re-encode the client did before decoding into the target
+	b.Run("via-any", func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetBytes(int64(len(body)))
+		for i := 0; i < b.N; i++ {
+			var result map[string]any
+			if err := json.Unmarshal(body, &result); err != nil {
+				b.Fatal(err)
+			}
+			var txs []RespNormalTx
+			if err := client.unmarshalResponse(ctx, result["result"], &txs); err != nil || len(txs) != 10000 {
+				b.Fatal(err)
+			}
+		}
+	})
+}
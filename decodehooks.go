@@ -0,0 +1,148 @@
+package etherscan
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// ============================================================================
+// Decode Hooks
+// ============================================================================
+
+// DecodeHook rewrites the raw result of an endpoint before it is decoded
+//
+// chainID is the chain the request was sent to. The returned JSON replaces
+// result and is decoded into the method's return type as usual, so a hook can
+// rename fields, convert values or replace the result entirely. For proxy
+// (JSON-RPC) endpoints result is the "result" member of the response.
+type DecodeHook func(chainID int, result json.RawMessage) (json.RawMessage, error)
+
+// DecodeHooks maps endpoints to the hook rewriting their results
+//
+// Keys are "module.action" (e.g. "account.txlist") or "module" (e.g.
+// "proxy"); the most specific key wins. Hooks let callers adapt to chains
+// whose explorer answers with nonstandard field names without forking the
+// package.
+//
+// Example:
+//
+//	client := NewHTTPClient(HTTPClientConfig{
+//	    APIKey: "YOUR_API_KEY",
+//	    DecodeHooks: DecodeHooks{
+//	        // This explorer spells the field "txHash" in transfer lists
+//	        "account.tokentx": RenameFields(map[string]string{"txHash": "hash"}),
+//	    },
+//	})
+type DecodeHooks map[string]DecodeHook
+
+// For returns the hook of an endpoint, nil if there is none
+func (h DecodeHooks) For(module, action string) DecodeHook {
+	if hook, ok := h[module+"."+action]; ok {
+		return hook
+	}
+	return h[module]
+}
+
+// RenameFields returns a DecodeHook renaming object keys, at any depth, from the keys to the values of names
+//
+// A renamed key does not overwrite a field already present under the new name.
+// Hooks can be combined by calling one from another.
+func RenameFields(names map[string]string) DecodeHook {
+	var rename func(value any) any
+	rename = func(value any) any {
+		switch v := value.(type) {
+		case map[string]any:
+			for from, to := range names {
+				if field, ok := v[from]; ok {
+					if _, taken := v[to]; !taken {
+						v[to] = field
+						delete(v, from)
+					}
+				}
+			}
+			for k, field := range v {
+				v[k] = rename(field)
+			}
+		case []any:
+			for i, item := range v {
+				v[i] = rename(item)
+			}
+		}
+		return value
+	}
+
+	return func(_ int, result json.RawMessage) (json.RawMessage, error) {
+		value, err := decodeJSONValue(result)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(rename(value))
+	}
+}
+
+// applyDecodeHook passes data, the result of a successful request, through the client's hook for the endpoint
+func (c *HTTPClient) applyDecodeHook(params requestParams, data any) (any, error) {
+	hook := c.decodeHooks.For(params.module, params.action)
+	if hook == nil {
+		return data, nil
+	}
+	chainID := c.defaultChainID
+	if id, err := strconv.Atoi(params.params["chainid"]); err == nil && id != 0 {
+		chainID = id
+	}
+
+	// JSON-RPC responses keep their envelope; only the result is rewritten
+	envelope, isRPC := data.(map[string]any)
+	if isRPC {
+		_, isRPC = envelope["jsonrpc"]
+	}
+	result := data
+	if isRPC {
+		result = envelope["result"]
+	}
+
+	raw, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+	if raw, err = hook(chainID, raw); err != nil {
+		return nil, fmt.Errorf("etherscan: decode hook for %s %s: %w", params.module, params.action, err)
+	}
+	var rewritten any
+	if trimmed := bytes.TrimLeft(raw, " \t\r\n"); !isRPC && len(trimmed) > 0 && (trimmed[0] == '[' || trimmed[0] == '{') {
+		// Lists and objects stay raw for unmarshalResponse, like undecorated results
+		if !json.Valid(raw) {
+			err = errors.New("invalid JSON")
+		}
+		rewritten = json.RawMessage(raw)
+	} else {
+		rewritten, err = decodeJSONValue(raw)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("etherscan: decode hook for %s %s returned invalid JSON: %w", params.module, params.action, err)
+	}
+
+	if isRPC {
+		copied := make(map[string]any, len(envelope))
+		for k, v := range envelope {
+			copied[k] = v
+		}
+		copied["result"] = rewritten
+		return copied, nil
+	}
+	return rewritten, nil
+}
+
+// decodeJSONValue decodes data into generic values, keeping numbers as written
+func decodeJSONValue(data []byte) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var value any
+	if err := dec.Decode(&value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
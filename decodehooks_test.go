@@ -0,0 +1,57 @@
+package etherscan
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDecodeHooks(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("action") {
+		case "txlist":
+			w.Write([]byte(`{"status":"1","message":"OK","result":[{"txHash":"0x1","value":"123456789012345678901234567890"}]}`))
+		case "eth_blockNumber":
+			w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"10"}`))
+		case "balance":
+			w.Write([]byte(`{"status":"1","message":"OK","result":"5"}`))
+		}
+	}))
+	defer server.Close()
+
+	errHook := errors.New("hook failed")
+	var hookChain int
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+		DecodeHooks: DecodeHooks{
+			"account.txlist": RenameFields(map[string]string{"txHash": "hash"}),
+			// This explorer answers eth_blockNumber in decimal
+			"proxy": func(chainID int, result json.RawMessage) (json.RawMessage, error) {
+				hookChain = chainID
+				return json.RawMessage(`"0xa"`), nil
+			},
+			"account.balance": func(int, json.RawMessage) (json.RawMessage, error) {
+				return nil, errHook
+			},
+		},
+	})
+	ctx := context.Background()
+
+	txs, err := client.GetNormalTxs(ctx, testAddr, nil)
+	if err != nil || len(txs) != 1 || txs[0].Hash != "0x1" || txs[0].Value != "123456789012345678901234567890" {
+		t.Errorf("expected the renamed field and an exact value: %+v, %v", txs, err)
+	}
+
+	head, err := client.RpcEthBlockNumber(ctx, nil)
+	if err != nil || head != "0xa" || hookChain != EthereumMainnet {
+		t.Errorf("expected the module hook to rewrite the RPC result: %q, chain %d, %v", head, hookChain, err)
+	}
+
+	if _, err := client.GetEthBalance(ctx, testAddr, nil); !errors.Is(err, errHook) {
+		t.Errorf("expected the hook error, got %v", err)
+	}
+}
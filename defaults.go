@@ -164,6 +164,13 @@ func ExtractAPIParams(opts any) (map[string]string, error) {
 			continue
 		}
 
+		// Reject invalid typed parameters before they reach the API
+		if validator, ok := field.Interface().(paramValidator); ok {
+			if err := validator.Validate(); err != nil {
+				return nil, err
+			}
+		}
+
 		// Convert field value to string
 		var value string
 		switch field.Kind() {
@@ -0,0 +1,246 @@
+package etherscan
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dwdwow/etherscan-go/parallel"
+)
+
+// ============================================================================
+// Contract Deployments
+// ============================================================================
+
+// ContractCreation is a contract deployment found in a transaction list
+type ContractCreation struct {
+	TxHash      string    `json:"txHash" bson:"txHash"`
+	BlockNumber int64     `json:"blockNumber" bson:"blockNumber"`
+	Time        time.Time `json:"time" bson:"time"`
+	Deployer    string    `json:"deployer" bson:"deployer"`
+
+	// ContractAddress is the created contract in lowercase, "" if the list did not report it
+	// and it was not resolved
+	ContractAddress string `json:"contractAddress" bson:"contractAddress"`
+
+	// Failed reports that the deployment reverted, so no contract exists at ContractAddress
+	Failed bool `json:"failed" bson:"failed"`
+
+	// Resolved reports that ResolveContractCreations looked the contract up; Verified and
+	// ContractName are only meaningful then
+	Resolved     bool   `json:"resolved" bson:"resolved"`
+	Verified     bool   `json:"verified" bson:"verified"`
+	ContractName string `json:"contractName" bson:"contractName"`
+}
+
+// ExtractContractCreations returns the contract deployments among txs, in their order
+//
+// A deployment is a transaction without a recipient. Deployments made by
+// factory contracts are internal transactions and do not appear in txlist.
+//
+// Example:
+//
+//	txs, _ := client.GetNormalTxs(ctx, deployer, nil)
+//	for _, c := range etherscan.ExtractContractCreations(txs) {
+//	    fmt.Printf("%s deployed %s in block %d\n", c.Deployer, c.ContractAddress, c.BlockNumber)
+//	}
+func ExtractContractCreations(txs []RespNormalTx) []ContractCreation {
+	creations := []ContractCreation{}
+	for _, tx := range txs {
+		if TxContractCreation(tx) {
+			creations = append(creations, newContractCreation(tx))
+		}
+	}
+	return creations
+}
+
+// newContractCreation describes the deployment made by tx
+func newContractCreation(tx RespNormalTx) ContractCreation {
+	creation := ContractCreation{
+		TxHash:          tx.Hash,
+		BlockNumber:     TxBlockNumber(tx),
+		Deployer:        strings.ToLower(tx.From),
+		ContractAddress: strings.ToLower(tx.ContractAddress),
+		Failed:          TxFailed(tx),
+	}
+	if at, err := ParseTimestamp(tx.TimeStamp); err == nil {
+		creation.Time = at
+	}
+	return creation
+}
+
+// ResolveContractCreationsOpts contains optional parameters for ResolveContractCreations
+type ResolveContractCreationsOpts struct {
+	// Concurrency is the number of deployments looked up in parallel
+	// Default: 4
+	Concurrency int `default:"4"`
+
+	// ChainID specifies which blockchain network to query
+	// Default: empty (uses client default)
+	ChainID int64
+
+	// OnLimitExceeded specifies behavior when rate limit is exceeded
+	// Default: RateLimitBlock (wait until a token is available)
+	OnLimitExceeded RateLimitBehavior `default:""`
+}
+
+// ResolveContractCreations fills in the address and verification status of contract deployments
+//
+// A missing ContractAddress is read from the deployment's receipt, then the
+// verified source is looked up with GetContractSourceCode to set Verified and
+// ContractName. Failed deployments created no contract and are left as they are.
+//
+// Args:
+//   - ctx: Context for request cancellation and timeout
+//   - creations: Deployments, e.g. from ExtractContractCreations
+//   - opts: Optional parameters (can be nil)
+//
+// Returns:
+//   - []ContractCreation: A copy of creations with the successful ones resolved
+//   - error: Error if a request fails
+//
+// Note:
+//   - Costs one call per successful deployment, plus one per missing address
+func (c *HTTPClient) ResolveContractCreations(ctx context.Context, creations []ContractCreation, opts *ResolveContractCreationsOpts) ([]ContractCreation, error) {
+	if opts == nil {
+		opts = &ResolveContractCreationsOpts{}
+	}
+	if err := ApplyDefaults(opts); err != nil {
+		return nil, err
+	}
+
+	return parallel.Map(ctx, creations, opts.Concurrency, func(ctx context.Context, creation ContractCreation) (ContractCreation, error) {
+		if creation.Failed {
+			return creation, nil
+		}
+		if creation.ContractAddress == "" {
+			receipt, err := c.RpcEthTxReceipt(ctx, creation.TxHash, &RpcEthTxReceiptOpts{
+				ChainID:         opts.ChainID,
+				OnLimitExceeded: opts.OnLimitExceeded,
+			})
+			if err != nil {
+				return creation, err
+			}
+			if receipt == nil || receipt.ContractAddress == nil || *receipt.ContractAddress == "" {
+				return creation, fmt.Errorf("etherscan: no contract address in the receipt of %s", creation.TxHash)
+			}
+			creation.ContractAddress = strings.ToLower(*receipt.ContractAddress)
+		}
+
+		sources, err := c.GetContractSourceCode(ctx, creation.ContractAddress, &GetContractSourceCodeOpts{
+			ChainID:         opts.ChainID,
+			OnLimitExceeded: opts.OnLimitExceeded,
+		})
+		if err != nil {
+			return creation, err
+		}
+		creation.Resolved = true
+		if len(sources) > 0 && sources[0].SourceCode != "" {
+			creation.Verified = true
+			creation.ContractName = sources[0].ContractName
+		}
+		return creation, nil
+	})
+}
+
+// GetDeployHistoryOpts contains optional parameters for GetDeployHistory
+type GetDeployHistoryOpts struct {
+	// StartBlock and EndBlock bound the scanned transactions
+	// Default: 0 and 0 (the whole history)
+	StartBlock int64
+	EndBlock   int64
+
+	// Resolve looks up every deployment with ResolveContractCreations
+	// Default: false
+	Resolve bool
+
+	// Concurrency is the number of deployments resolved in parallel
+	// Default: 4
+	Concurrency int `default:"4"`
+
+	// ChainID specifies which blockchain network to query
+	// Default: empty (uses client default)
+	ChainID int64
+
+	// OnLimitExceeded specifies behavior when rate limit is exceeded
+	// Default: RateLimitBlock (wait until a token is available)
+	OnLimitExceeded RateLimitBehavior `default:""`
+}
+
+// GetDeployHistory returns the contracts an address deployed directly, oldest first
+//
+// The address's transactions are fetched in full, split on the 1000-result
+// cap like GetAllEventLogs, and its deployments are extracted with
+// ExtractContractCreations; with Resolve set they also get their addresses and
+// verification status.
+//
+// Args:
+//   - ctx: Context for request cancellation and timeout
+//   - deployer: The deploying address
+//   - opts: Optional parameters (can be nil)
+//
+// Returns:
+//   - []ContractCreation: The deployments sent by deployer, in block order
+//   - error: Error if a request fails
+//
+// Example:
+//
+//	deployments, err := client.GetDeployHistory(ctx, deployer, &etherscan.GetDeployHistoryOpts{Resolve: true})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, d := range deployments {
+//	    fmt.Printf("%s %s %s verified=%v\n", d.Time.Format(time.DateOnly), d.ContractAddress, d.ContractName, d.Verified)
+//	}
+//
+// Note:
+//   - Costs one call per 1000 transactions, plus the ResolveContractCreations calls
+func (c *HTTPClient) GetDeployHistory(ctx context.Context, deployer string, opts *GetDeployHistoryOpts) ([]ContractCreation, error) {
+	if opts == nil {
+		opts = &GetDeployHistoryOpts{}
+	}
+	if err := ApplyDefaults(opts); err != nil {
+		return nil, err
+	}
+	endBlock := opts.EndBlock
+	if endBlock <= 0 {
+		endBlock = defaultEndBlock
+	}
+
+	txs, err := spillLogsByRange(ctx, c, opts.StartBlock, endBlock, func(fromBlock, toBlock, page int64) ([]RespNormalTx, error) {
+		return c.GetNormalTxs(ctx, deployer, &GetNormalTxsOpts{
+			StartBlock:      fromBlock,
+			EndBlock:        toBlock,
+			Page:            page,
+			Offset:          logsPerCall,
+			Sort:            SortAsc,
+			ChainID:         opts.ChainID,
+			OnLimitExceeded: opts.OnLimitExceeded,
+		})
+	})
+	defer txs.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	creations := []ContractCreation{}
+	sentBy := TxFrom(deployer)
+	err = txs.Each(func(tx RespNormalTx) error {
+		if TxContractCreation(tx) && sentBy(tx) {
+			creations = append(creations, newContractCreation(tx))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !opts.Resolve || len(creations) == 0 {
+		return creations, nil
+	}
+	return c.ResolveContractCreations(ctx, creations, &ResolveContractCreationsOpts{
+		Concurrency:     opts.Concurrency,
+		ChainID:         opts.ChainID,
+		OnLimitExceeded: opts.OnLimitExceeded,
+	})
+}
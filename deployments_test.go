@@ -0,0 +1,96 @@
+package etherscan
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+func TestExtractContractCreations(t *testing.T) {
+	txs := []RespNormalTx{
+		{Hash: "0x1", BlockNumber: "10", TimeStamp: "1700000000", From: "0xDEPLOYER", To: "", ContractAddress: "0xAAA", TxReceiptStatus: "1"},
+		{Hash: "0x2", BlockNumber: "11", From: "0xdeployer", To: "0xbbb", TxReceiptStatus: "1"},
+		{Hash: "0x3", BlockNumber: "12", From: "0xdeployer", To: "", ContractAddress: "0xccc", IsError: "1", TxReceiptStatus: "0"},
+	}
+	creations := ExtractContractCreations(txs)
+	if len(creations) != 2 {
+		t.Fatalf("expected 2 deployments, got %+v", creations)
+	}
+	first := creations[0]
+	if first.TxHash != "0x1" || first.BlockNumber != 10 || first.Time.Unix() != 1700000000 ||
+		first.Deployer != "0xdeployer" || first.ContractAddress != "0xaaa" || first.Failed {
+		t.Errorf("unexpected first deployment: %+v", first)
+	}
+	if !creations[1].Failed {
+		t.Errorf("expected the reverted deployment to be failed: %+v", creations[1])
+	}
+}
+
+func TestGetDeployHistory(t *testing.T) {
+	deployer := TestAddresses.VitalikButerin
+	verified := "0x1111111111111111111111111111111111111111"
+	unverified := "0x2222222222222222222222222222222222222222"
+
+	var mu sync.Mutex
+	fetched := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		mu.Lock()
+		fetched[q.Get("action")]++
+		mu.Unlock()
+		switch q.Get("action") {
+		case "txlist":
+			fmt.Fprintf(w, `{"status":"1","message":"OK","result":[
+				{"hash":"0xa","blockNumber":"100","timeStamp":"1700000000","from":"%[1]s","to":"","contractAddress":"%[2]s","txreceipt_status":"1"},
+				{"hash":"0xb","blockNumber":"101","timeStamp":"1700000100","from":"%[1]s","to":"%[2]s","txreceipt_status":"1"},
+				{"hash":"0xc","blockNumber":"102","timeStamp":"1700000200","from":"%[1]s","to":"","contractAddress":"","txreceipt_status":"1"},
+				{"hash":"0xd","blockNumber":"103","timeStamp":"1700000300","from":"%[1]s","to":"","isError":"1","txreceipt_status":"0"},
+				{"hash":"0xe","blockNumber":"104","timeStamp":"1700000400","from":"%[2]s","to":"","contractAddress":"%[3]s","txreceipt_status":"1"}
+			]}`, deployer, verified, unverified)
+		case "eth_getTransactionReceipt":
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":{"transactionHash":"%s","status":"0x1","contractAddress":"%s"}}`, q.Get("txhash"), unverified)
+		case "getsourcecode":
+			if q.Get("address") == verified {
+				fmt.Fprint(w, `{"status":"1","message":"OK","result":[{"SourceCode":"contract Token {}","ContractName":"Token"}]}`)
+			} else {
+				fmt.Fprint(w, `{"status":"1","message":"OK","result":[{"SourceCode":"","ContractName":""}]}`)
+			}
+		}
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+		MaxRetries: -1,
+	})
+	ctx := context.Background()
+
+	deployments, err := client.GetDeployHistory(ctx, deployer, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deployments) != 3 || deployments[0].Resolved || fetched["getsourcecode"] != 0 {
+		t.Fatalf("expected 3 unresolved deployments of the deployer, got %+v and %v", deployments, fetched)
+	}
+
+	deployments, err = client.GetDeployHistory(ctx, deployer, &GetDeployHistoryOpts{Resolve: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := deployments[0]; !d.Resolved || !d.Verified || d.ContractName != "Token" || d.ContractAddress != verified {
+		t.Errorf("expected a verified Token deployment, got %+v", d)
+	}
+	if d := deployments[1]; !d.Resolved || d.Verified || d.ContractAddress != unverified {
+		t.Errorf("expected the address from the receipt and no verified source, got %+v", d)
+	}
+	if d := deployments[2]; !d.Failed || d.Resolved {
+		t.Errorf("expected the failed deployment to be left unresolved, got %+v", d)
+	}
+	if fetched["eth_getTransactionReceipt"] != 1 || fetched["getsourcecode"] != 2 {
+		t.Errorf("expected one receipt and two source lookups, got %v", fetched)
+	}
+}
@@ -0,0 +1,37 @@
+package etherscan
+
+// ============================================================================
+// Deprecations
+// ============================================================================
+
+// Deprecation describes an API kept as a thin wrapper around its replacement
+//
+// Deprecated APIs keep working and carry a "Deprecated:" doc paragraph, so
+// editors and staticcheck flag their use at compile time. They are removed no
+// earlier than the next major version.
+type Deprecation struct {
+	// Name is the deprecated HTTPClient method, e.g. "GetEthPrice"
+	Name string
+
+	// Replacement is the API to use instead
+	Replacement string
+
+	// Reason explains what the replacement improves
+	Reason string
+
+	// Example shows the replacement call
+	Example string
+}
+
+// Deprecations lists every deprecated API of the package
+//
+// Names are HTTPClient methods; the etherscan-migrate command reports their
+// uses in a code base together with a migration guide.
+var Deprecations = []Deprecation{
+	{
+		Name:        "GetEthPrice",
+		Replacement: "GetNativeTokenPrice",
+		Reason:      "ethprice returns the native token price on every chain; the replacement uses neutral field names, adds the native symbol and parses timestamps",
+		Example:     "price, err := client.GetNativeTokenPrice(ctx, &etherscan.GetNativeTokenPriceOpts{ChainID: chainID})\n// price.EthUSD -> price.USD, price.EthUSDTimestamp -> price.USDTime",
+	},
+}
@@ -0,0 +1,26 @@
+package etherscan
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDeprecationsDocumented(t *testing.T) {
+	// Every registered deprecation must carry a "Deprecated:" doc paragraph in the package
+	var sources strings.Builder
+	files, _ := filepath.Glob("*.go")
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sources.Write(data)
+	}
+	for _, d := range Deprecations {
+		if !strings.Contains(sources.String(), "// Deprecated: Use "+d.Replacement) {
+			t.Errorf("%s has no Deprecated doc paragraph pointing to %s", d.Name, d.Replacement)
+		}
+	}
+}
@@ -0,0 +1,334 @@
+package etherscan
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// ============================================================================
+// Distributed Rate Limiting
+// ============================================================================
+
+// DistributedLimiter coordinates the request rate of several processes sharing one API key
+//
+// The client's own MultiRateLimiter only sees the requests of its process, so
+// N instances of a service each allowed 5 calls per second collectively send
+// 5N and trigger Etherscan's NOTOK rate-limit responses. With a
+// DistributedLimiter configured, every request also takes a token from
+// buckets shared by all instances.
+type DistributedLimiter interface {
+	// Reserve takes one token from each of the buckets key+limit, atomically:
+	// either every bucket grants a token or none is charged.
+	//
+	// Returns:
+	//   - time.Duration: 0 if the tokens were taken, otherwise how long until all buckets have one
+	//   - error: Error if the backend could not be reached
+	Reserve(ctx context.Context, key string, limits []RateLimit) (time.Duration, error)
+}
+
+// distributedLimitKey is the bucket key of an API key; the key itself is hashed so it never reaches the backend
+func distributedLimitKey(apiKey string) string {
+	sum := sha256.Sum256([]byte(apiKey))
+	return "etherscan:ratelimit:" + hex.EncodeToString(sum[:8])
+}
+
+// acquireDistributed takes a token from the shared buckets of the client's API key
+//
+// It follows the same behaviors as the local limiter: RateLimitBlock waits
+// for the shared buckets to refill, RateLimitRaise and RateLimitSkip report
+// false at once.
+func (c *HTTPClient) acquireDistributed(ctx context.Context, behavior RateLimitBehavior) (bool, error) {
+	if c.distributedLimiter == nil {
+		return true, nil
+	}
+	for {
+		wait, err := c.distributedLimiter.Reserve(ctx, c.distributedLimitKey, c.rateLimits)
+		if err != nil {
+			return false, fmt.Errorf("etherscan: distributed rate limiter: %w", err)
+		}
+		if wait <= 0 {
+			return true, nil
+		}
+		if behavior != RateLimitBlock {
+			return false, nil
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return false, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// ============================================================================
+// Redis Backend
+// ============================================================================
+
+// redisTokenBucketScript refills and charges the token buckets KEYS under the
+// limits ARGV (pairs of limit and period in microseconds), using the server
+// clock so the instances need not agree on the time. It returns 0 when every
+// bucket had a token, otherwise the microseconds until they all do.
+const redisTokenBucketScript = `
+local t = redis.call('TIME')
+local now = tonumber(t[1]) * 1000000 + tonumber(t[2])
+local tokens, wait = {}, 0
+for i, key in ipairs(KEYS) do
+  local limit = tonumber(ARGV[2 * i - 1])
+  local period = tonumber(ARGV[2 * i])
+  local state = redis.call('HMGET', key, 'tokens', 'ts')
+  local available = tonumber(state[1]) or limit
+  local ts = tonumber(state[2]) or now
+  available = math.min(limit, available + (now - ts) * limit / period)
+  tokens[i] = available
+  if available < 1 then
+    wait = math.max(wait, math.ceil((1 - available) * period / limit))
+  end
+end
+for i, key in ipairs(KEYS) do
+  local period = tonumber(ARGV[2 * i])
+  if wait == 0 then
+    tokens[i] = tokens[i] - 1
+  end
+  redis.call('HSET', key, 'tokens', tostring(tokens[i]), 'ts', string.format('%.0f', now))
+  redis.call('PEXPIRE', key, math.ceil(period / 1000))
+end
+return wait
+`
+
+// RedisLimiterOpts contains optional parameters for NewRedisLimiter
+type RedisLimiterOpts struct {
+	// Password authenticates the connection with AUTH
+	// Default: empty (no authentication)
+	Password string
+
+	// Username selects an ACL user for AUTH (Redis 6+)
+	// Default: empty (the default user)
+	Username string
+
+	// DB is the database selected on connect
+	// Default: 0
+	DB int
+
+	// DialTimeout bounds connecting to the server
+	// Default: 5s
+	DialTimeout time.Duration
+}
+
+// RedisLimiter is a DistributedLimiter keeping token buckets in Redis
+//
+// Each Reserve runs one Lua script, so the refill and charge of all buckets
+// are atomic across instances. It speaks the Redis protocol directly over one
+// connection, which is redialed after any error; Reserve calls from the same
+// process are serialized on it.
+type RedisLimiter struct {
+	addr string
+	opts RedisLimiterOpts
+
+	mu   sync.Mutex
+	conn net.Conn
+	rd   *bufio.Reader
+}
+
+// NewRedisLimiter creates a DistributedLimiter backed by the Redis server at addr
+//
+// The connection is opened lazily by the first Reserve, so the server need not
+// be up when the client is constructed.
+//
+// Args:
+//   - addr: Server address as host:port
+//   - opts: Optional parameters (can be nil)
+//
+// Example:
+//
+//	limiter := etherscan.NewRedisLimiter("redis:6379", &etherscan.RedisLimiterOpts{Password: os.Getenv("REDIS_PASSWORD")})
+//	defer limiter.Close()
+//	client := etherscan.NewHTTPClient(etherscan.HTTPClientConfig{
+//	    APIKey:             os.Getenv("ETHERSCAN_API_KEY"),
+//	    DistributedLimiter: limiter,
+//	})
+//
+// Note:
+//   - Requires Redis 5 or later, which replicates script effects rather than scripts
+func NewRedisLimiter(addr string, opts *RedisLimiterOpts) *RedisLimiter {
+	l := &RedisLimiter{addr: addr}
+	if opts != nil {
+		l.opts = *opts
+	}
+	if l.opts.DialTimeout <= 0 {
+		l.opts.DialTimeout = 5 * time.Second
+	}
+	return l
+}
+
+// Reserve implements DistributedLimiter
+func (l *RedisLimiter) Reserve(ctx context.Context, key string, limits []RateLimit) (time.Duration, error) {
+	args := []string{"EVAL", redisTokenBucketScript, strconv.Itoa(len(limits))}
+	for _, limit := range limits {
+		args = append(args, key+":"+strconv.FormatInt(limit.Period.Milliseconds(), 10))
+	}
+	for _, limit := range limits {
+		if limit.Limit <= 0 || limit.Period <= 0 {
+			return 0, fmt.Errorf("invalid rate limit %d per %s", limit.Limit, limit.Period)
+		}
+		args = append(args, strconv.FormatInt(limit.Limit, 10), strconv.FormatInt(limit.Period.Microseconds(), 10))
+	}
+
+	reply, err := l.do(ctx, args...)
+	if err != nil {
+		return 0, err
+	}
+	wait, ok := reply.(int64)
+	if !ok {
+		return 0, fmt.Errorf("redis: unexpected reply %v", reply)
+	}
+	return time.Duration(wait) * time.Microsecond, nil
+}
+
+// Close closes the connection to the server
+func (l *RedisLimiter) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.conn == nil {
+		return nil
+	}
+	err := l.conn.Close()
+	l.conn, l.rd = nil, nil
+	return err
+}
+
+// redisError is an error reply of the server
+type redisError string
+
+func (e redisError) Error() string { return "redis: " + string(e) }
+
+// do sends one command and reads its reply, connecting first if needed
+func (l *RedisLimiter) do(ctx context.Context, args ...string) (any, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.conn == nil {
+		if err := l.connect(ctx); err != nil {
+			return nil, err
+		}
+	}
+	reply, err := l.roundTrip(ctx, args)
+	var replyErr redisError
+	if err != nil && !errors.As(err, &replyErr) {
+		// The connection state is unknown after a transport error
+		l.conn.Close()
+		l.conn, l.rd = nil, nil
+	}
+	return reply, err
+}
+
+// connect dials the server and authenticates; l.mu must be held
+func (l *RedisLimiter) connect(ctx context.Context) error {
+	dialer := net.Dialer{Timeout: l.opts.DialTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", l.addr)
+	if err != nil {
+		return err
+	}
+	l.conn, l.rd = conn, bufio.NewReader(conn)
+
+	var setup [][]string
+	switch {
+	case l.opts.Username != "":
+		setup = append(setup, []string{"AUTH", l.opts.Username, l.opts.Password})
+	case l.opts.Password != "":
+		setup = append(setup, []string{"AUTH", l.opts.Password})
+	}
+	if l.opts.DB != 0 {
+		setup = append(setup, []string{"SELECT", strconv.Itoa(l.opts.DB)})
+	}
+	for _, args := range setup {
+		if _, err := l.roundTrip(ctx, args); err != nil {
+			conn.Close()
+			l.conn, l.rd = nil, nil
+			return err
+		}
+	}
+	return nil
+}
+
+// roundTrip writes args as a RESP array and reads the reply; l.mu must be held
+func (l *RedisLimiter) roundTrip(ctx context.Context, args []string) (any, error) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(l.opts.DialTimeout)
+	}
+	if err := l.conn.SetDeadline(deadline); err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, 0, 64)
+	buf = append(buf, '*')
+	buf = strconv.AppendInt(buf, int64(len(args)), 10)
+	buf = append(buf, "\r\n"...)
+	for _, arg := range args {
+		buf = append(buf, '$')
+		buf = strconv.AppendInt(buf, int64(len(arg)), 10)
+		buf = append(buf, "\r\n"...)
+		buf = append(buf, arg...)
+		buf = append(buf, "\r\n"...)
+	}
+	if _, err := l.conn.Write(buf); err != nil {
+		return nil, err
+	}
+	return readRESP(l.rd)
+}
+
+// readRESP reads one reply: simple strings and bulk strings as string, integers as int64,
+// arrays as []any, nulls as nil and error replies as a redisError
+func readRESP(rd *bufio.Reader) (any, error) {
+	line, err := rd.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	if len(line) < 3 || line[len(line)-2] != '\r' {
+		return nil, fmt.Errorf("redis: malformed reply %q", line)
+	}
+	kind, body := line[0], line[1:len(line)-2]
+	switch kind {
+	case '+':
+		return body, nil
+	case '-':
+		return nil, redisError(body)
+	case ':':
+		return strconv.ParseInt(body, 10, 64)
+	case '$':
+		n, err := strconv.Atoi(body)
+		if err != nil || n < 0 {
+			return nil, err
+		}
+		data := make([]byte, n+2)
+		if _, err := io.ReadFull(rd, data); err != nil {
+			return nil, err
+		}
+		return string(data[:n]), nil
+	case '*':
+		n, err := strconv.Atoi(body)
+		if err != nil || n < 0 {
+			return nil, err
+		}
+		items := make([]any, n)
+		for i := range items {
+			if items[i], err = readRESP(rd); err != nil {
+				return nil, err
+			}
+		}
+		return items, nil
+	default:
+		return nil, fmt.Errorf("redis: malformed reply %q", line)
+	}
+}
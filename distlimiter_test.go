@@ -0,0 +1,139 @@
+package etherscan
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeDistributedLimiter denies the first denials reservations with a short wait
+type fakeDistributedLimiter struct {
+	mu       sync.Mutex
+	denials  int
+	err      error
+	keys     []string
+	reserved int
+}
+
+func (f *fakeDistributedLimiter) Reserve(ctx context.Context, key string, limits []RateLimit) (time.Duration, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.keys = append(f.keys, key)
+	if f.err != nil {
+		return 0, f.err
+	}
+	if f.denials > 0 {
+		f.denials--
+		return 5 * time.Millisecond, nil
+	}
+	f.reserved++
+	return 0, nil
+}
+
+func TestDistributedLimiter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":"1","message":"OK","result":"1000"}`))
+	}))
+	defer server.Close()
+
+	shared := &fakeDistributedLimiter{denials: 2}
+	client := NewHTTPClient(HTTPClientConfig{
+		APIKey:             "SECRET",
+		APIVersion:         APIVersionV1,
+		V1BaseURLs:         map[int]string{EthereumMainnet: server.URL},
+		MaxRetries:         -1,
+		DistributedLimiter: shared,
+	})
+
+	// RateLimitBlock waits out the shared buckets
+	if _, err := client.GetEthBalance(context.Background(), TestAddresses.VitalikButerin, nil); err != nil {
+		t.Fatalf("blocking request failed: %v", err)
+	}
+	if len(shared.keys) != 3 || shared.reserved != 1 {
+		t.Errorf("expected 2 denied and 1 granted reservation, got %d keys, %d reserved", len(shared.keys), shared.reserved)
+	}
+	if strings.Contains(shared.keys[0], "SECRET") || shared.keys[0] != distributedLimitKey("SECRET") {
+		t.Errorf("unexpected bucket key %q", shared.keys[0])
+	}
+
+	shared.denials = 1
+	_, err := client.GetEthBalance(context.Background(), TestAddresses.VitalikButerin, &GetEthBalanceOpts{OnLimitExceeded: RateLimitRaise})
+	if !errors.Is(err, ErrRateLimitExceeded) {
+		t.Errorf("expected ErrRateLimitExceeded, got %v", err)
+	}
+
+	shared.err = errors.New("connection refused")
+	if _, err := client.GetEthBalance(context.Background(), TestAddresses.VitalikButerin, nil); err == nil || !strings.Contains(err.Error(), "connection refused") {
+		t.Errorf("expected the backend error, got %v", err)
+	}
+}
+
+func TestRedisLimiter(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+
+	commands := make(chan []any, 10)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		rd := bufio.NewReader(conn)
+		replies := map[string][]string{"AUTH": {"+OK"}, "SELECT": {"+OK"}, "EVAL": {":1500", ":0", "-ERR script failed"}}
+		for {
+			cmd, err := readRESP(rd)
+			if err != nil {
+				return
+			}
+			args := cmd.([]any)
+			commands <- args
+			name := args[0].(string)
+			reply := replies[name][0]
+			replies[name] = replies[name][1:]
+			conn.Write([]byte(reply + "\r\n"))
+		}
+	}()
+
+	limiter := NewRedisLimiter(ln.Addr().String(), &RedisLimiterOpts{Password: "pw", DB: 2})
+	defer limiter.Close()
+	limits := []RateLimit{{Limit: 5, Period: time.Second}, {Limit: 1000, Period: 24 * time.Hour}}
+
+	wait, err := limiter.Reserve(context.Background(), "k", limits)
+	if err != nil || wait != 1500*time.Microsecond {
+		t.Fatalf("expected a 1.5ms wait, got %s, %v", wait, err)
+	}
+	if auth := <-commands; auth[0] != "AUTH" || auth[1] != "pw" {
+		t.Errorf("unexpected AUTH command %v", auth)
+	}
+	if sel := <-commands; sel[0] != "SELECT" || sel[1] != "2" {
+		t.Errorf("unexpected SELECT command %v", sel)
+	}
+	eval := <-commands
+	want := []any{"2", "k:1000", "k:86400000", "5", "1000000", "1000", "86400000000"}
+	for i, arg := range want {
+		if eval[i+2] != arg {
+			t.Errorf("EVAL argument %d: expected %v, got %v", i+2, arg, eval[i+2])
+		}
+	}
+
+	if wait, err = limiter.Reserve(context.Background(), "k", limits); err != nil || wait != 0 {
+		t.Errorf("expected the tokens to be granted, got %s, %v", wait, err)
+	}
+	if _, err = limiter.Reserve(context.Background(), "k", limits); err == nil || !strings.Contains(err.Error(), "script failed") {
+		t.Errorf("expected the error reply, got %v", err)
+	}
+	if _, err := limiter.Reserve(context.Background(), "k", []RateLimit{{Limit: 0, Period: time.Second}}); err == nil {
+		t.Error("expected an error for a zero limit")
+	}
+}
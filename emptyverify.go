@@ -0,0 +1,81 @@
+package etherscan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// ============================================================================
+// Empty Result Verification
+// ============================================================================
+
+// ErrUnexpectedlyEmpty is returned by VerifyNonEmpty calls whose result stayed empty
+// although a cross-check says the address has history
+var ErrUnexpectedlyEmpty = errors.New("etherscan: empty result contradicts cross-check")
+
+// verifyNonEmpty refetches an empty full-history result once after the retry delay,
+// if check reports (with a description for the error) that it should not be empty
+func verifyNonEmpty[T any](ctx context.Context, c *HTTPClient, action string, check func() (string, error), refetch func() ([]T, error)) ([]T, error) {
+	reason, err := check()
+	if err != nil {
+		return nil, err
+	}
+	if reason == "" {
+		return []T{}, nil
+	}
+
+	log.Printf("%sempty %s result although %s, retrying in %s...", logPrefix(ctx), action, reason, c.retryDelay)
+	timer := time.NewTimer(c.retryDelay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-timer.C:
+	}
+
+	result, err := refetch()
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, fmt.Errorf("%w: %s is empty although %s", ErrUnexpectedlyEmpty, action, reason)
+	}
+	return result, nil
+}
+
+// nonceCheck is the txlist cross-check: an address with a non-zero nonce has sent transactions
+func (c *HTTPClient) nonceCheck(ctx context.Context, address string, chainID int64, onLimitExceeded RateLimitBehavior) (string, error) {
+	count, err := c.RpcEthTxCount(ctx, address, BlockTagLatest, &RpcEthTxCountOpts{
+		ChainID:         chainID,
+		OnLimitExceeded: onLimitExceeded,
+	})
+	if err != nil {
+		return "", err
+	}
+	nonce, err := parseHexUint64(count)
+	if err != nil || nonce == 0 {
+		return "", err
+	}
+	return fmt.Sprintf("the nonce of %s is %d", address, nonce), nil
+}
+
+// tokenBalanceCheck is the tokentx cross-check: an address holding a token has received it
+func (c *HTTPClient) tokenBalanceCheck(ctx context.Context, token, address string, chainID int64, onLimitExceeded RateLimitBehavior) (string, error) {
+	balance, err := c.GetERC20AccountBalance(ctx, token, address, &GetERC20AccountBalanceOpts{
+		ChainID:         chainID,
+		OnLimitExceeded: onLimitExceeded,
+	})
+	if err != nil {
+		return "", err
+	}
+	n, ok := new(big.Int).SetString(strings.TrimSpace(balance), 10)
+	if !ok || n.Sign() == 0 {
+		return "", nil
+	}
+	return fmt.Sprintf("%s holds %s of %s", address, n, token), nil
+}
@@ -0,0 +1,75 @@
+package etherscan
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestVerifyNonEmpty(t *testing.T) {
+	var txlistCalls, tokentxCalls atomic.Int32
+	nonce, txlistEmptyFor := "0x3", int32(1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("action") {
+		case "eth_getTransactionCount":
+			w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"` + nonce + `"}`))
+		case "txlist":
+			if txlistCalls.Add(1) <= txlistEmptyFor {
+				w.Write([]byte(`{"status":"0","message":"No transactions found","result":[]}`))
+				return
+			}
+			w.Write([]byte(`{"status":"1","message":"OK","result":[{"hash":"0x1"}]}`))
+		case "tokenbalance":
+			w.Write([]byte(`{"status":"1","message":"OK","result":"0"}`))
+		case "tokentx":
+			tokentxCalls.Add(1)
+			w.Write([]byte(`{"status":"0","message":"No transactions found","result":[]}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+		MaxRetries: -1,
+		RetryDelay: time.Millisecond,
+	})
+	ctx := context.Background()
+	addr := TestAddresses.VitalikButerin
+
+	// A transient empty page is refetched once
+	txs, err := client.GetNormalTxs(ctx, addr, &GetNormalTxsOpts{VerifyNonEmpty: true})
+	if err != nil || len(txs) != 1 || txlistCalls.Load() != 2 {
+		t.Errorf("expected the retry to return the transaction, got %v %v after %d calls", txs, err, txlistCalls.Load())
+	}
+
+	// An empty page that stays empty is an error
+	txlistCalls.Store(0)
+	txlistEmptyFor = 2
+	if _, err := client.GetNormalTxs(ctx, addr, &GetNormalTxsOpts{VerifyNonEmpty: true}); !errors.Is(err, ErrUnexpectedlyEmpty) {
+		t.Errorf("expected ErrUnexpectedlyEmpty, got %v", err)
+	}
+
+	// Without history, or a narrowed query, empty is trusted
+	txlistCalls.Store(0)
+	nonce = "0x0"
+	if txs, err := client.GetNormalTxs(ctx, addr, &GetNormalTxsOpts{VerifyNonEmpty: true}); err != nil || len(txs) != 0 || txlistCalls.Load() != 1 {
+		t.Errorf("expected a trusted empty result, got %v %v after %d calls", txs, err, txlistCalls.Load())
+	}
+	txlistCalls.Store(0)
+	nonce = "0x3"
+	if _, err := client.GetNormalTxs(ctx, addr, &GetNormalTxsOpts{VerifyNonEmpty: true, StartBlock: 100}); err != nil || txlistCalls.Load() != 1 {
+		t.Errorf("expected no retry for a block range, got %v after %d calls", err, txlistCalls.Load())
+	}
+
+	// Token transfers are cross-checked against the token balance
+	token := "0x" + strings.Repeat("ab", 20)
+	if _, err := client.GetERC20TokenTransfers(ctx, &GetERC20TokenTransfersOpts{Address: addr, ContractAddress: token, VerifyNonEmpty: true}); err != nil || tokentxCalls.Load() != 1 {
+		t.Errorf("expected no retry without a token balance, got %v after %d calls", err, tokentxCalls.Load())
+	}
+}
@@ -0,0 +1,126 @@
+package etherscan
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// ============================================================================
+// ENS Reverse Resolution
+// ============================================================================
+
+// ENSRegistry is the address of the ENS registry on Ethereum mainnet
+const ENSRegistry = "0x00000000000c2e074ec69a0dfb2997ba6c7d2e1e"
+
+// zeroAddress is the address word returned for unset ENS records
+const zeroAddress = "0x0000000000000000000000000000000000000000"
+
+// ENSNamehash returns the EIP-137 namehash of an ENS name
+//
+// The name is hashed as given; callers resolving user input must normalize
+// it (UTS-46) first.
+func ENSNamehash(name string) []byte {
+	node := make([]byte, 32)
+	if name == "" {
+		return node
+	}
+	labels := strings.Split(name, ".")
+	for i := len(labels) - 1; i >= 0; i-- {
+		node = Keccak256(node, Keccak256([]byte(labels[i])))
+	}
+	return node
+}
+
+// LookupENSNameOpts contains optional parameters for LookupENSName
+type LookupENSNameOpts struct {
+	// ChainID specifies the chain of the ENS registry
+	// Default: EthereumMainnet
+	ChainID int64
+
+	// OnLimitExceeded specifies behavior when rate limit is exceeded
+	// Default: RateLimitBlock (wait until a token is available)
+	OnLimitExceeded RateLimitBehavior `default:""`
+}
+
+// LookupENSName returns the primary ENS name of an address, "" if it has none
+//
+// The reverse record <address>.addr.reverse is read from its resolver, then
+// the name is resolved forward and only returned if it points back to the
+// address: anyone can set a reverse record claiming any name.
+//
+// Args:
+//   - ctx: Context for request cancellation and timeout
+//   - address: The address to look up
+//   - opts: Optional parameters (can be nil)
+//
+// Returns:
+//   - string: The verified primary name, "" if none is set or it does not resolve back
+//   - error: Error if a call fails
+//
+// Example:
+//
+//	name, err := client.LookupENSName(ctx, TestAddresses.VitalikButerin, nil)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(name) // vitalik.eth
+//
+// Note:
+//   - Costs two eth_call requests without a reverse record, four with one
+func (c *HTTPClient) LookupENSName(ctx context.Context, address string, opts *LookupENSNameOpts) (string, error) {
+	if opts == nil {
+		opts = &LookupENSNameOpts{}
+	}
+	if err := ApplyDefaults(opts); err != nil {
+		return "", err
+	}
+	if opts.ChainID == 0 {
+		opts.ChainID = EthereumMainnet
+	}
+	address, err := NormalizeAddress(address)
+	if err != nil {
+		return "", err
+	}
+
+	reverseNode := ENSNamehash(strings.TrimPrefix(address, "0x") + ".addr.reverse")
+	resolver, err := c.ensCall(ctx, ENSRegistry, "resolver(bytes32)", reverseNode, "address", opts)
+	if err != nil || resolver == zeroAddress {
+		return "", err
+	}
+	name, err := c.ensCall(ctx, resolver, "name(bytes32)", reverseNode, "string", opts)
+	if err != nil || name == "" {
+		return "", err
+	}
+
+	nameNode := ENSNamehash(name)
+	resolver, err = c.ensCall(ctx, ENSRegistry, "resolver(bytes32)", nameNode, "address", opts)
+	if err != nil || resolver == zeroAddress {
+		return "", err
+	}
+	resolved, err := c.ensCall(ctx, resolver, "addr(bytes32)", nameNode, "address", opts)
+	if err != nil || resolved != address {
+		return "", err
+	}
+	return name, nil
+}
+
+// ensCall calls an ENS contract method taking a node and returning one address or string
+func (c *HTTPClient) ensCall(ctx context.Context, to, signature string, node []byte, output string, opts *LookupENSNameOpts) (string, error) {
+	data, err := EncodeABICall(MethodSelector(signature), []string{"bytes32"}, node)
+	if err != nil {
+		return "", err
+	}
+	result, err := c.RpcEthCall(ctx, to, data, &RpcEthCallOpts{
+		ChainID:         opts.ChainID,
+		OnLimitExceeded: opts.OnLimitExceeded,
+	})
+	if err != nil {
+		return "", err
+	}
+	values, err := DecodeABIResult(result, []string{output})
+	if err != nil {
+		return "", fmt.Errorf("etherscan: ENS %s on %s: %w", signature, to, err)
+	}
+	return values[0].(string), nil
+}
@@ -0,0 +1,199 @@
+package etherscan
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ============================================================================
+// Typed Parameters
+// ============================================================================
+
+// Typed parameters are validated before a request is sent (see ExtractAPIParams),
+// so a typo such as "dsc" fails with a clear error instead of an empty response.
+// The empty value is always accepted and means "use the API default".
+
+// SortOrder is the sort direction of list endpoints
+type SortOrder string
+
+const (
+	SortAsc  SortOrder = "asc"
+	SortDesc SortOrder = "desc"
+)
+
+// Validate returns an error if s is not a known sort order
+func (s SortOrder) Validate() error {
+	switch s {
+	case "", SortAsc, SortDesc:
+		return nil
+	}
+	return fmt.Errorf("etherscan: invalid sort order %q, expected %q or %q", string(s), SortAsc, SortDesc)
+}
+
+// BlockTag is a JSON-RPC block parameter: a named tag or a hex block number
+type BlockTag string
+
+const (
+	BlockTagLatest    BlockTag = "latest"
+	BlockTagEarliest  BlockTag = "earliest"
+	BlockTagPending   BlockTag = "pending"
+	BlockTagSafe      BlockTag = "safe"
+	BlockTagFinalized BlockTag = "finalized"
+)
+
+// BlockNumberTag returns the BlockTag for a specific block number
+//
+// Example:
+//
+//	block, err := client.RpcEthBlockByNumber(ctx, BlockNumberTag(18000000), nil)
+func BlockNumberTag(blockNo int64) BlockTag {
+	return BlockTag("0x" + strconv.FormatInt(blockNo, 16))
+}
+
+// Validate returns an error if t is neither a named tag nor a hex block number
+func (t BlockTag) Validate() error {
+	switch t {
+	case "", BlockTagLatest, BlockTagEarliest, BlockTagPending, BlockTagSafe, BlockTagFinalized:
+		return nil
+	}
+	if hexNum, ok := strings.CutPrefix(string(t), "0x"); ok && hexNum != "" {
+		if _, err := strconv.ParseUint(hexNum, 16, 64); err == nil {
+			return nil
+		}
+	}
+	return fmt.Errorf("etherscan: invalid block tag %q, expected latest, earliest, pending, safe, finalized or a 0x-prefixed block number", string(t))
+}
+
+// BlockType selects canonical blocks or uncles in getminedblocks
+type BlockType string
+
+const (
+	BlockTypeBlocks BlockType = "blocks"
+	BlockTypeUncles BlockType = "uncles"
+)
+
+// Validate returns an error if b is not a known block type
+func (b BlockType) Validate() error {
+	switch b {
+	case "", BlockTypeBlocks, BlockTypeUncles:
+		return nil
+	}
+	return fmt.Errorf("etherscan: invalid block type %q, expected %q or %q", string(b), BlockTypeBlocks, BlockTypeUncles)
+}
+
+// TopicOperator combines two topic filters in getLogs
+type TopicOperator string
+
+const (
+	TopicOpAnd TopicOperator = "and"
+	TopicOpOr  TopicOperator = "or"
+)
+
+// Validate returns an error if o is not a known topic operator
+func (o TopicOperator) Validate() error {
+	switch o {
+	case "", TopicOpAnd, TopicOpOr:
+		return nil
+	}
+	return fmt.Errorf("etherscan: invalid topic operator %q, expected %q or %q", string(o), TopicOpAnd, TopicOpOr)
+}
+
+// ClosestBlock selects which side of a timestamp getblocknobytime resolves to
+type ClosestBlock string
+
+const (
+	ClosestBefore ClosestBlock = "before"
+	ClosestAfter  ClosestBlock = "after"
+)
+
+// Validate returns an error if c is not a known direction
+func (c ClosestBlock) Validate() error {
+	switch c {
+	case "", ClosestBefore, ClosestAfter:
+		return nil
+	}
+	return fmt.Errorf("etherscan: invalid closest value %q, expected %q or %q", string(c), ClosestBefore, ClosestAfter)
+}
+
+// CodeFormat is the source code format of a verification request
+type CodeFormat string
+
+const (
+	CodeFormatSoliditySingleFile   CodeFormat = "solidity-single-file"
+	CodeFormatSolidityStandardJSON CodeFormat = "solidity-standard-json-input"
+	CodeFormatVyperJSON            CodeFormat = "vyper-json"
+	CodeFormatStylus               CodeFormat = "stylus"
+)
+
+// Validate returns an error if f is not a known code format
+func (f CodeFormat) Validate() error {
+	switch f {
+	case "", CodeFormatSoliditySingleFile, CodeFormatSolidityStandardJSON, CodeFormatVyperJSON, CodeFormatStylus:
+		return nil
+	}
+	return fmt.Errorf("etherscan: invalid code format %q, expected %q, %q, %q or %q", string(f),
+		CodeFormatSoliditySingleFile, CodeFormatSolidityStandardJSON, CodeFormatVyperJSON, CodeFormatStylus)
+}
+
+// LicenseType is the open source license of a verified contract, by Etherscan's numeric ID
+type LicenseType int64
+
+const (
+	LicenseNone       LicenseType = 1
+	LicenseUnlicense  LicenseType = 2
+	LicenseMIT        LicenseType = 3
+	LicenseGPL2       LicenseType = 4
+	LicenseGPL3       LicenseType = 5
+	LicenseLGPL2_1    LicenseType = 6
+	LicenseLGPL3      LicenseType = 7
+	LicenseBSD2Clause LicenseType = 8
+	LicenseBSD3Clause LicenseType = 9
+	LicenseMPL2       LicenseType = 10
+	LicenseOSL3       LicenseType = 11
+	LicenseApache2    LicenseType = 12
+	LicenseAGPL3      LicenseType = 13
+	LicenseBSL1_1     LicenseType = 14
+)
+
+// maxKnownLicenseType is the highest license ID Validate accepts
+const maxKnownLicenseType = LicenseBSL1_1
+
+// licenseNames are the license names Etherscan shows, by ID
+var licenseNames = map[LicenseType]string{
+	LicenseNone:       "None",
+	LicenseUnlicense:  "Unlicense",
+	LicenseMIT:        "MIT",
+	LicenseGPL2:       "GNU GPLv2",
+	LicenseGPL3:       "GNU GPLv3",
+	LicenseLGPL2_1:    "GNU LGPLv2.1",
+	LicenseLGPL3:      "GNU LGPLv3",
+	LicenseBSD2Clause: "BSD-2-Clause",
+	LicenseBSD3Clause: "BSD-3-Clause",
+	LicenseMPL2:       "MPL-2.0",
+	LicenseOSL3:       "OSL-3.0",
+	LicenseApache2:    "Apache-2.0",
+	LicenseAGPL3:      "GNU AGPLv3",
+	LicenseBSL1_1:     "BSL 1.1",
+}
+
+// String returns the license name, or the numeric ID if it is unknown
+func (l LicenseType) String() string {
+	if name, ok := licenseNames[l]; ok {
+		return name
+	}
+	return strconv.FormatInt(int64(l), 10)
+}
+
+// Validate returns an error if l is not a known license ID
+func (l LicenseType) Validate() error {
+	if l == 0 || (l >= LicenseNone && l <= maxKnownLicenseType) {
+		return nil
+	}
+	return fmt.Errorf("etherscan: invalid license type %d, expected 1 (None) to %d (%s)", int64(l), int64(maxKnownLicenseType), maxKnownLicenseType)
+}
+
+// paramValidator is implemented by typed parameters
+type paramValidator interface {
+	Validate() error
+}
@@ -0,0 +1,63 @@
+package etherscan
+
+import (
+	"context"
+	"testing"
+)
+
+func TestTypedParamsValidate(t *testing.T) {
+	valid := []paramValidator{
+		SortAsc, SortDesc, SortOrder(""),
+		BlockTagLatest, BlockTagPending, BlockNumberTag(18000000), BlockTag("0x0"),
+		BlockTypeBlocks, BlockTypeUncles,
+		TopicOpAnd, TopicOpOr,
+		ClosestBefore, ClosestAfter,
+		CodeFormatSoliditySingleFile, CodeFormatSolidityStandardJSON, CodeFormatVyperJSON, CodeFormatStylus,
+		LicenseType(0), LicenseNone, LicenseMIT, LicenseBSL1_1,
+	}
+	for _, v := range valid {
+		if err := v.Validate(); err != nil {
+			t.Errorf("expected %v to be valid, got %v", v, err)
+		}
+	}
+
+	invalid := []paramValidator{
+		SortOrder("dsc"),
+		BlockTag("lastest"), BlockTag("0x"), BlockTag("0xzz"), BlockTag("18000000"),
+		BlockType("uncle"),
+		TopicOperator("AND"),
+		ClosestBlock("nearest"),
+		CodeFormat("solidity-standard-json"),
+		LicenseType(-1), LicenseType(15),
+	}
+	for _, v := range invalid {
+		if err := v.Validate(); err == nil {
+			t.Errorf("expected %v to be rejected", v)
+		}
+	}
+
+	if got := BlockNumberTag(255); got != "0xff" {
+		t.Errorf("expected 0xff, got %s", got)
+	}
+	if LicenseMIT.String() != "MIT" || LicenseType(99).String() != "99" {
+		t.Errorf("unexpected license names %s and %s", LicenseMIT, LicenseType(99))
+	}
+}
+
+func TestTypedParamsRejectedBeforeRequest(t *testing.T) {
+	client := NewHTTPClient(HTTPClientConfig{})
+
+	// Every call must fail locally, without network access
+	if _, err := client.GetNormalTxs(context.Background(), TestAddresses.VitalikButerin, &GetNormalTxsOpts{Sort: "dsc"}); err == nil {
+		t.Error("expected error for invalid sort order")
+	}
+	if _, err := client.RpcEthTxCount(context.Background(), TestAddresses.VitalikButerin, "lastest", nil); err == nil {
+		t.Error("expected error for invalid block tag")
+	}
+	if _, err := client.VerifySourceCode(context.Background(), "", TestAddresses.VitalikButerin, "A", "v0.8.24", "solidity-json", nil); err == nil {
+		t.Error("expected error for invalid code format")
+	}
+	if _, err := client.VerifyStylusSourceCode(context.Background(), "", TestAddresses.VitalikButerin, "a", "stylus:0.5.3", 42, nil); err == nil {
+		t.Error("expected error for invalid license type")
+	}
+}
@@ -0,0 +1,243 @@
+package etherscan
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// ============================================================================
+// Exchange Origin
+// ============================================================================
+
+// defaultExchangeLabels are matched against funder labels and name tags when the opts leave them empty
+var defaultExchangeLabels = []string{"exchange", "binance", "coinbase", "kraken", "okx", "bitfinex", "kucoin", "bybit", "huobi", "gemini"}
+
+const (
+	// labelMatchConfidence is the confidence of an exchange matched by a label or label slug
+	labelMatchConfidence = 0.9
+
+	// nametagMatchConfidence is the confidence of an exchange matched by its name tag only
+	nametagMatchConfidence = 0.7
+)
+
+// FundingStop is the reason DetectExchangeOrigin stopped following the funding chain
+type FundingStop string
+
+const (
+	// FundingStopExchange means a funder matched the exchange labels
+	FundingStopExchange FundingStop = "exchange"
+
+	// FundingStopUnfunded means the last address has no known funder
+	FundingStopUnfunded FundingStop = "unfunded"
+
+	// FundingStopCycle means a funder was already on the path
+	FundingStopCycle FundingStop = "cycle"
+
+	// FundingStopMaxHops means MaxHops funders were followed without a match
+	FundingStopMaxHops FundingStop = "max-hops"
+)
+
+// FundingHop is one step of a funding chain: Address was first funded by Funder
+type FundingHop struct {
+	Address     string `json:"address" bson:"address"`
+	Funder      string `json:"funder" bson:"funder"`
+	FundingTxn  string `json:"fundingTxn" bson:"fundingTxn"`
+	BlockNumber int64  `json:"blockNumber" bson:"blockNumber"`
+	TimeStamp   string `json:"timeStamp" bson:"timeStamp"`
+	Value       string `json:"value" bson:"value"`
+
+	// Nametag and Labels describe Funder, from its address tag
+	Nametag string   `json:"nametag" bson:"nametag"`
+	Labels  []string `json:"labels" bson:"labels"`
+}
+
+// ExchangeOrigin is the nearest exchange up the funding chain of an address
+type ExchangeOrigin struct {
+	Address string `json:"address" bson:"address"`
+
+	// Found reports whether an exchange was reached; Exchange, Nametag and Hops are empty otherwise
+	Found bool `json:"found" bson:"found"`
+
+	// Exchange is the exchange address that funded the chain
+	Exchange string `json:"exchange" bson:"exchange"`
+	Nametag  string `json:"nametag" bson:"nametag"`
+
+	// Hops is the number of funding steps from Address to Exchange, 1 if the exchange funded it directly
+	Hops int `json:"hops" bson:"hops"`
+
+	// Confidence is a heuristic in [0, 1]: label matches score higher than
+	// name tag matches, and each hop past the first multiplies by HopDecay
+	Confidence float64 `json:"confidence" bson:"confidence"`
+
+	// Path lists the hops followed, from Address up to the last funder looked up
+	Path []FundingHop `json:"path" bson:"path"`
+
+	Stop FundingStop `json:"stop" bson:"stop"`
+}
+
+// DetectExchangeOriginOpts contains optional parameters for DetectExchangeOrigin
+type DetectExchangeOriginOpts struct {
+	// Labels labels funders locally; with SkipTagLookup it is the only label source
+	// Default: nil
+	Labels *LabelDB
+
+	// SkipTagLookup labels funders from Labels only, without the GetAddressTag calls
+	// Default: false
+	SkipTagLookup bool
+
+	// MaxHops is the most funders followed
+	// Default: 5
+	MaxHops int `default:"5"`
+
+	// HopDecay multiplies the confidence for each hop past the first
+	// Default: 0.8
+	HopDecay float64 `default:"0.8"`
+
+	// ExchangeLabels are matched, case-insensitively, as substrings of funder labels and name tags
+	// Default: "exchange" and the names of the largest exchanges
+	ExchangeLabels []string
+
+	// ChainID specifies which blockchain network to query
+	// Default: empty (uses client default)
+	ChainID int64
+
+	// OnLimitExceeded specifies behavior when rate limit is exceeded
+	// Default: RateLimitBlock (wait until a token is available)
+	OnLimitExceeded RateLimitBehavior `default:""`
+}
+
+// DetectExchangeOrigin follows the funding chain of an address up to the nearest exchange
+//
+// Starting from address, each step asks GetAddressFundedBy for the funder of
+// the current address and labels the funder with GetAddressTagWithFallback,
+// falling back to Labels. The walk stops at the first funder whose labels,
+// label slugs or name tag match ExchangeLabels, at an address without a
+// funder, at a cycle, or after MaxHops funders. This answers the usual
+// compliance question of which exchange account an address traces back to.
+//
+// Args:
+//   - ctx: Context for request cancellation and timeout
+//   - address: The address to trace
+//   - opts: Optional parameters (can be nil)
+//
+// Returns:
+//   - *ExchangeOrigin: The nearest exchange and the path to it, or the path walked and why it stopped
+//   - error: Error if a request fails
+//
+// Example:
+//
+//	origin, err := client.DetectExchangeOrigin(ctx, wallet, nil)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	if origin.Found {
+//	    fmt.Printf("funded from %s (%s), %d hops, confidence %.2f\n", origin.Exchange, origin.Nametag, origin.Hops, origin.Confidence)
+//	}
+//
+// Note:
+//   - GetAddressTag is a PRO endpoint; set SkipTagLookup to label from Labels only
+//   - Costs two calls per hop, or one with SkipTagLookup
+func (c *HTTPClient) DetectExchangeOrigin(ctx context.Context, address string, opts *DetectExchangeOriginOpts) (*ExchangeOrigin, error) {
+	if opts == nil {
+		opts = &DetectExchangeOriginOpts{}
+	}
+	if err := ApplyDefaults(opts); err != nil {
+		return nil, err
+	}
+	if opts.MaxHops <= 0 {
+		return nil, fmt.Errorf("etherscan: MaxHops must be positive, got %d", opts.MaxHops)
+	}
+	exchangeLabels := opts.ExchangeLabels
+	if len(exchangeLabels) == 0 {
+		exchangeLabels = defaultExchangeLabels
+	}
+
+	origin := &ExchangeOrigin{Address: address, Stop: FundingStopMaxHops}
+	visited := map[string]struct{}{strings.ToLower(address): {}}
+	current := address
+	for hop := 1; hop <= opts.MaxHops; hop++ {
+		funding, err := c.GetAddressFundedBy(ctx, current, &GetAddressFundedByOpts{
+			ChainID:         opts.ChainID,
+			OnLimitExceeded: opts.OnLimitExceeded,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if funding.FundingAddress == "" {
+			origin.Stop = FundingStopUnfunded
+			return origin, nil
+		}
+		funder := funding.FundingAddress
+		if _, ok := visited[strings.ToLower(funder)]; ok {
+			origin.Stop = FundingStopCycle
+			return origin, nil
+		}
+		visited[strings.ToLower(funder)] = struct{}{}
+
+		tag, err := c.funderTag(ctx, funder, opts)
+		if err != nil {
+			return nil, err
+		}
+		labels := unionStrings(unionStrings(nil, tag.Labels), tag.LabelsSlug)
+		origin.Path = append(origin.Path, FundingHop{
+			Address:     current,
+			Funder:      funder,
+			FundingTxn:  funding.FundingTxn,
+			BlockNumber: funding.Block,
+			TimeStamp:   funding.TimeStamp,
+			Value:       funding.Value,
+			Nametag:     tag.Nametag,
+			Labels:      labels,
+		})
+
+		confidence := 0.0
+		switch {
+		case matchesLabel(labels, exchangeLabels):
+			confidence = labelMatchConfidence
+		case matchesLabel([]string{tag.Nametag}, exchangeLabels):
+			confidence = nametagMatchConfidence
+		}
+		if confidence > 0 {
+			for i := 1; i < hop; i++ {
+				confidence *= opts.HopDecay
+			}
+			origin.Found = true
+			origin.Exchange = funder
+			origin.Nametag = tag.Nametag
+			origin.Hops = hop
+			origin.Confidence = confidence
+			origin.Stop = FundingStopExchange
+			return origin, nil
+		}
+		current = funder
+	}
+	return origin, nil
+}
+
+// funderTag returns the address tag of funder, empty if it has none
+func (c *HTTPClient) funderTag(ctx context.Context, funder string, opts *DetectExchangeOriginOpts) (RespAddressTag, error) {
+	var tags []RespAddressTag
+	switch {
+	case opts.SkipTagLookup:
+		if opts.Labels != nil {
+			tags = opts.Labels.Match([]string{funder})
+		}
+	default:
+		var err error
+		tags, err = c.GetAddressTagWithFallback(ctx, []string{funder}, opts.Labels, &GetAddressTagOpts{
+			ChainID:         opts.ChainID,
+			OnLimitExceeded: opts.OnLimitExceeded,
+		})
+		if err != nil {
+			return RespAddressTag{}, err
+		}
+	}
+	var tag RespAddressTag
+	for _, found := range tags {
+		if strings.EqualFold(found.Address, funder) {
+			mergeAddressTag(&tag, found)
+		}
+	}
+	return tag, nil
+}
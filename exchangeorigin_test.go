@@ -0,0 +1,81 @@
+package etherscan
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestDetectExchangeOrigin(t *testing.T) {
+	wallet := strings.ToLower(TestAddresses.VitalikButerin)
+	relay := "0x" + strings.Repeat("aa", 20)
+	exchange := "0x" + strings.Repeat("bb", 20)
+
+	// wallet <- relay <- exchange, and the exchange was funded by the wallet
+	funders := map[string]string{wallet: relay, relay: exchange, exchange: wallet}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		switch q.Get("action") {
+		case "fundedby":
+			fmt.Fprintf(w, `{"status":"1","message":"OK","result":{"block":100,"fundingAddress":"%s","fundingTxn":"0xfund","value":"5"}}`, funders[q.Get("address")])
+		case "getaddresstag":
+			if q.Get("address") == exchange {
+				fmt.Fprintf(w, `{"status":"1","message":"OK","result":[{"address":"%s","nametag":"Binance 14","labels":["Exchange"]}]}`, exchange)
+				return
+			}
+			w.Write([]byte(`{"status":"1","message":"OK","result":[]}`))
+		default:
+			t.Errorf("unexpected action %q", q.Get("action"))
+		}
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+		MaxRetries: -1,
+	})
+	ctx := context.Background()
+
+	origin, err := client.DetectExchangeOrigin(ctx, wallet, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !origin.Found || origin.Exchange != exchange || origin.Nametag != "Binance 14" || origin.Hops != 2 || origin.Stop != FundingStopExchange {
+		t.Fatalf("unexpected origin: %+v", origin)
+	}
+	if math.Abs(origin.Confidence-labelMatchConfidence*0.8) > 1e-9 {
+		t.Errorf("expected one hop of decay, got %v", origin.Confidence)
+	}
+	if len(origin.Path) != 2 || origin.Path[0].Funder != relay || origin.Path[1].Address != relay || origin.Path[1].Labels[0] != "Exchange" {
+		t.Errorf("unexpected path: %+v", origin.Path)
+	}
+
+	// A local name tag matches directly, without tag lookups
+	db := NewLabelDB()
+	db.Add(RespAddressTag{Address: relay, Nametag: "Kraken 3"})
+	origin, err = client.DetectExchangeOrigin(ctx, wallet, &DetectExchangeOriginOpts{Labels: db, SkipTagLookup: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if origin.Exchange != relay || origin.Hops != 1 || origin.Confidence != nametagMatchConfidence {
+		t.Errorf("unexpected local origin: %+v", origin)
+	}
+
+	// Without labels the walk ends where the chain loops back
+	origin, err = client.DetectExchangeOrigin(ctx, wallet, &DetectExchangeOriginOpts{SkipTagLookup: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if origin.Found || origin.Stop != FundingStopCycle || len(origin.Path) != 2 {
+		t.Errorf("expected a cycle, got %+v", origin)
+	}
+	origin, err = client.DetectExchangeOrigin(ctx, wallet, &DetectExchangeOriginOpts{SkipTagLookup: true, MaxHops: 1})
+	if err != nil || origin.Stop != FundingStopMaxHops || len(origin.Path) != 1 {
+		t.Errorf("expected the hop limit, got %+v, %v", origin, err)
+	}
+}
@@ -0,0 +1,157 @@
+package etherscan
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"os"
+	"strings"
+)
+
+// ============================================================================
+// Export Files
+// ============================================================================
+
+// gzipSuffix marks export file names written and read gzip-compressed
+const gzipSuffix = ".gz"
+
+// exportBufferSize is the write buffer of an ExportFile
+const exportBufferSize = 64 << 10
+
+// ExportFile is an output file for CSV or JSON Lines exports
+//
+// A name ending in .gz (e.g. "history.csv.gz" or "logs.jsonl.gz") is
+// gzip-compressed as it is written, so multi-million-row exports never
+// exist uncompressed on disk or in memory. Close must be called to flush the
+// buffer and the gzip trailer; a compressed file is truncated without it.
+//
+// An ExportFile is not safe for concurrent use.
+type ExportFile struct {
+	file *os.File
+	gz   *gzip.Writer
+	buf  *bufio.Writer
+	enc  *json.Encoder
+}
+
+// CreateExportFile creates or truncates an export file, gzip-compressed if path ends in .gz
+//
+// Args:
+//   - path: The file to write
+//
+// Returns:
+//   - *ExportFile: The file, to be closed by the caller
+//   - error: Error if the file cannot be created
+//
+// Example:
+//
+//	// Stream an address history to a compressed JSON Lines file
+//	out, err := etherscan.CreateExportFile("transfers.jsonl.gz")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	err = client.BackfillEvents(ctx, token, []string{etherscan.TopicTransfer}, 18000000, 0,
+//	    func(logs []etherscan.RespEventLogByAddressFilteredByTopics, next etherscan.Checkpoint) error {
+//	        for _, log := range logs {
+//	            if err := out.WriteJSONL(log); err != nil {
+//	                return err
+//	            }
+//	        }
+//	        return nil
+//	    }, nil, nil)
+//	if closeErr := out.Close(); err == nil {
+//	    err = closeErr
+//	}
+//
+//	// Or as CSV
+//	out, _ = etherscan.CreateExportFile("staking-2024.csv.gz")
+//	report.WriteCSV(out)
+//	out.Close()
+func CreateExportFile(path string) (*ExportFile, error) {
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	f := &ExportFile{file: file}
+	var w io.Writer = file
+	if strings.HasSuffix(path, gzipSuffix) {
+		f.gz = gzip.NewWriter(file)
+		w = f.gz
+	}
+	f.buf = bufio.NewWriterSize(w, exportBufferSize)
+	return f, nil
+}
+
+// Name returns the path the file was created with
+func (f *ExportFile) Name() string {
+	return f.file.Name()
+}
+
+// Write implements io.Writer, compressing p if the file is gzipped
+func (f *ExportFile) Write(p []byte) (int, error) {
+	return f.buf.Write(p)
+}
+
+// WriteJSONL writes v as one JSON Lines record: its compact JSON and a newline
+func (f *ExportFile) WriteJSONL(v any) error {
+	if f.enc == nil {
+		f.enc = json.NewEncoder(f.buf)
+		f.enc.SetEscapeHTML(false)
+	}
+	return f.enc.Encode(v)
+}
+
+// Close flushes the buffered data and the gzip trailer, then closes the file
+func (f *ExportFile) Close() error {
+	err := f.buf.Flush()
+	if f.gz != nil {
+		if gzErr := f.gz.Close(); err == nil {
+			err = gzErr
+		}
+	}
+	if closeErr := f.file.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// OpenExportFile opens an export file for reading, decompressing it if path ends in .gz
+//
+// Example:
+//
+//	in, err := etherscan.OpenExportFile("labels.csv.gz")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	defer in.Close()
+//	n, err := db.LoadCSV(in, "exchange")
+func OpenExportFile(path string) (io.ReadCloser, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasSuffix(path, gzipSuffix) {
+		return file, nil
+	}
+	gz, err := gzip.NewReader(file)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	return &gzipFile{Reader: gz, file: file}, nil
+}
+
+// gzipFile closes the gzip reader of an export file together with the file
+type gzipFile struct {
+	*gzip.Reader
+	file *os.File
+}
+
+// Close implements io.Closer
+func (g *gzipFile) Close() error {
+	err := g.Reader.Close()
+	if closeErr := g.file.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
@@ -0,0 +1,71 @@
+package etherscan
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestExportFile(t *testing.T) {
+	records := []map[string]string{{"hash": "0x1", "note": "<a&b>"}, {"hash": "0x2"}}
+	for _, name := range []string{"logs.jsonl", "logs.jsonl.gz"} {
+		path := filepath.Join(t.TempDir(), name)
+		out, err := CreateExportFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, r := range records {
+			if err := out.WriteJSONL(r); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := out.Close(); err != nil {
+			t.Fatal(err)
+		}
+
+		raw, _ := os.ReadFile(path)
+		if gzipped := bytes.HasPrefix(raw, []byte{0x1f, 0x8b}); gzipped != (filepath.Ext(name) == ".gz") {
+			t.Errorf("%s: expected gzip only for .gz names, gzipped %v", name, gzipped)
+		}
+
+		in, err := OpenExportFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := io.ReadAll(in)
+		in.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := "{\"hash\":\"0x1\",\"note\":\"<a&b>\"}\n{\"hash\":\"0x2\"}\n"; string(data) != want {
+			t.Errorf("%s: expected JSON Lines %q, got %q", name, want, data)
+		}
+	}
+}
+
+func TestExportFileCSV(t *testing.T) {
+	report := &StakingIncomeReport{Year: 2024}
+	path := filepath.Join(t.TempDir(), "staking.csv.gz")
+	out, err := CreateExportFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := report.WriteCSV(out); err != nil {
+		t.Fatal(err)
+	}
+	if err := out.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	in, err := OpenExportFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer in.Close()
+	data, _ := io.ReadAll(in)
+	if !bytes.HasPrefix(data, []byte("date,time,block,")) || !bytes.Contains(data, []byte("total,2024,")) {
+		t.Errorf("unexpected CSV: %q", data)
+	}
+}
@@ -0,0 +1,161 @@
+package etherscan
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// ============================================================================
+// Failed Transaction Postmortem
+// ============================================================================
+
+// TxFailure is the consolidated diagnosis of a failed transaction
+type TxFailure struct {
+	// Tx is the hydrated transaction the diagnosis is based on
+	Tx *TxFull
+
+	// Failed reports whether the transaction was mined and reverted; the other fields are only set then
+	Failed bool
+
+	// Reason is the best available explanation, from the decoded revert, gas exhaustion or ErrDescription
+	Reason string
+
+	// ErrDescription is Etherscan's getstatus description, e.g. "Reverted" or "Out of gas"
+	ErrDescription string
+
+	// Revert is the decoded revert of the re-simulation at the parent block, nil if it did not revert
+	Revert *TxRevert
+
+	// GasLimit and GasUsed are the gas of the transaction and of its receipt
+	GasLimit uint64
+	GasUsed  uint64
+
+	// OutOfGas reports whether the transaction used all of its gas
+	OutOfGas bool
+
+	// FailedInternalTxs are the internal calls flagged as errors, innermost failures included
+	FailedInternalTxs []RespInternalTxByHash
+}
+
+// ExplainFailureOpts contains optional parameters for ExplainFailure
+type ExplainFailureOpts struct {
+	// ChainID specifies which blockchain network to query
+	// Default: empty (uses client default)
+	ChainID int64
+
+	// OnLimitExceeded specifies behavior when rate limit is exceeded
+	// Default: RateLimitBlock (wait until a token is available)
+	OnLimitExceeded RateLimitBehavior `default:""`
+}
+
+// ExplainFailure diagnoses why a transaction failed
+//
+// The transaction is hydrated with GetTxFull (receipt, getstatus error
+// description and internal transactions), then re-simulated with eth_call at
+// the parent block using the original sender, value, input and gas limit so
+// the revert data can be decoded with DecodeRevertData. Gas exhaustion is
+// detected by comparing the receipt's gas used with the gas limit.
+//
+// Args:
+//   - ctx: Context for request cancellation and timeout
+//   - txHash: Transaction hash
+//   - opts: Optional parameters (can be nil)
+//
+// Returns:
+//   - *TxFailure: The diagnosis; Failed is false for successful or pending transactions
+//   - error: ErrTxNotFound if the hash is unknown, or a request error
+//
+// Example:
+//
+//	failure, err := client.ExplainFailure(ctx, txHash, nil)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	if failure.Failed {
+//	    fmt.Printf("failed: %s (gas %d/%d)\n", failure.Reason, failure.GasUsed, failure.GasLimit)
+//	}
+//
+// Note:
+//   - Costs 5 API calls for a failed transaction, 4 otherwise
+//   - The simulation runs on the state at the end of the parent block, without
+//     the transactions before it in the same block; if those caused the failure,
+//     the simulation may succeed and Reason falls back to gas or ErrDescription
+//   - Decoding revert data needs an archive node behind the proxy endpoint for old blocks
+func (c *HTTPClient) ExplainFailure(ctx context.Context, txHash string, opts *ExplainFailureOpts) (*TxFailure, error) {
+	if opts == nil {
+		opts = &ExplainFailureOpts{}
+	}
+	if err := ApplyDefaults(opts); err != nil {
+		return nil, err
+	}
+
+	full, err := c.GetTxFull(ctx, txHash, &GetTxFullOpts{
+		ChainID:         opts.ChainID,
+		OnLimitExceeded: opts.OnLimitExceeded,
+	})
+	if err != nil {
+		return nil, err
+	}
+	failure := &TxFailure{Tx: full}
+	if full.Pending || full.Success {
+		return failure, nil
+	}
+
+	failure.Failed = true
+	failure.ErrDescription = full.ErrDescription
+	for _, itx := range full.InternalTxs {
+		if itx.IsError == "1" {
+			failure.FailedInternalTxs = append(failure.FailedInternalTxs, itx)
+		}
+	}
+	if failure.GasLimit, err = parseHexUint64(full.Tx.Gas); err != nil {
+		return nil, err
+	}
+	if failure.GasUsed, err = parseHexUint64(full.Receipt.GasUsed); err != nil {
+		return nil, err
+	}
+	failure.OutOfGas = failure.GasLimit > 0 && failure.GasUsed >= failure.GasLimit
+
+	block, err := parseHexUint64(full.Receipt.BlockNumber)
+	if err != nil {
+		return nil, err
+	}
+	tag := BlockTagEarliest
+	if block > 0 {
+		tag = BlockNumberTag(int64(block) - 1)
+	}
+	params := map[string]string{
+		"from":    full.Tx.From,
+		"data":    full.Tx.Input,
+		"value":   full.Tx.Value,
+		"gas":     full.Tx.Gas,
+		"tag":     string(tag),
+		"chainid": strconv.FormatInt(opts.ChainID, 10),
+	}
+	// Contract creations have no recipient; eth_call then runs the init code
+	if full.Tx.To != "" {
+		params["to"] = full.Tx.To
+	}
+	resp, err := c.rpcProxyCall(ctx, "eth_call", params, opts.OnLimitExceeded)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Error != nil {
+		failure.Revert = revertFromRpcError(resp.Error)
+	}
+
+	switch {
+	case failure.Revert != nil && failure.Revert.Reason != "":
+		failure.Reason = failure.Revert.Reason
+	case failure.OutOfGas:
+		failure.Reason = fmt.Sprintf("out of gas: used all %d gas", failure.GasLimit)
+	case failure.ErrDescription != "":
+		failure.Reason = failure.ErrDescription
+	case failure.Revert != nil:
+		failure.Reason = failure.Revert.Error()
+	default:
+		failure.Reason = "execution reverted"
+	}
+	return failure, nil
+}
@@ -0,0 +1,87 @@
+package etherscan
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestExplainFailure(t *testing.T) {
+	revertData, err := EncodeABICall(RevertSelectorError, []string{"string"}, "insufficient balance")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var simulated map[string]string
+	newClient := func(gasUsed, callResp string) *HTTPClient {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			q := r.URL.Query()
+			switch q.Get("action") {
+			case "eth_getTransactionByHash":
+				w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":{"hash":"0xabc","from":"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa","to":"0xcccccccccccccccccccccccccccccccccccccccc","gas":"0x5208","value":"0x0","input":"0xa9059cbb"}}`))
+			case "eth_getTransactionReceipt":
+				fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":{"transactionHash":"0xabc","blockNumber":"0x64","status":"0x0","gasUsed":"%s","logs":[]}}`, gasUsed)
+			case "txlistinternal":
+				w.Write([]byte(`{"status":"1","message":"OK","result":[{"isError":"0"},{"isError":"1","errCode":"Reverted"}]}`))
+			case "getstatus":
+				w.Write([]byte(`{"status":"1","message":"OK","result":{"isError":"1","errDescription":"Reverted"}}`))
+			case "eth_call":
+				simulated = map[string]string{"tag": q.Get("tag"), "from": q.Get("from"), "gas": q.Get("gas")}
+				w.Write([]byte(callResp))
+			}
+		}))
+		t.Cleanup(server.Close)
+		return NewHTTPClient(HTTPClientConfig{
+			APIVersion: APIVersionV1,
+			V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+		})
+	}
+	ctx := context.Background()
+
+	reverted := `{"jsonrpc":"2.0","id":1,"error":{"code":3,"message":"execution reverted","data":"` + revertData + `"}}`
+	failure, err := newClient("0x5000", reverted).ExplainFailure(ctx, "0xabc", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !failure.Failed || failure.Reason != "insufficient balance" || failure.OutOfGas || len(failure.FailedInternalTxs) != 1 {
+		t.Errorf("unexpected diagnosis: %+v", failure)
+	}
+	if simulated["tag"] != "0x63" || simulated["from"] != testAddr || simulated["gas"] != "0x5208" {
+		t.Errorf("expected the simulation at the parent block with the original sender and gas, got %v", simulated)
+	}
+
+	// Without revert data the gas exhaustion explains the failure
+	failure, err = newClient("0x5208", `{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"out of gas"}}`).ExplainFailure(ctx, "0xabc", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !failure.OutOfGas || failure.GasUsed != 21000 || failure.Reason != "out of gas: used all 21000 gas" {
+		t.Errorf("expected out of gas, got %+v", failure)
+	}
+
+	// A simulation that succeeds leaves Etherscan's description as the reason
+	failure, err = newClient("0x5000", `{"jsonrpc":"2.0","id":1,"result":"0x"}`).ExplainFailure(ctx, "0xabc", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if failure.Revert != nil || failure.Reason != "Reverted" {
+		t.Errorf("expected the getstatus description, got %+v", failure)
+	}
+}
+
+func TestExplainFailureSuccessfulTx(t *testing.T) {
+	client := newTxFullTestClient(t, map[string]string{
+		"eth_getTransactionByHash":  `{"jsonrpc":"2.0","id":1,"result":{"hash":"0xabc"}}`,
+		"eth_getTransactionReceipt": `{"jsonrpc":"2.0","id":1,"result":{"transactionHash":"0xabc","status":"0x1","logs":[]}}`,
+		"getstatus":                 `{"status":"1","message":"OK","result":{"isError":"0","errDescription":""}}`,
+	})
+	failure, err := client.ExplainFailure(context.Background(), "0xabc", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if failure.Failed || failure.Reason != "" {
+		t.Errorf("expected no failure, got %+v", failure)
+	}
+}
@@ -0,0 +1,306 @@
+// Package fixture records sanitized API responses and checks that the etherscan response types decode them losslessly
+//
+// A fixture is one recorded response of one client method on one chain. Check
+// decodes its result into the method's response type and fails if a field of
+// the response is not declared by the type or does not survive re-encoding, so
+// a schema change on Etherscan's side shows up as a failing test instead of
+// silently empty fields in production.
+//
+// Fixtures live in testdata/<chainid>/<Method>.json, or <Method>.json.gz for
+// large responses compressed with gzip. To contribute fixtures for
+// a chain, install a Recorder as the client's transport, call each method once
+// and Save the exchange:
+//
+//	rec := fixture.NewRecorder(nil)
+//	client := etherscan.NewHTTPClient(etherscan.HTTPClientConfig{
+//	    APIKey:     os.Getenv("ETHERSCAN_API_KEY"),
+//	    HTTPClient: &http.Client{Transport: rec},
+//	})
+//	if _, err := client.GetNormalTxs(ctx, addr, &etherscan.GetNormalTxsOpts{ChainID: etherscan.BaseMainnet, Offset: 2}); err != nil {
+//	    log.Fatal(err)
+//	}
+//	if _, err := rec.Save("fixture/testdata", "GetNormalTxs"); err != nil {
+//	    log.Fatal(err)
+//	}
+//
+// The API key is stripped from the recorded query; response bodies are public
+// chain data and are kept as is.
+package fixture
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Fixture is one recorded API response
+type Fixture struct {
+	// Method is the client method the response belongs to, a key of Types
+	Method string `json:"method"`
+
+	ChainID int64  `json:"chainId"`
+	Module  string `json:"module"`
+	Action  string `json:"action"`
+
+	// Query holds the request parameters without the API key
+	Query map[string]string `json:"query"`
+
+	// Response is the raw response body
+	Response json.RawMessage `json:"response"`
+}
+
+// sensitiveParams are the query parameters never written to a fixture
+var sensitiveParams = []string{"apikey"}
+
+// Recorder is an http.RoundTripper that keeps the last exchange so it can be saved as a fixture
+//
+// It is safe for concurrent use, but Save only sees the most recent exchange,
+// so record one call at a time.
+type Recorder struct {
+	transport http.RoundTripper
+
+	mu   sync.Mutex
+	last *Fixture
+}
+
+// NewRecorder returns a Recorder sending requests through transport, or http.DefaultTransport if nil
+func NewRecorder(transport http.RoundTripper) *Recorder {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	return &Recorder{transport: transport}
+}
+
+// RoundTrip implements http.RoundTripper
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	query := req.URL.Query()
+	for _, param := range sensitiveParams {
+		query.Del(param)
+	}
+	f := &Fixture{
+		Module:   query.Get("module"),
+		Action:   query.Get("action"),
+		Query:    make(map[string]string, len(query)),
+		Response: body,
+	}
+	f.ChainID, _ = strconv.ParseInt(query.Get("chainid"), 10, 64)
+	for key := range query {
+		if key != "module" && key != "action" && key != "chainid" {
+			f.Query[key] = query.Get(key)
+		}
+	}
+
+	r.mu.Lock()
+	r.last = f
+	r.mu.Unlock()
+	return resp, nil
+}
+
+// Last returns a copy of the last exchange, without a Method, or nil if nothing was recorded
+func (r *Recorder) Last() *Fixture {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.last == nil {
+		return nil
+	}
+	f := *r.last
+	return &f
+}
+
+// Save writes the last exchange as the fixture of method to dir/<chainid>/<method>.json
+//
+// The response is checked first, so a fixture that does not round-trip is
+// never written.
+//
+// Returns:
+//   - string: The path written to
+//   - error: Error if nothing was recorded, the response does not pass Check, or the write fails
+func (r *Recorder) Save(dir, method string) (string, error) {
+	f := r.Last()
+	if f == nil {
+		return "", errors.New("fixture: nothing recorded")
+	}
+
+	f.Method = method
+	if err := Check(f); err != nil {
+		return "", err
+	}
+	data, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(dir, strconv.FormatInt(f.ChainID, 10), method+".json")
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return "", err
+	}
+	return path, os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// Load reads every fixture below dir, sorted by path
+func Load(dir string) ([]*Fixture, error) {
+	var paths []string
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err == nil && !d.IsDir() && (strings.HasSuffix(path, ".json") || strings.HasSuffix(path, ".json.gz")) {
+			paths = append(paths, path)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+
+	fixtures := make([]*Fixture, 0, len(paths))
+	for _, path := range paths {
+		data, err := readFixture(path)
+		if err != nil {
+			return nil, err
+		}
+		var f Fixture
+		if err := json.Unmarshal(data, &f); err != nil {
+			return nil, fmt.Errorf("fixture: %s: %w", path, err)
+		}
+		fixtures = append(fixtures, &f)
+	}
+	return fixtures, nil
+}
+
+// readFixture reads a fixture file, decompressing it if it is gzipped
+func readFixture(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil || !strings.HasSuffix(path, ".gz") {
+		return data, err
+	}
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("fixture: %s: %w", path, err)
+	}
+	defer gz.Close()
+	if data, err = io.ReadAll(gz); err != nil {
+		return nil, fmt.Errorf("fixture: %s: %w", path, err)
+	}
+	return data, nil
+}
+
+// Check decodes the result of f into the response type of f.Method and verifies it round-trips
+//
+// Every field of the result must be declared by the type and re-encode to the
+// same value. Fields the type declares but the response lacks are fine, as
+// are JSON nulls, which decode to zero values.
+func Check(f *Fixture) error {
+	newTarget, ok := Types[f.Method]
+	if !ok {
+		return fmt.Errorf("fixture: unknown method %q", f.Method)
+	}
+
+	var envelope struct {
+		Status  string          `json:"status"`
+		Message string          `json:"message"`
+		Result  json.RawMessage `json:"result"`
+		Error   json.RawMessage `json:"error"`
+	}
+	if err := json.Unmarshal(f.Response, &envelope); err != nil {
+		return fmt.Errorf("fixture: %s: response is not JSON: %w", f.Method, err)
+	}
+	if envelope.Status == "0" || len(envelope.Error) > 0 || len(envelope.Result) == 0 {
+		return fmt.Errorf("fixture: %s: response is an error, not a result: %s", f.Method, f.Response)
+	}
+
+	target := newTarget()
+	if err := json.Unmarshal(envelope.Result, target); err != nil {
+		return fmt.Errorf("fixture: %s: decode failed: %w", f.Method, err)
+	}
+	encoded, err := json.Marshal(target)
+	if err != nil {
+		return err
+	}
+
+	original, err := decodeGeneric(envelope.Result)
+	if err != nil {
+		return err
+	}
+	roundTripped, err := decodeGeneric(encoded)
+	if err != nil {
+		return err
+	}
+	if err := compare("result", original, roundTripped); err != nil {
+		return fmt.Errorf("fixture: %s: %w", f.Method, err)
+	}
+	return nil
+}
+
+// decodeGeneric decodes data into maps, slices and json.Numbers
+func decodeGeneric(data []byte) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v any
+	err := dec.Decode(&v)
+	return v, err
+}
+
+// compare reports the first field of original that is missing from or different in roundTripped
+func compare(path string, original, roundTripped any) error {
+	switch want := original.(type) {
+	case nil:
+		return nil
+	case map[string]any:
+		got, ok := roundTripped.(map[string]any)
+		if !ok {
+			return fmt.Errorf("%s: object re-encoded as %T", path, roundTripped)
+		}
+		keys := make([]string, 0, len(want))
+		for key := range want {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			value, ok := got[key]
+			if !ok {
+				return fmt.Errorf("%s.%s: field not declared by the response type", path, key)
+			}
+			if err := compare(path+"."+key, want[key], value); err != nil {
+				return err
+			}
+		}
+		return nil
+	case []any:
+		got, ok := roundTripped.([]any)
+		if !ok || len(got) != len(want) {
+			return fmt.Errorf("%s: array of %d items re-encoded as %v", path, len(want), roundTripped)
+		}
+		for i := range want {
+			if err := compare(path+"["+strconv.Itoa(i)+"]", want[i], got[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		if original != roundTripped {
+			return fmt.Errorf("%s: %v re-encoded as %v", path, original, roundTripped)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,156 @@
+package fixture
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/dwdwow/etherscan-go"
+)
+
+func TestFixtures(t *testing.T) {
+	fixtures, err := Load("testdata")
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if len(fixtures) == 0 {
+		t.Fatal("no fixtures found in testdata")
+	}
+	for _, f := range fixtures {
+		t.Run(f.Method+"/"+f.Module+"."+f.Action, func(t *testing.T) {
+			if err := Check(f); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestTypesNameClientMethods(t *testing.T) {
+	client := reflect.TypeOf(&etherscan.HTTPClient{})
+	for method := range Types {
+		if _, ok := client.MethodByName(method); !ok {
+			t.Errorf("Types has %s, which is not a method of HTTPClient", method)
+		}
+	}
+}
+
+func TestCheckDetectsDrift(t *testing.T) {
+	cases := map[string]struct {
+		method   string
+		response string
+		want     string
+	}{
+		"undeclared field": {
+			"GetContractExecutionStatus",
+			`{"status":"1","message":"OK","result":{"isError":"0","errDescription":"","gasRefund":"12"}}`,
+			"result.gasRefund: field not declared",
+		},
+		"nested field": {
+			"GetBlockAndUncleRewards",
+			`{"status":"1","message":"OK","result":{"blockNumber":"1","uncles":[{"miner":"0xabc","depth":"1"}]}}`,
+			"result.uncles[0].depth",
+		},
+		"type change": {
+			"GetGasOracle",
+			`{"status":"1","message":"OK","result":{"LastBlock":13053741}}`,
+			"decode failed",
+		},
+		"error response": {
+			"GetEthBalance",
+			`{"status":"0","message":"NOTOK","result":"Invalid API Key"}`,
+			"response is an error",
+		},
+		"unknown method": {"GetNothing", `{"status":"1","message":"OK","result":"1"}`, "unknown method"},
+	}
+	for name, tc := range cases {
+		err := Check(&Fixture{Method: tc.method, Response: json.RawMessage(tc.response)})
+		if err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Errorf("%s: expected an error containing %q, got %v", name, tc.want, err)
+		}
+	}
+}
+
+func TestRecorder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":"1","message":"OK","result":"40891626854930000000000"}`))
+	}))
+	defer server.Close()
+
+	// Send the V2 requests, which carry the chainid, to the test server
+	target, _ := url.Parse(server.URL)
+	rec := NewRecorder(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		req.URL.Scheme, req.URL.Host = target.Scheme, target.Host
+		return http.DefaultTransport.RoundTrip(req)
+	}))
+	client := etherscan.NewHTTPClient(etherscan.HTTPClientConfig{
+		APIKey:     "SECRET",
+		HTTPClient: &http.Client{Transport: rec},
+	})
+	if _, err := rec.Save(t.TempDir(), "GetEthBalance"); err == nil || rec.Last() != nil {
+		t.Error("expected Save to fail before anything was recorded")
+	}
+	balance, err := client.GetEthBalance(context.Background(), "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", &etherscan.GetEthBalanceOpts{ChainID: etherscan.EthereumMainnet})
+	if err != nil || balance != "40891626854930000000000" {
+		t.Fatalf("GetEthBalance through the recorder: %s, %v", balance, err)
+	}
+
+	if last := rec.Last(); last == nil || last.Action != "balance" || last.Method != "" {
+		t.Errorf("unexpected last exchange: %+v", last)
+	}
+
+	dir := t.TempDir()
+	path, err := rec.Save(dir, "GetEthBalance")
+	if err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	if path != filepath.Join(dir, "1", "GetEthBalance.json") {
+		t.Errorf("unexpected fixture path %s", path)
+	}
+
+	fixtures, err := Load(dir)
+	if err != nil || len(fixtures) != 1 {
+		t.Fatalf("Load: %v, %v", fixtures, err)
+	}
+	f := fixtures[0]
+	if f.Module != "account" || f.Action != "balance" || f.ChainID != 1 || f.Query["address"] != "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa" {
+		t.Errorf("unexpected fixture: %+v", f)
+	}
+	if _, ok := f.Query["apikey"]; ok || strings.Contains(string(f.Response), "SECRET") {
+		t.Errorf("expected the API key to be stripped: %+v", f)
+	}
+}
+
+func TestLoadGzipped(t *testing.T) {
+	dir := t.TempDir()
+	out, err := etherscan.CreateExportFile(filepath.Join(dir, "GetEthBalance.json.gz"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := out.WriteJSONL(&Fixture{Method: "GetEthBalance", ChainID: 1, Module: "account", Action: "balance", Response: json.RawMessage(`{"status":"1","message":"OK","result":"1"}`)}); err != nil {
+		t.Fatal(err)
+	}
+	if err := out.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	fixtures, err := Load(dir)
+	if err != nil || len(fixtures) != 1 {
+		t.Fatalf("Load: %v, %v", fixtures, err)
+	}
+	if err := Check(fixtures[0]); err != nil {
+		t.Errorf("expected the gzipped fixture to pass: %v", err)
+	}
+}
+
+// roundTripFunc adapts a function to http.RoundTripper
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
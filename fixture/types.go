@@ -0,0 +1,100 @@
+package fixture
+
+import "github.com/dwdwow/etherscan-go"
+
+// Types maps client method names to constructors of their response types
+//
+// Fixtures name the method they were recorded from; Check decodes the result
+// into a fresh value from this table. Methods sharing an action, such as
+// GetInternalTxsByAddress and GetInternalTxsByHash, have their own entries.
+// Scalar results (balances, counts, hex quantities) decode into strings.
+var Types = map[string]func() any{
+	"CheckCreditUsage":                      func() any { return new(etherscan.RespCreditUsage) },
+	"CheckSourceCodeVerificationStatus":     func() any { return new(string) },
+	"GetAccountERC20Holdings":               func() any { return new([]etherscan.RespERC20Holding) },
+	"GetAccountNFTHoldings":                 func() any { return new([]etherscan.RespNFTHolding) },
+	"GetAccountNFTInventories":              func() any { return new([]etherscan.RespNFTTokenInventory) },
+	"GetAddressFundedBy":                    func() any { return new(etherscan.RespAddressFundedBy) },
+	"GetAddressTag":                         func() any { return new([]etherscan.RespAddressTag) },
+	"GetBeaconChainWithdrawals":             func() any { return new([]etherscan.RespBeaconChainWithdrawal) },
+	"GetBlockAndUncleRewards":               func() any { return new(etherscan.RespBlockReward) },
+	"GetBlockCountdownTime":                 func() any { return new(etherscan.RespEstimateBlockCountdownTimeByBlockNo) },
+	"GetBlockNumberByTimestamp":             func() any { return new(string) },
+	"GetBlockTxsCount":                      func() any { return new(etherscan.RespBlockTxsCountByBlockNo) },
+	"GetBlocksValidatedByAddress":           func() any { return new([]etherscan.RespBlockValidated) },
+	"GetBridgeTxs":                          func() any { return new([]etherscan.RespBridgeTx) },
+	"GetConfirmationTimeEstimate":           func() any { return new(string) },
+	"GetContractABI":                        func() any { return new(string) },
+	"GetContractCreatorAndCreation":         func() any { return new([]etherscan.RespContractCreationAndCreation) },
+	"GetContractExecutionStatus":            func() any { return new(etherscan.RespContractExecutionStatus) },
+	"GetContractSourceCode":                 func() any { return new([]etherscan.RespContractSourceCode) },
+	"GetDailyAverageGasLimit":               func() any { return new([]etherscan.RespDailyAvgGasLimit) },
+	"GetDailyAverageGasPrice":               func() any { return new([]etherscan.RespDailyAvgGasPrice) },
+	"GetDailyAvgBlockSizes":                 func() any { return new([]etherscan.RespDailyAvgBlockSize) },
+	"GetDailyAvgBlockTime":                  func() any { return new([]etherscan.RespDailyAvgTimeBlockMined) },
+	"GetDailyAvgDifficulties":               func() any { return new([]etherscan.RespDailyAvgDifficulty) },
+	"GetDailyAvgHashrates":                  func() any { return new([]etherscan.RespDailyAvgHashrate) },
+	"GetDailyBlockCountRewards":             func() any { return new([]etherscan.RespDailyBlockCountReward) },
+	"GetDailyBlockRewards":                  func() any { return new([]etherscan.RespDailyBlockReward) },
+	"GetDailyNetworkUtilizations":           func() any { return new([]etherscan.RespDailyNetworkUtilization) },
+	"GetDailyNewAddresses":                  func() any { return new([]etherscan.RespDailyNewAddress) },
+	"GetDailyTotalGasUsed":                  func() any { return new([]etherscan.RespDailyTotalGasUsed) },
+	"GetDailyTxCounts":                      func() any { return new([]etherscan.RespDailyTxCount) },
+	"GetDailyTxFees":                        func() any { return new([]etherscan.RespDailyTxFee) },
+	"GetDailyUncleBlockCountAndRewards":     func() any { return new([]etherscan.RespDailyUncleBlockCountAndReward) },
+	"GetDepositTxs":                         func() any { return new([]etherscan.RespDepositTx) },
+	"GetERC1155TokenTransfers":              func() any { return new([]etherscan.RespERC1155TokenTransfer) },
+	"GetERC20AccountBalance":                func() any { return new(string) },
+	"GetERC20HistoricalAccountBalance":      func() any { return new(string) },
+	"GetERC20HistoricalTotalSupply":         func() any { return new(string) },
+	"GetERC20HolderCount":                   func() any { return new(string) },
+	"GetERC20HolderDistribution":            func() any { return new([]etherscan.RespERC20HolderChartPoint) },
+	"GetERC20Holders":                       func() any { return new([]etherscan.RespERC20HolderInfo) },
+	"GetERC20TokenTransfers":                func() any { return new([]etherscan.RespERC20TokenTransfer) },
+	"GetERC20TotalSupply":                   func() any { return new(string) },
+	"GetERC721TokenTransfers":               func() any { return new([]etherscan.RespERC721TokenTransfer) },
+	"GetEthBalance":                         func() any { return new(string) },
+	"GetEthBalanceByBlockNumber":            func() any { return new(string) },
+	"GetEthBalances":                        func() any { return new([]etherscan.RespEthBalanceEntry) },
+	"GetEthDailyMarketCaps":                 func() any { return new([]etherscan.RespEthDailyMarketCap) },
+	"GetEthHistoricalPrices":                func() any { return new([]etherscan.RespEthHistoricalPrice) },
+	"GetEthPrice":                           func() any { return new(etherscan.RespEthPrice) },
+	"GetEthereumNodesSize":                  func() any { return new([]etherscan.RespEtheumNodeSize) },
+	"GetEventLogsByAddress":                 func() any { return new([]etherscan.RespEventLogByAddress) },
+	"GetEventLogsByAddressFilteredByTopics": func() any { return new([]etherscan.RespEventLogByAddressFilteredByTopics) },
+	"GetEventLogsByTopics":                  func() any { return new([]etherscan.RespEventLogByTopics) },
+	"GetGasOracle":                          func() any { return new(etherscan.RespGasOracle) },
+	"GetInternalTxsByAddress":               func() any { return new([]etherscan.RespInternalTxByAddress) },
+	"GetInternalTxsByBlockRange":            func() any { return new([]etherscan.RespInternalTxByBlockRange) },
+	"GetInternalTxsByHash":                  func() any { return new([]etherscan.RespInternalTxByHash) },
+	"GetLabelMasterlist":                    func() any { return new([]etherscan.RespLabelMaster) },
+	"GetLatestCSVBatchNumber":               func() any { return new([]etherscan.RespLatestCSVBatchNumber) },
+	"GetNodeCount":                          func() any { return new(etherscan.RespNodeCount) },
+	"GetNormalTxs":                          func() any { return new([]etherscan.RespNormalTx) },
+	"GetPlasmaDeposits":                     func() any { return new([]etherscan.RespPlasmaDeposit) },
+	"GetTokenInfo":                          func() any { return new(etherscan.RespTokenInfo) },
+	"GetTopERC20Holders":                    func() any { return new([]etherscan.RespTopTokenHolder) },
+	"GetTotalEth2Supply":                    func() any { return new(string) },
+	"GetTotalEthSupply":                     func() any { return new(string) },
+	"GetTxReceiptStatus":                    func() any { return new(etherscan.RespCheckTxReceiptStatus) },
+	"GetWithdrawalTxs":                      func() any { return new([]etherscan.RespWithdrawalTx) },
+	"RpcEthBlockByNumber":                   func() any { return new(etherscan.RespEthBlockInfo) },
+	"RpcEthBlockByNumberWithFullTxs":        func() any { return new(etherscan.RespEthBlockInfoWithFullTxs) },
+	"RpcEthBlockNumber":                     func() any { return new(string) },
+	"RpcEthBlockTxCountByNumber":            func() any { return new(string) },
+	"RpcEthCall":                            func() any { return new(string) },
+	"RpcEthEstimateGas":                     func() any { return new(string) },
+	"RpcEthGetCode":                         func() any { return new(string) },
+	"RpcEthGetGasPrice":                     func() any { return new(string) },
+	"RpcEthGetProof":                        func() any { return new(etherscan.RespEthProofInfo) },
+	"RpcEthGetStorageAt":                    func() any { return new(string) },
+	"RpcEthSendRawTx":                       func() any { return new(string) },
+	"RpcEthTxByBlockNumberAndIndex":         func() any { return new(etherscan.RespEthTxInfo) },
+	"RpcEthTxByHash":                        func() any { return new(etherscan.RespEthTxInfo) },
+	"RpcEthTxCount":                         func() any { return new(string) },
+	"RpcEthTxReceipt":                       func() any { return new(etherscan.RespEthTxReceiptInfo) },
+	"RpcEthUncleByBlockNumberAndIndex":      func() any { return new(etherscan.RespEthUncleBlockInfo) },
+	"VerifySourceCode":                      func() any { return new(string) },
+	"VerifyStylusSourceCode":                func() any { return new(string) },
+	"VerifyVyperSourceCode":                 func() any { return new(string) },
+}
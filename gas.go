@@ -24,6 +24,10 @@ type GetConfirmationTimeEstimateOpts struct {
 	//   - RateLimitRaise: Return an error when rate limit is exceeded
 	//   - RateLimitSkip: Return false without executing when rate limit is exceeded
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // GetConfirmationTimeEstimate returns the estimated time, in seconds, for a transaction to be confirmed on the blockchain
@@ -73,8 +77,10 @@ func (c *HTTPClient) GetConfirmationTimeEstimate(ctx context.Context, gasPrice i
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -84,6 +90,7 @@ func (c *HTTPClient) GetConfirmationTimeEstimate(ctx context.Context, gasPrice i
 		params:          params,
 		noFoundReturn:   "",
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return "", err
@@ -109,6 +116,10 @@ type GetGasOracleOpts struct {
 	//   - RateLimitRaise: Return an error when rate limit is exceeded
 	//   - RateLimitSkip: Return false without executing when rate limit is exceeded
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // GetGasOracle returns the current Safe, Proposed and Fast gas prices
@@ -153,8 +164,10 @@ func (c *HTTPClient) GetGasOracle(ctx context.Context, opts *GetGasOracleOpts) (
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -164,13 +177,14 @@ func (c *HTTPClient) GetGasOracle(ctx context.Context, opts *GetGasOracleOpts) (
 		params:          params,
 		noFoundReturn:   RespGasOracle{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result RespGasOracle
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
@@ -182,7 +196,7 @@ type GetDailyAverageGasLimitOpts struct {
 	// Options:
 	//   - "asc" (default): Sort by date in ascending order (oldest first)
 	//   - "desc": Sort by date in descending order (newest first)
-	Sort string `default:"asc" json:"sort"`
+	Sort SortOrder `default:"asc" json:"sort"`
 
 	// ChainID specifies which blockchain network to query
 	// Default: empty (uses client default)
@@ -196,6 +210,10 @@ type GetDailyAverageGasLimitOpts struct {
 	//   - RateLimitRaise: Return an error when rate limit is exceeded
 	//   - RateLimitSkip: Return false without executing when rate limit is exceeded
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // GetDailyAverageGasLimit returns the historical daily average gas limit of the Ethereum network
@@ -252,8 +270,10 @@ func (c *HTTPClient) GetDailyAverageGasLimit(ctx context.Context, startDate, end
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -263,13 +283,14 @@ func (c *HTTPClient) GetDailyAverageGasLimit(ctx context.Context, startDate, end
 		params:          params,
 		noFoundReturn:   []RespDailyAvgGasLimit{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result []RespDailyAvgGasLimit
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
 	return result, nil
@@ -281,7 +302,7 @@ type GetDailyTotalGasUsedOpts struct {
 	// Options:
 	//   - "asc" (default): Sort by date in ascending order (oldest first)
 	//   - "desc": Sort by date in descending order (newest first)
-	Sort string `default:"asc" json:"sort"`
+	Sort SortOrder `default:"asc" json:"sort"`
 
 	// ChainID specifies which blockchain network to query
 	// Default: empty (uses client default)
@@ -295,6 +316,10 @@ type GetDailyTotalGasUsedOpts struct {
 	//   - RateLimitRaise: Return an error when rate limit is exceeded
 	//   - RateLimitSkip: Return false without executing when rate limit is exceeded
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // GetDailyTotalGasUsed returns the total amount of gas used daily for transactions on the Ethereum network
@@ -351,8 +376,10 @@ func (c *HTTPClient) GetDailyTotalGasUsed(ctx context.Context, startDate, endDat
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -362,13 +389,14 @@ func (c *HTTPClient) GetDailyTotalGasUsed(ctx context.Context, startDate, endDat
 		params:          params,
 		noFoundReturn:   []RespDailyTotalGasUsed{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result []RespDailyTotalGasUsed
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
 	return result, nil
@@ -380,7 +408,7 @@ type GetDailyAverageGasPriceOpts struct {
 	// Options:
 	//   - "asc" (default): Sort by date in ascending order (oldest first)
 	//   - "desc": Sort by date in descending order (newest first)
-	Sort string `default:"asc" json:"sort"`
+	Sort SortOrder `default:"asc" json:"sort"`
 
 	// ChainID specifies which blockchain network to query
 	// Default: empty (uses client default)
@@ -394,6 +422,10 @@ type GetDailyAverageGasPriceOpts struct {
 	//   - RateLimitRaise: Return an error when rate limit is exceeded
 	//   - RateLimitSkip: Return false without executing when rate limit is exceeded
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // GetDailyAverageGasPrice returns the daily average gas price used on the Ethereum network
@@ -451,8 +483,10 @@ func (c *HTTPClient) GetDailyAverageGasPrice(ctx context.Context, startDate, end
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -462,13 +496,14 @@ func (c *HTTPClient) GetDailyAverageGasPrice(ctx context.Context, startDate, end
 		params:          params,
 		noFoundReturn:   []RespDailyAvgGasPrice{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result []RespDailyAvgGasPrice
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
 	return result, nil
@@ -0,0 +1,298 @@
+package etherscan
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ============================================================================
+// Gas Price Histogram
+// ============================================================================
+
+// GasPriceBucket is one bucket of a GasPriceReport histogram
+type GasPriceBucket struct {
+	// MinWei and MaxWei are the inclusive gas price bounds of the bucket in wei
+	MinWei *big.Int `json:"minWei" bson:"minWei"`
+	MaxWei *big.Int `json:"maxWei" bson:"maxWei"`
+
+	// Count is the number of transactions in the bucket
+	Count int `json:"count" bson:"count"`
+
+	// GasUsed is the total gas used by the transactions in the bucket
+	GasUsed *big.Int `json:"gasUsed" bson:"gasUsed"`
+}
+
+// GasPriceReport is the distribution of gas prices paid by an address and how they compare to the daily average
+type GasPriceReport struct {
+	Address    string `json:"address" bson:"address"`
+	StartBlock int64  `json:"startBlock" bson:"startBlock"`
+	EndBlock   int64  `json:"endBlock" bson:"endBlock"`
+
+	// TxCount is the number of transactions sent (and paid for) by the address
+	TxCount int `json:"txCount" bson:"txCount"`
+
+	// Buckets are equal-width gas price buckets from MinGasPriceWei to MaxGasPriceWei
+	Buckets []GasPriceBucket `json:"buckets" bson:"buckets"`
+
+	// MinGasPriceWei, MedianGasPriceWei and MaxGasPriceWei are nil if TxCount is 0
+	MinGasPriceWei    *big.Int `json:"minGasPriceWei" bson:"minGasPriceWei"`
+	MedianGasPriceWei *big.Int `json:"medianGasPriceWei" bson:"medianGasPriceWei"`
+	MaxGasPriceWei    *big.Int `json:"maxGasPriceWei" bson:"maxGasPriceWei"`
+
+	// OverpaidTxCount is the number of transactions that paid more than their day's average gas price
+	OverpaidTxCount int `json:"overpaidTxCount" bson:"overpaidTxCount"`
+
+	// OverpaymentPercent is the gas-weighted excess over the daily average,
+	// sum(gasUsed * (price - avg)) / sum(gasUsed * avg) * 100; negative if the address paid less
+	OverpaymentPercent float64 `json:"overpaymentPercent" bson:"overpaymentPercent"`
+
+	// ExcessFeeWei is the fee paid above the daily average, sum(gasUsed * max(price - avg, 0))
+	ExcessFeeWei *big.Int `json:"excessFeeWei" bson:"excessFeeWei"`
+
+	// UncomparedTxCount is the number of transactions on days without average gas price data,
+	// which are excluded from the comparison
+	UncomparedTxCount int `json:"uncomparedTxCount" bson:"uncomparedTxCount"`
+}
+
+// GasPriceHistogramOpts contains optional parameters for GasPriceHistogram
+type GasPriceHistogramOpts struct {
+	// Buckets is the maximum number of histogram buckets; narrow price ranges get fewer
+	// Default: 10
+	Buckets int `default:"10"`
+
+	// ChainID specifies which blockchain network to query
+	// Default: empty (uses client default)
+	ChainID int64
+
+	// OnLimitExceeded specifies behavior when rate limit is exceeded
+	// Default: RateLimitBlock (wait until a token is available)
+	OnLimitExceeded RateLimitBehavior `default:""`
+}
+
+// gasPayment is the gas price and gas used of one transaction
+type gasPayment struct {
+	day     string
+	price   *big.Int
+	gasUsed *big.Int
+}
+
+// GasPriceHistogram reports the gas prices an address paid between from and to
+//
+// The time window is resolved to blocks with GetBlockNumberByTimestamp, and every
+// normal transaction sent by the address in that range is fetched. The effective
+// gas prices are bucketed into a histogram and compared, per UTC day, against
+// GetDailyAverageGasPrice to report how much the address overpaid.
+//
+// Args:
+//   - ctx: Context for request cancellation and timeout
+//   - address: The address whose transactions are analyzed
+//   - from: Start of the time window
+//   - to: End of the time window
+//   - opts: Optional parameters (can be nil)
+//
+// Returns:
+//   - *GasPriceReport: Histogram and overpayment statistics
+//   - error: Error if any request fails
+//
+// Example:
+//
+//	to := time.Now()
+//	report, err := client.GasPriceHistogram(ctx, wallet, to.AddDate(0, -1, 0), to, nil)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, b := range report.Buckets {
+//	    fmt.Printf("%s-%s wei: %d txs\n", b.MinWei, b.MaxWei, b.Count)
+//	}
+//	fmt.Printf("Overpaid by %.1f%% (%s wei)\n", report.OverpaymentPercent, report.ExcessFeeWei)
+//
+// Note:
+//   - Only transactions sent by address count; incoming transactions are paid by others
+//   - GetDailyAverageGasPrice is a PRO endpoint
+//   - Costs two block lookups, one call per 1000 transactions and one daily stats call
+func (c *HTTPClient) GasPriceHistogram(ctx context.Context, address string, from, to time.Time, opts *GasPriceHistogramOpts) (*GasPriceReport, error) {
+	if opts == nil {
+		opts = &GasPriceHistogramOpts{}
+	}
+	if err := ApplyDefaults(opts); err != nil {
+		return nil, err
+	}
+	if to.Before(from) {
+		return nil, fmt.Errorf("etherscan: invalid time window, %s is after %s", from, to)
+	}
+	if opts.Buckets < 1 {
+		return nil, fmt.Errorf("etherscan: invalid bucket count %d", opts.Buckets)
+	}
+
+	startBlock, err := c.GetBlockNumberByTimestamp(ctx, from.Unix(), ClosestAfter, &GetBlockNumberByTimestampOpts{
+		ChainID:         opts.ChainID,
+		OnLimitExceeded: opts.OnLimitExceeded,
+	})
+	if err != nil {
+		return nil, err
+	}
+	endBlock, err := c.GetBlockNumberByTimestamp(ctx, to.Unix(), ClosestBefore, &GetBlockNumberByTimestampOpts{
+		ChainID:         opts.ChainID,
+		OnLimitExceeded: opts.OnLimitExceeded,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	report := &GasPriceReport{
+		Address:      address,
+		StartBlock:   int64(startBlock),
+		EndBlock:     int64(endBlock),
+		ExcessFeeWei: new(big.Int),
+	}
+	if startBlock < 0 || endBlock < 0 || startBlock > endBlock {
+		return report, nil
+	}
+
+	txs, err := spillLogsByRange(ctx, c, report.StartBlock, report.EndBlock, func(fromBlock, toBlock, page int64) ([]RespNormalTx, error) {
+		return c.GetNormalTxs(ctx, address, &GetNormalTxsOpts{
+			StartBlock:      fromBlock,
+			EndBlock:        toBlock,
+			Page:            page,
+			Offset:          logsPerCall,
+			Sort:            SortAsc,
+			ChainID:         opts.ChainID,
+			OnLimitExceeded: opts.OnLimitExceeded,
+		})
+	})
+	defer txs.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	var payments []gasPayment
+	sentBy := TxFrom(address)
+	err = txs.Each(func(tx RespNormalTx) error {
+		if !sentBy(tx) {
+			return nil
+		}
+		price, ok := new(big.Int).SetString(tx.GasPrice, 10)
+		if !ok {
+			return fmt.Errorf("etherscan: invalid gas price %q in tx %s", tx.GasPrice, tx.Hash)
+		}
+		gasUsed, ok := new(big.Int).SetString(tx.GasUsed, 10)
+		if !ok {
+			return fmt.Errorf("etherscan: invalid gas used %q in tx %s", tx.GasUsed, tx.Hash)
+		}
+		at, err := ParseTimestamp(tx.TimeStamp)
+		if err != nil {
+			return fmt.Errorf("%w in tx %s", err, tx.Hash)
+		}
+		payments = append(payments, gasPayment{
+			day:     UTCDate(at),
+			price:   price,
+			gasUsed: gasUsed,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	report.TxCount = len(payments)
+	if len(payments) == 0 {
+		return report, nil
+	}
+
+	report.Buckets = gasPriceBuckets(payments, opts.Buckets)
+	prices := make([]*big.Int, len(payments))
+	for i, p := range payments {
+		prices[i] = p.price
+	}
+	slices.SortFunc(prices, (*big.Int).Cmp)
+	report.MinGasPriceWei = prices[0]
+	report.MaxGasPriceWei = prices[len(prices)-1]
+	report.MedianGasPriceWei = prices[len(prices)/2]
+
+	daily, err := c.GetDailyAverageGasPrice(ctx, payments[0].day, payments[len(payments)-1].day, &GetDailyAverageGasPriceOpts{
+		ChainID:         opts.ChainID,
+		OnLimitExceeded: opts.OnLimitExceeded,
+	})
+	if err != nil {
+		return nil, err
+	}
+	averages := make(map[string]*big.Int, len(daily))
+	for _, d := range daily {
+		if avg, ok := new(big.Int).SetString(d.AvgGasPriceWei, 10); ok {
+			averages[strings.TrimSpace(d.UTCDate)] = avg
+		}
+	}
+
+	diff, baseline := new(big.Int), new(big.Int)
+	for _, p := range payments {
+		avg, ok := averages[p.day]
+		if !ok {
+			report.UncomparedTxCount++
+			continue
+		}
+		excess := new(big.Int).Sub(p.price, avg)
+		excess.Mul(excess, p.gasUsed)
+		diff.Add(diff, excess)
+		baseline.Add(baseline, new(big.Int).Mul(avg, p.gasUsed))
+		if excess.Sign() > 0 {
+			report.ExcessFeeWei.Add(report.ExcessFeeWei, excess)
+		}
+		if p.price.Cmp(avg) > 0 {
+			report.OverpaidTxCount++
+		}
+	}
+	if baseline.Sign() > 0 {
+		percent, _ := new(big.Rat).SetFrac(new(big.Int).Mul(diff, big.NewInt(100)), baseline).Float64()
+		report.OverpaymentPercent = percent
+	}
+	return report, nil
+}
+
+// gasPriceBuckets splits payments into at most n equal-width buckets between their lowest and highest price
+func gasPriceBuckets(payments []gasPayment, n int) []GasPriceBucket {
+	lo, hi := payments[0].price, payments[0].price
+	for _, p := range payments[1:] {
+		if p.price.Cmp(lo) < 0 {
+			lo = p.price
+		}
+		if p.price.Cmp(hi) > 0 {
+			hi = p.price
+		}
+	}
+
+	// width = ceil((hi - lo + 1) / n), so that every price falls in one of n buckets
+	span := new(big.Int).Sub(hi, lo)
+	span.Add(span, big.NewInt(1))
+	if span.Cmp(big.NewInt(int64(n))) < 0 {
+		n = int(span.Int64())
+	}
+	width := new(big.Int).Add(span, big.NewInt(int64(n-1)))
+	width.Quo(width, big.NewInt(int64(n)))
+
+	// Rounding the width up may cover the span with fewer buckets, n = ceil(span / width)
+	covered := new(big.Int).Add(span, width)
+	covered.Sub(covered, big.NewInt(1))
+	n = int(covered.Quo(covered, width).Int64())
+
+	buckets := make([]GasPriceBucket, n)
+	for i := range buckets {
+		minWei := new(big.Int).Mul(width, big.NewInt(int64(i)))
+		minWei.Add(minWei, lo)
+		maxWei := new(big.Int).Add(minWei, width)
+		maxWei.Sub(maxWei, big.NewInt(1))
+		if i == n-1 || maxWei.Cmp(hi) > 0 {
+			maxWei = new(big.Int).Set(hi)
+		}
+		buckets[i] = GasPriceBucket{MinWei: minWei, MaxWei: maxWei, GasUsed: new(big.Int)}
+	}
+	for _, p := range payments {
+		offset := new(big.Int).Sub(p.price, lo)
+		i := int(offset.Quo(offset, width).Int64())
+		buckets[i].Count++
+		buckets[i].GasUsed.Add(buckets[i].GasUsed, p.gasUsed)
+	}
+	return buckets
+}
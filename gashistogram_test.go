@@ -0,0 +1,106 @@
+package etherscan
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestGasPriceHistogram(t *testing.T) {
+	var statsRange string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		switch query.Get("action") {
+		case "getblocknobytime":
+			if query.Get("closest") == "after" {
+				w.Write([]byte(`{"status":"1","message":"OK","result":"100"}`))
+			} else {
+				w.Write([]byte(`{"status":"1","message":"OK","result":"200"}`))
+			}
+		case "txlist":
+			// 2024-01-01 00:00 UTC and 2024-01-02 00:00 UTC
+			w.Write([]byte(`{"status":"1","message":"OK","result":[
+				{"hash":"0x1","from":"0xAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA","timeStamp":"1704067200","gasPrice":"10","gasUsed":"100"},
+				{"hash":"0x2","from":"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa","timeStamp":"1704067300","gasPrice":"30","gasUsed":"100"},
+				{"hash":"0x3","from":"0xother","timeStamp":"1704067400","gasPrice":"999","gasUsed":"100"},
+				{"hash":"0x4","from":"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa","timeStamp":"1704153600","gasPrice":"20","gasUsed":"200"},
+				{"hash":"0x5","from":"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa","timeStamp":"1704153700","gasPrice":"19","gasUsed":"200"}]}`))
+		case "dailyavggasprice":
+			statsRange = query.Get("startdate") + "/" + query.Get("enddate")
+			w.Write([]byte(`{"status":"1","message":"OK","result":[
+				{"UTCDate":"2024-01-01","avgGasPrice_Wei":"20"},
+				{"UTCDate":"2024-01-02","avgGasPrice_Wei":"20"}]}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+	})
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	report, err := client.GasPriceHistogram(context.Background(), testAddr, from, from.AddDate(0, 0, 2), &GasPriceHistogramOpts{Buckets: 3})
+	if err != nil {
+		t.Fatalf("GasPriceHistogram failed: %v", err)
+	}
+
+	if report.StartBlock != 100 || report.EndBlock != 200 || report.TxCount != 4 {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+	if statsRange != "2024-01-01/2024-01-02" {
+		t.Errorf("unexpected stats date range %q", statsRange)
+	}
+	if report.MinGasPriceWei.Int64() != 10 || report.MaxGasPriceWei.Int64() != 30 || report.MedianGasPriceWei.Int64() != 20 {
+		t.Errorf("unexpected min/median/max: %s/%s/%s", report.MinGasPriceWei, report.MedianGasPriceWei, report.MaxGasPriceWei)
+	}
+
+	// Prices 10..30 in 3 buckets of width 7: [10,16] [17,23] [24,30]
+	wantCounts := []int{1, 2, 1}
+	if len(report.Buckets) != len(wantCounts) {
+		t.Fatalf("expected %d buckets, got %d", len(wantCounts), len(report.Buckets))
+	}
+	for i, b := range report.Buckets {
+		if b.Count != wantCounts[i] {
+			t.Errorf("bucket %d [%s, %s]: expected %d txs, got %d", i, b.MinWei, b.MaxWei, wantCounts[i], b.Count)
+		}
+	}
+	if report.Buckets[2].MaxWei.Int64() != 30 {
+		t.Errorf("last bucket should end at the max price, got %s", report.Buckets[2].MaxWei)
+	}
+
+	// Excess: -1000 + 1000 + 0 - 200 = -200 over a baseline of 12000
+	if report.OverpaidTxCount != 1 || report.ExcessFeeWei.Int64() != 1000 {
+		t.Errorf("unexpected overpayment: %d txs, %s wei", report.OverpaidTxCount, report.ExcessFeeWei)
+	}
+	if want := -200.0 / 12000 * 100; report.OverpaymentPercent < want-1e-9 || report.OverpaymentPercent > want+1e-9 {
+		t.Errorf("expected %.4f%%, got %.4f%%", want, report.OverpaymentPercent)
+	}
+}
+
+func TestGasPriceBucketsNarrowRange(t *testing.T) {
+	payments := []gasPayment{{price: big.NewInt(5), gasUsed: big.NewInt(1)}, {price: big.NewInt(6), gasUsed: big.NewInt(1)}}
+	buckets := gasPriceBuckets(payments, 10)
+	if len(buckets) != 2 || buckets[0].Count != 1 || buckets[1].Count != 1 {
+		t.Errorf("expected one bucket per distinct price, got %+v", buckets)
+	}
+}
+
+func TestGasPriceBucketsRoundedWidth(t *testing.T) {
+	// A span of 11 prices in 10 buckets needs width 2, so 6 buckets cover it
+	payments := []gasPayment{{price: big.NewInt(100), gasUsed: big.NewInt(1)}, {price: big.NewInt(110), gasUsed: big.NewInt(1)}}
+	buckets := gasPriceBuckets(payments, 10)
+	if len(buckets) != 6 {
+		t.Fatalf("expected 6 buckets, got %d", len(buckets))
+	}
+	for i, b := range buckets {
+		if b.MinWei.Cmp(b.MaxWei) > 0 {
+			t.Errorf("bucket %d is empty: [%s, %s]", i, b.MinWei, b.MaxWei)
+		}
+	}
+	if buckets[5].MaxWei.Int64() != 110 || buckets[0].Count != 1 || buckets[5].Count != 1 {
+		t.Errorf("unexpected buckets %+v", buckets)
+	}
+}
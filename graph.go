@@ -0,0 +1,430 @@
+package etherscan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// ============================================================================
+// Interaction Graph
+// ============================================================================
+
+// GraphEdgeKind is the kind of transfer an interaction graph edge aggregates
+type GraphEdgeKind string
+
+const (
+	// GraphEdgeNormal aggregates native value sent in normal transactions
+	GraphEdgeNormal GraphEdgeKind = "normal"
+
+	// GraphEdgeInternal aggregates native value moved by internal transactions
+	GraphEdgeInternal GraphEdgeKind = "internal"
+
+	// GraphEdgeERC20 aggregates ERC-20 token transfers, one edge per token
+	GraphEdgeERC20 GraphEdgeKind = "erc20"
+)
+
+// GraphNode is an address in an interaction graph
+type GraphNode struct {
+	// Address is the lowercased address
+	Address string `json:"address"`
+
+	// Depth is the number of hops from the nearest seed address
+	Depth int `json:"depth"`
+
+	// Expanded reports whether the transfers of the node were fetched
+	Expanded bool `json:"expanded"`
+
+	// Truncated reports whether a per-node cap cut off transfers or counterparties
+	Truncated bool `json:"truncated"`
+}
+
+// GraphEdge aggregates all transfers of one kind from one address to another
+type GraphEdge struct {
+	From string        `json:"from"`
+	To   string        `json:"to"`
+	Kind GraphEdgeKind `json:"kind"`
+
+	// Token is the token contract for GraphEdgeERC20 edges, empty for native value
+	Token string `json:"token,omitempty"`
+
+	// Value is the total amount transferred, in wei or the token's smallest unit
+	Value *big.Int `json:"value"`
+
+	// Count is the number of transfers
+	Count int64 `json:"count"`
+}
+
+// InteractionGraph is the result of BuildInteractionGraph
+type InteractionGraph struct {
+	Nodes []GraphNode `json:"nodes"`
+	Edges []GraphEdge `json:"edges"`
+}
+
+// InteractionGraphOpts contains optional parameters for BuildInteractionGraph
+type InteractionGraphOpts struct {
+	// Kinds selects the transfer kinds to follow
+	// Default: nil (normal, internal and ERC-20 transfers)
+	Kinds []GraphEdgeKind
+
+	// MaxTxsPerNode is the number of most recent transfers fetched per node and kind
+	// Default: 1000
+	MaxTxsPerNode int64 `default:"1000"`
+
+	// MaxNeighborsPerNode keeps only the most frequent counterparties of each node
+	// Default: 50
+	MaxNeighborsPerNode int `default:"50"`
+
+	// MaxNodes caps the total size of the graph
+	// Default: 500
+	MaxNodes int `default:"500"`
+
+	// MinValue drops transfers below this amount, e.g. to ignore dust
+	// Default: nil (keep all transfers)
+	MinValue *big.Int
+
+	// StopAt lists addresses that become nodes but are never expanded,
+	// such as exchanges or routers that would explode the crawl
+	StopAt []string
+
+	// StartBlock is the first block considered
+	// Default: 0
+	StartBlock int64 `default:"0"`
+
+	// EndBlock is the last block considered
+	// Default: 999999999999 (latest block)
+	EndBlock int64 `default:"999999999999"`
+
+	// ChainID specifies which blockchain network to query
+	// Default: empty (uses client default)
+	ChainID int64
+
+	// OnLimitExceeded specifies behavior when rate limit is exceeded
+	// Default: RateLimitBlock (wait until a token is available)
+	OnLimitExceeded RateLimitBehavior `default:""`
+}
+
+// graphTransfer is a transfer normalized across the transfer kinds
+type graphTransfer struct {
+	// id identifies the transfer across the fetches of both endpoints
+	id              string
+	from, to, token string
+	value           *big.Int
+}
+
+// BuildInteractionGraph crawls outward from seedAddresses and returns the addresses they interact with
+//
+// Each expanded node fetches its most recent normal, internal and ERC-20
+// transfers, aggregates them into weighted edges (total value and count per
+// direction, kind and token), and enqueues its most frequent counterparties.
+// Nodes closer than depth hops to a seed are expanded; depth 1 returns the
+// seeds and their direct counterparties. The result is deterministic and can be
+// exported with WriteDOT or marshalled to JSON for graph tools.
+//
+// Args:
+//   - ctx: Context for request cancellation and timeout
+//   - seedAddresses: Addresses to start the crawl from
+//   - depth: Number of hops to expand
+//   - opts: Optional parameters (can be nil)
+//
+// Returns:
+//   - *InteractionGraph: Nodes and weighted edges
+//   - error: Error if any request fails; with ErrBudgetExhausted the graph
+//     crawled before the budget of WithMaxRequests ran out is returned as well
+//
+// Example:
+//
+//	graph, err := client.BuildInteractionGraph(ctx, []string{suspect}, 2, &InteractionGraphOpts{
+//	    MaxNeighborsPerNode: 20,
+//	    StopAt:              knownExchanges,
+//	})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	graph.WriteDOT(os.Stdout)
+//
+// Note:
+//   - Each expanded node costs one call per transfer kind
+//   - Failed transactions are ignored
+func (c *HTTPClient) BuildInteractionGraph(ctx context.Context, seedAddresses []string, depth int, opts *InteractionGraphOpts) (*InteractionGraph, error) {
+	if opts == nil {
+		opts = &InteractionGraphOpts{}
+	}
+	if err := ApplyDefaults(opts); err != nil {
+		return nil, err
+	}
+
+	kinds := opts.Kinds
+	if len(kinds) == 0 {
+		kinds = []GraphEdgeKind{GraphEdgeNormal, GraphEdgeInternal, GraphEdgeERC20}
+	}
+	stopAt := make(map[string]bool, len(opts.StopAt))
+	for _, addr := range opts.StopAt {
+		stopAt[strings.ToLower(addr)] = true
+	}
+
+	nodes := make(map[string]*GraphNode)
+	edges := make(map[[4]string]*GraphEdge)
+	seen := make(map[string]bool)
+	var queue []string
+
+	addNode := func(addr string, depth int) bool {
+		if _, ok := nodes[addr]; ok {
+			return true
+		}
+		if len(nodes) >= opts.MaxNodes {
+			return false
+		}
+		nodes[addr] = &GraphNode{Address: addr, Depth: depth}
+		queue = append(queue, addr)
+		return true
+	}
+	for _, addr := range seedAddresses {
+		addNode(strings.ToLower(addr), 0)
+	}
+
+	for len(queue) > 0 {
+		addr := queue[0]
+		queue = queue[1:]
+		node := nodes[addr]
+		if node.Depth >= depth || stopAt[addr] {
+			continue
+		}
+		node.Expanded = true
+
+		byKind := make(map[GraphEdgeKind][]graphTransfer, len(kinds))
+		for _, kind := range kinds {
+			transfers, truncated, err := c.fetchGraphTransfers(ctx, addr, kind, opts)
+			if errors.Is(err, ErrBudgetExhausted) {
+				// Keep the graph crawled so far; the node's own edges are incomplete
+				node.Truncated = true
+				return newInteractionGraph(nodes, edges), err
+			}
+			if err != nil {
+				return nil, err
+			}
+			byKind[kind] = transfers
+			node.Truncated = node.Truncated || truncated
+		}
+
+		// Rank counterparties by number of interactions
+		counts := make(map[string]int)
+		for _, transfers := range byKind {
+			for _, t := range transfers {
+				if peer := graphPeer(addr, t); peer != "" {
+					counts[peer]++
+				}
+			}
+		}
+		peers := make([]string, 0, len(counts))
+		for peer := range counts {
+			peers = append(peers, peer)
+		}
+		sort.Slice(peers, func(i, j int) bool {
+			if counts[peers[i]] != counts[peers[j]] {
+				return counts[peers[i]] > counts[peers[j]]
+			}
+			return peers[i] < peers[j]
+		})
+		if len(peers) > opts.MaxNeighborsPerNode {
+			peers = peers[:opts.MaxNeighborsPerNode]
+			node.Truncated = true
+		}
+
+		kept := make(map[string]bool, len(peers))
+		for _, peer := range peers {
+			if addNode(peer, node.Depth+1) {
+				kept[peer] = true
+			} else {
+				node.Truncated = true
+			}
+		}
+
+		for _, kind := range kinds {
+			for _, t := range byKind[kind] {
+				// Transfers between two expanded nodes are fetched twice
+				if !kept[graphPeer(addr, t)] || seen[string(kind)+t.id] {
+					continue
+				}
+				seen[string(kind)+t.id] = true
+
+				key := [4]string{t.from, t.to, string(kind), t.token}
+				edge, ok := edges[key]
+				if !ok {
+					edge = &GraphEdge{From: t.from, To: t.to, Kind: kind, Token: t.token, Value: new(big.Int)}
+					edges[key] = edge
+				}
+				edge.Value.Add(edge.Value, t.value)
+				edge.Count++
+			}
+		}
+	}
+
+	return newInteractionGraph(nodes, edges), nil
+}
+
+// fetchGraphTransfers returns the most recent transfers of one kind involving addr
+func (c *HTTPClient) fetchGraphTransfers(ctx context.Context, addr string, kind GraphEdgeKind, opts *InteractionGraphOpts) ([]graphTransfer, bool, error) {
+	var transfers []graphTransfer
+	var fetched int
+	occurrences := make(map[string]int)
+	add := func(id, from, to, token, value string) {
+		v, ok := new(big.Int).SetString(value, 10)
+		if !ok {
+			v = new(big.Int)
+		}
+		if opts.MinValue != nil && v.Cmp(opts.MinValue) < 0 {
+			return
+		}
+		// Number identical transfers within a tx so that they stay distinct
+		occurrences[id]++
+		transfers = append(transfers, graphTransfer{
+			id:    fmt.Sprintf("%s#%d", id, occurrences[id]),
+			from:  strings.ToLower(from),
+			to:    strings.ToLower(to),
+			token: strings.ToLower(token),
+			value: v,
+		})
+	}
+
+	switch kind {
+	case GraphEdgeNormal:
+		txs, err := c.GetNormalTxs(ctx, addr, &GetNormalTxsOpts{
+			StartBlock:      opts.StartBlock,
+			EndBlock:        opts.EndBlock,
+			Offset:          opts.MaxTxsPerNode,
+			Sort:            SortDesc,
+			ChainID:         opts.ChainID,
+			OnLimitExceeded: opts.OnLimitExceeded,
+		})
+		if err != nil {
+			return nil, false, err
+		}
+		fetched = len(txs)
+		for _, tx := range FilterTxs(txs, NotTx(TxFailed)) {
+			to := tx.To
+			if to == "" {
+				to = tx.ContractAddress
+			}
+			add(tx.Hash, tx.From, to, "", tx.Value)
+		}
+	case GraphEdgeInternal:
+		txs, err := c.GetInternalTxsByAddress(ctx, addr, &GetInternalTxsByAddressOpts{
+			StartBlock:      opts.StartBlock,
+			EndBlock:        opts.EndBlock,
+			Offset:          opts.MaxTxsPerNode,
+			Sort:            SortDesc,
+			ChainID:         opts.ChainID,
+			OnLimitExceeded: opts.OnLimitExceeded,
+		})
+		if err != nil {
+			return nil, false, err
+		}
+		fetched = len(txs)
+		for _, tx := range txs {
+			if tx.IsError == "1" {
+				continue
+			}
+			to := tx.To
+			if to == "" {
+				to = tx.ContractAddress
+			}
+			add(tx.Hash+"/"+tx.TraceID, tx.From, to, "", tx.Value)
+		}
+	case GraphEdgeERC20:
+		txs, err := c.GetERC20TokenTransfers(ctx, &GetERC20TokenTransfersOpts{
+			Address:         addr,
+			StartBlock:      opts.StartBlock,
+			EndBlock:        opts.EndBlock,
+			Offset:          opts.MaxTxsPerNode,
+			Sort:            SortDesc,
+			ChainID:         opts.ChainID,
+			OnLimitExceeded: opts.OnLimitExceeded,
+		})
+		if err != nil {
+			return nil, false, err
+		}
+		fetched = len(txs)
+		for _, tx := range txs {
+			add(strings.Join([]string{tx.Hash, tx.From, tx.To, tx.ContractAddress, tx.Value}, "/"), tx.From, tx.To, tx.ContractAddress, tx.Value)
+		}
+	default:
+		return nil, false, fmt.Errorf("etherscan: unknown graph edge kind %q", kind)
+	}
+	return transfers, int64(fetched) >= opts.MaxTxsPerNode, nil
+}
+
+// graphPeer returns the counterparty of addr in t, or "" for self-transfers and empty endpoints
+func graphPeer(addr string, t graphTransfer) string {
+	peer := t.to
+	if t.to == addr {
+		peer = t.from
+	}
+	if peer == addr || peer == "" {
+		return ""
+	}
+	return peer
+}
+
+// newInteractionGraph returns the nodes and edges in a deterministic order
+func newInteractionGraph(nodes map[string]*GraphNode, edges map[[4]string]*GraphEdge) *InteractionGraph {
+	graph := &InteractionGraph{
+		Nodes: make([]GraphNode, 0, len(nodes)),
+		Edges: make([]GraphEdge, 0, len(edges)),
+	}
+	for _, node := range nodes {
+		graph.Nodes = append(graph.Nodes, *node)
+	}
+	for _, edge := range edges {
+		graph.Edges = append(graph.Edges, *edge)
+	}
+	slices.SortFunc(graph.Nodes, func(a, b GraphNode) int {
+		if a.Depth != b.Depth {
+			return a.Depth - b.Depth
+		}
+		return strings.Compare(a.Address, b.Address)
+	})
+	slices.SortFunc(graph.Edges, func(a, b GraphEdge) int {
+		for _, cmp := range []int{
+			strings.Compare(a.From, b.From),
+			strings.Compare(a.To, b.To),
+			strings.Compare(string(a.Kind), string(b.Kind)),
+			strings.Compare(a.Token, b.Token),
+		} {
+			if cmp != 0 {
+				return cmp
+			}
+		}
+		return 0
+	})
+	return graph
+}
+
+// WriteDOT writes the graph in Graphviz DOT format
+func (g *InteractionGraph) WriteDOT(w io.Writer) error {
+	if _, err := fmt.Fprintln(w, "digraph interactions {"); err != nil {
+		return err
+	}
+	for _, node := range g.Nodes {
+		if _, err := fmt.Fprintf(w, "  %q [depth=%d];\n", node.Address, node.Depth); err != nil {
+			return err
+		}
+	}
+	for _, edge := range g.Edges {
+		label := string(edge.Kind)
+		if edge.Token != "" {
+			label += ":" + edge.Token
+		}
+		if _, err := fmt.Fprintf(w, "  %q -> %q [kind=%q, value=%q, count=%d, label=%q];\n",
+			edge.From, edge.To, edge.Kind, edge.Value.String(), edge.Count, label); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintln(w, "}")
+	return err
+}
@@ -0,0 +1,106 @@
+package etherscan
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestBuildInteractionGraph(t *testing.T) {
+	txlists := map[string]string{
+		"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa": `[{"hash":"0x1","from":"0xAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA","to":"0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb","value":"10","isError":"0"},
+			{"hash":"0x2","from":"0xcccccccccccccccccccccccccccccccccccccccc","to":"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa","value":"5","isError":"0"},
+			{"hash":"0x3","from":"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa","to":"0xcccccccccccccccccccccccccccccccccccccccc","value":"7","isError":"1"}]`,
+		"0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb": `[{"hash":"0x1","from":"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa","to":"0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb","value":"10","isError":"0"},
+			{"hash":"0x4","from":"0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb","to":"0xdddddddddddddddddddddddddddddddddddddddd","value":"3","isError":"0"}]`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if result, ok := txlists[strings.ToLower(query.Get("address"))]; ok && query.Get("action") == "txlist" {
+			w.Write([]byte(`{"status":"1","message":"OK","result":` + result + `}`))
+			return
+		}
+		w.Write([]byte(`{"status":"0","message":"No transactions found","result":[]}`))
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+	})
+
+	graph, err := client.BuildInteractionGraph(context.Background(), []string{"0xAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA"}, 2, nil)
+	if err != nil {
+		t.Fatalf("BuildInteractionGraph failed: %v", err)
+	}
+
+	wantNodes := []GraphNode{
+		{Address: "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", Depth: 0, Expanded: true},
+		{Address: "0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb", Depth: 1, Expanded: true},
+		{Address: "0xcccccccccccccccccccccccccccccccccccccccc", Depth: 1, Expanded: true},
+		{Address: "0xdddddddddddddddddddddddddddddddddddddddd", Depth: 2},
+	}
+	if len(graph.Nodes) != len(wantNodes) {
+		t.Fatalf("expected %d nodes, got %+v", len(wantNodes), graph.Nodes)
+	}
+	for i, want := range wantNodes {
+		if graph.Nodes[i] != want {
+			t.Errorf("node %d: expected %+v, got %+v", i, want, graph.Nodes[i])
+		}
+	}
+
+	wantEdges := []string{"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa->0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb 10/1", "0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb->0xdddddddddddddddddddddddddddddddddddddddd 3/1", "0xcccccccccccccccccccccccccccccccccccccccc->0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa 5/1"}
+	if len(graph.Edges) != len(wantEdges) {
+		t.Fatalf("expected %d edges, got %+v", len(wantEdges), graph.Edges)
+	}
+	for i, edge := range graph.Edges {
+		got := fmt.Sprintf("%s->%s %s/%d", edge.From, edge.To, edge.Value, edge.Count)
+		if got != wantEdges[i] {
+			t.Errorf("edge %d: expected %s, got %s", i, wantEdges[i], got)
+		}
+	}
+
+	var dot strings.Builder
+	if err := graph.WriteDOT(&dot); err != nil {
+		t.Fatalf("WriteDOT failed: %v", err)
+	}
+	if !strings.Contains(dot.String(), `"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa" -> "0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"`) {
+		t.Errorf("unexpected DOT output:\n%s", dot.String())
+	}
+}
+
+func TestBuildInteractionGraphMaxNeighbors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("action") != "txlist" {
+			w.Write([]byte(`{"status":"0","message":"No transactions found","result":[]}`))
+			return
+		}
+		w.Write([]byte(`{"status":"1","message":"OK","result":[
+			{"hash":"0x1","from":"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa","to":"0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb","value":"1","isError":"0"},
+			{"hash":"0x2","from":"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa","to":"0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb","value":"1","isError":"0"},
+			{"hash":"0x3","from":"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa","to":"0xcccccccccccccccccccccccccccccccccccccccc","value":"1","isError":"0"}]}`))
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+	})
+
+	graph, err := client.BuildInteractionGraph(context.Background(), []string{"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"}, 1, &InteractionGraphOpts{
+		Kinds:               []GraphEdgeKind{GraphEdgeNormal},
+		MaxNeighborsPerNode: 1,
+	})
+	if err != nil {
+		t.Fatalf("BuildInteractionGraph failed: %v", err)
+	}
+	if len(graph.Nodes) != 2 || graph.Nodes[1].Address != "0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb" || !graph.Nodes[0].Truncated {
+		t.Errorf("expected only the most frequent neighbor, got %+v", graph.Nodes)
+	}
+	if len(graph.Edges) != 1 || graph.Edges[0].Count != 2 {
+		t.Errorf("expected one edge with count 2, got %+v", graph.Edges)
+	}
+}
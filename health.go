@@ -0,0 +1,167 @@
+package etherscan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ============================================================================
+// Health Check
+// ============================================================================
+
+// defaultHealthPollInterval separates the two block number polls when HealthCheckOpts.PollInterval is zero
+const defaultHealthPollInterval = 15 * time.Second
+
+// HealthReport is the result of HealthCheck
+type HealthReport struct {
+	// Healthy reports whether every check passed; Problems then is empty
+	Healthy  bool     `json:"healthy" bson:"healthy"`
+	Problems []string `json:"problems" bson:"problems"`
+
+	// APIKeyValid reports whether the API accepted the key; Latency is the round trip of that call
+	APIKeyValid bool          `json:"apiKeyValid" bson:"apiKeyValid"`
+	Latency     time.Duration `json:"latency" bson:"latency"`
+
+	// Credits is the credit usage of the key, nil if it could not be fetched
+	Credits *RespCreditUsage `json:"credits" bson:"credits"`
+
+	// CreditsAvailable reports whether at least MinCredits credits are left
+	CreditsAvailable bool `json:"creditsAvailable" bson:"creditsAvailable"`
+
+	// FirstBlock and SecondBlock are the block numbers of the two proxy polls, 0 if a poll failed
+	FirstBlock  int64 `json:"firstBlock" bson:"firstBlock"`
+	SecondBlock int64 `json:"secondBlock" bson:"secondBlock"`
+
+	// ChainAdvancing reports whether SecondBlock is past FirstBlock
+	ChainAdvancing bool `json:"chainAdvancing" bson:"chainAdvancing"`
+}
+
+// HealthCheckOpts contains optional parameters for HealthCheck
+type HealthCheckOpts struct {
+	// PollInterval is the wait between the two block number polls
+	// Default: 15s
+	PollInterval time.Duration
+
+	// MinCredits is the number of remaining credits below which the key is reported as exhausted
+	// Default: 1
+	MinCredits int64 `default:"1"`
+
+	// ChainID specifies which blockchain network to query
+	// Default: empty (uses client default)
+	ChainID int64
+
+	// OnLimitExceeded specifies behavior when rate limit is exceeded
+	// Default: RateLimitBlock (wait until a token is available)
+	OnLimitExceeded RateLimitBehavior `default:""`
+}
+
+// HealthCheck verifies that the API key works and that the chain is being indexed
+//
+// The key is checked with CheckCreditUsage, which also measures the latency of
+// a round trip and reports the credits left. The block number is then polled
+// twice through the proxy module, PollInterval apart, to confirm that new
+// blocks keep arriving. Failed checks are collected in the report rather than
+// returned as errors, so services can log or expose the whole picture from a
+// startup check or a monitoring probe.
+//
+// Args:
+//   - ctx: Context for request cancellation and timeout
+//   - opts: Optional parameters (can be nil)
+//
+// Returns:
+//   - *HealthReport: The result of every check
+//   - error: Only if ctx is done or opts is invalid
+//
+// Example:
+//
+//	report, err := client.HealthCheck(ctx, &HealthCheckOpts{PollInterval: 5 * time.Second})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	if !report.Healthy {
+//	    log.Fatalf("etherscan unhealthy: %v", report.Problems)
+//	}
+//	log.Printf("etherscan ok: %s latency, %d credits left", report.Latency, report.Credits.CreditsAvailable)
+//
+// Note:
+//   - Costs 3 API calls and takes at least PollInterval
+//   - PollInterval must exceed the chain's block time, or a live chain is reported as stalled
+func (c *HTTPClient) HealthCheck(ctx context.Context, opts *HealthCheckOpts) (*HealthReport, error) {
+	if opts == nil {
+		opts = &HealthCheckOpts{}
+	}
+	if err := ApplyDefaults(opts); err != nil {
+		return nil, err
+	}
+	if opts.PollInterval < 0 {
+		return nil, fmt.Errorf("etherscan: invalid poll interval %s", opts.PollInterval)
+	}
+	interval := opts.PollInterval
+	if interval == 0 {
+		interval = defaultHealthPollInterval
+	}
+
+	report := &HealthReport{}
+	problem := func(format string, args ...any) {
+		report.Problems = append(report.Problems, fmt.Sprintf(format, args...))
+	}
+
+	start := time.Now()
+	credits, err := c.CheckCreditUsage(ctx, &CheckCreditUsageOpts{
+		ChainID:         opts.ChainID,
+		OnLimitExceeded: opts.OnLimitExceeded,
+	})
+	report.Latency = time.Since(start)
+	var apiErr *APIError
+	switch {
+	case ctx.Err() != nil:
+		return nil, ctx.Err()
+	case err == nil:
+		report.APIKeyValid = true
+		report.Credits = credits
+		report.CreditsAvailable = credits.CreditsAvailable >= opts.MinCredits
+		if !report.CreditsAvailable {
+			problem("only %d credits left, resetting in %s", credits.CreditsAvailable, credits.IntervalExpiryTimespan)
+		}
+	case errors.As(err, &apiErr) && !IsTransientError(err):
+		problem("API key rejected: %v", err)
+	default:
+		problem("API unreachable: %v", err)
+	}
+
+	poll := func() int64 {
+		hex, err := c.RpcEthBlockNumber(ctx, &RpcEthBlockNumberOpts{
+			ChainID:         opts.ChainID,
+			OnLimitExceeded: opts.OnLimitExceeded,
+		})
+		if err == nil {
+			var block uint64
+			if block, err = parseHexUint64(hex); err == nil {
+				return int64(block)
+			}
+		}
+		if ctx.Err() == nil {
+			problem("block number poll failed: %v", err)
+		}
+		return 0
+	}
+	if report.FirstBlock = poll(); report.FirstBlock > 0 {
+		select {
+		case <-ctx.Done():
+		case <-time.After(interval):
+		}
+		report.SecondBlock = poll()
+	}
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	report.ChainAdvancing = report.SecondBlock > report.FirstBlock
+	if report.FirstBlock > 0 && report.SecondBlock > 0 && !report.ChainAdvancing {
+		problem("no new block in %s, chain stalled at %d", interval, report.SecondBlock)
+	}
+	report.Healthy = len(report.Problems) == 0
+	return report, nil
+}
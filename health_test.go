@@ -0,0 +1,65 @@
+package etherscan
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newHealthTestClient(t *testing.T, creditsBody string, advancing bool) *HTTPClient {
+	t.Helper()
+	var block atomic.Int64
+	block.Store(100)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("action") {
+		case "getapilimit":
+			w.Write([]byte(creditsBody))
+		case "eth_blockNumber":
+			n := block.Load()
+			if advancing {
+				n = block.Add(1)
+			}
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":"0x%x"}`, n)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+	})
+}
+
+func TestHealthCheck(t *testing.T) {
+	client := newHealthTestClient(t, `{"status":"1","message":"OK","result":{"creditsUsed":10,"creditsAvailable":90,"creditLimit":100}}`, true)
+
+	report, err := client.HealthCheck(context.Background(), &HealthCheckOpts{PollInterval: time.Millisecond})
+	if err != nil {
+		t.Fatalf("HealthCheck failed: %v", err)
+	}
+	if !report.Healthy || !report.APIKeyValid || !report.CreditsAvailable || !report.ChainAdvancing {
+		t.Errorf("expected a healthy report, got %+v", report)
+	}
+	if report.FirstBlock != 101 || report.SecondBlock != 102 || report.Credits.CreditsAvailable != 90 {
+		t.Errorf("unexpected report values: %+v", report)
+	}
+}
+
+func TestHealthCheckProblems(t *testing.T) {
+	client := newHealthTestClient(t, `{"status":"0","message":"NOTOK","result":"Invalid API Key"}`, false)
+
+	report, err := client.HealthCheck(context.Background(), &HealthCheckOpts{PollInterval: time.Millisecond})
+	if err != nil {
+		t.Fatalf("HealthCheck failed: %v", err)
+	}
+	if report.Healthy || report.APIKeyValid || report.ChainAdvancing || report.Credits != nil {
+		t.Errorf("expected an unhealthy report, got %+v", report)
+	}
+	if len(report.Problems) != 2 {
+		t.Errorf("expected a rejected key and a stalled chain, got %q", report.Problems)
+	}
+}
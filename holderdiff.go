@@ -0,0 +1,241 @@
+package etherscan
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+)
+
+// ============================================================================
+// Holder Snapshot Diffs
+// ============================================================================
+
+// HolderChange is how one holder's balance changed between two snapshots
+type HolderChange struct {
+	// Holder is the holder address in lowercase
+	Holder string `json:"holder" bson:"holder"`
+
+	// OldBalance, NewBalance and Delta (new minus old) are in the token's smallest unit
+	OldBalance *big.Int `json:"oldBalance" bson:"oldBalance"`
+	NewBalance *big.Int `json:"newBalance" bson:"newBalance"`
+	Delta      *big.Int `json:"delta" bson:"delta"`
+}
+
+// HolderDiff is the difference between two holder snapshots
+//
+// Each list is ordered by the size of the change, largest first, then by address.
+type HolderDiff struct {
+	// Added holders hold a balance in the new snapshot only
+	Added []HolderChange `json:"added" bson:"added"`
+
+	// Removed holders hold a balance in the old snapshot only
+	Removed []HolderChange `json:"removed" bson:"removed"`
+
+	// Changed holders hold different balances in both snapshots
+	Changed []HolderChange `json:"changed" bson:"changed"`
+
+	// Unchanged counts the holders with the same balance in both snapshots
+	Unchanged int `json:"unchanged" bson:"unchanged"`
+
+	// NetDelta is the sum of all deltas: the change in the snapshots' total supply
+	NetDelta *big.Int `json:"netDelta" bson:"netDelta"`
+}
+
+// DiffHolderSnapshots compares the holder lists before and after, e.g. two GetERC20Holders snapshots around a distribution
+//
+// Holders are matched case-insensitively; a holder listed with a zero
+// balance counts as absent. A holder listed twice in one snapshot is an error.
+//
+// Example:
+//
+//	diff, err := etherscan.DiffHolderSnapshots(lastWeek, today)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, h := range diff.Added {
+//	    fmt.Printf("new holder %s with %s\n", h.Holder, h.NewBalance)
+//	}
+func DiffHolderSnapshots(before, after []RespERC20HolderInfo) (*HolderDiff, error) {
+	oldBalances, err := holderBalances(before)
+	if err != nil {
+		return nil, err
+	}
+	newBalances, err := holderBalances(after)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &HolderDiff{Added: []HolderChange{}, Removed: []HolderChange{}, Changed: []HolderChange{}, NetDelta: new(big.Int)}
+	zero := new(big.Int)
+	for holder, oldBalance := range oldBalances {
+		newBalance, ok := newBalances[holder]
+		if !ok {
+			newBalance = zero
+		}
+		change := HolderChange{Holder: holder, OldBalance: oldBalance, NewBalance: newBalance, Delta: new(big.Int).Sub(newBalance, oldBalance)}
+		switch {
+		case !ok:
+			diff.Removed = append(diff.Removed, change)
+		case change.Delta.Sign() == 0:
+			diff.Unchanged++
+			continue
+		default:
+			diff.Changed = append(diff.Changed, change)
+		}
+		diff.NetDelta.Add(diff.NetDelta, change.Delta)
+	}
+	for holder, newBalance := range newBalances {
+		if _, ok := oldBalances[holder]; ok {
+			continue
+		}
+		diff.Added = append(diff.Added, HolderChange{Holder: holder, OldBalance: zero, NewBalance: newBalance, Delta: newBalance})
+		diff.NetDelta.Add(diff.NetDelta, newBalance)
+	}
+
+	for _, changes := range [][]HolderChange{diff.Added, diff.Removed, diff.Changed} {
+		sort.Slice(changes, func(i, j int) bool {
+			if cmp := new(big.Int).Abs(changes[i].Delta).Cmp(new(big.Int).Abs(changes[j].Delta)); cmp != 0 {
+				return cmp > 0
+			}
+			return changes[i].Holder < changes[j].Holder
+		})
+	}
+	return diff, nil
+}
+
+// holderBalances maps the holders of a snapshot with a non-zero balance to it
+func holderBalances(snapshot []RespERC20HolderInfo) (map[string]*big.Int, error) {
+	balances := make(map[string]*big.Int, len(snapshot))
+	seen := make(map[string]struct{}, len(snapshot))
+	for _, h := range snapshot {
+		holder := strings.ToLower(strings.TrimSpace(h.TokenHolderAddress))
+		if _, ok := seen[holder]; ok {
+			return nil, fmt.Errorf("etherscan: holder %s listed twice in a snapshot", holder)
+		}
+		seen[holder] = struct{}{}
+		balance, ok := new(big.Int).SetString(strings.TrimSpace(h.TokenHolderQuantity), 10)
+		if !ok {
+			return nil, fmt.Errorf("etherscan: invalid balance %q of holder %s", h.TokenHolderQuantity, holder)
+		}
+		if balance.Sign() != 0 {
+			balances[holder] = balance
+		}
+	}
+	return balances, nil
+}
+
+// DiffHoldersBetweenBlocksOpts contains optional parameters for DiffHoldersBetweenBlocks
+type DiffHoldersBetweenBlocksOpts struct {
+	// BatchSize is the number of balanceOf calls per Multicall3 eth_call
+	// Default: 20
+	BatchSize int `default:"20"`
+
+	// Concurrency is the number of balance batches fetched in parallel
+	// Default: 4
+	Concurrency int `default:"4"`
+
+	// ChainID specifies which blockchain network to query
+	// Default: empty (uses client default)
+	ChainID int64
+
+	// OnLimitExceeded specifies behavior when rate limit is exceeded
+	// Default: RateLimitBlock (wait until a token is available)
+	OnLimitExceeded RateLimitBehavior `default:""`
+}
+
+// DiffHoldersBetweenBlocks diffs the holders of a token at two blocks
+//
+// Only addresses that sent or received the token after fromBlock, up to
+// toBlock, can have a different balance, so those are collected from the
+// token's transfers and their balances read at both blocks with
+// GetTokenBalancesAt. The diff is exact without snapshotting every holder;
+// Unchanged counts only the addresses that transferred but ended where they
+// started.
+//
+// Args:
+//   - ctx: Context for request cancellation and timeout
+//   - token: The ERC-20 contract address
+//   - fromBlock: The block of the old snapshot
+//   - toBlock: The block of the new snapshot
+//   - opts: Optional parameters (can be nil)
+//
+// Returns:
+//   - *HolderDiff: The holders added, removed and changed between the blocks
+//   - error: Error if the range is invalid or a request fails
+//
+// Example:
+//
+//	diff, err := client.DiffHoldersBetweenBlocks(ctx, govToken, snapshotBlock, distributionBlock, nil)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("%d new holders, %d exited, %d changed\n", len(diff.Added), len(diff.Removed), len(diff.Changed))
+//
+// Note:
+//   - Costs one call per 1000 transfers plus two Multicall3 eth_calls per BatchSize touched holders
+func (c *HTTPClient) DiffHoldersBetweenBlocks(ctx context.Context, token string, fromBlock, toBlock int64, opts *DiffHoldersBetweenBlocksOpts) (*HolderDiff, error) {
+	if opts == nil {
+		opts = &DiffHoldersBetweenBlocksOpts{}
+	}
+	if err := ApplyDefaults(opts); err != nil {
+		return nil, err
+	}
+	if fromBlock < 0 || toBlock < fromBlock {
+		return nil, fmt.Errorf("etherscan: invalid block range %d-%d", fromBlock, toBlock)
+	}
+
+	var holders []string
+	if toBlock > fromBlock {
+		transfers, err := spillLogsByRange(ctx, c, fromBlock+1, toBlock, func(from, to, page int64) ([]RespERC20TokenTransfer, error) {
+			return c.GetERC20TokenTransfers(ctx, &GetERC20TokenTransfersOpts{
+				ContractAddress: token,
+				StartBlock:      from,
+				EndBlock:        to,
+				Page:            page,
+				Offset:          logsPerCall,
+				Sort:            SortAsc,
+				ChainID:         opts.ChainID,
+				OnLimitExceeded: opts.OnLimitExceeded,
+			})
+		})
+		defer transfers.Close()
+		if err != nil {
+			return nil, err
+		}
+		touched := make(map[string]struct{})
+		err = transfers.Each(func(t RespERC20TokenTransfer) error {
+			for _, address := range []string{t.From, t.To} {
+				address = strings.ToLower(address)
+				if _, ok := touched[address]; !ok && address != "" {
+					touched[address] = struct{}{}
+					holders = append(holders, address)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	balanceOpts := &GetTokenBalancesAtOpts{
+		BatchSize:       opts.BatchSize,
+		Concurrency:     opts.Concurrency,
+		ChainID:         opts.ChainID,
+		OnLimitExceeded: opts.OnLimitExceeded,
+	}
+	var snapshots [2][]RespERC20HolderInfo
+	for i, block := range []int64{fromBlock, toBlock} {
+		balances, err := c.GetTokenBalancesAt(ctx, token, holders, block, balanceOpts)
+		if err != nil {
+			return nil, err
+		}
+		snapshots[i] = make([]RespERC20HolderInfo, len(balances))
+		for j, b := range balances {
+			snapshots[i][j] = RespERC20HolderInfo{TokenHolderAddress: b.Holder, TokenHolderQuantity: b.Balance.String()}
+		}
+	}
+	return DiffHolderSnapshots(snapshots[0], snapshots[1])
+}
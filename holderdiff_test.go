@@ -0,0 +1,108 @@
+package etherscan
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestDiffHolderSnapshots(t *testing.T) {
+	before := []RespERC20HolderInfo{
+		{TokenHolderAddress: "0xAAA", TokenHolderQuantity: "100"},
+		{TokenHolderAddress: "0xbbb", TokenHolderQuantity: "50"},
+		{TokenHolderAddress: "0xccc", TokenHolderQuantity: "10"},
+		{TokenHolderAddress: "0xddd", TokenHolderQuantity: "0"},
+	}
+	after := []RespERC20HolderInfo{
+		{TokenHolderAddress: "0xaaa", TokenHolderQuantity: "70"},
+		{TokenHolderAddress: "0xccc", TokenHolderQuantity: "10"},
+		{TokenHolderAddress: "0xddd", TokenHolderQuantity: "5"},
+		{TokenHolderAddress: "0xeee", TokenHolderQuantity: "500"},
+	}
+	diff, err := DiffHolderSnapshots(before, after)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(diff.Added) != 2 || diff.Added[0].Holder != "0xeee" || diff.Added[1].Holder != "0xddd" || diff.Added[1].Delta.Int64() != 5 {
+		t.Errorf("unexpected added holders: %+v", diff.Added)
+	}
+	if len(diff.Removed) != 1 || diff.Removed[0].Holder != "0xbbb" || diff.Removed[0].Delta.Int64() != -50 || diff.Removed[0].NewBalance.Sign() != 0 {
+		t.Errorf("unexpected removed holders: %+v", diff.Removed)
+	}
+	if len(diff.Changed) != 1 || diff.Changed[0].Holder != "0xaaa" || diff.Changed[0].Delta.Int64() != -30 {
+		t.Errorf("unexpected changed holders: %+v", diff.Changed)
+	}
+	if diff.Unchanged != 1 || diff.NetDelta.Int64() != 425 {
+		t.Errorf("expected 1 unchanged holder and a net delta of 425, got %d and %s", diff.Unchanged, diff.NetDelta)
+	}
+
+	if _, err := DiffHolderSnapshots(append(before, before[0]), after); err == nil {
+		t.Error("expected a duplicate holder to be rejected")
+	}
+	if _, err := DiffHolderSnapshots([]RespERC20HolderInfo{{TokenHolderAddress: "0xaaa", TokenHolderQuantity: "1.5"}}, nil); err == nil {
+		t.Error("expected an invalid balance to be rejected")
+	}
+}
+
+func TestDiffHoldersBetweenBlocks(t *testing.T) {
+	holder := func(i int) string { return fmt.Sprintf("0x%038x%02x", 0, i) }
+	balances := map[string][]int{
+		// holder: balance at block 100, at block 200
+		"00": {0, 0},
+		"01": {0, 10},
+		"02": {5, 5},
+		"03": {7, 0},
+		"04": {1, 4},
+	}
+	var tokentxQuery string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		switch query.Get("action") {
+		case "tokentx":
+			tokentxQuery = query.Get("address") + "/" + query.Get("startblock") + "-" + query.Get("endblock")
+			fmt.Fprintf(w, `{"status":"1","message":"OK","result":[
+				{"hash":"0x1","from":"%s","to":"%s","value":"10"},
+				{"hash":"0x2","from":"%s","to":"%s","value":"3"},
+				{"hash":"0x3","from":"%s","to":"%s","value":"4"}]}`,
+				holder(0), holder(1), holder(3), holder(4), holder(3), holder(2))
+		case "eth_call":
+			at := 0
+			if query.Get("tag") == string(BlockNumberTag(200)) {
+				at = 1
+			}
+			var results []multicallResult
+			for _, part := range strings.Split(query.Get("data"), selectorBalanceOf+strings.Repeat("0", 24))[1:] {
+				results = append(results, multicallResult{success: true, returnData: fmt.Sprintf("%064x", balances[part[38:40]][at])})
+			}
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":"%s"}`, encodeAggregate3Result(results))
+		}
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+		MaxRetries: -1,
+	})
+	token := "0x" + strings.Repeat("ab", 20)
+	diff, err := client.DiffHoldersBetweenBlocks(context.Background(), token, 100, 200, &DiffHoldersBetweenBlocksOpts{BatchSize: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tokentxQuery != "/101-200" {
+		t.Errorf("expected the token's transfers after the old block, got %s", tokentxQuery)
+	}
+	if len(diff.Added) != 1 || diff.Added[0].Holder != holder(1) ||
+		len(diff.Removed) != 1 || diff.Removed[0].Holder != holder(3) ||
+		len(diff.Changed) != 1 || diff.Changed[0].Holder != holder(4) || diff.Changed[0].Delta.Int64() != 3 ||
+		diff.Unchanged != 1 {
+		t.Errorf("unexpected diff: %+v", diff)
+	}
+
+	if _, err := client.DiffHoldersBetweenBlocks(context.Background(), token, 200, 100, nil); err == nil {
+		t.Error("expected an invalid range to be rejected")
+	}
+}
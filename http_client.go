@@ -8,10 +8,16 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"maps"
+	"net"
 	"net/http"
 	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -92,6 +98,61 @@ const (
 	OpBNBTestnet            = 5611
 )
 
+// API Versions
+const (
+	// APIVersionV2 uses the unified endpoint (BaseURL) with a chainid parameter on every request
+	APIVersionV2 APIVersion = "v2"
+	// APIVersionV1 uses the legacy per-chain domains (see LegacyV1BaseURLs) without a chainid parameter
+	APIVersionV1 APIVersion = "v1"
+)
+
+// APIVersion selects which Etherscan API generation the client talks to
+type APIVersion string
+
+// LegacyV1BaseURLs maps chain IDs to their legacy (V1) per-chain API endpoints
+//
+// Only used when the client is constructed with APIVersionV1. Entries can be
+// overridden or extended through HTTPClientConfig.V1BaseURLs.
+var LegacyV1BaseURLs = map[int]string{
+	EthereumMainnet:        "https://api.etherscan.io/api",
+	SepoliaTestnet:         "https://api-sepolia.etherscan.io/api",
+	HoleskyTestnet:         "https://api-holesky.etherscan.io/api",
+	AbstractMainnet:        "https://api.abscan.org/api",
+	ApechainMainnet:        "https://api.apescan.io/api",
+	ArbitrumNovaMainnet:    "https://api-nova.arbiscan.io/api",
+	ArbitrumOneMainnet:     "https://api.arbiscan.io/api",
+	ArbitrumSepoliaTestnet: "https://api-sepolia.arbiscan.io/api",
+	AvalancheCChain:        "https://api.snowscan.xyz/api",
+	BaseMainnet:            "https://api.basescan.org/api",
+	BaseSepoliaTestnet:     "https://api-sepolia.basescan.org/api",
+	BerachainMainnet:       "https://api.berascan.com/api",
+	BittorrentChainMainnet: "https://api.bttcscan.com/api",
+	BlastMainnet:           "https://api.blastscan.io/api",
+	BNBSmartChainMainnet:   "https://api.bscscan.com/api",
+	BNBSmartChainTestnet:   "https://api-testnet.bscscan.com/api",
+	CeloMainnet:            "https://api.celoscan.io/api",
+	CronosMainnet:          "https://api.cronoscan.com/api",
+	FraxtalMainnet:         "https://api.fraxscan.com/api",
+	Gnosis:                 "https://api.gnosisscan.io/api",
+	LineaMainnet:           "https://api.lineascan.build/api",
+	MantleMainnet:          "https://api.mantlescan.xyz/api",
+	MoonbaseAlphaTestnet:   "https://api-moonbase.moonscan.io/api",
+	MoonbeamMainnet:        "https://api-moonbeam.moonscan.io/api",
+	MoonriverMainnet:       "https://api-moonriver.moonscan.io/api",
+	OPMainnet:              "https://api-optimistic.etherscan.io/api",
+	OPSepoliaTestnet:       "https://api-sepolia-optimistic.etherscan.io/api",
+	OpBNBMainnet:           "https://api-opbnb.bscscan.com/api",
+	PolygonMainnet:         "https://api.polygonscan.com/api",
+	PolygonAmoyTestnet:     "https://api-amoy.polygonscan.com/api",
+	ScrollMainnet:          "https://api.scrollscan.com/api",
+	SonicMainnet:           "https://api.sonicscan.org/api",
+	SophonMainnet:          "https://api.sophscan.xyz/api",
+	TaikoMainnet:           "https://api.taikoscan.io/api",
+	UnichainMainnet:        "https://api.uniscan.xyz/api",
+	WorldMainnet:           "https://api.worldscan.org/api",
+	ZKSyncMainnet:          "https://api-era.zksync.network/api",
+}
+
 // API Tiers
 const (
 	FreeTier         = "free"
@@ -120,12 +181,50 @@ const (
 )
 
 // HTTPClient is a client for the Etherscan V2 API
+//
+// An HTTPClient is safe for concurrent use by multiple goroutines, and should
+// be shared: its rate limiter, circuit breaker, tag usage and schema warnings
+// only cover the calls made through it, so one client per goroutine exceeds
+// the key's limits by the number of clients. NewHTTPClient copies the maps of
+// the config; changing them afterwards does not affect the client. The
+// package-level tables (ChainCapabilities, SchemaVersions, ...) must only be
+// edited at init time.
 type HTTPClient struct {
-	apiKey          string
-	defaultChainID  int
-	rateLimiter     *MultiRateLimiter
-	onLimitExceeded RateLimitBehavior
-	httpClient      *http.Client
+	apiKey           string
+	chainAPIKeys     map[int]string
+	defaultChainID   int
+	chainIDDefaulted bool // DefaultChainID was not configured and fell back to EthereumMainnet
+	rateLimiter      *MultiRateLimiter
+	onLimitExceeded  RateLimitBehavior
+	httpClient       *http.Client
+	apiVersion       APIVersion
+	v1BaseURLs       map[int]string
+	rpcURLs          map[int]string
+	chainIDWarnOnce  sync.Once
+
+	skipCapabilityCheck       bool
+	skipAddressValidation     bool
+	captureUnknownFields      bool
+	requireExplicitBlockRange bool
+	maxRetries                int
+	retryDelay                time.Duration
+	balanceHistoryLimiter     *RateLimiter // paces FindBalanceCrossing and SampleBalanceHistory to the balancehistory throttle
+	tokenInfoLimiter          *RateLimiter // paces GetPortfolioValue to the tokeninfo throttle
+	supplyHistoryLimiter      *RateLimiter // paces GetTokenSupplySeries to the tokensupplyhistory throttle
+	debugDumpDir              string
+	tracer                    Tracer
+	rateLimits                []RateLimit
+	distributedLimiter        DistributedLimiter
+	distributedLimitKey       string
+	spamFilter                *SpamFilter
+	timeouts                  TimeoutPolicy
+	decodeHooks               DecodeHooks
+	tagUsage                  tagUsage
+	resultMemoryBudget        int64
+	spillDir                  string
+	circuitBreaker            *circuitBreaker
+	upstreams                 *upstreamRouter
+	schemaMonitor             *schemaMonitor
 }
 
 // HTTPClientConfig represents configuration for HTTPClient
@@ -133,6 +232,10 @@ type HTTPClientConfig struct {
 	// APIKey is the Etherscan API key (required)
 	APIKey string
 
+	// ChainAPIKeys overrides APIKey for specific chains, e.g. separate keys per legacy V1 explorer
+	// Default: nil (APIKey is used for every chain)
+	ChainAPIKeys map[int]string
+
 	// DefaultChainID is the default chain ID to use when not specified in requests
 	// Default: EthereumMainnet (1)
 	DefaultChainID int
@@ -149,6 +252,131 @@ type HTTPClientConfig struct {
 	// HTTPClient allows using a custom HTTP client
 	// Default: &http.Client{Timeout: 30 * time.Second}
 	HTTPClient *http.Client
+
+	// Timeouts sets request timeouts per module or action. Without a custom HTTPClient the
+	// default client then has no timeout of its own and "" defaults to 30 seconds; a custom
+	// client's Timeout still caps every request.
+	// Default: nil (the HTTP client's timeout applies to every request)
+	Timeouts TimeoutPolicy
+
+	// APIVersion selects the unified V2 endpoint or the legacy V1 per-chain domains
+	// Options: APIVersionV2, APIVersionV1
+	// Default: APIVersionV2
+	APIVersion APIVersion
+
+	// V1BaseURLs overrides or extends LegacyV1BaseURLs when APIVersion is APIVersionV1
+	// Default: nil (use LegacyV1BaseURLs)
+	V1BaseURLs map[int]string
+
+	// RPCURLs routes the proxy module calls of the given chains to standard JSON-RPC endpoints
+	// (Infura, Alchemy, a local node, ...) instead of Etherscan. The Rpc* methods keep their
+	// signatures and types; the calls bypass the client's rate limiter and cost no API credits.
+	// Default: nil (every call goes to Etherscan)
+	RPCURLs map[int]string
+
+	// Tracer, if set, starts a span per API call (see Tracer for an OpenTelemetry adapter)
+	// Default: nil (no tracing)
+	Tracer Tracer
+
+	// DistributedLimiter, if set, additionally charges every Etherscan request to buckets shared
+	// by all processes using the same API key (see NewRedisLimiter). Each process keeps its local
+	// limiter too; a backend error fails the request.
+	// Default: nil (only this process is rate limited)
+	DistributedLimiter DistributedLimiter
+
+	// DecodeHooks rewrites the raw results of specific endpoints before they are decoded
+	// Default: nil (results are decoded as returned)
+	DecodeHooks DecodeHooks
+
+	// SpamFilter decides which transfers the ExcludeSpam option of the token transfer queries drops
+	// Default: nil (the zero SpamFilter: every heuristic but the airdrop check, no blocklist)
+	SpamFilter *SpamFilter
+
+	// DebugDumpDir, if set, receives the full body of every response that is not valid JSON
+	// Default: "" (no dumps)
+	DebugDumpDir string
+
+	// SkipCapabilityCheck disables the ChainCapabilities check done before each request
+	// Default: false (unsupported actions fail fast with ErrUnsupportedOnChain)
+	SkipCapabilityCheck bool
+
+	// SkipAddressValidation sends address parameters as given instead of normalizing them with NormalizeAddress
+	// Default: false (malformed addresses fail fast with ErrInvalidAddress)
+	SkipAddressValidation bool
+
+	// CaptureUnknownFields stores response fields not declared by the Resp* structs in their UnknownFields
+	// Default: false (undeclared fields are dropped, decoding is cheaper)
+	CaptureUnknownFields bool
+
+	// RequireExplicitBlockRange rejects list calls whose block range is left at the default genesis-to-latest scan
+	// Default: false (omitted block bounds query the full history)
+	RequireExplicitBlockRange bool
+
+	// RateLimitPerSecond overrides the per-second call limit of APITier
+	// Default: 0 (use the tier limit)
+	RateLimitPerSecond int64
+
+	// DailyLimit overrides the daily call limit of APITier
+	// Default: 0 (use the tier limit)
+	DailyLimit int64
+
+	// MaxRetries is how often a request is retried after a transport error or a rate-limit response
+	// Default: 3 (a negative value disables retries)
+	MaxRetries int
+
+	// RetryDelay is the pause before each retry
+	// Default: 1 second
+	RetryDelay time.Duration
+
+	// CircuitBreakerThreshold opens the circuit of an endpoint after this many consecutive
+	// 5xx, NOTOK or transport failures; calls then fail with ErrCircuitOpen until the cooldown ends
+	// Default: 0 (no circuit breaker)
+	CircuitBreakerThreshold int
+
+	// CircuitBreakerCooldown is how long an open circuit fails fast before a trial request is let through
+	// Default: 30 seconds
+	CircuitBreakerCooldown time.Duration
+
+	// OnCircuitStateChange is called, outside any lock, each time the circuit of an endpoint changes state
+	// Default: nil
+	OnCircuitStateChange func(CircuitEvent)
+
+	// Upstreams lists, per chain, the APIs to try in order, e.g. Etherscan V2, the legacy V1 domain
+	// and a Blockscout mirror. A call failing on an upstream with a 5xx, NOTOK or transport error is
+	// sent to the next one; an upstream failing UpstreamFailureThreshold times in a row is skipped
+	// for UpstreamRetryInterval, then tried first again (failback). See CheckUpstreams.
+	// Default: nil (every chain uses the endpoint selected by APIVersion)
+	Upstreams map[int][]Upstream
+
+	// UpstreamFailureThreshold is the number of consecutive failures after which an upstream is skipped
+	// Default: 3
+	UpstreamFailureThreshold int
+
+	// UpstreamRetryInterval is how long a failing upstream is skipped before it is tried again
+	// Default: 30 seconds
+	UpstreamRetryInterval time.Duration
+
+	// OnUpstreamChange is called, outside any lock, each time another upstream serves a chain
+	// Default: nil
+	OnUpstreamChange func(UpstreamEvent)
+
+	// SchemaWarnings logs, once each, responses that carry markers of a newer format than their
+	// endpoint targets: unknown statuses, undeclared record fields and deprecation notices (see SchemaWarning)
+	// Default: false (no checks)
+	SchemaWarnings bool
+
+	// OnSchemaWarning receives the schema warnings instead of the log; setting it enables the checks
+	// Default: nil
+	OnSchemaWarning func(SchemaWarning)
+
+	// ResultMemoryBudget is the memory, in bytes, the aggregation helpers hold of the rows they
+	// scan before spilling them to disk (see SpillBuffer)
+	// Default: 256 MiB (a negative value disables spilling)
+	ResultMemoryBudget int64
+
+	// SpillDir is where aggregation helpers write spilled rows
+	// Default: empty (os.TempDir)
+	SpillDir string
 }
 
 // NewHTTPClient creates a new Etherscan HTTP client
@@ -161,7 +389,8 @@ type HTTPClientConfig struct {
 //	    APITier: ProPlusTier,
 //	})
 func NewHTTPClient(config HTTPClientConfig) *HTTPClient {
-	if config.DefaultChainID == 0 {
+	chainIDDefaulted := config.DefaultChainID == 0
+	if chainIDDefaulted {
 		config.DefaultChainID = EthereumMainnet
 	}
 
@@ -173,40 +402,61 @@ func NewHTTPClient(config HTTPClientConfig) *HTTPClient {
 		config.OnLimitExceeded = RateLimitBlock
 	}
 
+	if config.HTTPClient == nil && len(config.Timeouts) > 0 {
+		// The policy replaces the client timeout, which would cap longer endpoint timeouts
+		timeouts := make(TimeoutPolicy, len(config.Timeouts)+1)
+		timeouts[""] = defaultRequestTimeout
+		for key, timeout := range config.Timeouts {
+			timeouts[key] = timeout
+		}
+		config.Timeouts = timeouts
+		config.HTTPClient = &http.Client{}
+	}
 	if config.HTTPClient == nil {
 		config.HTTPClient = &http.Client{
-			Timeout: 30 * time.Second,
+			Timeout: defaultRequestTimeout,
 		}
 	}
 
+	if config.APIVersion == "" {
+		config.APIVersion = APIVersionV2
+	}
+
+	if config.MaxRetries == 0 {
+		config.MaxRetries = 3
+	} else if config.MaxRetries < 0 {
+		config.MaxRetries = 0
+	}
+
+	if config.RetryDelay <= 0 {
+		config.RetryDelay = 1 * time.Second
+	}
+
+	if config.ResultMemoryBudget == 0 {
+		config.ResultMemoryBudget = defaultResultMemoryBudget
+	} else if config.ResultMemoryBudget < 0 {
+		config.ResultMemoryBudget = 0
+	}
+
+	v1BaseURLs := make(map[int]string, len(LegacyV1BaseURLs)+len(config.V1BaseURLs))
+	for chainID, uri := range LegacyV1BaseURLs {
+		v1BaseURLs[chainID] = uri
+	}
+	for chainID, uri := range config.V1BaseURLs {
+		v1BaseURLs[chainID] = uri
+	}
+
 	// Setup rate limiters based on API tier
-	var rateLimits []RateLimit
-	switch config.APITier {
-	case StandardTier:
-		rateLimits = []RateLimit{
-			{Limit: StandardTierRateLimit, Period: 1 * time.Second},
-			{Limit: StandardTierDailyLimit, Period: 24 * time.Hour},
-		}
-	case AdvancedTier:
-		rateLimits = []RateLimit{
-			{Limit: AdvancedTierRateLimit, Period: 1 * time.Second},
-			{Limit: AdvancedTierDailyLimit, Period: 24 * time.Hour},
-		}
-	case ProfessionalTier:
-		rateLimits = []RateLimit{
-			{Limit: ProfessionalTierRateLimit, Period: 1 * time.Second},
-			{Limit: ProfessionalTierDailyLimit, Period: 24 * time.Hour},
-		}
-	case ProPlusTier:
-		rateLimits = []RateLimit{
-			{Limit: ProPlusTierRateLimit, Period: 1 * time.Second},
-			{Limit: ProPlusTierDailyLimit, Period: 24 * time.Hour},
-		}
-	default: // FreeTier
-		rateLimits = []RateLimit{
-			{Limit: FreeTierRateLimit, Period: 1 * time.Second},
-			{Limit: FreeTierDailyLimit, Period: 24 * time.Hour},
-		}
+	perSecond, daily := tierLimits(config.APITier)
+	if config.RateLimitPerSecond > 0 {
+		perSecond = config.RateLimitPerSecond
+	}
+	if config.DailyLimit > 0 {
+		daily = config.DailyLimit
+	}
+	rateLimits := []RateLimit{
+		{Limit: perSecond, Period: 1 * time.Second},
+		{Limit: daily, Period: 24 * time.Hour},
 	}
 
 	limiter, err := NewMultiRateLimiter(rateLimits, config.OnLimitExceeded)
@@ -214,14 +464,124 @@ func NewHTTPClient(config HTTPClientConfig) *HTTPClient {
 		// should never happen
 		panic(err)
 	}
+	balanceHistoryLimiter, err := NewRateLimiter(balanceHistoryRateLimit, time.Second, RateLimitBlock)
+	if err != nil {
+		// should never happen
+		panic(err)
+	}
+	tokenInfoLimiter, err := NewRateLimiter(tokenInfoRateLimit, time.Second, RateLimitBlock)
+	if err != nil {
+		// should never happen
+		panic(err)
+	}
+	supplyHistoryLimiter, err := NewRateLimiter(supplyHistoryRateLimit, time.Second, RateLimitBlock)
+	if err != nil {
+		// should never happen
+		panic(err)
+	}
 
 	return &HTTPClient{
-		apiKey:          config.APIKey,
-		defaultChainID:  config.DefaultChainID,
-		rateLimiter:     limiter,
-		onLimitExceeded: config.OnLimitExceeded,
-		httpClient:      config.HTTPClient,
+		apiKey:           config.APIKey,
+		chainAPIKeys:     maps.Clone(config.ChainAPIKeys),
+		defaultChainID:   config.DefaultChainID,
+		chainIDDefaulted: chainIDDefaulted,
+		rateLimiter:      limiter,
+		onLimitExceeded:  config.OnLimitExceeded,
+		httpClient:       config.HTTPClient,
+		apiVersion:       config.APIVersion,
+		v1BaseURLs:       v1BaseURLs,
+		rpcURLs:          maps.Clone(config.RPCURLs),
+
+		skipCapabilityCheck:       config.SkipCapabilityCheck,
+		skipAddressValidation:     config.SkipAddressValidation,
+		captureUnknownFields:      config.CaptureUnknownFields,
+		requireExplicitBlockRange: config.RequireExplicitBlockRange,
+		maxRetries:                config.MaxRetries,
+		retryDelay:                config.RetryDelay,
+		resultMemoryBudget:        config.ResultMemoryBudget,
+		spillDir:                  config.SpillDir,
+		balanceHistoryLimiter:     balanceHistoryLimiter,
+		tokenInfoLimiter:          tokenInfoLimiter,
+		supplyHistoryLimiter:      supplyHistoryLimiter,
+		debugDumpDir:              config.DebugDumpDir,
+		tracer:                    config.Tracer,
+		rateLimits:                rateLimits,
+		distributedLimiter:        config.DistributedLimiter,
+		distributedLimitKey:       distributedLimitKey(config.APIKey),
+		spamFilter:                config.SpamFilter,
+		timeouts:                  maps.Clone(config.Timeouts),
+		decodeHooks:               maps.Clone(config.DecodeHooks),
+		circuitBreaker:            newCircuitBreaker(config.CircuitBreakerThreshold, config.CircuitBreakerCooldown, config.OnCircuitStateChange),
+		schemaMonitor:             newSchemaMonitor(config.SchemaWarnings, config.OnSchemaWarning),
+		upstreams:                 newUpstreamRouter(config.Upstreams, v1BaseURLs, config.UpstreamFailureThreshold, config.UpstreamRetryInterval, config.OnUpstreamChange),
+	}
+}
+
+// APIVersion returns the API version the client was constructed with
+func (c *HTTPClient) APIVersion() APIVersion {
+	return c.apiVersion
+}
+
+// RateLimitStats returns a snapshot of the client's local rate limiter
+//
+// Use it to tell whether throughput is bounded by the local limiter (Waits and
+// TotalWait growing) or by Etherscan itself (requests failing upstream while
+// tokens are available).
+//
+// Example:
+//
+//	stats := client.RateLimitStats()
+//	fmt.Printf("waiting: %d, waited %s over %d calls\n", stats.Waiting, stats.TotalWait, stats.Waits)
+func (c *HTTPClient) RateLimitStats() RateLimitStats {
+	return c.rateLimiter.Stats()
+}
+
+// Wait blocks until the client's rate limiter grants one call or ctx is done
+//
+// API methods acquire their own tokens; call Wait before requests made outside
+// the client (for example raw HTTP calls with the same API key) so they count
+// against the same limit. It makes *HTTPClient a parallel.Limiter.
+//
+// Example:
+//
+//	results, err := parallel.MapWithLimiter(ctx, urls, 8, client, fetch)
+func (c *HTTPClient) Wait(ctx context.Context) error {
+	behavior := RateLimitBlock
+	if _, err := c.rateLimiter.Acquire(ctx, 1, &behavior); err != nil {
+		return err
 	}
+	_, err := c.acquireDistributed(ctx, behavior)
+	return err
+}
+
+// apiKeyFor returns the API key to use for the given chain ID
+func (c *HTTPClient) apiKeyFor(chainID string) string {
+	if id, err := strconv.Atoi(chainID); err == nil {
+		if key, ok := c.chainAPIKeys[id]; ok && key != "" {
+			return key
+		}
+	}
+	return c.apiKey
+}
+
+// resolveBaseURL returns the endpoint to use for the given chain ID
+//
+// In V2 mode every chain shares BaseURL. In V1 mode the legacy per-chain
+// domain is looked up and an error is returned for unknown chains.
+func (c *HTTPClient) resolveBaseURL(chainID string) (string, error) {
+	if c.apiVersion != APIVersionV1 {
+		return BaseURL, nil
+	}
+
+	id, err := strconv.Atoi(chainID)
+	if err != nil {
+		return "", fmt.Errorf("etherscan: invalid chainid %q: %w", chainID, err)
+	}
+	uri, ok := c.v1BaseURLs[id]
+	if !ok {
+		return "", fmt.Errorf("etherscan: no V1 endpoint known for chain %d, set HTTPClientConfig.V1BaseURLs or use APIVersionV2", id)
+	}
+	return uri, nil
 }
 
 // requestParams contains parameters for internal request method
@@ -235,6 +595,8 @@ type requestParams struct {
 	baseURL         string
 	onLimitExceeded RateLimitBehavior
 	retryCount      int // Track retry attempts for rate limiting
+	meta            *CallMeta
+	upstream        *Upstream // set by routeRequest when the chain has HTTPClientConfig.Upstreams
 }
 
 // request is the internal method for making API requests
@@ -244,29 +606,130 @@ func (c *HTTPClient) request(params requestParams) (any, error) {
 		params.ctx = context.Background()
 	}
 
+	// One span per attempt; rate-limit retries recurse and get their own span
+	ctx, span := c.startSpan(params.ctx, params.module, params.action)
+	defer span.End()
+	params.ctx = ctx
+
+	// Retries recurse through request; the breaker counts the outcome of the outermost call only
+	endpoint := params.module + "." + params.action
+	if params.retryCount == 0 {
+		params.meta.reset()
+		if err := c.circuitBreaker.allow(endpoint); err != nil {
+			span.RecordError(err)
+			return nil, err
+		}
+	}
+	data, err := c.routeRequest(params, span)
+	if params.retryCount == 0 {
+		c.circuitBreaker.record(endpoint, err)
+	}
+	if err != nil {
+		span.RecordError(err)
+	} else if params.retryCount == 0 {
+		// Rate-limit retries recurse; only the outermost call records and rewrites the result
+		c.recordAudit(params, data)
+		if data, err = c.applyDecodeHook(params, data); err != nil {
+			span.RecordError(err)
+		}
+	}
+	return data, err
+}
+
+// doRequest executes a single request attempt, recording its attributes on span
+func (c *HTTPClient) doRequest(params requestParams, span Span) (any, error) {
 	// Determine rate limit behavior
 	behavior := c.onLimitExceeded
 	if params.onLimitExceeded != "" {
 		behavior = params.onLimitExceeded
 	}
 
+	// Work on a copy so the caller's map, and with it a rate-limit retry, sees the original params
+	original := params
+	params.params = make(map[string]string, len(original.params)+1)
+	for k, v := range original.params {
+		params.params[k] = v
+	}
+	// Set default chain ID if not provided or if it's 0
+	if chainID, ok := params.params["chainid"]; !ok || chainID == "" || chainID == "0" {
+		params.params["chainid"] = strconv.Itoa(c.defaultChainID)
+		if c.apiVersion == APIVersionV2 && c.chainIDDefaulted {
+			c.chainIDWarnOnce.Do(func() {
+				log.Printf("%schainid not set for %s %s, defaulting to %d; V2 expects an explicit chainid on every request", logPrefix(params.ctx), params.module, params.action, c.defaultChainID)
+			})
+		}
+	}
+
+	// A malformed or mistyped address would only come back as an empty result
+	if !c.skipAddressValidation {
+		if err := normalizeAddressParams(params.params); err != nil {
+			return nil, err
+		}
+	}
+
+	// Proxy calls of chains with their own JSON-RPC endpoint never reach Etherscan
+	var rpcURL string
+	if params.module == "proxy" && params.upstream == nil {
+		if id, err := strconv.Atoi(params.params["chainid"]); err == nil {
+			rpcURL = c.rpcURLs[id]
+		}
+	}
+
+	// Fail fast on actions known not to exist on this chain
+	if !c.skipCapabilityCheck && rpcURL == "" {
+		if err := checkCapability(params.module, params.action, params.params["chainid"]); err != nil {
+			return nil, err
+		}
+	}
+
+	// Refuse accidental full-history scans
+	if c.requireExplicitBlockRange {
+		if err := checkExplicitBlockRange(params.params); err != nil {
+			return nil, err
+		}
+	}
+
+	span.SetAttributes(
+		SpanAttribute{Key: SpanAttrModule, Value: params.module},
+		SpanAttribute{Key: SpanAttrAction, Value: params.action},
+		SpanAttribute{Key: SpanAttrChainID, Value: params.params["chainid"]},
+		SpanAttribute{Key: SpanAttrAttempt, Value: int64(params.retryCount + 1)},
+	)
+	if page, ok := params.params["page"]; ok {
+		span.SetAttributes(SpanAttribute{Key: SpanAttrPage, Value: page})
+	}
+	if tag := RequestTag(params.ctx); tag != "" {
+		span.SetAttributes(SpanAttribute{Key: SpanAttrRequestTag, Value: tag})
+	}
+
+	// Stop call trees that would fan out beyond their WithMaxRequests budget
+	if err := chargeBudget(params.ctx, params.module, params.action); err != nil {
+		return nil, err
+	}
+
+	if rpcURL != "" {
+		var cancel context.CancelFunc
+		params.ctx, cancel = c.withEndpointTimeout(params.ctx, params.module, params.action)
+		defer cancel()
+		return c.doRPCRequest(params, rpcURL, span)
+	}
+
 	// Acquire rate limit token
+	waitStart := time.Now()
 	acquired, err := c.rateLimiter.Acquire(params.ctx, 1, &behavior)
+	if err == nil && acquired {
+		acquired, err = c.acquireDistributed(params.ctx, behavior)
+	}
+	waited := time.Since(waitStart)
+	span.SetAttributes(rateLimitWaitAttr(waited))
+	callStatsFrom(params.ctx).add(func(s *CallStats) { s.RateLimitWait += waited })
 	if err != nil {
 		return nil, err
 	}
 	if !acquired {
-		return nil, errors.New("rate limit exceeded")
-	}
-
-	// Set default chain ID if not provided
-	if params.params == nil {
-		params.params = make(map[string]string)
-	}
-	// Set default chain ID if not provided or if it's 0
-	if chainID, ok := params.params["chainid"]; !ok || chainID == "" || chainID == "0" {
-		params.params["chainid"] = strconv.Itoa(c.defaultChainID)
+		return nil, ErrRateLimitExceeded
 	}
+	c.tagUsage.record(params.ctx, params.module, params.action)
 
 	// Remove nil/empty values
 	for k, v := range params.params {
@@ -279,26 +742,40 @@ func (c *HTTPClient) request(params requestParams) (any, error) {
 	if params.method == "" {
 		params.method = "GET"
 	}
-	if params.baseURL == "" {
-		params.baseURL = BaseURL
+	apiKey := c.apiKeyFor(params.params["chainid"])
+	if params.upstream != nil {
+		params.baseURL = params.upstream.URL
+		apiKey = params.upstream.apiKey(apiKey)
+		// Only the unified endpoint selects the chain with chainid
+		if params.upstream.Kind != UpstreamV2 {
+			delete(params.params, "chainid")
+		}
+	} else if params.baseURL == "" {
+		params.baseURL, err = c.resolveBaseURL(params.params["chainid"])
+		if err != nil {
+			return nil, err
+		}
+		// Legacy endpoints are selected by domain and do not accept chainid
+		if c.apiVersion == APIVersionV1 {
+			delete(params.params, "chainid")
+		}
 	}
 
-	// Build request
+	// Build request, bounded by the endpoint's timeout from here on
+	reqCtx, cancel := c.withEndpointTimeout(params.ctx, params.module, params.action)
+	defer cancel()
 	var req *http.Request
 
 	switch params.method {
 	case "GET":
 		// Build query parameters
-		queryParams := url.Values{}
-		queryParams.Set("module", params.module)
-		queryParams.Set("action", params.action)
-		queryParams.Set("apikey", c.apiKey)
-		for k, v := range params.params {
-			queryParams.Set(k, v)
+		queryParams := requestQuery(params.module, params.action, apiKey, params.params)
+		if apiKey == "" && params.upstream != nil {
+			queryParams.Del("apikey")
 		}
 
 		uri := fmt.Sprintf("%s?%s", params.baseURL, queryParams.Encode())
-		req, err = http.NewRequestWithContext(params.ctx, "GET", uri, nil)
+		req, err = http.NewRequestWithContext(reqCtx, "GET", uri, nil)
 	case "POST":
 		// Build URL with basic params
 		queryParams := url.Values{}
@@ -308,13 +785,15 @@ func (c *HTTPClient) request(params requestParams) (any, error) {
 		}
 		queryParams.Set("module", params.module)
 		queryParams.Set("action", params.action)
-		queryParams.Set("apikey", c.apiKey)
+		if apiKey != "" || params.upstream == nil {
+			queryParams.Set("apikey", apiKey)
+		}
 
 		uri := fmt.Sprintf("%s?%s", params.baseURL, queryParams.Encode())
 
 		// Send remaining params as JSON body
 		jsonData, _ := json.Marshal(params.params)
-		req, err = http.NewRequestWithContext(params.ctx, "POST", uri, bytes.NewBuffer(jsonData))
+		req, err = http.NewRequestWithContext(reqCtx, "POST", uri, bytes.NewBuffer(jsonData))
 		if err == nil {
 			req.Header.Set("Content-Type", "application/json")
 		}
@@ -323,99 +802,287 @@ func (c *HTTPClient) request(params requestParams) (any, error) {
 	if err != nil {
 		return nil, err
 	}
+	req, trace := traceCallStats(req, callStatsFrom(params.ctx))
 
 	// Execute request with retries
 	var resp *http.Response
-	retryTimes := 3
-	for i := range retryTimes {
+	var sent time.Time
+	for i := 0; ; i++ {
+		sent = time.Now()
+		params.meta.recordAttempt()
 		resp, err = c.httpClient.Do(req)
-		if err == nil {
+		if err == nil || i == c.maxRetries {
 			break
 		}
 
-		log.Printf("etherscan: request %s %s failed: %v, retrying %d of %d...", params.module, params.action, err, i+1, retryTimes)
-		if i < retryTimes-1 {
-			time.Sleep(1 * time.Second)
-		}
+		log.Printf("%srequest %s %s failed: %v, retrying %d of %d...", logPrefix(params.ctx), params.module, params.action, err, i+1, c.maxRetries)
+		time.Sleep(c.retryDelay)
 	}
 
 	if err != nil {
 		return nil, fmt.Errorf("etherscan: request %s %s failed after retries: %w", params.module, params.action, err)
 	}
 	defer resp.Body.Close()
+	span.SetAttributes(SpanAttribute{Key: SpanAttrHTTPStatus, Value: int64(resp.StatusCode)})
+	params.meta.recordResponse(resp, sent)
 
-	// Read response
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
+	// HTTP 429 is the per-IP limit in front of the API, distinct from the per-key limit reported in the body
+	if resp.StatusCode == http.StatusTooManyRequests {
+		cooldown := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
+		if cooldown <= 0 {
+			cooldown = c.retryDelay
+		}
+		// Every goroutine sharing the client backs off, not only this one
+		c.rateLimiter.PauseUntil(time.Now().Add(cooldown))
+		if params.retryCount < c.maxRetries {
+			log.Printf("%sHTTP 429 for %s %s, pausing requests for %s...", logPrefix(params.ctx), params.module, params.action, cooldown)
+			original.retryCount++
+			return c.request(original)
+		}
+		return nil, &APIError{
+			Module:     params.module,
+			Action:     params.action,
+			StatusCode: resp.StatusCode,
+			Message:    http.StatusText(resp.StatusCode),
+			RetryAfter: cooldown,
+		}
+	}
+
+	// Read response into a pooled buffer; the envelope decode copies the result out of it
+	buf := getBodyBuffer(resp.ContentLength)
+	defer putBodyBuffer(buf)
+	if _, err := buf.ReadFrom(resp.Body); err != nil {
 		return nil, fmt.Errorf("etherscan: read response body failed: %w", err)
 	}
+	body := buf.Bytes()
+	trace.bodyRead()
+	params.meta.recordBody(body, sent)
 
 	// Parse JSON response
-	var result map[string]any
-	if err := json.Unmarshal(body, &result); err != nil {
-		log.Printf("etherscan: parse %s %s response failed: %v", params.module, params.action, err)
-		return params.noFoundReturn, nil
+	var envelope responseEnvelope
+	decodeStart := time.Now()
+	err = json.Unmarshal(body, &envelope)
+	var data any
+	if err == nil {
+		if envelope.JSONRPC != nil {
+			// JSON-RPC responses are returned whole, with their id and error
+			var result map[string]any
+			err = json.Unmarshal(body, &result)
+			data = result
+		} else {
+			data, err = resultValue(envelope.Result)
+		}
+	}
+	recordDecode(params.ctx, decodeStart)
+	if err != nil {
+		c.dumpBody(params.module, params.action, body)
+		return nil, &DecodeError{
+			Module:     params.module,
+			Action:     params.action,
+			StatusCode: resp.StatusCode,
+			URL:        redactURL(req.URL),
+			Body:       bytes.Clone(body),
+			Err:        err,
+		}
 	}
 
 	// Check status
 	status := ""
 	message := ""
-	var data any
-
-	if statusVal, ok := result["status"]; ok {
-		status = fmt.Sprintf("%v", statusVal)
+	if envelope.Status != nil {
+		status = fmt.Sprintf("%v", envelope.Status)
 	}
-	if msgVal, ok := result["message"]; ok {
-		message = fmt.Sprintf("%v", msgVal)
+	if envelope.Message != nil {
+		message = fmt.Sprintf("%v", envelope.Message)
 	}
+	params.meta.recordResult(data)
+	c.schemaMonitor.checkEnvelope(params.module, params.action, status, message, data)
 
-	// Check if it's a JSON-RPC response
-	if _, hasJSONRPC := result["jsonrpc"]; hasJSONRPC {
-		data = result
-	} else {
-		data = result["result"]
-	}
+	if resp.StatusCode != 200 || status == "0" {
+		apiErr := &APIError{
+			Module:     params.module,
+			Action:     params.action,
+			StatusCode: resp.StatusCode,
+			Status:     status,
+			Message:    message,
+			Result:     genericResult(data),
+		}
 
-	// Handle HTTP errors
-	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("etherscan: %s %s failed: %d %s %s %v", params.module, params.action, resp.StatusCode, status, message, data)
-	}
+		// Handle HTTP errors
+		if resp.StatusCode != 200 {
+			return nil, apiErr
+		}
 
-	// Handle API errors
-	if status == "0" {
 		// Check for "No X found" messages
 		if strings.HasPrefix(message, "No ") && strings.HasSuffix(message, " found") {
 			return params.noFoundReturn, nil
 		}
 
 		// Check for rate limit errors
-		if strings.Contains(message, "Maximum rate limit reached") ||
-			strings.Contains(message, "rate limit") ||
-			strings.Contains(message, "Rate limit") {
-			// Retry with 1 second delay
-			log.Printf("etherscan: rate limit detected for %s %s, retrying in 1 second...", params.module, params.action)
-			time.Sleep(1 * time.Second)
-
+		if apiErr.RateLimited() {
 			// Recursively retry the request (with a limit to prevent infinite recursion)
-			if params.retryCount < 3 {
-				params.retryCount++
-				return c.request(params)
+			if params.retryCount < c.maxRetries {
+				log.Printf("%srate limit detected for %s %s, retrying in %s...", logPrefix(params.ctx), params.module, params.action, c.retryDelay)
+				time.Sleep(c.retryDelay)
+				original.retryCount++
+				return c.request(original)
 			}
 		}
 
-		return nil, fmt.Errorf("etherscan: %s %s failed: %d %s %s %v", params.module, params.action, resp.StatusCode, status, message, data)
+		return nil, apiErr
 	}
 
 	return data, nil
 }
 
+// APIError is returned when the API answers with a non-200 status or status "0"
+type APIError struct {
+	Module     string
+	Action     string
+	StatusCode int
+	Status     string
+	Message    string
+	Result     any
+
+	// RetryAfter is the cooldown requested with an HTTP 429, 0 for other errors
+	RetryAfter time.Duration
+}
+
+// Error implements the error interface
+func (e *APIError) Error() string {
+	return fmt.Sprintf("etherscan: %s %s failed: %d %s %s %v", e.Module, e.Action, e.StatusCode, e.Status, e.Message, e.Result)
+}
+
+// RateLimited reports whether the API rejected the call for exceeding a rate limit
+func (e *APIError) RateLimited() bool {
+	return e.StatusCode == http.StatusTooManyRequests ||
+		strings.Contains(e.Message, "Maximum rate limit reached") ||
+		strings.Contains(e.Message, "rate limit") ||
+		strings.Contains(e.Message, "Rate limit")
+}
+
+// parseRetryAfter returns the cooldown of a Retry-After header, in seconds or as an HTTP date, 0 if absent or invalid
+func parseRetryAfter(header string, now time.Time) time.Duration {
+	header = strings.TrimSpace(header)
+	if header == "" {
+		return 0
+	}
+	if seconds, err := strconv.ParseInt(header, 10, 64); err == nil {
+		return time.Duration(max(seconds, 0)) * time.Second
+	}
+	if at, err := http.ParseTime(header); err == nil {
+		return max(at.Sub(now), 0)
+	}
+	return 0
+}
+
+// IsTransientError reports whether err is worth retrying later or routing around
+//
+// Timeouts, rate limits (from the API or the client's own limiter) and HTTP 5xx
+// responses are transient. Bad API keys, invalid parameters and other API
+// errors are not, and neither is a cancelled context.
+func IsTransientError(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return false
+	}
+	if errors.Is(err, ErrRateLimitExceeded) {
+		return true
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.RateLimited() || apiErr.StatusCode >= 500
+	}
+	var decodeErr *DecodeError
+	if errors.As(err, &decodeErr) {
+		return decodeErr.StatusCode >= 500
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
 // unmarshalResponse unmarshals the API response into the target type
-func unmarshalResponse(data any, target any) error {
-	jsonData, err := json.Marshal(data)
-	if err != nil {
-		return err
+//
+// List and object results arrive as json.RawMessage and are decoded into
+// target in one pass; other values are encoded first.
+func (c *HTTPClient) unmarshalResponse(ctx context.Context, data any, target any) error {
+	defer recordDecode(ctx, time.Now())
+	jsonData, ok := data.(json.RawMessage)
+	if !ok {
+		var err error
+		if jsonData, err = json.Marshal(data); err != nil {
+			return err
+		}
+	}
+	if err := json.Unmarshal(jsonData, target); err != nil {
+		return &DecodeError{Body: jsonData, Err: err}
+	}
+	if c.captureUnknownFields {
+		fillUnknownFields(jsonData, reflect.ValueOf(target))
+	}
+	c.schemaMonitor.checkFields(jsonData, target)
+	return nil
+}
+
+// decodeErrorBodyLimit is the number of body bytes included in DecodeError.Error
+const decodeErrorBodyLimit = 512
+
+// DecodeError is returned when a response cannot be decoded
+//
+// If the raw HTTP body is not valid JSON, every field is set. If the body parsed
+// but its result did not match the expected type, only Body (the re-encoded
+// result) and Err are set.
+type DecodeError struct {
+	Module     string
+	Action     string
+	StatusCode int
+	URL        string // API key redacted
+	Body       []byte // full body; Error() only prints the first 512 bytes
+	Err        error
+}
+
+// Error implements the error interface
+func (e *DecodeError) Error() string {
+	body := e.Body
+	truncated := ""
+	if len(body) > decodeErrorBodyLimit {
+		body = body[:decodeErrorBodyLimit]
+		truncated = "..."
+	}
+	if e.URL == "" {
+		return fmt.Sprintf("etherscan: decode response failed: %v, body: %s%s", e.Err, body, truncated)
+	}
+	return fmt.Sprintf("etherscan: decode %s %s response failed: %v, status: %d, url: %s, body: %s%s",
+		e.Module, e.Action, e.Err, e.StatusCode, e.URL, body, truncated)
+}
+
+// Unwrap returns the underlying JSON error
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// redactURL returns u as a string with the apikey query parameter masked
+func redactURL(u *url.URL) string {
+	redacted := *u
+	query := redacted.Query()
+	if query.Has("apikey") {
+		query.Set("apikey", "REDACTED")
+		redacted.RawQuery = query.Encode()
+	}
+	return redacted.String()
+}
+
+// dumpBody writes body to the debug directory, if configured
+func (c *HTTPClient) dumpBody(module, action string, body []byte) {
+	if c.debugDumpDir == "" {
+		return
+	}
+	name := fmt.Sprintf("%d-%s-%s.body", time.Now().UnixNano(), module, action)
+	path := filepath.Join(c.debugDumpDir, name)
+	if err := os.WriteFile(path, body, 0o644); err != nil {
+		log.Printf("etherscan: dump %s %s response failed: %v", module, action, err)
+		return
 	}
-	return json.Unmarshal(jsonData, target)
+	log.Printf("etherscan: dumped undecodable %s %s response to %s", module, action, path)
 }
 
 // GetSupportedChains returns the list of supported blockchain networks
@@ -2,8 +2,15 @@ package etherscan
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 )
 
 func TestHTTPClient_GetSupportedChains(t *testing.T) {
@@ -18,3 +25,317 @@ func TestHTTPClient_GetSupportedChains(t *testing.T) {
 		fmt.Println(chain.ChainName)
 	}
 }
+
+func TestHTTPClient_ResolveBaseURL(t *testing.T) {
+	v2 := NewHTTPClient(HTTPClientConfig{})
+	if v2.APIVersion() != APIVersionV2 {
+		t.Fatalf("expected default API version %s, got %s", APIVersionV2, v2.APIVersion())
+	}
+	uri, err := v2.resolveBaseURL("137")
+	if err != nil {
+		t.Fatalf("resolveBaseURL failed: %v", err)
+	}
+	if uri != BaseURL {
+		t.Errorf("expected %s, got %s", BaseURL, uri)
+	}
+
+	v1 := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{999999: "https://example.invalid/api"},
+	})
+	uri, err = v1.resolveBaseURL("137")
+	if err != nil {
+		t.Fatalf("resolveBaseURL failed: %v", err)
+	}
+	if uri != LegacyV1BaseURLs[PolygonMainnet] {
+		t.Errorf("expected %s, got %s", LegacyV1BaseURLs[PolygonMainnet], uri)
+	}
+
+	uri, err = v1.resolveBaseURL("999999")
+	if err != nil {
+		t.Fatalf("resolveBaseURL failed: %v", err)
+	}
+	if uri != "https://example.invalid/api" {
+		t.Errorf("expected override URL, got %s", uri)
+	}
+
+	if _, err := v1.resolveBaseURL("123456789"); err == nil {
+		t.Error("expected error for unknown V1 chain")
+	}
+}
+
+func TestHTTPClient_DecodeError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+		w.Write([]byte("<html>502 Bad Gateway</html>"))
+	}))
+	defer server.Close()
+
+	dumpDir := t.TempDir()
+	client := NewHTTPClient(HTTPClientConfig{
+		APIKey:       "secret-key",
+		APIVersion:   APIVersionV1,
+		V1BaseURLs:   map[int]string{EthereumMainnet: server.URL},
+		DebugDumpDir: dumpDir,
+	})
+
+	_, err := client.GetEthBalance(context.Background(), TestAddresses.VitalikButerin, nil)
+	var decodeErr *DecodeError
+	if !errors.As(err, &decodeErr) {
+		t.Fatalf("expected *DecodeError, got %v", err)
+	}
+	if decodeErr.StatusCode != http.StatusBadGateway {
+		t.Errorf("expected status 502, got %d", decodeErr.StatusCode)
+	}
+	if strings.Contains(decodeErr.Error(), "secret-key") {
+		t.Errorf("API key leaked in error: %v", decodeErr)
+	}
+	if !strings.Contains(decodeErr.Error(), "502 Bad Gateway") {
+		t.Errorf("expected body snippet in error, got %v", decodeErr)
+	}
+
+	entries, err := os.ReadDir(dumpDir)
+	if err != nil {
+		t.Fatalf("ReadDir failed: %v", err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("expected 1 dumped body, got %d", len(entries))
+	}
+}
+
+func TestHTTPClient_V1RetryKeepsChain(t *testing.T) {
+	defaultServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("retry was sent to the default chain's domain: %s", r.URL.RawQuery)
+		w.Write([]byte(`{"status":"1","message":"OK","result":"0"}`))
+	}))
+	defer defaultServer.Close()
+
+	var calls int
+	polygonServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.URL.Query().Get("apikey") != "polygon-key" {
+			t.Errorf("expected the Polygon key, got %q", r.URL.Query().Get("apikey"))
+		}
+		if r.URL.Query().Has("chainid") {
+			t.Errorf("legacy request %d carries a chainid", calls)
+		}
+		if calls == 1 {
+			w.Write([]byte(`{"status":"0","message":"Maximum rate limit reached","result":null}`))
+			return
+		}
+		w.Write([]byte(`{"status":"1","message":"OK","result":"42"}`))
+	}))
+	defer polygonServer.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIKey:       "default-key",
+		ChainAPIKeys: map[int]string{PolygonMainnet: "polygon-key"},
+		APIVersion:   APIVersionV1,
+		V1BaseURLs:   map[int]string{EthereumMainnet: defaultServer.URL, PolygonMainnet: polygonServer.URL},
+	})
+	balance, err := client.GetEthBalance(context.Background(), TestAddresses.VitalikButerin, &GetEthBalanceOpts{ChainID: PolygonMainnet})
+	if err != nil {
+		t.Fatalf("GetEthBalance failed: %v", err)
+	}
+	if balance != "42" || calls != 2 {
+		t.Errorf("expected the retried balance after 2 calls, got %q after %d", balance, calls)
+	}
+}
+
+func TestHTTPClient_RetryPolicy(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Write([]byte(`{"status":"0","message":"Maximum rate limit reached","result":null}`))
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion:         APIVersionV1,
+		V1BaseURLs:         map[int]string{EthereumMainnet: server.URL},
+		RateLimitPerSecond: 100,
+		MaxRetries:         2,
+		RetryDelay:         time.Millisecond,
+	})
+	if _, err := client.GetEthBalance(context.Background(), TestAddresses.VitalikButerin, nil); err == nil {
+		t.Fatal("expected the rate-limit error once retries are exhausted")
+	}
+	if calls != 3 {
+		t.Errorf("expected 1 call and 2 retries, got %d calls", calls)
+	}
+
+	calls = 0
+	client = NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+		MaxRetries: -1,
+	})
+	client.GetEthBalance(context.Background(), TestAddresses.VitalikButerin, nil)
+	if calls != 1 {
+		t.Errorf("expected retries to be disabled, got %d calls", calls)
+	}
+}
+
+func TestHTTPClient_RetryAfter(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte("<html>Too Many Requests</html>"))
+			return
+		}
+		w.Write([]byte(`{"status":"1","message":"OK","result":"1000"}`))
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+		MaxRetries: 1,
+		RetryDelay: time.Millisecond,
+	})
+	start := time.Now()
+	balance, err := client.GetEthBalance(context.Background(), TestAddresses.VitalikButerin, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if balance != "1000" || calls != 2 {
+		t.Errorf("expected the retried balance after 2 calls, got %q after %d", balance, calls)
+	}
+	if elapsed := time.Since(start); elapsed < 900*time.Millisecond {
+		t.Errorf("expected the retry to wait out Retry-After, got %s", elapsed)
+	}
+
+	// Without retries the cooldown is reported and still holds back other callers
+	calls = 0
+	client = NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+		MaxRetries: -1,
+	})
+	_, err = client.GetEthBalance(context.Background(), TestAddresses.VitalikButerin, nil)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusTooManyRequests || apiErr.RetryAfter != time.Second || !IsTransientError(err) {
+		t.Fatalf("expected a transient 429 error with a 1s cooldown, got %v", err)
+	}
+	if time.Until(client.RateLimitStats().PausedUntil) <= 0 {
+		t.Error("expected the limiter to be paused")
+	}
+	_, err = client.GetEthBalance(context.Background(), TestAddresses.VitalikButerin, &GetEthBalanceOpts{OnLimitExceeded: RateLimitRaise})
+	if !errors.Is(err, ErrRateLimitExceeded) || calls != 1 {
+		t.Errorf("expected the paused limiter to refuse the call, got %v after %d calls", err, calls)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+	cases := map[string]time.Duration{
+		"":                              0,
+		"30":                            30 * time.Second,
+		"-5":                            0,
+		"Fri, 01 Mar 2024 12:00:10 GMT": 10 * time.Second,
+		"Fri, 01 Mar 2024 11:59:00 GMT": 0,
+		"soon":                          0,
+	}
+	for header, want := range cases {
+		if got := parseRetryAfter(header, now); got != want {
+			t.Errorf("%q: expected %s, got %s", header, want, got)
+		}
+	}
+}
+
+func TestIsTransientError(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want bool
+	}{
+		"nil":             {nil, false},
+		"server error":    {&APIError{StatusCode: 502}, true},
+		"api rate limit":  {&APIError{StatusCode: 200, Status: "0", Message: "Maximum rate limit reached"}, true},
+		"ip rate limit":   {&APIError{StatusCode: 429}, true},
+		"client limiter":  {fmt.Errorf("wrapped: %w", ErrRateLimitExceeded), true},
+		"bad gateway":     {&DecodeError{StatusCode: 502}, true},
+		"invalid api key": {&APIError{StatusCode: 200, Status: "0", Message: "NOTOK", Result: "Invalid API Key"}, false},
+		"cancelled":       {context.Canceled, false},
+	}
+	for name, tc := range cases {
+		if got := IsTransientError(tc.err); got != tc.want {
+			t.Errorf("%s: expected %v, got %v", name, tc.want, got)
+		}
+	}
+}
+
+func TestHTTPClient_SharedAcrossGoroutines(t *testing.T) {
+	// Run with -race: one client hammered from many goroutines, with every piece of shared state enabled
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("action") {
+		case "txlist":
+			w.Write([]byte(`{"status":"1","message":"OK","result":[{"hash":"0x01","blockNumber":"1","txType":"0x4"}]}`))
+		case "balance":
+			w.Write([]byte(`{"status":"0","message":"NOTOK","result":"Error! Upstream failure"}`))
+		default:
+			w.Write([]byte(`{"status":"1","message":"OK","result":"1"}`))
+		}
+	}))
+	defer server.Close()
+
+	config := HTTPClientConfig{
+		APIVersion:              APIVersionV1,
+		V1BaseURLs:              map[int]string{EthereumMainnet: server.URL},
+		ChainAPIKeys:            map[int]string{EthereumMainnet: "key"},
+		Timeouts:                TimeoutPolicy{"account": 5 * time.Second},
+		CaptureUnknownFields:    true,
+		MaxRetries:              -1,
+		RateLimitPerSecond:      200,
+		CircuitBreakerThreshold: 1000,
+		OnSchemaWarning:         func(SchemaWarning) {},
+	}
+	client := NewHTTPClient(config)
+
+	var wg sync.WaitGroup
+	for i := range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx, stats := WithCallStats(WithRequestTag(context.Background(), fmt.Sprintf("worker-%d", i%5)))
+			for range 4 {
+				var meta CallMeta
+				if _, err := client.GetNormalTxs(ctx, testAddr, &GetNormalTxsOpts{Meta: &meta}); err != nil {
+					t.Error(err)
+					return
+				}
+				if meta.Attempts != 1 {
+					t.Errorf("expected the meta of this call only, got %d attempts", meta.Attempts)
+				}
+				if _, err := client.GetEthBalance(ctx, testAddr, nil); err == nil {
+					t.Error("expected the NOTOK balance to fail")
+				}
+				client.RateLimitStats()
+				client.CircuitStates()
+				client.UsageByTag()
+			}
+			if calls := stats.Stats().Calls; calls != 8 {
+				t.Errorf("expected 8 calls in this goroutine's stats, got %d", calls)
+			}
+		}()
+	}
+	// Changing the config maps after NewHTTPClient does not reach the client
+	for chainID := range 100 {
+		config.ChainAPIKeys[chainID+1000] = "other"
+		config.Timeouts[fmt.Sprint(chainID)] = time.Second
+	}
+	wg.Wait()
+
+	var calls int64
+	for _, usage := range client.UsageByTag() {
+		calls += usage.Calls
+	}
+	if calls != 400 {
+		t.Errorf("expected 400 calls over all tags, got %d", calls)
+	}
+	if state := client.CircuitStates()["account.balance"]; state != CircuitClosed {
+		t.Errorf("expected the balance circuit still closed below its threshold, got %s", state)
+	}
+}
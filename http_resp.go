@@ -30,6 +30,8 @@ type RespBridgeTx struct {
 	Symbol          string `json:"symbol" bson:"symbol"`
 	ContractAddress string `json:"contractAddress" bson:"contractAddress"`
 	Divisor         string `json:"divisor" bson:"divisor"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 type RespGetBridgeTxs []RespBridgeTx
@@ -44,6 +46,8 @@ type RespGetBridgeTxs []RespBridgeTx
 type RespEthBalanceEntry struct {
 	Account string `json:"account" bson:"account"`
 	Balance string `json:"balance" bson:"balance"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 type RespGetEthBalances []RespEthBalanceEntry
@@ -78,6 +82,8 @@ type RespNormalTx struct {
 	Confirmations     string `json:"confirmations" bson:"confirmations"`
 	MethodID          string `json:"methodId" bson:"methodId"`
 	FunctionName      string `json:"functionName" bson:"functionName"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 type RespGetNormalTxs []RespNormalTx
@@ -98,6 +104,8 @@ type RespInternalTxByAddress struct {
 	TraceID         string `json:"traceId" bson:"traceId"`
 	IsError         string `json:"isError" bson:"isError"`
 	ErrCode         string `json:"errCode" bson:"errCode"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 type RespGetInternalTxsByAddress []RespInternalTxByAddress
@@ -116,6 +124,8 @@ type RespInternalTxByHash struct {
 	GasUsed         string `json:"gasUsed" bson:"gasUsed"`
 	IsError         string `json:"isError" bson:"isError"`
 	ErrCode         string `json:"errCode" bson:"errCode"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 type RespGetInternalTxsByHash []RespInternalTxByHash
@@ -136,6 +146,8 @@ type RespInternalTxByBlockRange struct {
 	TraceID         string `json:"traceId" bson:"traceId"`
 	IsError         string `json:"isError" bson:"isError"`
 	ErrCode         string `json:"errCode" bson:"errCode"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 type RespGetInternalTxsByBlockRange []RespInternalTxByBlockRange
@@ -161,6 +173,8 @@ type RespERC20TokenTransfer struct {
 	CumulativeGasUsed string `json:"cumulativeGasUsed" bson:"cumulativeGasUsed"`
 	Input             string `json:"input" bson:"input"`
 	Confirmations     string `json:"confirmations" bson:"confirmations"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 type RespGetERC20TokenTransfers []RespERC20TokenTransfer
@@ -186,6 +200,8 @@ type RespERC721TokenTransfer struct {
 	CumulativeGasUsed string `json:"cumulativeGasUsed" bson:"cumulativeGasUsed"`
 	Input             string `json:"input" bson:"input"`
 	Confirmations     string `json:"confirmations" bson:"confirmations"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 type RespGetERC721TokenTransfers []RespERC721TokenTransfer
@@ -211,6 +227,8 @@ type RespERC1155TokenTransfer struct {
 	TokenName         string `json:"tokenName" bson:"tokenName"`
 	TokenSymbol       string `json:"tokenSymbol" bson:"tokenSymbol"`
 	Confirmations     string `json:"confirmations" bson:"confirmations"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 type RespGetERC1155TokenTransfers []RespERC1155TokenTransfer
@@ -222,6 +240,8 @@ type RespAddressFundedBy struct {
 	FundingAddress string `json:"fundingAddress" bson:"fundingAddress"`
 	FundingTxn     string `json:"fundingTxn" bson:"fundingTxn"`
 	Value          string `json:"value" bson:"value"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 // RespBlockValidated represents a validated block
@@ -229,6 +249,8 @@ type RespBlockValidated struct {
 	BlockNumber string `json:"blockNumber" bson:"blockNumber"`
 	TimeStamp   string `json:"timeStamp" bson:"timeStamp"`
 	BlockReward string `json:"blockReward" bson:"blockReward"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 type RespBlocksValidatedByAddress []RespBlockValidated
@@ -241,6 +263,8 @@ type RespBeaconChainWithdrawal struct {
 	Amount          string `json:"amount" bson:"amount"`
 	BlockNumber     string `json:"blockNumber" bson:"blockNumber"`
 	Timestamp       string `json:"timestamp" bson:"timestamp"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 type RespBeaconChainWithdrawals []RespBeaconChainWithdrawal
@@ -267,6 +291,8 @@ type RespContractSourceCode struct {
 	Implementation       string `json:"Implementation" bson:"Implementation"`
 	SwarmSource          string `json:"SwarmSource" bson:"SwarmSource"`
 	SimilarMatch         string `json:"SimilarMatch" bson:"SimilarMatch"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 type RespContractSourceCodes []RespContractSourceCode
@@ -280,6 +306,8 @@ type RespContractCreationAndCreation struct {
 	Timestamp        string `json:"timestamp" bson:"timestamp"`
 	ContractFactory  string `json:"contractFactory" bson:"contractFactory"`
 	CreationBytecode string `json:"creationBytecode" bson:"creationBytecode"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 type RespContractCreationAndCreations []RespContractCreationAndCreation
@@ -296,11 +324,15 @@ type RespCheckSourceCodeVerificationStatus string
 type RespContractExecutionStatus struct {
 	IsError        string `json:"isError" bson:"isError"`
 	ErrDescription string `json:"errDescription" bson:"errDescription"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 // RespCheckTxReceiptStatus represents transaction receipt status
 type RespCheckTxReceiptStatus struct {
 	Status string `json:"status" bson:"status"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 // Block Module Response Types
@@ -320,6 +352,8 @@ type RespBlockReward struct {
 	BlockReward          string        `json:"blockReward" bson:"blockReward"`
 	Uncles               []UncleReward `json:"uncles" bson:"uncles"`
 	UncleInclusionReward string        `json:"uncleInclusionReward" bson:"uncleInclusionReward"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 // RespBlockTxsCountByBlockNo represents transaction count by block number
@@ -330,6 +364,8 @@ type RespBlockTxsCountByBlockNo struct {
 	ERC20TxsCount    int64 `json:"erc20TxsCount" bson:"erc20TxsCount"`
 	ERC721TxsCount   int64 `json:"erc721TxsCount" bson:"erc721TxsCount"`
 	ERC1155TxsCount  int64 `json:"erc1155TxsCount" bson:"erc1155TxsCount"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 // RespEstimateBlockCountdownTimeByBlockNo represents estimated block countdown time
@@ -338,6 +374,8 @@ type RespEstimateBlockCountdownTimeByBlockNo struct {
 	CountdownBlock    string `json:"CountdownBlock" bson:"CountdownBlock"`
 	RemainingBlock    string `json:"RemainingBlock" bson:"RemainingBlock"`
 	EstimateTimeInSec string `json:"EstimateTimeInSec" bson:"EstimateTimeInSec"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 type RespBlockNumber int
@@ -347,6 +385,8 @@ type RespDailyAvgBlockSize struct {
 	UTCDate        string `json:"UTCDate" bson:"UTCDate"`
 	UnixTimeStamp  string `json:"unixTimeStamp" bson:"unixTimeStamp"`
 	BlockSizeBytes int64  `json:"blockSize_bytes" bson:"blockSize_bytes"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 type RespDailyAvgBlockSizes []RespDailyAvgBlockSize
@@ -357,6 +397,8 @@ type RespDailyBlockCountReward struct {
 	UnixTimeStamp   string `json:"unixTimeStamp" bson:"unixTimeStamp"`
 	BlockCount      int64  `json:"blockCount" bson:"blockCount"`
 	BlockRewardsEth string `json:"blockRewards_Eth" bson:"blockRewards_Eth"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 type RespDailyBlockCountRewards []RespDailyBlockCountReward
@@ -366,6 +408,8 @@ type RespDailyBlockReward struct {
 	UTCDate         string `json:"UTCDate" bson:"UTCDate"`
 	UnixTimeStamp   string `json:"unixTimeStamp" bson:"unixTimeStamp"`
 	BlockRewardsEth string `json:"blockRewards_Eth" bson:"blockRewards_Eth"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 type RespDailyBlockRewards []RespDailyBlockReward
@@ -375,6 +419,8 @@ type RespDailyAvgTimeBlockMined struct {
 	UTCDate       string `json:"UTCDate" bson:"UTCDate"`
 	UnixTimeStamp string `json:"unixTimeStamp" bson:"unixTimeStamp"`
 	BlockTimeSec  string `json:"blockTime_sec" bson:"blockTime_sec"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 type RespDailyAvgTimeBlockMineds []RespDailyAvgTimeBlockMined
@@ -385,6 +431,8 @@ type RespDailyUncleBlockCountAndReward struct {
 	UnixTimeStamp        string `json:"unixTimeStamp" bson:"unixTimeStamp"`
 	UncleBlockCount      int64  `json:"uncleBlockCount" bson:"uncleBlockCount"`
 	UncleBlockRewardsEth string `json:"uncleBlockRewards_Eth" bson:"uncleBlockRewards_Eth"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 type RespDailyUncleBlockCountAndRewards []RespDailyUncleBlockCountAndReward
@@ -403,6 +451,8 @@ type RespEventLogByAddress struct {
 	LogIndex         string   `json:"logIndex" bson:"logIndex"`
 	TransactionHash  string   `json:"transactionHash" bson:"transactionHash"`
 	TransactionIndex string   `json:"transactionIndex" bson:"transactionIndex"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 type RespEventLogsByAddress []RespEventLogByAddress
@@ -419,6 +469,8 @@ type RespEventLogByTopics struct {
 	LogIndex         string   `json:"logIndex" bson:"logIndex"`
 	TransactionHash  string   `json:"transactionHash" bson:"transactionHash"`
 	TransactionIndex string   `json:"transactionIndex" bson:"transactionIndex"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 type RespEventLogsByTopics []RespEventLogByTopics
@@ -435,6 +487,8 @@ type RespEventLogByAddressFilteredByTopics struct {
 	LogIndex         string   `json:"logIndex" bson:"logIndex"`
 	TransactionHash  string   `json:"transactionHash" bson:"transactionHash"`
 	TransactionIndex string   `json:"transactionIndex" bson:"transactionIndex"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 type RespEventLogsByAddressFilteredByTopics []RespEventLogByAddressFilteredByTopics
@@ -443,9 +497,24 @@ type RespEventLogsByAddressFilteredByTopics []RespEventLogByAddressFilteredByTop
 
 // RespJsonRpc represents a generic JSON-RPC response
 type RespJsonRpc[Result any] struct {
-	Jsonrpc string `json:"jsonrpc" bson:"jsonrpc"`
-	ID      int64  `json:"id" bson:"id"`
-	Result  Result `json:"result" bson:"result"`
+	Jsonrpc string            `json:"jsonrpc" bson:"jsonrpc"`
+	ID      int64             `json:"id" bson:"id"`
+	Result  Result            `json:"result" bson:"result"`
+	Error   *RespJsonRpcError `json:"error,omitempty" bson:"error,omitempty"`
+}
+
+// RespJsonRpcError represents the error object of a JSON-RPC response
+// Example:
+//
+//	{
+//	    "code": 3,
+//	    "message": "execution reverted: Ownable: caller is not the owner",
+//	    "data": "0x08c379a0..."
+//	}
+type RespJsonRpcError struct {
+	Code    int64  `json:"code" bson:"code"`
+	Message string `json:"message" bson:"message"`
+	Data    any    `json:"data,omitempty" bson:"data,omitempty"`
 }
 
 type RespEthBlockNumberHex = RespJsonRpc[string]
@@ -473,6 +542,8 @@ type RespEthBlockInfo struct {
 	Transactions     []string `json:"transactions" bson:"transactions"`
 	TransactionsRoot string   `json:"transactionsRoot" bson:"transactionsRoot"`
 	Uncles           []string `json:"uncles" bson:"uncles"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 type RespEthBlock = RespJsonRpc[RespEthBlockInfo]
@@ -499,6 +570,8 @@ type RespEthBlockInfoWithFullTxs struct {
 	Transactions     []RespEthTxInfo `json:"transactions" bson:"transactions"`
 	TransactionsRoot string          `json:"transactionsRoot" bson:"transactionsRoot"`
 	Uncles           []string        `json:"uncles" bson:"uncles"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 type RespEthBlockWithFullTxs = RespJsonRpc[RespEthBlockInfoWithFullTxs]
@@ -524,6 +597,8 @@ type RespEthUncleBlockInfo struct {
 	Timestamp        string   `json:"timestamp" bson:"timestamp"`
 	TransactionsRoot string   `json:"transactionsRoot" bson:"transactionsRoot"`
 	Uncles           []string `json:"uncles" bson:"uncles"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 type RespEthUncleBlock = RespJsonRpc[RespEthUncleBlockInfo]
@@ -551,6 +626,8 @@ type RespEthTxInfo struct {
 	V                    string `json:"v" bson:"v"`
 	R                    string `json:"r" bson:"r"`
 	S                    string `json:"s" bson:"s"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 type RespEthTx = RespJsonRpc[RespEthTxInfo]
@@ -570,6 +647,8 @@ type RespEthTxReceiptLog struct {
 	BlockHash        string   `json:"blockHash" bson:"blockHash"`
 	LogIndex         string   `json:"logIndex" bson:"logIndex"`
 	Removed          bool     `json:"removed" bson:"removed"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 // RespEthTxReceiptInfo represents Ethereum transaction receipt information
@@ -588,6 +667,8 @@ type RespEthTxReceiptInfo struct {
 	TransactionHash   string                `json:"transactionHash" bson:"transactionHash"`
 	TransactionIndex  string                `json:"transactionIndex" bson:"transactionIndex"`
 	Type              string                `json:"type" bson:"type"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 type RespEthTxReceipt = RespJsonRpc[RespEthTxReceiptInfo]
@@ -598,6 +679,41 @@ type RespEthGetCode = RespJsonRpc[string]
 
 type RespEthGetStorageAt = RespJsonRpc[string]
 
+// RespEthProofInfo represents an EIP-1186 account proof
+// Example:
+//
+//	{
+//	    "address": "0x7f0d15c7faae65896648c8273b6d7e43f58fa842",
+//	    "accountProof": ["0xf90211a0...", "0xf90211a0..."],
+//	    "balance": "0x0",
+//	    "codeHash": "0xc5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470",
+//	    "nonce": "0x0",
+//	    "storageHash": "0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421",
+//	    "storageProof": [{"key": "0x0", "value": "0x0", "proof": []}]
+//	}
+type RespEthProofInfo struct {
+	Address      string                `json:"address" bson:"address"`
+	AccountProof []string              `json:"accountProof" bson:"accountProof"`
+	Balance      string                `json:"balance" bson:"balance"`
+	CodeHash     string                `json:"codeHash" bson:"codeHash"`
+	Nonce        string                `json:"nonce" bson:"nonce"`
+	StorageHash  string                `json:"storageHash" bson:"storageHash"`
+	StorageProof []RespEthStorageProof `json:"storageProof" bson:"storageProof"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
+}
+
+// RespEthStorageProof represents the proof of a single storage slot
+type RespEthStorageProof struct {
+	Key   string   `json:"key" bson:"key"`
+	Value string   `json:"value" bson:"value"`
+	Proof []string `json:"proof" bson:"proof"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
+}
+
+type RespEthGetProof = RespJsonRpc[RespEthProofInfo]
+
 type RespEthGetGasPrice = RespJsonRpc[string]
 
 type RespEthEstimateGas = RespJsonRpc[string]
@@ -616,17 +732,37 @@ type RespERC20HistoricalAccountBalance string
 type RespERC20HolderInfo struct {
 	TokenHolderAddress  string `json:"TokenHolderAddress" bson:"TokenHolderAddress"`
 	TokenHolderQuantity string `json:"TokenHolderQuantity" bson:"TokenHolderQuantity"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 type RespERC20Holders []RespERC20HolderInfo
 
 type RespERC20HolderCount string
 
+// RespERC20HolderChartPoint represents the holder count of a token on a given day
+// Example:
+//
+//	{
+//	    "UTCDate": "2024-01-01",
+//	    "unixTimeStamp": "1704067200",
+//	    "holderCount": "5321456"
+//	}
+type RespERC20HolderChartPoint struct {
+	UTCDate       string `json:"UTCDate" bson:"UTCDate"`
+	UnixTimeStamp string `json:"unixTimeStamp" bson:"unixTimeStamp"`
+	HolderCount   string `json:"holderCount" bson:"holderCount"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
+}
+
 // RespTopTokenHolder represents top token holder information
 type RespTopTokenHolder struct {
 	TokenHolderAddress     string `json:"TokenHolderAddress" bson:"TokenHolderAddress"`
 	TokenHolderQuantity    string `json:"TokenHolderQuantity" bson:"TokenHolderQuantity"`
 	TokenHolderAddressType string `json:"TokenHolderAddressType" bson:"TokenHolderAddressType"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 type RespTopTokenHolders []RespTopTokenHolder
@@ -656,6 +792,8 @@ type RespTokenInfo struct {
 	Discord         string `json:"discord" bson:"discord"`
 	Whitepaper      string `json:"whitepaper" bson:"whitepaper"`
 	TokenPriceUSD   string `json:"tokenPriceUSD" bson:"tokenPriceUSD"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 // RespERC20Holding represents an ERC-20 token holding
@@ -665,6 +803,8 @@ type RespERC20Holding struct {
 	TokenSymbol   string `json:"TokenSymbol" bson:"TokenSymbol"`
 	TokenQuantity string `json:"TokenQuantity" bson:"TokenQuantity"`
 	TokenDivisor  string `json:"TokenDivisor" bson:"TokenDivisor"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 type RespERC20Holdings []RespERC20Holding
@@ -675,6 +815,8 @@ type RespNFTHolding struct {
 	TokenName     string `json:"TokenName" bson:"TokenName"`
 	TokenSymbol   string `json:"TokenSymbol" bson:"TokenSymbol"`
 	TokenQuantity string `json:"TokenQuantity" bson:"TokenQuantity"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 type RespNFTHoldings []RespNFTHolding
@@ -683,6 +825,8 @@ type RespNFTHoldings []RespNFTHolding
 type RespNFTTokenInventory struct {
 	TokenAddress string `json:"TokenAddress" bson:"TokenAddress"`
 	TokenID      string `json:"TokenId" bson:"TokenId"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 type RespNFTTokenInventories []RespNFTTokenInventory
@@ -699,6 +843,8 @@ type RespGasOracle struct {
 	FastGasPrice    string `json:"FastGasPrice" bson:"FastGasPrice"`
 	SuggestBaseFee  string `json:"suggestBaseFee" bson:"suggestBaseFee"`
 	GasUsedRatio    string `json:"gasUsedRatio" bson:"gasUsedRatio"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 // RespDailyAvgGasLimit represents daily average gas limit
@@ -706,6 +852,8 @@ type RespDailyAvgGasLimit struct {
 	UTCDate       string `json:"UTCDate" bson:"UTCDate"`
 	UnixTimeStamp string `json:"unixTimeStamp" bson:"unixTimeStamp"`
 	GasLimit      string `json:"gasLimit" bson:"gasLimit"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 type RespDailyAvgGasLimits []RespDailyAvgGasLimit
@@ -715,6 +863,8 @@ type RespDailyTotalGasUsed struct {
 	UTCDate       string `json:"UTCDate" bson:"UTCDate"`
 	UnixTimeStamp string `json:"unixTimeStamp" bson:"unixTimeStamp"`
 	GasUsed       string `json:"gasUsed" bson:"gasUsed"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 type RespDailyTotalGasUseds []RespDailyTotalGasUsed
@@ -726,6 +876,8 @@ type RespDailyAvgGasPrice struct {
 	MaxGasPriceWei string `json:"maxGasPrice_Wei" bson:"maxGasPrice_Wei"`
 	MinGasPriceWei string `json:"minGasPrice_Wei" bson:"minGasPrice_Wei"`
 	AvgGasPriceWei string `json:"avgGasPrice_Wei" bson:"avgGasPrice_Wei"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 type RespDailyAvgGasPrices []RespDailyAvgGasPrice
@@ -742,6 +894,8 @@ type RespEthPrice struct {
 	EthBTCTimestamp string `json:"ethbtc_timestamp" bson:"ethbtc_timestamp"`
 	EthUSD          string `json:"ethusd" bson:"ethusd"`
 	EthUSDTimestamp string `json:"ethusd_timestamp" bson:"ethusd_timestamp"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 // RespEtheumNodeSize represents Ethereum node size information
@@ -751,6 +905,8 @@ type RespEtheumNodeSize struct {
 	ChainSize      string `json:"chainSize" bson:"chainSize"`
 	ClientType     string `json:"clientType" bson:"clientType"`
 	SyncMode       string `json:"syncMode" bson:"syncMode"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 type RespEtheumNodesSize []RespEtheumNodeSize
@@ -759,6 +915,8 @@ type RespEtheumNodesSize []RespEtheumNodeSize
 type RespNodeCount struct {
 	UTCDate        string `json:"UTCDate" bson:"UTCDate"`
 	TotalNodeCount string `json:"TotalNodeCount" bson:"TotalNodeCount"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 // RespDailyTxFee represents daily transaction fee
@@ -766,6 +924,8 @@ type RespDailyTxFee struct {
 	UTCDate           string `json:"UTCDate" bson:"UTCDate"`
 	UnixTimeStamp     string `json:"unixTimeStamp" bson:"unixTimeStamp"`
 	TransactionFeeEth string `json:"transactionFee_Eth" bson:"transactionFee_Eth"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 type RespDailyTxFees []RespDailyTxFee
@@ -775,6 +935,8 @@ type RespDailyNewAddress struct {
 	UTCDate         string `json:"UTCDate" bson:"UTCDate"`
 	UnixTimeStamp   string `json:"unixTimeStamp" bson:"unixTimeStamp"`
 	NewAddressCount int64  `json:"newAddressCount" bson:"newAddressCount"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 type RespDailyNewAddresses []RespDailyNewAddress
@@ -784,6 +946,8 @@ type RespDailyNetworkUtilization struct {
 	UTCDate            string `json:"UTCDate" bson:"UTCDate"`
 	UnixTimeStamp      string `json:"unixTimeStamp" bson:"unixTimeStamp"`
 	NetworkUtilization string `json:"networkUtilization" bson:"networkUtilization"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 type RespDailyNetworkUtilizations []RespDailyNetworkUtilization
@@ -793,6 +957,8 @@ type RespDailyAvgHashrate struct {
 	UTCDate         string `json:"UTCDate" bson:"UTCDate"`
 	UnixTimeStamp   string `json:"unixTimeStamp" bson:"unixTimeStamp"`
 	NetworkHashRate string `json:"networkHashRate" bson:"networkHashRate"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 type RespDailyAvgHashrates []RespDailyAvgHashrate
@@ -802,6 +968,8 @@ type RespDailyTxCount struct {
 	UTCDate          string `json:"UTCDate" bson:"UTCDate"`
 	UnixTimeStamp    string `json:"unixTimeStamp" bson:"unixTimeStamp"`
 	TransactionCount int64  `json:"transactionCount" bson:"transactionCount"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 type RespDailyTxCounts []RespDailyTxCount
@@ -811,6 +979,8 @@ type RespDailyAvgDifficulty struct {
 	UTCDate           string `json:"UTCDate" bson:"UTCDate"`
 	UnixTimeStamp     string `json:"unixTimeStamp" bson:"unixTimeStamp"`
 	NetworkDifficulty string `json:"networkDifficulty" bson:"networkDifficulty"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 type RespDailyAvgDifficulties []RespDailyAvgDifficulty
@@ -820,10 +990,32 @@ type RespEthHistoricalPrice struct {
 	UTCDate       string `json:"UTCDate" bson:"UTCDate"`
 	UnixTimeStamp string `json:"unixTimeStamp" bson:"unixTimeStamp"`
 	Value         string `json:"value" bson:"value"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 type RespEthHistoricalPrices []RespEthHistoricalPrice
 
+// RespEthDailyMarketCap represents the ETH supply, price and market cap of one day
+// Example:
+//
+//	{
+//	    "UTCDate": "2019-02-01",
+//	    "unixTimeStamp": "1548979200",
+//	    "supply": "104481838.59",
+//	    "marketCap": "11227.6886386847",
+//	    "price": "107.46"
+//	}
+type RespEthDailyMarketCap struct {
+	UTCDate       string `json:"UTCDate" bson:"UTCDate"`
+	UnixTimeStamp string `json:"unixTimeStamp" bson:"unixTimeStamp"`
+	Supply        string `json:"supply" bson:"supply"`
+	MarketCap     string `json:"marketCap" bson:"marketCap"` // millions of USD
+	Price         string `json:"price" bson:"price"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
+}
+
 // Layer 2 Module Response Types
 
 // RespPlasmaDeposit represents a plasma deposit
@@ -831,6 +1023,8 @@ type RespPlasmaDeposit struct {
 	BlockNumber string `json:"blockNumber" bson:"blockNumber"`
 	TimeStamp   string `json:"timeStamp" bson:"timeStamp"`
 	BlockReward string `json:"blockReward" bson:"blockReward"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 type RespPlasmaDeposits []RespPlasmaDeposit
@@ -860,6 +1054,8 @@ type RespDepositTx struct {
 	TokenSentFrom     string `json:"tokenSentFrom" bson:"tokenSentFrom"`
 	TokenSentTo       string `json:"tokenSentTo" bson:"tokenSentTo"`
 	TokenValue        string `json:"tokenValue" bson:"tokenValue"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 type RespDepositTxs []RespDepositTx
@@ -890,6 +1086,8 @@ type RespWithdrawalTx struct {
 	WithdrawalType         string `json:"withdrawalType" bson:"withdrawalType"`
 	TokenValue             string `json:"tokenValue" bson:"tokenValue"`
 	L1TransactionHashProve string `json:"L1transactionhashProve" bson:"L1transactionhashProve"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 type RespWithdrawalTxs []RespWithdrawalTx
@@ -903,6 +1101,8 @@ type RespCreditUsage struct {
 	CreditLimit            int64  `json:"creditLimit" bson:"creditLimit"`
 	LimitInterval          string `json:"limitInterval" bson:"limitInterval"`
 	IntervalExpiryTimespan string `json:"intervalExpiryTimespan" bson:"intervalExpiryTimespan"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 // RespSupportedChain represents a supported chain
@@ -912,12 +1112,16 @@ type RespSupportedChain struct {
 	BlockExplorer string `json:"blockexplorer" bson:"blockexplorer"`
 	APIURL        string `json:"apiurl" bson:"apiurl"`
 	Status        int64  `json:"status" bson:"status"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 // RespSupportedChains represents supported chains information
 type RespSupportedChains struct {
 	TotalCount int64                `json:"totalcount" bson:"totalcount"`
 	Result     []RespSupportedChain `json:"result" bson:"result"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 // Address Tagging & Labeling Module Response Types
@@ -936,6 +1140,8 @@ type RespAddressTag struct {
 	Reputation           int64    `json:"reputation" bson:"reputation"`
 	OtherAttributes      []string `json:"other_attributes" bson:"other_attributes"`
 	LastUpdatedTimestamp int64    `json:"lastupdatedtimestamp" bson:"lastupdatedtimestamp"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 type RespAddressTags []RespAddressTag
@@ -947,6 +1153,8 @@ type RespLabelMaster struct {
 	ShortDescription     string `json:"shortdescription" bson:"shortdescription"`
 	Notes                string `json:"notes" bson:"notes"`
 	LastUpdatedTimestamp int64  `json:"lastupdatedtimestamp" bson:"lastupdatedtimestamp"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 type RespLabelMasterlist []RespLabelMaster
@@ -956,6 +1164,8 @@ type RespLatestCSVBatchNumber struct {
 	Nametag              string `json:"nametag" bson:"nametag"`
 	Batch                string `json:"batch" bson:"batch"`
 	LastUpdatedTimestamp int64  `json:"lastUpdatedTimestamp" bson:"lastUpdatedTimestamp"`
+
+	UnknownFields UnknownFields `json:"-" bson:"-"`
 }
 
 type RespLatestCSVBatchNumbers []RespLatestCSVBatchNumber
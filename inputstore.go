@@ -0,0 +1,127 @@
+package etherscan
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// ============================================================================
+// Input Archive
+// ============================================================================
+
+// InputRefPrefix marks a tx Input that was replaced by a content-addressed reference
+const InputRefPrefix = "sha256:"
+
+// InputStore is a content-addressed blob store for transaction calldata
+//
+// Keys are InputHash values. Implementations must be safe for concurrent use;
+// Put is called for every archived input and should be cheap for keys that
+// already exist.
+type InputStore interface {
+	Put(ctx context.Context, hash string, data []byte) error
+	Get(ctx context.Context, hash string) ([]byte, bool, error)
+}
+
+// InputHash returns the content address of raw calldata
+func InputHash(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// MemoryInputStore is an in-memory InputStore
+type MemoryInputStore struct {
+	blobs map[string][]byte
+	mu    sync.RWMutex
+}
+
+// NewMemoryInputStore creates an empty in-memory input store
+func NewMemoryInputStore() *MemoryInputStore {
+	return &MemoryInputStore{blobs: make(map[string][]byte)}
+}
+
+// Put stores data under hash if it is not already present
+func (s *MemoryInputStore) Put(ctx context.Context, hash string, data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.blobs[hash]; !ok {
+		s.blobs[hash] = append([]byte(nil), data...)
+	}
+	return nil
+}
+
+// Get returns a copy of the blob stored under hash
+func (s *MemoryInputStore) Get(ctx context.Context, hash string) ([]byte, bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	data, ok := s.blobs[hash]
+	if !ok {
+		return nil, false, nil
+	}
+	return append([]byte(nil), data...), true, nil
+}
+
+// Len returns the number of distinct blobs stored
+func (s *MemoryInputStore) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return len(s.blobs)
+}
+
+// ArchiveInputs moves the calldata of txs into store and replaces each Input with a reference
+//
+// Call it on every page while paging through a tx list: repeated payloads such as
+// identical approve() or transfer() calls are stored once. Empty inputs ("0x")
+// and inputs that are already references are left untouched.
+//
+// Example:
+//
+//	store := NewMemoryInputStore()
+//	txs, _ := client.GetNormalTxs(ctx, addr, nil)
+//	if err := ArchiveInputs(ctx, store, txs); err != nil {
+//	    log.Fatal(err)
+//	}
+//	// txs[i].Input is now "sha256:<hex>"; RestoreInputs reverses it
+func ArchiveInputs(ctx context.Context, store InputStore, txs []RespNormalTx) error {
+	for i := range txs {
+		input := txs[i].Input
+		if input == "" || input == "0x" || strings.HasPrefix(input, InputRefPrefix) {
+			continue
+		}
+		data, err := hex.DecodeString(strings.TrimPrefix(input, "0x"))
+		if err != nil {
+			return fmt.Errorf("etherscan: invalid input of tx %s: %w", txs[i].Hash, err)
+		}
+		hash := InputHash(data)
+		if err := store.Put(ctx, hash, data); err != nil {
+			return err
+		}
+		txs[i].Input = InputRefPrefix + hash
+	}
+	return nil
+}
+
+// RestoreInputs replaces input references in txs with the calldata from store
+func RestoreInputs(ctx context.Context, store InputStore, txs []RespNormalTx) error {
+	for i := range txs {
+		hash, ok := strings.CutPrefix(txs[i].Input, InputRefPrefix)
+		if !ok {
+			continue
+		}
+		data, found, err := store.Get(ctx, hash)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("etherscan: input %s of tx %s not found in store", hash, txs[i].Hash)
+		}
+		txs[i].Input = "0x" + hex.EncodeToString(data)
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package etherscan
+
+import (
+	"context"
+	"testing"
+)
+
+func TestArchiveAndRestoreInputs(t *testing.T) {
+	approve := "0x095ea7b30000000000000000000000007a250d5630b4cf539739df2c5dacb4c659f2488dffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"
+	txs := []RespNormalTx{
+		{Hash: "0x1", Input: approve},
+		{Hash: "0x2", Input: "0x"},
+		{Hash: "0x3", Input: approve},
+	}
+
+	ctx := context.Background()
+	store := NewMemoryInputStore()
+	if err := ArchiveInputs(ctx, store, txs); err != nil {
+		t.Fatalf("ArchiveInputs failed: %v", err)
+	}
+	if store.Len() != 1 {
+		t.Errorf("expected 1 distinct blob, got %d", store.Len())
+	}
+	if txs[0].Input != txs[2].Input || txs[1].Input != "0x" {
+		t.Errorf("unexpected archived inputs: %q %q %q", txs[0].Input, txs[1].Input, txs[2].Input)
+	}
+
+	if err := RestoreInputs(ctx, store, txs); err != nil {
+		t.Fatalf("RestoreInputs failed: %v", err)
+	}
+	if txs[0].Input != approve || txs[2].Input != approve {
+		t.Error("restored input does not match original")
+	}
+
+	missing := []RespNormalTx{{Hash: "0x4", Input: InputRefPrefix + "00"}}
+	if err := RestoreInputs(ctx, store, missing); err == nil {
+		t.Error("expected error for missing blob")
+	}
+}
+
+func TestMemoryInputStoreCopies(t *testing.T) {
+	store := NewMemoryInputStore()
+	ctx := context.Background()
+	data := []byte{1, 2, 3}
+	if err := store.Put(ctx, "h", data); err != nil {
+		t.Fatal(err)
+	}
+	data[0] = 9
+
+	got, ok, err := store.Get(ctx, "h")
+	if err != nil || !ok || got[0] != 1 {
+		t.Fatalf("expected the stored blob, got %v %v %v", got, ok, err)
+	}
+	got[0] = 9
+	if again, _, _ := store.Get(ctx, "h"); again[0] != 1 {
+		t.Errorf("mutating a returned blob changed the store: %v", again)
+	}
+}
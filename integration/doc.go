@@ -0,0 +1,27 @@
+// Package integration exercises every endpoint wrapper against the live Etherscan API
+//
+// The suite is opt-in: it is built only with the integration tag and needs an
+// API key, so the default go test ./... never touches the network through it.
+//
+//	ETHERSCAN_API_KEY=... go test -tags integration ./integration
+//
+// Each case calls one client method once, through a fixture.Recorder, and
+// checks that the call succeeds, that the raw response decodes losslessly
+// into the method's response type (see fixture.Check), that no schema warning
+// is raised, and that the response still has the shape recorded in its golden
+// file, testdata/<Method>.json. Golden files keep the structure of a response
+// and only the values of fields listed as stable for the case, so balances,
+// prices and other dynamic data do not fail the suite; run with -update to
+// rewrite them after reviewing a change.
+//
+// The environment tunes a run:
+//
+//   - ETHERSCAN_INTEGRATION_BUDGET: the credits the run may spend, 200 by
+//     default; cases that would exceed it are skipped, and the context
+//     budget stops retries from spending more
+//   - ETHERSCAN_INTEGRATION_PRO: set to 1 to also run the API Pro endpoints
+//   - ETHERSCAN_INTEGRATION_SKIP: comma-separated methods to skip, e.g.
+//     endpoints a plan or chain does not offer
+//   - ETHERSCAN_INTEGRATION_TIER: the API tier used for rate limiting,
+//     "free" by default
+package integration
@@ -0,0 +1,660 @@
+//go:build integration
+
+package integration
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/dwdwow/etherscan-go"
+	"github.com/dwdwow/etherscan-go/fixture"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files from the live responses")
+
+// defaultBudget is the credits a run may spend when ETHERSCAN_INTEGRATION_BUDGET is unset
+const defaultBudget = 200
+
+// Addresses, transactions and blocks from the Etherscan API documentation, whose data does not change
+const (
+	vitalik        = "0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045"
+	usdt           = "0xdAC17F958D2ee523a2206206994597C13D831ec7"
+	usdc           = "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"
+	maker          = "0x57d90b64a1a57749b0f932f1a3395792e12e7055"
+	makerHolder    = "0xe04f27eb70e025b78871a2ad7eabe85e61212761"
+	cryptoKitties  = "0x06012c8cf97bead5deae237070f9587f8e7a266d"
+	kittyOwner     = "0x6975be450864c02b4613023c2152ee0743572325"
+	erc1155        = "0x76be3b62873462d2142405439777e971754e8e77"
+	erc1155Owner   = "0x83f564d180b58ad9a02a449105568189ee7de8cb"
+	nftContract    = "0xed5af388653567af2f388e6224dc7c4b3241c544"
+	nftOwner       = "0x123432244443b54409430979df8333f9308a6040"
+	validator      = "0x9dd134d14d1e65f84b706d6f205cd5b1cd03a46b"
+	withdrawer     = "0xB9D7934878B5FB9610B3fE8A5e441e8fad7E293f"
+	logEmitter     = "0xbd3531da5cf5857e7cfaa92426877b022e612cf8"
+	plasmaUser     = "0x4880bd4695a8e59dc527d124085749744b6c988e"
+	storageHolder  = "0x6e03d9cce9d60f3e9f2597e13cd4c54c55330cfd"
+	callTarget     = "0xAEEF46DB4855E25702F8237E8f403FddcaF931C0"
+	callData       = "0x70a08231000000000000000000000000e16359506c028e51f16be38986ec5746251e9724"
+	estimateTarget = "0xf0160428a8552ac9bb7e050d90eeade4ddd52843"
+	transferTopic  = "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"
+	sampleTx       = "0xbc78ab8a9e9a0bca7d0321a27b2c03addeae08ba81ea98b03cd3dd237eabed44"
+	failedTx       = "0x15f8e5ea1079d9a0bb04a4c58ae5fe7654b5b2b4463375ff7ffb490aa0032f3a"
+	receiptTx      = "0x513c1ba0bebf66436b5fed86ab668452b7805593c05073eb2d51d3a52f480a76"
+	internalTx     = "0x40eb908387324f2b575b4879cd9d7188f69c8fc9d87c901b9e2daaea4b442170"
+	logBlock       = 12878196
+	startDate      = "2023-01-01"
+	endDate        = "2023-01-02"
+)
+
+// endpointCase is one call of one client method
+type endpointCase struct {
+	// method is the client method called, a key of fixture.Types
+	method string
+
+	// module and action price the call with etherscan.EstimateCredits; both are empty for calls outside the API
+	module, action string
+
+	// pro marks API Pro endpoints that etherscan.ActionCosts does not list under module.action
+	pro bool
+
+	// skip is the reason the case never runs, such as a call that writes
+	skip string
+
+	// raw marks responses that are not JSON envelopes, only checked for success
+	raw bool
+
+	// stable lists the paths of the golden shape kept by value, see shapeOf
+	stable []string
+
+	call func(ctx context.Context, c *etherscan.HTTPClient) error
+}
+
+// cases covers every endpoint wrapper; the *Big variants share their endpoint with the string ones
+var cases = []endpointCase{
+	// Account
+	{method: "GetEthBalance", module: "account", action: "balance", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetEthBalance(ctx, vitalik, nil))
+	}},
+	{method: "GetEthBalances", module: "account", action: "balancemulti", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetEthBalances(ctx, []string{vitalik, usdt}, nil))
+	}},
+	{method: "GetEthBalanceByBlockNumber", module: "account", action: "balancehistory", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetEthBalanceByBlockNumber(ctx, vitalik, 8000000, nil))
+	}, stable: []string{"$"}},
+	{method: "GetNormalTxs", module: "account", action: "txlist", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetNormalTxs(ctx, vitalik, &etherscan.GetNormalTxsOpts{Offset: 2, Sort: etherscan.SortAsc}))
+	}, stable: []string{"$[].hash", "$[].blockNumber"}},
+	{method: "GetInternalTxsByAddress", module: "account", action: "txlistinternal", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetInternalTxsByAddress(ctx, vitalik, &etherscan.GetInternalTxsByAddressOpts{Offset: 2, Sort: etherscan.SortAsc}))
+	}},
+	{method: "GetInternalTxsByHash", module: "account", action: "txlistinternal", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetInternalTxsByHash(ctx, internalTx, nil))
+	}, stable: []string{"$[].blockNumber", "$[].value"}},
+	{method: "GetInternalTxsByBlockRange", module: "account", action: "txlistinternal", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetInternalTxsByBlockRange(ctx, 13481773, 13491773, &etherscan.GetInternalTxsByBlockRangeOpts{Offset: 2, Sort: etherscan.SortAsc}))
+	}},
+	{method: "GetERC20TokenTransfers", module: "account", action: "tokentx", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetERC20TokenTransfers(ctx, &etherscan.GetERC20TokenTransfersOpts{Address: vitalik, Offset: 2, Sort: etherscan.SortAsc}))
+	}},
+	{method: "GetERC721TokenTransfers", module: "account", action: "tokennfttx", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetERC721TokenTransfers(ctx, &etherscan.GetERC721TokenTransfersOpts{Address: kittyOwner, ContractAddress: cryptoKitties, Offset: 2, Sort: etherscan.SortAsc}))
+	}, stable: []string{"$[].hash", "$[].tokenID"}},
+	{method: "GetERC1155TokenTransfers", module: "account", action: "token1155tx", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetERC1155TokenTransfers(ctx, &etherscan.GetERC1155TokenTransfersOpts{Address: erc1155Owner, ContractAddress: erc1155, Offset: 2, Sort: etherscan.SortAsc}))
+	}, stable: []string{"$[].hash"}},
+	{method: "GetAddressFundedBy", module: "account", action: "fundedby", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetAddressFundedBy(ctx, vitalik, nil))
+	}, stable: []string{"$.fundingAddress", "$.fundingTxn"}},
+	{method: "GetBlocksValidatedByAddress", module: "account", action: "getminedblocks", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetBlocksValidatedByAddress(ctx, validator, &etherscan.GetBlocksValidatedByAddressOpts{Offset: 2}))
+	}},
+	{method: "GetBeaconChainWithdrawals", module: "account", action: "txsBeaconWithdrawal", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetBeaconChainWithdrawals(ctx, withdrawer, &etherscan.GetBeaconChainWithdrawalsOpts{Offset: 2, Sort: etherscan.SortAsc}))
+	}, stable: []string{"$[].withdrawalIndex"}},
+	{method: "GetContractCreatorAndCreation", module: "contract", action: "getcontractcreation", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetContractCreatorAndCreation(ctx, []string{usdt}, nil))
+	}, stable: []string{"$[].contractCreator", "$[].txHash"}},
+
+	// Transactions
+	{method: "GetContractExecutionStatus", module: "transaction", action: "getstatus", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetContractExecutionStatus(ctx, failedTx, nil))
+	}, stable: []string{"$.isError", "$.errDescription"}},
+	{method: "GetTxReceiptStatus", module: "transaction", action: "gettxreceiptstatus", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetTxReceiptStatus(ctx, receiptTx, nil))
+	}, stable: []string{"$.status"}},
+
+	// Layer 2 and sidechains
+	{method: "GetPlasmaDeposits", module: "account", action: "txnbridge", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetPlasmaDeposits(ctx, plasmaUser, &etherscan.GetPlasmaDepositsOpts{ChainID: etherscan.PolygonMainnet, Offset: 2}))
+	}},
+	{method: "GetBridgeTxs", module: "account", action: "txnbridge", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetBridgeTxs(ctx, vitalik, &etherscan.GetBridgeTxsOpts{ChainID: etherscan.Gnosis, Offset: 2}))
+	}},
+	{method: "GetDepositTxs", module: "account", action: "getdeposittxs", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetDepositTxs(ctx, vitalik, &etherscan.GetDepositTxsOpts{ChainID: etherscan.OPMainnet, Offset: 2}))
+	}},
+	{method: "GetWithdrawalTxs", module: "account", action: "getwithdrawaltxs", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetWithdrawalTxs(ctx, vitalik, &etherscan.GetWithdrawalTxsOpts{ChainID: etherscan.OPMainnet, Offset: 2}))
+	}},
+
+	// Tokens
+	{method: "GetERC20TotalSupply", module: "stats", action: "tokensupply", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetERC20TotalSupply(ctx, usdc, nil))
+	}},
+	{method: "GetERC20AccountBalance", module: "account", action: "tokenbalance", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetERC20AccountBalance(ctx, usdc, vitalik, nil))
+	}},
+	{method: "GetERC20HistoricalTotalSupply", module: "stats", action: "tokensupplyhistory", pro: true, call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetERC20HistoricalTotalSupply(ctx, maker, 8000000, nil))
+	}, stable: []string{"$"}},
+	{method: "GetERC20HistoricalAccountBalance", module: "account", action: "tokenbalancehistory", pro: true, call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetERC20HistoricalAccountBalance(ctx, maker, makerHolder, 8000000, nil))
+	}, stable: []string{"$"}},
+	{method: "GetERC20Holders", module: "token", action: "tokenholderlist", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetERC20Holders(ctx, usdt, &etherscan.GetERC20HoldersOpts{Offset: 2}))
+	}},
+	{method: "GetERC20HolderCount", module: "token", action: "tokenholdercount", pro: true, call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetERC20HolderCount(ctx, usdt, nil))
+	}},
+	{method: "GetERC20HolderDistribution", module: "token", action: "tokenholderchart", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetERC20HolderDistribution(ctx, usdt, "30d", nil))
+	}},
+	{method: "GetTopERC20Holders", module: "token", action: "topholders", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetTopERC20Holders(ctx, usdt, 2, nil))
+	}},
+	{method: "GetTokenInfo", module: "token", action: "tokeninfo", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetTokenInfo(ctx, usdt, nil))
+	}, stable: []string{"$[].contractAddress", "$[].symbol", "$[].divisor"}},
+	{method: "GetAccountERC20Holdings", module: "account", action: "addresstokenbalance", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetAccountERC20Holdings(ctx, vitalik, &etherscan.GetAccountERC20HoldingsOpts{Offset: 2}))
+	}},
+	{method: "GetAccountNFTHoldings", module: "account", action: "addresstokennftbalance", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetAccountNFTHoldings(ctx, nftOwner, &etherscan.GetAccountNFTHoldingsOpts{Offset: 2}))
+	}},
+	{method: "GetAccountNFTInventories", module: "account", action: "addresstokennftinventory", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetAccountNFTInventories(ctx, nftOwner, nftContract, &etherscan.GetAccountNFTInventoriesOpts{Offset: 2}))
+	}},
+
+	// Contracts
+	{method: "GetContractABI", module: "contract", action: "getabi", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetContractABI(ctx, usdt, nil))
+	}, stable: []string{"$"}},
+	{method: "GetContractSourceCode", module: "contract", action: "getsourcecode", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetContractSourceCode(ctx, usdt, nil))
+	}, stable: []string{"$[].ContractName", "$[].CompilerVersion"}},
+	{method: "VerifySourceCode", skip: "submits a verification"},
+	{method: "VerifyVyperSourceCode", skip: "submits a verification"},
+	{method: "VerifyStylusSourceCode", skip: "submits a verification"},
+	{method: "CheckSourceCodeVerificationStatus", skip: "needs the GUID of a fresh verification"},
+
+	// Blocks
+	{method: "GetBlockAndUncleRewards", module: "block", action: "getblockreward", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetBlockAndUncleRewards(ctx, 2165403, nil))
+	}, stable: []string{"$.blockNumber", "$.blockMiner", "$.blockReward", "$.uncles"}},
+	{method: "GetBlockTxsCount", module: "block", action: "getblocktxnscount", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetBlockTxsCount(ctx, 2165403, nil))
+	}, stable: []string{"$"}},
+	{method: "GetBlockCountdownTime", module: "block", action: "getblockcountdown", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetBlockCountdownTime(ctx, 99999999, nil))
+	}},
+	{method: "GetBlockNumberByTimestamp", module: "block", action: "getblocknobytime", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetBlockNumberByTimestamp(ctx, 1578638524, etherscan.ClosestBefore, nil))
+	}, stable: []string{"$"}},
+	{method: "GetDailyAvgBlockSizes", module: "stats", action: "dailyavgblocksize", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetDailyAvgBlockSizes(ctx, startDate, endDate, nil))
+	}, stable: []string{"$"}},
+
+	// Logs
+	{method: "GetEventLogsByAddress", module: "logs", action: "getLogs", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetEventLogsByAddress(ctx, logEmitter, &etherscan.GetEventLogsByAddressOpts{FromBlock: logBlock, ToBlock: logBlock, Offset: 2}))
+	}, stable: []string{"$[].transactionHash", "$[].topics"}},
+	{method: "GetEventLogsByTopics", module: "logs", action: "getLogs", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetEventLogsByTopics(ctx, &etherscan.GetEventLogsByTopicsOpts{FromBlock: logBlock, ToBlock: logBlock, Topic0: transferTopic, Offset: 2}))
+	}},
+	{method: "GetEventLogsByAddressFilteredByTopics", module: "logs", action: "getLogs", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetEventLogsByAddressFilteredByTopics(ctx, logEmitter, &etherscan.GetEventLogsByAddressFilteredByTopicsOpts{FromBlock: logBlock, ToBlock: logBlock, Topic0: transferTopic, Offset: 2}))
+	}, stable: []string{"$[].transactionHash"}},
+
+	// Gas
+	{method: "GetConfirmationTimeEstimate", module: "gastracker", action: "gasestimate", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetConfirmationTimeEstimate(ctx, 2000000000, nil))
+	}},
+	{method: "GetGasOracle", module: "gastracker", action: "gasoracle", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetGasOracle(ctx, nil))
+	}},
+	{method: "GetDailyAverageGasLimit", module: "stats", action: "dailyavggaslimit", pro: true, call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetDailyAverageGasLimit(ctx, startDate, endDate, nil))
+	}, stable: []string{"$"}},
+	{method: "GetDailyTotalGasUsed", module: "stats", action: "dailygasused", pro: true, call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetDailyTotalGasUsed(ctx, startDate, endDate, nil))
+	}, stable: []string{"$"}},
+	{method: "GetDailyAverageGasPrice", module: "stats", action: "dailyavggasprice", pro: true, call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetDailyAverageGasPrice(ctx, startDate, endDate, nil))
+	}, stable: []string{"$"}},
+
+	// Stats
+	{method: "GetTotalEthSupply", module: "stats", action: "ethsupply", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetTotalEthSupply(ctx, nil))
+	}},
+	{method: "GetTotalEth2Supply", module: "stats", action: "ethsupply2", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetTotalEth2Supply(ctx, nil))
+	}},
+	{method: "GetEthPrice", module: "stats", action: "ethprice", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetEthPrice(ctx, nil))
+	}},
+	{method: "GetEthHistoricalPrices", module: "stats", action: "ethdailyprice", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetEthHistoricalPrices(ctx, startDate, endDate, nil))
+	}, stable: []string{"$"}},
+	{method: "GetEthDailyMarketCaps", module: "stats", action: "ethdailymarketcap", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetEthDailyMarketCaps(ctx, startDate, endDate, nil))
+	}, stable: []string{"$"}},
+	{method: "GetEthereumNodesSize", module: "stats", action: "chainsize", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetEthereumNodesSize(ctx, startDate, endDate, "geth", "default", etherscan.SortAsc, nil))
+	}},
+	{method: "GetNodeCount", module: "stats", action: "nodecount", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetNodeCount(ctx, nil))
+	}},
+	{method: "GetDailyBlockCountRewards", module: "stats", action: "dailyblkcount", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetDailyBlockCountRewards(ctx, startDate, endDate, nil))
+	}, stable: []string{"$"}},
+	{method: "GetDailyBlockRewards", module: "stats", action: "dailyblockrewards", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetDailyBlockRewards(ctx, startDate, endDate, nil))
+	}, stable: []string{"$"}},
+	{method: "GetDailyAvgBlockTime", module: "stats", action: "dailyavgblocktime", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetDailyAvgBlockTime(ctx, startDate, endDate, nil))
+	}, stable: []string{"$"}},
+	{method: "GetDailyUncleBlockCountAndRewards", module: "stats", action: "dailyuncleblkcount", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetDailyUncleBlockCountAndRewards(ctx, startDate, endDate, nil))
+	}, stable: []string{"$"}},
+	{method: "GetDailyTxFees", module: "stats", action: "dailytxnfee", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetDailyTxFees(ctx, startDate, endDate, nil))
+	}, stable: []string{"$"}},
+	{method: "GetDailyNewAddresses", module: "stats", action: "dailynewaddress", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetDailyNewAddresses(ctx, startDate, endDate, nil))
+	}, stable: []string{"$"}},
+	{method: "GetDailyNetworkUtilizations", module: "stats", action: "dailynetutilization", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetDailyNetworkUtilizations(ctx, startDate, endDate, nil))
+	}, stable: []string{"$"}},
+	{method: "GetDailyAvgHashrates", module: "stats", action: "dailyavghashrate", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetDailyAvgHashrates(ctx, startDate, endDate, nil))
+	}, stable: []string{"$"}},
+	{method: "GetDailyTxCounts", module: "stats", action: "dailytx", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetDailyTxCounts(ctx, startDate, endDate, nil))
+	}, stable: []string{"$"}},
+	{method: "GetDailyAvgDifficulties", module: "stats", action: "dailyavgnetdifficulty", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetDailyAvgDifficulties(ctx, startDate, endDate, nil))
+	}, stable: []string{"$"}},
+
+	// Proxy
+	{method: "RpcEthBlockNumber", module: "proxy", action: "eth_blockNumber", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.RpcEthBlockNumber(ctx, nil))
+	}},
+	{method: "RpcEthBlockByNumber", module: "proxy", action: "eth_getBlockByNumber", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.RpcEthBlockByNumber(ctx, etherscan.BlockNumberTag(68943), nil))
+	}, stable: []string{"$.hash", "$.number", "$.transactions"}},
+	{method: "RpcEthBlockByNumberWithFullTxs", module: "proxy", action: "eth_getBlockByNumber", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.RpcEthBlockByNumberWithFullTxs(ctx, etherscan.BlockNumberTag(68943), nil))
+	}, stable: []string{"$.hash", "$.transactions[].hash"}},
+	{method: "RpcEthUncleByBlockNumberAndIndex", module: "proxy", action: "eth_getUncleByBlockNumberAndIndex", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.RpcEthUncleByBlockNumberAndIndex(ctx, etherscan.BlockNumberTag(12989046), "0x0", nil))
+	}, stable: []string{"$.hash"}},
+	{method: "RpcEthBlockTxCountByNumber", module: "proxy", action: "eth_getBlockTransactionCountByNumber", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.RpcEthBlockTxCountByNumber(ctx, etherscan.BlockNumberTag(1113976), nil))
+	}, stable: []string{"$"}},
+	{method: "RpcEthTxByHash", module: "proxy", action: "eth_getTransactionByHash", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.RpcEthTxByHash(ctx, sampleTx, nil))
+	}, stable: []string{"$.hash", "$.blockNumber", "$.from", "$.to"}},
+	{method: "RpcEthTxByBlockNumberAndIndex", module: "proxy", action: "eth_getTransactionByBlockNumberAndIndex", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.RpcEthTxByBlockNumberAndIndex(ctx, etherscan.BlockNumberTag(12989213), "0x11a", nil))
+	}, stable: []string{"$.hash"}},
+	{method: "RpcEthTxCount", module: "proxy", action: "eth_getTransactionCount", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.RpcEthTxCount(ctx, vitalik, etherscan.BlockTagLatest, nil))
+	}},
+	{method: "RpcEthSendRawTx", skip: "broadcasts a transaction"},
+	{method: "RpcEthTxReceipt", module: "proxy", action: "eth_getTransactionReceipt", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.RpcEthTxReceipt(ctx, sampleTx, nil))
+	}, stable: []string{"$.transactionHash", "$.status", "$.gasUsed"}},
+	{method: "RpcEthCall", module: "proxy", action: "eth_call", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.RpcEthCall(ctx, callTarget, callData, nil))
+	}},
+	{method: "RpcEthGetCode", module: "proxy", action: "eth_getCode", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.RpcEthGetCode(ctx, usdt, nil))
+	}, stable: []string{"$"}},
+	{method: "RpcEthGetStorageAt", module: "proxy", action: "eth_getStorageAt", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.RpcEthGetStorageAt(ctx, storageHolder, "0x0", nil))
+	}},
+	{method: "RpcEthGetProof", module: "proxy", action: "eth_getProof", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.RpcEthGetProof(ctx, usdt, []string{"0x0"}, etherscan.BlockTagLatest, nil))
+	}},
+	{method: "RpcEthGetGasPrice", module: "proxy", action: "eth_gasPrice", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.RpcEthGetGasPrice(ctx, nil))
+	}},
+	{method: "RpcEthEstimateGas", module: "proxy", action: "eth_estimateGas", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.RpcEthEstimateGas(ctx, estimateTarget, "0x4e71d92d", nil))
+	}},
+
+	// Metadata and usage
+	{method: "GetAddressTag", module: "nametag", action: "getaddresstag", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetAddressTag(ctx, []string{usdt}, nil))
+	}, stable: []string{"$[].address"}},
+	{method: "GetLabelMasterlist", module: "nametag", action: "getlabelmasterlist", pro: true, call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetLabelMasterlist(ctx, nil))
+	}},
+	{method: "GetLatestCSVBatchNumber", module: "nametag", action: "getcurrentbatch", pro: true, call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetLatestCSVBatchNumber(ctx, nil))
+	}},
+	{method: "ExportSpecificLabelCSV", module: "nametag", action: "exportaddresstags", pro: true, raw: true, call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.ExportSpecificLabelCSV(ctx, "lido"))
+	}},
+	{method: "ExportOFACSanctionedRelatedLabelsCSV", module: "nametag", action: "exportaddresstags", pro: true, raw: true, call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.ExportOFACSanctionedRelatedLabelsCSV(ctx))
+	}},
+	{method: "ExportAllAddressTagsCSV", skip: "downloads every address tag"},
+	{method: "CheckCreditUsage", module: "getapilimit", action: "getapilimit", call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.CheckCreditUsage(ctx, nil))
+	}},
+	{method: "GetSupportedChains", raw: true, call: func(ctx context.Context, c *etherscan.HTTPClient) error {
+		return discard(c.GetSupportedChains(ctx))
+	}},
+}
+
+// discard drops the result of a call, keeping its error
+func discard[T any](_ T, err error) error {
+	return err
+}
+
+// isPro reports whether the case needs an API Pro plan
+func (tc endpointCase) isPro() bool {
+	return tc.pro || etherscan.ActionCosts[tc.module+"."+tc.action].Pro
+}
+
+// credits returns the credits one call of the case costs
+func (tc endpointCase) credits() int64 {
+	if tc.module == "" {
+		// The chain list is not an API call
+		return 0
+	}
+	return etherscan.EstimateCredits([]etherscan.PlannedCall{{Module: tc.module, Action: tc.action, Count: 1}}).TotalCredits
+}
+
+func TestEndpoints(t *testing.T) {
+	apiKey := os.Getenv("ETHERSCAN_API_KEY")
+	if apiKey == "" {
+		t.Skip("Skipping integration tests: ETHERSCAN_API_KEY environment variable not set")
+	}
+	budget := int64(defaultBudget)
+	if value := os.Getenv("ETHERSCAN_INTEGRATION_BUDGET"); value != "" {
+		var err error
+		if budget, err = strconv.ParseInt(value, 10, 64); err != nil || budget < 0 {
+			t.Fatalf("invalid ETHERSCAN_INTEGRATION_BUDGET %q", value)
+		}
+	}
+	runPro := os.Getenv("ETHERSCAN_INTEGRATION_PRO") == "1"
+	skipped := make(map[string]bool)
+	for _, method := range strings.Split(os.Getenv("ETHERSCAN_INTEGRATION_SKIP"), ",") {
+		if method = strings.TrimSpace(method); method != "" {
+			skipped[method] = true
+		}
+	}
+	tier := os.Getenv("ETHERSCAN_INTEGRATION_TIER")
+	if tier == "" {
+		tier = etherscan.FreeTier
+	}
+
+	var mu sync.Mutex
+	var warnings []etherscan.SchemaWarning
+	rec := fixture.NewRecorder(nil)
+	client := etherscan.NewHTTPClient(etherscan.HTTPClientConfig{
+		APIKey:     apiKey,
+		APITier:    tier,
+		HTTPClient: &http.Client{Transport: rec},
+		OnSchemaWarning: func(w etherscan.SchemaWarning) {
+			mu.Lock()
+			warnings = append(warnings, w)
+			mu.Unlock()
+		},
+	})
+
+	// The context budget also stops retries from spending past the credit budget
+	ctx := etherscan.WithMaxRequests(context.Background(), budget)
+	var spent int64
+	for _, tc := range cases {
+		t.Run(tc.method, func(t *testing.T) {
+			switch {
+			case tc.skip != "":
+				t.Skip(tc.skip)
+			case skipped[tc.method]:
+				t.Skip("listed in ETHERSCAN_INTEGRATION_SKIP")
+			case tc.isPro() && !runPro:
+				t.Skip("API Pro endpoint; set ETHERSCAN_INTEGRATION_PRO=1 to run it")
+			}
+			credits := tc.credits()
+			if spent+credits > budget {
+				t.Skipf("credit budget of %d exhausted", budget)
+			}
+			spent += credits
+
+			mu.Lock()
+			warnings = nil
+			mu.Unlock()
+			err := tc.call(ctx, client)
+			if errors.Is(err, etherscan.ErrBudgetExhausted) {
+				t.Skipf("credit budget of %d exhausted by retries", budget)
+			}
+			if err != nil {
+				t.Fatalf("%s failed: %v", tc.method, err)
+			}
+			mu.Lock()
+			for _, w := range warnings {
+				t.Errorf("schema warning: %s", w)
+			}
+			mu.Unlock()
+			if tc.raw {
+				return
+			}
+
+			f := rec.Last()
+			if f == nil || f.Module != tc.module || f.Action != tc.action {
+				t.Fatalf("expected a recorded %s.%s response, got %+v", tc.module, tc.action, f)
+			}
+			f.Method = tc.method
+			if err := fixture.Check(f); err != nil {
+				t.Error(err)
+			}
+			checkGolden(t, tc, f.Response)
+		})
+	}
+	t.Logf("spent %d of %d credits", spent, budget)
+}
+
+func TestCasesCoverEveryMethod(t *testing.T) {
+	covered := make(map[string]bool, len(cases))
+	for _, tc := range cases {
+		if covered[tc.method] {
+			t.Errorf("%s has more than one case", tc.method)
+		}
+		covered[tc.method] = true
+		if _, ok := fixture.Types[tc.method]; !ok && !tc.raw && tc.skip == "" {
+			t.Errorf("%s has no response type in fixture.Types", tc.method)
+		}
+	}
+	for method := range fixture.Types {
+		if !covered[method] && !strings.HasSuffix(method, "Big") {
+			t.Errorf("no case for %s", method)
+		}
+	}
+}
+
+func TestShapeTolerance(t *testing.T) {
+	golden, _ := decodeGeneric([]byte(`[{"hash":"0x01","value":"string","gas":"string","logs":["string"]}]`))
+	stable := map[string]bool{"$[].hash": true}
+	shape := func(data string) any {
+		v, _ := decodeGeneric([]byte(data))
+		return shapeOf("$", v, stable)
+	}
+
+	// Dynamic values, empty lists and new fields pass
+	if problems := compareShape("$", golden, shape(`[{"hash":"0x01","value":"7","gas":"21000","logs":[],"extra":true}]`), stable); len(problems) != 0 {
+		t.Errorf("expected a match, got %v", problems)
+	}
+	// Stable values, missing fields and changed types fail
+	problems := compareShape("$", golden, shape(`[{"hash":"0x02","value":7,"logs":[1]}]`), stable)
+	if len(problems) != 4 {
+		t.Errorf("expected 4 problems, got %v", problems)
+	}
+}
+
+// checkGolden compares the shape of a response with the golden file of its case, or rewrites it with -update
+func checkGolden(t *testing.T, tc endpointCase, response json.RawMessage) {
+	t.Helper()
+	var envelope struct {
+		Result json.RawMessage `json:"result"`
+	}
+	if err := json.Unmarshal(response, &envelope); err != nil {
+		t.Fatalf("response is not JSON: %v", err)
+	}
+	result, err := decodeGeneric(envelope.Result)
+	if err != nil {
+		t.Fatalf("result is not JSON: %v", err)
+	}
+	stable := make(map[string]bool, len(tc.stable))
+	for _, path := range tc.stable {
+		stable[path] = true
+	}
+	live := shapeOf("$", result, stable)
+
+	path := filepath.Join("testdata", tc.method+".json")
+	if *update {
+		data, err := json.MarshalIndent(live, "", "  ")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, append(data, '\n'), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		t.Logf("no golden file %s; run with -update to record it", path)
+		return
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	golden, err := decodeGeneric(data)
+	if err != nil {
+		t.Fatalf("%s: %v", path, err)
+	}
+	for _, problem := range compareShape("$", golden, live, stable) {
+		t.Errorf("%s: %s", path, problem)
+	}
+}
+
+// decodeGeneric decodes data into maps, slices and json.Numbers
+func decodeGeneric(data []byte) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v any
+	err := dec.Decode(&v)
+	return v, err
+}
+
+// shapeOf reduces a decoded result to its structure, keeping the values at the stable paths
+//
+// Paths start at "$" for the result, add ".field" for object fields and "[]"
+// for array elements, e.g. "$[].hash". Leaves outside stable paths become
+// "string", "number" or "bool", and arrays keep their first element only.
+func shapeOf(path string, v any, stable map[string]bool) any {
+	if stable[path] {
+		return v
+	}
+	switch v := v.(type) {
+	case map[string]any:
+		shape := make(map[string]any, len(v))
+		for key, value := range v {
+			shape[key] = shapeOf(path+"."+key, value, stable)
+		}
+		return shape
+	case []any:
+		if len(v) == 0 {
+			return []any{}
+		}
+		return []any{shapeOf(path+"[]", v[0], stable)}
+	case string:
+		return "string"
+	case json.Number:
+		return "number"
+	case bool:
+		return "bool"
+	default:
+		return nil
+	}
+}
+
+// compareShape lists the differences of a live shape from a golden one
+//
+// Differences that depend on the data are tolerated: nulls on either side,
+// empty arrays, and fields the golden shape does not have. Values at stable
+// paths must be equal.
+func compareShape(path string, golden, live any, stable map[string]bool) []string {
+	if golden == nil || live == nil {
+		return nil
+	}
+	if stable[path] {
+		if !reflect.DeepEqual(golden, live) {
+			return []string{fmt.Sprintf("%s: expected %v, got %v", path, golden, live)}
+		}
+		return nil
+	}
+	switch want := golden.(type) {
+	case map[string]any:
+		got, ok := live.(map[string]any)
+		if !ok {
+			return []string{fmt.Sprintf("%s: expected an object, got %v", path, live)}
+		}
+		keys := make([]string, 0, len(want))
+		for key := range want {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		var problems []string
+		for _, key := range keys {
+			value, ok := got[key]
+			if !ok {
+				problems = append(problems, fmt.Sprintf("%s.%s: field missing from the response", path, key))
+				continue
+			}
+			problems = append(problems, compareShape(path+"."+key, want[key], value, stable)...)
+		}
+		return problems
+	case []any:
+		got, ok := live.([]any)
+		if !ok {
+			return []string{fmt.Sprintf("%s: expected an array, got %v", path, live)}
+		}
+		if len(want) == 0 || len(got) == 0 {
+			return nil
+		}
+		return compareShape(path+"[]", want[0], got[0], stable)
+	default:
+		if golden != live {
+			return []string{fmt.Sprintf("%s: expected %v, got %v", path, golden, live)}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,114 @@
+package etherscan
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"math/bits"
+)
+
+// ============================================================================
+// Keccak-256
+// ============================================================================
+
+// keccakRate is the sponge rate of Keccak-256 in bytes
+const keccakRate = 136
+
+// keccakRoundConstants are the iota constants of the 24 Keccak-f[1600] rounds
+var keccakRoundConstants = [24]uint64{
+	0x0000000000000001, 0x0000000000008082, 0x800000000000808a, 0x8000000080008000,
+	0x000000000000808b, 0x0000000080000001, 0x8000000080008081, 0x8000000000008009,
+	0x000000000000008a, 0x0000000000000088, 0x0000000080008009, 0x000000008000000a,
+	0x000000008000808b, 0x800000000000008b, 0x8000000000008089, 0x8000000000008003,
+	0x8000000000008002, 0x8000000000000080, 0x000000000000800a, 0x800000008000000a,
+	0x8000000080008081, 0x8000000000008080, 0x0000000080000001, 0x8000000080008008,
+}
+
+// keccakRotations are the rho offsets, indexed like the state (x + 5y)
+var keccakRotations = [25]int{
+	0, 1, 62, 28, 27,
+	36, 44, 6, 55, 20,
+	3, 10, 43, 25, 39,
+	41, 45, 15, 21, 8,
+	18, 2, 61, 56, 14,
+}
+
+// Keccak256 returns the Keccak-256 hash of the concatenated data
+//
+// This is the original Keccak padding used by Ethereum for selectors, event
+// topics and address checksums, not the standardized SHA3-256 of crypto/sha3.
+func Keccak256(data ...[]byte) []byte {
+	return keccakSponge256(0x01, data...)
+}
+
+// keccakSponge256 hashes data with the 256-bit Keccak sponge and domain padding byte pad
+//
+// Keccak-256 pads with 0x01, SHA3-256 with 0x06.
+func keccakSponge256(pad byte, data ...[]byte) []byte {
+	var state [25]uint64
+	var block [keccakRate]byte
+	n := 0
+	absorb := func() {
+		for i := 0; i < keccakRate/8; i++ {
+			state[i] ^= binary.LittleEndian.Uint64(block[8*i:])
+		}
+		keccakF1600(&state)
+		n = 0
+	}
+	for _, d := range data {
+		for len(d) > 0 {
+			copied := copy(block[n:], d)
+			n += copied
+			d = d[copied:]
+			if n == keccakRate {
+				absorb()
+			}
+		}
+	}
+	clear(block[n:])
+	block[n] ^= pad
+	block[keccakRate-1] ^= 0x80
+	absorb()
+
+	out := make([]byte, 32)
+	for i := 0; i < 4; i++ {
+		binary.LittleEndian.PutUint64(out[8*i:], state[i])
+	}
+	return out
+}
+
+// MethodSelector returns the 4-byte selector of a canonical signature such as "transfer(address,uint256)"
+func MethodSelector(signature string) string {
+	return "0x" + hex.EncodeToString(Keccak256([]byte(signature))[:4])
+}
+
+// keccakF1600 applies the Keccak-f[1600] permutation to state
+func keccakF1600(state *[25]uint64) {
+	var c [5]uint64
+	var b [25]uint64
+	for round := 0; round < 24; round++ {
+		// theta
+		for x := 0; x < 5; x++ {
+			c[x] = state[x] ^ state[x+5] ^ state[x+10] ^ state[x+15] ^ state[x+20]
+		}
+		for x := 0; x < 5; x++ {
+			d := c[(x+4)%5] ^ bits.RotateLeft64(c[(x+1)%5], 1)
+			for y := 0; y < 25; y += 5 {
+				state[x+y] ^= d
+			}
+		}
+		// rho and pi
+		for x := 0; x < 5; x++ {
+			for y := 0; y < 5; y++ {
+				b[y+5*((2*x+3*y)%5)] = bits.RotateLeft64(state[x+5*y], keccakRotations[x+5*y])
+			}
+		}
+		// chi
+		for y := 0; y < 25; y += 5 {
+			for x := 0; x < 5; x++ {
+				state[x+y] = b[x+y] ^ (^b[(x+1)%5+y] & b[(x+2)%5+y])
+			}
+		}
+		// iota
+		state[0] ^= keccakRoundConstants[round]
+	}
+}
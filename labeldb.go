@@ -0,0 +1,343 @@
+package etherscan
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ============================================================================
+// Local Label Database
+// ============================================================================
+
+// LabelDB is an in-memory index of address name tags and labels
+//
+// It is loaded from local datasets such as the CSV produced by
+// ExportAllAddressTagsCSV / ExportSpecificLabelCSV, or JSON lists in the
+// RespAddressTag shape, and answers lookups without touching the API. Loading
+// several datasets merges them: labels are unioned and non-empty fields of later
+// records overwrite earlier ones.
+//
+// LabelDB is safe for concurrent use.
+type LabelDB struct {
+	tags    map[string]RespAddressTag
+	byLabel map[string]map[string]struct{}
+	mu      sync.RWMutex
+}
+
+// NewLabelDB creates an empty label database
+func NewLabelDB() *LabelDB {
+	return &LabelDB{
+		tags:    make(map[string]RespAddressTag),
+		byLabel: make(map[string]map[string]struct{}),
+	}
+}
+
+// labelCSVColumns maps normalized CSV header names to RespAddressTag fields
+var labelCSVColumns = map[string]string{
+	"address":          "address",
+	"nametag":          "nametag",
+	"name":             "nametag",
+	"internalnametag":  "internal_nametag",
+	"labels":           "labels",
+	"label":            "labels",
+	"url":              "url",
+	"website":          "url",
+	"shortdescription": "shortdescription",
+	"description":      "shortdescription",
+	"notes1":           "notes_1",
+	"notes2":           "notes_2",
+	"reputation":       "reputation",
+}
+
+// LoadCSV loads a labels CSV with a header row and returns the number of records read
+//
+// Columns are matched by name, case-insensitively and ignoring spaces and
+// underscores ("Name Tag", "name_tag" and "nametag" are equivalent). Only the
+// address column is required. Multiple labels in one cell are separated by ";"
+// or ",". extraLabels are added to every record, which is useful for exports of
+// a single label category that do not repeat the label per row.
+//
+// Example:
+//
+//	csvData, _ := client.ExportSpecificLabelCSV(ctx, "exchange")
+//	db := NewLabelDB()
+//	n, err := db.LoadCSV(bytes.NewReader(csvData), "exchange")
+func (db *LabelDB) LoadCSV(r io.Reader, extraLabels ...string) (int, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("etherscan: read labels csv header: %w", err)
+	}
+
+	columns := make(map[string]int)
+	for i, name := range header {
+		name = strings.TrimPrefix(strings.TrimSpace(name), "\ufeff")
+		key := strings.NewReplacer(" ", "", "_", "", "-", "").Replace(strings.ToLower(name))
+		if field, ok := labelCSVColumns[key]; ok {
+			if _, dup := columns[field]; !dup {
+				columns[field] = i
+			}
+		}
+	}
+	if _, ok := columns["address"]; !ok {
+		return 0, fmt.Errorf("etherscan: labels csv has no address column")
+	}
+
+	cell := func(record []string, field string) string {
+		i, ok := columns[field]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	count := 0
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return count, fmt.Errorf("etherscan: read labels csv: %w", err)
+		}
+
+		tag := RespAddressTag{
+			Address:          cell(record, "address"),
+			Nametag:          cell(record, "nametag"),
+			InternalNametag:  cell(record, "internal_nametag"),
+			URL:              cell(record, "url"),
+			ShortDescription: cell(record, "shortdescription"),
+			Notes1:           cell(record, "notes_1"),
+			Notes2:           cell(record, "notes_2"),
+			Labels:           append(splitLabels(cell(record, "labels")), extraLabels...),
+		}
+		if rep := cell(record, "reputation"); rep != "" {
+			tag.Reputation, _ = strconv.ParseInt(rep, 10, 64)
+		}
+		if tag.Address == "" {
+			continue
+		}
+		db.Add(tag)
+		count++
+	}
+	return count, nil
+}
+
+// LoadJSON loads a JSON array of RespAddressTag records and returns the number of records read
+func (db *LabelDB) LoadJSON(r io.Reader) (int, error) {
+	var tags []RespAddressTag
+	if err := json.NewDecoder(r).Decode(&tags); err != nil {
+		return 0, fmt.Errorf("etherscan: decode labels json: %w", err)
+	}
+
+	count := 0
+	for _, tag := range tags {
+		if tag.Address == "" {
+			continue
+		}
+		db.Add(tag)
+		count++
+	}
+	return count, nil
+}
+
+// Add inserts tag into the database, merging it with an existing record for the same address
+func (db *LabelDB) Add(tag RespAddressTag) {
+	key := strings.ToLower(strings.TrimSpace(tag.Address))
+	if key == "" {
+		return
+	}
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	merged, ok := db.tags[key]
+	if !ok {
+		merged = RespAddressTag{Address: key}
+	}
+	mergeAddressTag(&merged, tag)
+	db.tags[key] = merged
+
+	for _, label := range merged.Labels {
+		label = strings.ToLower(label)
+		if db.byLabel[label] == nil {
+			db.byLabel[label] = make(map[string]struct{})
+		}
+		db.byLabel[label][key] = struct{}{}
+	}
+}
+
+// Lookup returns the record of address (case-insensitive)
+func (db *LabelDB) Lookup(address string) (RespAddressTag, bool) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	tag, ok := db.tags[strings.ToLower(strings.TrimSpace(address))]
+	return tag, ok
+}
+
+// Match returns the records of all known addresses among addresses, in input order
+//
+// Unknown addresses are omitted, mirroring GetAddressTag which returns no entry
+// for untagged addresses.
+func (db *LabelDB) Match(addresses []string) []RespAddressTag {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	result := make([]RespAddressTag, 0, len(addresses))
+	for _, address := range addresses {
+		if tag, ok := db.tags[strings.ToLower(strings.TrimSpace(address))]; ok {
+			result = append(result, tag)
+		}
+	}
+	return result
+}
+
+// HasLabel reports whether address carries label (case-insensitive)
+func (db *LabelDB) HasLabel(address, label string) bool {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	_, ok := db.byLabel[strings.ToLower(label)][strings.ToLower(strings.TrimSpace(address))]
+	return ok
+}
+
+// AddressesWithLabel returns all addresses carrying label, sorted
+func (db *LabelDB) AddressesWithLabel(label string) []string {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	set := db.byLabel[strings.ToLower(label)]
+	addresses := make([]string, 0, len(set))
+	for address := range set {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+	return addresses
+}
+
+// Len returns the number of addresses in the database
+func (db *LabelDB) Len() int {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	return len(db.tags)
+}
+
+// GetAddressTagWithFallback returns address name tags from the API, or from db if the API is unavailable
+//
+// Addresses that the API returns no tag for are filled in from db as well, so
+// community lists can extend Etherscan's own labels. Only transient failures
+// (see IsTransientError) fall back; authentication, plan and parameter errors
+// are returned so misconfiguration is not hidden, as is context cancellation.
+//
+// Args:
+//   - ctx: Context for request cancellation and timeout
+//   - addresses: List of addresses to get tags for (maximum 100 addresses)
+//   - db: Local label database used as fallback; nil disables the fallback
+//   - opts: Optional parameters (can be nil)
+//
+// Returns:
+//   - []RespAddressTag: List of address tags with metadata
+//   - error: Error if the context is done, or a non-transient API error
+//
+// Example:
+//
+//	db := NewLabelDB()
+//	db.LoadCSV(file)
+//	tags, err := client.GetAddressTagWithFallback(ctx, addresses, db, nil)
+//
+// Note:
+//   - For bulk jobs that cannot afford the 2 calls/second throttle, call db.Match directly
+func (c *HTTPClient) GetAddressTagWithFallback(ctx context.Context, addresses []string, db *LabelDB, opts *GetAddressTagOpts) ([]RespAddressTag, error) {
+	tags, err := c.GetAddressTag(ctx, addresses, opts)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if db == nil || !IsTransientError(err) {
+			return nil, err
+		}
+		return db.Match(addresses), nil
+	}
+	if db == nil {
+		return tags, nil
+	}
+
+	found := make(map[string]struct{}, len(tags))
+	for _, tag := range tags {
+		found[strings.ToLower(tag.Address)] = struct{}{}
+	}
+	var missing []string
+	for _, address := range addresses {
+		if _, ok := found[strings.ToLower(address)]; !ok {
+			missing = append(missing, address)
+		}
+	}
+	return append(tags, db.Match(missing)...), nil
+}
+
+// mergeAddressTag copies the non-empty fields of src into dst and unions the labels
+func mergeAddressTag(dst *RespAddressTag, src RespAddressTag) {
+	setIfPresent := func(dst *string, src string) {
+		if src != "" {
+			*dst = src
+		}
+	}
+	setIfPresent(&dst.Nametag, src.Nametag)
+	setIfPresent(&dst.InternalNametag, src.InternalNametag)
+	setIfPresent(&dst.URL, src.URL)
+	setIfPresent(&dst.ShortDescription, src.ShortDescription)
+	setIfPresent(&dst.Notes1, src.Notes1)
+	setIfPresent(&dst.Notes2, src.Notes2)
+	if src.Reputation != 0 {
+		dst.Reputation = src.Reputation
+	}
+	if src.LastUpdatedTimestamp != 0 {
+		dst.LastUpdatedTimestamp = src.LastUpdatedTimestamp
+	}
+	dst.Labels = unionStrings(dst.Labels, src.Labels)
+	dst.LabelsSlug = unionStrings(dst.LabelsSlug, src.LabelsSlug)
+	dst.OtherAttributes = unionStrings(dst.OtherAttributes, src.OtherAttributes)
+}
+
+// unionStrings appends the values of b missing from a (case-insensitive)
+func unionStrings(a, b []string) []string {
+	seen := make(map[string]struct{}, len(a))
+	for _, s := range a {
+		seen[strings.ToLower(s)] = struct{}{}
+	}
+	for _, s := range b {
+		if _, ok := seen[strings.ToLower(s)]; ok || s == "" {
+			continue
+		}
+		seen[strings.ToLower(s)] = struct{}{}
+		a = append(a, s)
+	}
+	return a
+}
+
+// splitLabels splits a labels cell on ";" or ","
+func splitLabels(cell string) []string {
+	var labels []string
+	for _, label := range strings.FieldsFunc(cell, func(r rune) bool { return r == ';' || r == ',' }) {
+		if label = strings.TrimSpace(label); label != "" {
+			labels = append(labels, label)
+		}
+	}
+	return labels
+}
@@ -0,0 +1,101 @@
+package etherscan
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestLabelDB_LoadCSV(t *testing.T) {
+	csvData := "\ufeffAddress,Name Tag,Labels,Reputation\n" +
+		"0xABC0000000000000000000000000000000000001,Binance 14,\"exchange;binance\",1\n" +
+		"0xabc0000000000000000000000000000000000002,,exchange,\n" +
+		",missing address,,\n"
+
+	db := NewLabelDB()
+	n, err := db.LoadCSV(strings.NewReader(csvData), "cex")
+	if err != nil {
+		t.Fatalf("LoadCSV failed: %v", err)
+	}
+	if n != 2 || db.Len() != 2 {
+		t.Fatalf("expected 2 records, got n=%d len=%d", n, db.Len())
+	}
+
+	tag, ok := db.Lookup("0xabc0000000000000000000000000000000000001")
+	if !ok {
+		t.Fatal("expected address to be found case-insensitively")
+	}
+	if tag.Nametag != "Binance 14" || tag.Reputation != 1 {
+		t.Errorf("unexpected record: %+v", tag)
+	}
+	if len(tag.Labels) != 3 {
+		t.Errorf("expected labels exchange, binance, cex; got %v", tag.Labels)
+	}
+	if !db.HasLabel("0xABC0000000000000000000000000000000000002", "CEX") {
+		t.Error("expected extra label to apply to every row")
+	}
+	if got := db.AddressesWithLabel("exchange"); len(got) != 2 {
+		t.Errorf("expected 2 exchange addresses, got %v", got)
+	}
+}
+
+func TestLabelDB_LoadCSVNoAddressColumn(t *testing.T) {
+	db := NewLabelDB()
+	if _, err := db.LoadCSV(strings.NewReader("name,labels\nfoo,bar\n")); err == nil {
+		t.Fatal("expected error for csv without address column")
+	}
+}
+
+func TestLabelDB_Merge(t *testing.T) {
+	db := NewLabelDB()
+	jsonData := `[{"address":"0xAAA","nametag":"Old","labels":["defi"]},{"address":"0xBBB","nametag":"Other"}]`
+	if _, err := db.LoadJSON(strings.NewReader(jsonData)); err != nil {
+		t.Fatalf("LoadJSON failed: %v", err)
+	}
+	db.Add(RespAddressTag{Address: "0xaaa", Nametag: "New", Labels: []string{"DeFi", "dex"}})
+
+	tag, _ := db.Lookup("0xAAA")
+	if tag.Nametag != "New" {
+		t.Errorf("expected later nametag to win, got %q", tag.Nametag)
+	}
+	if len(tag.Labels) != 2 {
+		t.Errorf("expected labels to be unioned case-insensitively, got %v", tag.Labels)
+	}
+
+	matched := db.Match([]string{"0xbbb", "0xccc", "0xaaa"})
+	if len(matched) != 2 || matched[0].Nametag != "Other" || matched[1].Nametag != "New" {
+		t.Errorf("unexpected match result: %+v", matched)
+	}
+}
+
+func TestGetAddressTagWithFallback(t *testing.T) {
+	status, body := http.StatusServiceUnavailable, `{"status":"0","message":"NOTOK","result":"upstream unavailable"}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+	})
+	db := NewLabelDB()
+	db.Add(RespAddressTag{Address: "0xAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA", Nametag: "Local"})
+	ctx := context.Background()
+
+	tags, err := client.GetAddressTagWithFallback(ctx, []string{testAddr}, db, nil)
+	if err != nil || len(tags) != 1 || tags[0].Nametag != "Local" {
+		t.Errorf("expected the local tag on a 503, got %+v, %v", tags, err)
+	}
+	if _, err := client.GetAddressTagWithFallback(ctx, []string{testAddr}, nil, nil); err == nil {
+		t.Error("expected the API error without a fallback database")
+	}
+
+	status, body = http.StatusOK, `{"status":"0","message":"NOTOK","result":"Invalid API Key"}`
+	if _, err := client.GetAddressTagWithFallback(ctx, []string{testAddr}, db, nil); err == nil {
+		t.Error("expected an invalid API key not to fall back")
+	}
+}
@@ -2,6 +2,8 @@ package etherscan
 
 import (
 	"context"
+	"errors"
+	"strings"
 )
 
 // ============================================================================
@@ -32,6 +34,14 @@ type GetPlasmaDepositsOpts struct {
 	//   - RateLimitRaise: Return an error when rate limit is exceeded
 	//   - RateLimitSkip: Return false without executing when rate limit is exceeded
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
+
+	// PageInfo, if set, receives the pagination state of the returned page, see PageInfo
+	// Default: nil
+	PageInfo *PageInfo `json:"-"`
 }
 
 // GetPlasmaDeposits returns a list of Plasma Deposits received by an address
@@ -81,8 +91,12 @@ func (c *HTTPClient) GetPlasmaDeposits(ctx context.Context, address string, opts
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
+	var pageInfo *PageInfo
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
+		pageInfo = opts.PageInfo
 	}
 
 	data, err := c.request(requestParams{
@@ -92,15 +106,17 @@ func (c *HTTPClient) GetPlasmaDeposits(ctx context.Context, address string, opts
 		params:          params,
 		noFoundReturn:   []RespPlasmaDeposit{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result []RespPlasmaDeposit
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
+	recordPageInfo(pageInfo, params, result)
 	return result, nil
 }
 
@@ -116,7 +132,7 @@ type GetDepositTxsOpts struct {
 
 	// Sort order for the results
 	// Options: "asc" or "desc" (default: "desc")
-	Sort string `default:"desc" json:"sort"`
+	Sort SortOrder `default:"desc" json:"sort"`
 
 	// ChainID specifies which blockchain network to query
 	// Note: Only applicable to Arbitrum Stack (42161, 42170, 33139, 660279) and
@@ -125,6 +141,14 @@ type GetDepositTxsOpts struct {
 
 	// OnLimitExceeded specifies behavior when rate limit is exceeded
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
+
+	// PageInfo, if set, receives the pagination state of the returned page, see PageInfo
+	// Default: nil
+	PageInfo *PageInfo `json:"-"`
 }
 
 // GetDepositTxs returns a list of deposits in ETH or ERC20 tokens from Ethereum to L2
@@ -162,8 +186,12 @@ func (c *HTTPClient) GetDepositTxs(ctx context.Context, address string, opts *Ge
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
+	var pageInfo *PageInfo
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
+		pageInfo = opts.PageInfo
 	}
 
 	data, err := c.request(requestParams{
@@ -173,15 +201,17 @@ func (c *HTTPClient) GetDepositTxs(ctx context.Context, address string, opts *Ge
 		params:          params,
 		noFoundReturn:   []RespDepositTx{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result []RespDepositTx
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
+	recordPageInfo(pageInfo, params, result)
 	return result, nil
 }
 
@@ -197,7 +227,7 @@ type GetWithdrawalTxsOpts struct {
 
 	// Sort order for the results
 	// Options: "asc" or "desc" (default: "desc")
-	Sort string `default:"desc" json:"sort"`
+	Sort SortOrder `default:"desc" json:"sort"`
 
 	// ChainID specifies which blockchain network to query
 	// Note: Only applicable to Arbitrum Stack (42161, 42170, 33139, 660279) and
@@ -206,6 +236,14 @@ type GetWithdrawalTxsOpts struct {
 
 	// OnLimitExceeded specifies behavior when rate limit is exceeded
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
+
+	// PageInfo, if set, receives the pagination state of the returned page, see PageInfo
+	// Default: nil
+	PageInfo *PageInfo `json:"-"`
 }
 
 // GetWithdrawalTxs returns a list of withdrawals in ETH or ERC20 tokens from L2 to Ethereum
@@ -243,8 +281,12 @@ func (c *HTTPClient) GetWithdrawalTxs(ctx context.Context, address string, opts
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
+	var pageInfo *PageInfo
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
+		pageInfo = opts.PageInfo
 	}
 
 	data, err := c.request(requestParams{
@@ -254,14 +296,156 @@ func (c *HTTPClient) GetWithdrawalTxs(ctx context.Context, address string, opts
 		params:          params,
 		noFoundReturn:   []RespWithdrawalTx{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result []RespWithdrawalTx
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
+	recordPageInfo(pageInfo, params, result)
 	return result, nil
 }
+
+// ============================================================================
+// OP Stack Withdrawal Status
+// ============================================================================
+
+// ErrWithdrawalNotFound is returned when a withdrawal is not in the address's withdrawal list
+var ErrWithdrawalNotFound = errors.New("withdrawal not found")
+
+// WithdrawalStatus is the L1 lifecycle state of an L2 -> L1 withdrawal
+//
+// OP Stack withdrawals are proven on L1, wait out the challenge period and are
+// then finalized (relayed). Arbitrum withdrawals skip the prove step.
+type WithdrawalStatus string
+
+const (
+	// WithdrawalUnknown means the reported status could not be interpreted
+	WithdrawalUnknown WithdrawalStatus = "unknown"
+
+	// WithdrawalWaiting means the L2 output root has not been published to L1 yet
+	WithdrawalWaiting WithdrawalStatus = "waiting"
+
+	// WithdrawalReadyToProve means the withdrawal can be proven on L1
+	WithdrawalReadyToProve WithdrawalStatus = "ready_to_prove"
+
+	// WithdrawalInChallengePeriod means the withdrawal is proven and the challenge period is running
+	WithdrawalInChallengePeriod WithdrawalStatus = "in_challenge_period"
+
+	// WithdrawalReadyToFinalize means the challenge period is over and the withdrawal can be relayed
+	WithdrawalReadyToFinalize WithdrawalStatus = "ready_to_finalize"
+
+	// WithdrawalFinalized means the withdrawal was relayed on L1 and funds are available
+	WithdrawalFinalized WithdrawalStatus = "finalized"
+)
+
+// IsFinalized reports whether the withdrawal completed on L1
+func (s WithdrawalStatus) IsFinalized() bool {
+	return s == WithdrawalFinalized
+}
+
+// ParseWithdrawalStatus maps a status string reported by getwithdrawaltxs to a WithdrawalStatus
+//
+// Etherscan reports human-readable states such as "Waiting", "Ready to Prove",
+// "In Challenge Period", "Ready for Relay" and "Relayed"; matching is
+// case-insensitive and tolerant of wording differences between explorers.
+func ParseWithdrawalStatus(status string) WithdrawalStatus {
+	s := strings.ToLower(strings.TrimSpace(status))
+	switch {
+	case s == "":
+		return WithdrawalUnknown
+	// Checked first: "unconfirmed" contains "confirmed"
+	case strings.Contains(s, "unconfirmed"), strings.Contains(s, "waiting"), strings.Contains(s, "pending"):
+		return WithdrawalWaiting
+	case strings.Contains(s, "relayed"), strings.Contains(s, "finalized"), strings.Contains(s, "executed"):
+		return WithdrawalFinalized
+	case strings.Contains(s, "relay"), strings.Contains(s, "finalize"), strings.Contains(s, "confirmed"):
+		return WithdrawalReadyToFinalize
+	case strings.Contains(s, "challenge"), strings.Contains(s, "proven"):
+		return WithdrawalInChallengePeriod
+	case strings.Contains(s, "prove"):
+		return WithdrawalReadyToProve
+	}
+	return WithdrawalUnknown
+}
+
+// FinalizationStatus returns the L1 lifecycle state of the withdrawal
+//
+// The L1 transaction hashes take precedence over the status text: a relay
+// transaction means finalized, a prove transaction means at least in the
+// challenge period.
+func (w RespWithdrawalTx) FinalizationStatus() WithdrawalStatus {
+	if w.L1TransactionHash != "" {
+		return WithdrawalFinalized
+	}
+	status := ParseWithdrawalStatus(w.Status)
+	if w.L1TransactionHashProve != "" {
+		switch status {
+		case WithdrawalUnknown, WithdrawalWaiting, WithdrawalReadyToProve:
+			return WithdrawalInChallengePeriod
+		}
+	}
+	return status
+}
+
+// GetWithdrawalStatus returns the L1 lifecycle state of a single withdrawal
+//
+// It pages through GetWithdrawalTxs of address until the L2 transaction l2TxHash
+// is found, so apps can answer "is this withdrawal finalized on L1 yet".
+//
+// Args:
+//   - ctx: Context for request cancellation and timeout
+//   - address: Address that initiated the withdrawal
+//   - l2TxHash: Hash of the withdrawal transaction on L2
+//   - opts: Optional parameters (can be nil); Page is the first page scanned
+//
+// Returns:
+//   - WithdrawalStatus: Lifecycle state of the withdrawal
+//   - *RespWithdrawalTx: The withdrawal record
+//   - error: ErrWithdrawalNotFound if address has no such withdrawal, or a request error
+//
+// Example:
+//
+//	status, w, err := client.GetWithdrawalStatus(ctx, addr, l2TxHash, &GetWithdrawalTxsOpts{
+//	    ChainID: OPMainnet,
+//	})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	if status.IsFinalized() {
+//	    fmt.Printf("Finalized in L1 tx %s\n", w.L1TransactionHash)
+//	}
+//
+// Note:
+//   - Only applicable to Arbitrum Stack and Optimism Stack networks
+//   - Etherscan does not expose OP Stack batch or L1 origin data, so the status is
+//     derived from the withdrawal list only
+func (c *HTTPClient) GetWithdrawalStatus(ctx context.Context, address, l2TxHash string, opts *GetWithdrawalTxsOpts) (WithdrawalStatus, *RespWithdrawalTx, error) {
+	if opts == nil {
+		opts = &GetWithdrawalTxsOpts{}
+	}
+	if err := ApplyDefaults(opts); err != nil {
+		return WithdrawalUnknown, nil, err
+	}
+
+	pageOpts := *opts
+	for {
+		withdrawals, err := c.GetWithdrawalTxs(ctx, address, &pageOpts)
+		if err != nil {
+			return WithdrawalUnknown, nil, err
+		}
+		for i := range withdrawals {
+			if strings.EqualFold(withdrawals[i].Hash, l2TxHash) {
+				return withdrawals[i].FinalizationStatus(), &withdrawals[i], nil
+			}
+		}
+		if int64(len(withdrawals)) < pageOpts.Offset {
+			return WithdrawalUnknown, nil, ErrWithdrawalNotFound
+		}
+		pageOpts.Page++
+	}
+}
@@ -2,6 +2,9 @@ package etherscan
 
 import (
 	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 )
@@ -15,7 +18,7 @@ func TestGetPlasmaDeposits(t *testing.T) {
 	deposits, err := config.Client.GetPlasmaDeposits(ctx, TestAddresses.VitalikButerin, &GetPlasmaDepositsOpts{
 		Page:    1,
 		Offset:  10,
-		ChainID: 1, // Ethereum mainnet
+		ChainID: 137, // Polygon mainnet, txnbridge is not available on Ethereum
 	})
 	if err != nil {
 		t.Fatalf("GetPlasmaDeposits failed: %v", err)
@@ -110,16 +113,10 @@ func TestGetPlasmaDepositsWithNilOpts(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
-	// Test with nil opts (should use defaults)
-	deposits, err := config.Client.GetPlasmaDeposits(ctx, TestAddresses.VitalikButerin, nil)
-	if err != nil {
-		t.Fatalf("GetPlasmaDeposits with nil opts failed: %v", err)
-	}
-
-	if len(deposits) == 0 {
-		t.Log("No plasma deposits found for test address (with default opts)")
-	} else {
-		t.Logf("Found %d plasma deposits (with default opts)", len(deposits))
+	// Test with nil opts (defaults to Ethereum, where txnbridge does not exist)
+	_, err := config.Client.GetPlasmaDeposits(ctx, TestAddresses.VitalikButerin, nil)
+	if !errors.Is(err, ErrUnsupportedOnChain) {
+		t.Fatalf("expected ErrUnsupportedOnChain with nil opts, got %v", err)
 	}
 }
 
@@ -224,3 +221,64 @@ func TestGetWithdrawalTxsWithDifferentChain(t *testing.T) {
 		t.Logf("Found %d withdrawal transactions on Polygon", len(withdrawals))
 	}
 }
+
+func TestWithdrawalFinalizationStatus(t *testing.T) {
+	cases := []struct {
+		tx   RespWithdrawalTx
+		want WithdrawalStatus
+	}{
+		{RespWithdrawalTx{Status: "Waiting"}, WithdrawalWaiting},
+		{RespWithdrawalTx{Status: "Unconfirmed"}, WithdrawalWaiting},
+		{RespWithdrawalTx{Status: "Confirmed"}, WithdrawalReadyToFinalize},
+		{RespWithdrawalTx{Status: "Ready to Prove"}, WithdrawalReadyToProve},
+		{RespWithdrawalTx{Status: "In Challenge Period"}, WithdrawalInChallengePeriod},
+		{RespWithdrawalTx{Status: "Ready for Relay"}, WithdrawalReadyToFinalize},
+		{RespWithdrawalTx{Status: "Relayed"}, WithdrawalFinalized},
+		{RespWithdrawalTx{Status: "something new"}, WithdrawalUnknown},
+		{RespWithdrawalTx{Status: "Ready to Prove", L1TransactionHashProve: "0xprove"}, WithdrawalInChallengePeriod},
+		{RespWithdrawalTx{Status: "Ready for Relay", L1TransactionHashProve: "0xprove"}, WithdrawalReadyToFinalize},
+		{RespWithdrawalTx{L1TransactionHash: "0xrelay"}, WithdrawalFinalized},
+	}
+	for _, tc := range cases {
+		if got := tc.tx.FinalizationStatus(); got != tc.want {
+			t.Errorf("status %q (prove=%q, relay=%q): expected %s, got %s",
+				tc.tx.Status, tc.tx.L1TransactionHashProve, tc.tx.L1TransactionHash, tc.want, got)
+		}
+	}
+}
+
+func TestGetWithdrawalStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("page") {
+		case "1":
+			w.Write([]byte(`{"status":"1","message":"OK","result":[{"hash":"0x01","status":"Waiting"},{"hash":"0x02","status":"Waiting"}]}`))
+		case "2":
+			w.Write([]byte(`{"status":"1","message":"OK","result":[{"hash":"0xAB","status":"Relayed","L1transactionhash":"0xl1"}]}`))
+		default:
+			w.Write([]byte(`{"status":"0","message":"No transactions found","result":[]}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{OPMainnet: server.URL},
+	})
+	opts := &GetWithdrawalTxsOpts{Offset: 2, ChainID: OPMainnet}
+
+	status, w, err := client.GetWithdrawalStatus(context.Background(), TestAddresses.VitalikButerin, "0xab", opts)
+	if err != nil {
+		t.Fatalf("GetWithdrawalStatus failed: %v", err)
+	}
+	if !status.IsFinalized() || w.L1TransactionHash != "0xl1" {
+		t.Errorf("expected finalized withdrawal, got %s %+v", status, w)
+	}
+
+	_, _, err = client.GetWithdrawalStatus(context.Background(), TestAddresses.VitalikButerin, "0xcd", opts)
+	if !errors.Is(err, ErrWithdrawalNotFound) {
+		t.Errorf("expected ErrWithdrawalNotFound, got %v", err)
+	}
+	if opts.Page != 1 {
+		t.Errorf("expected caller page not to be advanced, got %d", opts.Page)
+	}
+}
@@ -0,0 +1,228 @@
+package etherscan
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/dwdwow/etherscan-go/parallel"
+)
+
+// ============================================================================
+// Validator Leaderboard
+// ============================================================================
+
+// ValidatorStats aggregates the sampled blocks produced by one miner or fee recipient
+type ValidatorStats struct {
+	// Miner is the block's miner field in lowercase: the fee recipient since the merge
+	Miner string `json:"miner" bson:"miner"`
+
+	// Blocks counts the sampled blocks; Share is Blocks over all sampled blocks
+	Blocks int     `json:"blocks" bson:"blocks"`
+	Share  float64 `json:"share" bson:"share"`
+
+	// FirstBlock and LastBlock are the lowest and highest sampled blocks of the miner
+	FirstBlock int64 `json:"firstBlock" bson:"firstBlock"`
+	LastBlock  int64 `json:"lastBlock" bson:"lastBlock"`
+
+	GasUsed uint64 `json:"gasUsed" bson:"gasUsed"`
+
+	// BurnedFees is the base fee times the gas used, in wei, summed over the blocks
+	BurnedFees *big.Int `json:"burnedFees" bson:"burnedFees"`
+
+	// Rewards is the block reward paid to the miner, in wei: priority fees plus any
+	// static issuance; nil if GetValidatorLeaderboardOpts.SkipRewards is set
+	Rewards *big.Int `json:"rewards" bson:"rewards"`
+}
+
+// ValidatorLeaderboard ranks the miners of a block range by sampled blocks
+type ValidatorLeaderboard struct {
+	FromBlock int64 `json:"fromBlock" bson:"fromBlock"`
+	ToBlock   int64 `json:"toBlock" bson:"toBlock"`
+
+	// Sampled is the number of blocks fetched; every block if the range is within Samples
+	Sampled int `json:"sampled" bson:"sampled"`
+
+	// Validators are ranked by blocks, then rewards, then address
+	Validators []ValidatorStats `json:"validators" bson:"validators"`
+}
+
+// GetValidatorLeaderboardOpts contains optional parameters for GetValidatorLeaderboard
+type GetValidatorLeaderboardOpts struct {
+	// Samples is the maximum number of blocks fetched, evenly spaced across the range
+	// Default: 1000
+	Samples int `default:"1000"`
+
+	// Concurrency is the number of blocks fetched in parallel
+	// Default: 4
+	Concurrency int `default:"4"`
+
+	// SkipRewards leaves out the getblockreward call per sampled block, and with it Rewards
+	// Default: false
+	SkipRewards bool
+
+	// ChainID specifies which blockchain network to query
+	// Default: empty (uses client default)
+	ChainID int64
+
+	// OnLimitExceeded specifies behavior when rate limit is exceeded
+	// Default: RateLimitBlock (wait until a token is available)
+	OnLimitExceeded RateLimitBehavior `default:""`
+}
+
+// leaderboardBlock is what GetValidatorLeaderboard keeps of one sampled block
+type leaderboardBlock struct {
+	number  int64
+	miner   string
+	gasUsed uint64
+	burned  *big.Int
+	reward  *big.Int
+}
+
+// GetValidatorLeaderboard ranks the miners or fee recipients of a block range
+//
+// Up to Samples blocks, evenly spaced from from to to inclusive, are fetched
+// in parallel with eth_getBlockByNumber (and getblockreward, unless
+// SkipRewards is set) and aggregated per miner: block count, share of the
+// sample, gas used, burned base fees and rewards. Sampling keeps wide ranges
+// cheap; the shares then estimate the miners' shares of the whole range.
+//
+// Args:
+//   - ctx: Context for request cancellation and timeout
+//   - from: The first block
+//   - to: The last block
+//   - opts: Optional parameters (can be nil)
+//
+// Returns:
+//   - *ValidatorLeaderboard: The miners of the sampled blocks, ranked
+//   - error: Error if the range is invalid or a request fails
+//
+// Example:
+//
+//	board, err := client.GetValidatorLeaderboard(ctx, 19000000, 19050000, &etherscan.GetValidatorLeaderboardOpts{Samples: 2000})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for i, v := range board.Validators[:10] {
+//	    fmt.Printf("%2d. %s %5.1f%% %s wei\n", i+1, v.Miner, v.Share*100, v.Rewards)
+//	}
+//
+// Note:
+//   - Costs two calls per sampled block, one with SkipRewards
+//   - Pre-merge blocks have no base fee and burn nothing
+func (c *HTTPClient) GetValidatorLeaderboard(ctx context.Context, from, to int64, opts *GetValidatorLeaderboardOpts) (*ValidatorLeaderboard, error) {
+	if opts == nil {
+		opts = &GetValidatorLeaderboardOpts{}
+	}
+	if err := ApplyDefaults(opts); err != nil {
+		return nil, err
+	}
+	if from < 0 || to < from {
+		return nil, fmt.Errorf("etherscan: invalid block range %d-%d", from, to)
+	}
+	if opts.Samples < 1 {
+		return nil, fmt.Errorf("etherscan: invalid number of samples %d", opts.Samples)
+	}
+
+	var blocks []int64
+	if span := to - from + 1; span <= int64(opts.Samples) {
+		for block := from; block <= to; block++ {
+			blocks = append(blocks, block)
+		}
+	} else {
+		for i := range int64(opts.Samples) {
+			block := to
+			if opts.Samples > 1 {
+				block = from + (to-from)*i/int64(opts.Samples-1)
+			}
+			blocks = append(blocks, block)
+		}
+	}
+
+	sampled, err := parallel.Map(ctx, blocks, opts.Concurrency, func(ctx context.Context, number int64) (leaderboardBlock, error) {
+		return c.fetchLeaderboardBlock(ctx, number, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	byMiner := make(map[string]*ValidatorStats)
+	for _, block := range sampled {
+		stats, ok := byMiner[block.miner]
+		if !ok {
+			stats = &ValidatorStats{Miner: block.miner, FirstBlock: block.number, BurnedFees: new(big.Int)}
+			if !opts.SkipRewards {
+				stats.Rewards = new(big.Int)
+			}
+			byMiner[block.miner] = stats
+		}
+		stats.Blocks++
+		if block.number < stats.FirstBlock {
+			stats.FirstBlock = block.number
+		}
+		stats.LastBlock = max(stats.LastBlock, block.number)
+		stats.GasUsed += block.gasUsed
+		stats.BurnedFees.Add(stats.BurnedFees, block.burned)
+		if block.reward != nil {
+			stats.Rewards.Add(stats.Rewards, block.reward)
+		}
+	}
+
+	board := &ValidatorLeaderboard{FromBlock: from, ToBlock: to, Sampled: len(sampled), Validators: make([]ValidatorStats, 0, len(byMiner))}
+	for _, stats := range byMiner {
+		stats.Share = float64(stats.Blocks) / float64(len(sampled))
+		board.Validators = append(board.Validators, *stats)
+	}
+	sort.Slice(board.Validators, func(i, j int) bool {
+		a, b := board.Validators[i], board.Validators[j]
+		if a.Blocks != b.Blocks {
+			return a.Blocks > b.Blocks
+		}
+		if a.Rewards != nil && b.Rewards != nil {
+			if cmp := a.Rewards.Cmp(b.Rewards); cmp != 0 {
+				return cmp > 0
+			}
+		}
+		return a.Miner < b.Miner
+	})
+	return board, nil
+}
+
+// fetchLeaderboardBlock fetches the header, and unless skipped the reward, of one sampled block
+func (c *HTTPClient) fetchLeaderboardBlock(ctx context.Context, number int64, opts *GetValidatorLeaderboardOpts) (leaderboardBlock, error) {
+	info, err := c.RpcEthBlockByNumber(ctx, BlockNumberTag(number), &RpcEthBlockByNumberOpts{
+		ChainID:         opts.ChainID,
+		OnLimitExceeded: opts.OnLimitExceeded,
+	})
+	if err != nil {
+		return leaderboardBlock{}, err
+	}
+	if info == nil || info.Hash == "" {
+		return leaderboardBlock{}, fmt.Errorf("etherscan: block %d not found", number)
+	}
+
+	block := leaderboardBlock{number: number, miner: strings.ToLower(info.Miner), burned: new(big.Int)}
+	if block.gasUsed, err = parseHexUint64(info.GasUsed); err != nil {
+		return leaderboardBlock{}, fmt.Errorf("etherscan: invalid gas used %q of block %d: %w", info.GasUsed, number, err)
+	}
+	if baseFee := parseHexBig(info.BaseFeePerGas); baseFee != nil {
+		block.burned.Mul(baseFee, new(big.Int).SetUint64(block.gasUsed))
+	}
+
+	if !opts.SkipRewards {
+		reward, err := c.GetBlockAndUncleRewards(ctx, number, &GetBlockAndUncleRewardsOpts{
+			ChainID:         opts.ChainID,
+			OnLimitExceeded: opts.OnLimitExceeded,
+		})
+		if err != nil {
+			return leaderboardBlock{}, err
+		}
+		var ok bool
+		if block.reward, ok = new(big.Int).SetString(reward.BlockReward, 10); !ok {
+			return leaderboardBlock{}, fmt.Errorf("etherscan: invalid block reward %q of block %d", reward.BlockReward, number)
+		}
+	}
+	return block, nil
+}
@@ -0,0 +1,83 @@
+package etherscan
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+func TestGetValidatorLeaderboard(t *testing.T) {
+	var mu sync.Mutex
+	fetched := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		mu.Lock()
+		fetched[q.Get("action")]++
+		mu.Unlock()
+		switch q.Get("action") {
+		case "eth_getBlockByNumber":
+			number, _ := strconv.ParseInt(q.Get("tag")[2:], 16, 64)
+			miner := "0xAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA"
+			if number%3 == 0 {
+				miner = "0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"
+			}
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":{"hash":"0x%x","number":"0x%x","miner":"%s","gasUsed":"0x64","baseFeePerGas":"0xa"}}`, number, number, miner)
+		case "getblockreward":
+			fmt.Fprintf(w, `{"status":"1","message":"OK","result":{"blockNumber":"%s","blockReward":"1000"}}`, q.Get("blockno"))
+		}
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+		MaxRetries: -1,
+	})
+	ctx := context.Background()
+
+	board, err := client.GetValidatorLeaderboard(ctx, 100, 105, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if board.Sampled != 6 || len(board.Validators) != 2 {
+		t.Fatalf("expected every block of the range from 2 miners, got %+v", board)
+	}
+	top := board.Validators[0]
+	if top.Miner != "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa" || top.Blocks != 4 || top.Share != 4.0/6 ||
+		top.FirstBlock != 100 || top.LastBlock != 104 || top.GasUsed != 400 ||
+		top.BurnedFees.String() != "4000" || top.Rewards.String() != "4000" {
+		t.Errorf("unexpected top miner: %+v", top)
+	}
+	if second := board.Validators[1]; second.Blocks != 2 || second.FirstBlock != 102 || second.LastBlock != 105 {
+		t.Errorf("unexpected second miner: %+v", second)
+	}
+	if fetched["eth_getBlockByNumber"] != 6 || fetched["getblockreward"] != 6 {
+		t.Errorf("expected one block and one reward call per block, got %v", fetched)
+	}
+
+	// Wide ranges are sampled evenly, endpoints included
+	fetched = map[string]int{}
+	board, err = client.GetValidatorLeaderboard(ctx, 0, 1000, &GetValidatorLeaderboardOpts{Samples: 11, SkipRewards: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if board.Sampled != 11 || fetched["eth_getBlockByNumber"] != 11 || fetched["getblockreward"] != 0 {
+		t.Errorf("expected 11 sampled blocks without rewards, got %d and %v", board.Sampled, fetched)
+	}
+	for _, v := range board.Validators {
+		if v.Rewards != nil {
+			t.Errorf("expected no rewards with SkipRewards, got %s for %s", v.Rewards, v.Miner)
+		}
+		if v.FirstBlock%100 != 0 || v.LastBlock%100 != 0 {
+			t.Errorf("expected samples every 100 blocks, got %d-%d", v.FirstBlock, v.LastBlock)
+		}
+	}
+
+	if _, err := client.GetValidatorLeaderboard(ctx, 10, 5, nil); err == nil {
+		t.Error("expected an invalid range to be rejected")
+	}
+}
@@ -0,0 +1,341 @@
+package etherscan
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// ============================================================================
+// Log Query Builder
+// ============================================================================
+
+// maxTopics is the number of topics a getLogs filter can match, topic0 to topic3
+const maxTopics = 4
+
+// LogQuery builds a getLogs filter, compiling topics and operators into the topicN and topicN_M_opr parameters
+//
+// Topics are set by position: Event sets topic0 to the hash of an event
+// signature, ArgAddress, ArgUint and Topic set the indexed arguments 1 to 3.
+// Each topic set after the first one is combined with the topic set just
+// before it by the pending operator, And unless Or was called in between,
+// and with every other earlier topic by "and". So
+//
+//	Logs().Event(sig).ArgAddress(1, from).Or().ArgAddress(2, to)
+//
+// matches sig AND (from OR to). The first invalid call is remembered and
+// returned when the query is compiled; later calls are ignored.
+//
+// A LogQuery is not safe for concurrent use; compile it once and share the opts.
+type LogQuery struct {
+	address    string
+	topics     [maxTopics]string
+	order      []int // topic positions in the order they were set
+	operators  map[[2]int]TopicOperator
+	pending    TopicOperator
+	fromBlock  int64
+	toBlock    int64
+	page       int64
+	offset     int64
+	chainID    int64
+	onExceeded RateLimitBehavior
+	err        error
+}
+
+// Logs starts an empty log query over the full block range
+func Logs() *LogQuery {
+	return &LogQuery{
+		operators: make(map[[2]int]TopicOperator),
+		toBlock:   defaultEndBlock,
+		page:      1,
+		offset:    logsPerCall,
+	}
+}
+
+// fail records the first error of the query
+func (q *LogQuery) fail(format string, args ...any) *LogQuery {
+	if q.err == nil {
+		q.err = fmt.Errorf("etherscan: log query: "+format, args...)
+	}
+	return q
+}
+
+// Address restricts the query to logs emitted by address
+func (q *LogQuery) Address(address string) *LogQuery {
+	normalized, err := NormalizeAddress(address)
+	if err != nil {
+		return q.fail("%w", err)
+	}
+	q.address = normalized
+	return q
+}
+
+// Event sets topic0 to the keccak256 hash of a canonical event signature, e.g. "Transfer(address,address,uint256)"
+func (q *LogQuery) Event(signature string) *LogQuery {
+	if !strings.Contains(signature, "(") || !strings.HasSuffix(signature, ")") {
+		return q.fail("invalid event signature %q", signature)
+	}
+	return q.Topic(0, "0x"+hex.EncodeToString(Keccak256([]byte(signature))))
+}
+
+// ArgAddress sets the topic of the indexed address argument at position i, 1 to 3
+func (q *LogQuery) ArgAddress(i int, address string) *LogQuery {
+	normalized, err := NormalizeAddress(address)
+	if err != nil {
+		return q.fail("topic%d: %w", i, err)
+	}
+	return q.arg(i, "0x000000000000000000000000"+strings.TrimPrefix(normalized, "0x"))
+}
+
+// ArgUint sets the topic of the indexed unsigned integer argument at position i, 1 to 3
+//
+// v is a *big.Int, int, int64 or uint64 and must fit in 256 bits.
+func (q *LogQuery) ArgUint(i int, v any) *LogQuery {
+	n, err := abiInteger(v)
+	if err != nil {
+		return q.fail("topic%d: %w", i, err)
+	}
+	if n.Sign() < 0 || n.BitLen() > 256 {
+		return q.fail("topic%d: %s does not fit in uint256", i, n)
+	}
+	return q.arg(i, fmt.Sprintf("0x%064x", n))
+}
+
+// arg sets an indexed argument topic, rejecting topic0
+func (q *LogQuery) arg(i int, topic string) *LogQuery {
+	if i == 0 {
+		return q.fail("topic0 is the event signature, indexed arguments are 1 to 3")
+	}
+	return q.Topic(i, topic)
+}
+
+// Topic sets the raw 32-byte topic at position i, 0 to 3
+func (q *LogQuery) Topic(i int, topic string) *LogQuery {
+	if q.err != nil {
+		return q
+	}
+	if i < 0 || i >= maxTopics {
+		return q.fail("topic index %d out of range 0-%d", i, maxTopics-1)
+	}
+	if q.topics[i] != "" {
+		return q.fail("topic%d set twice", i)
+	}
+	topic = strings.ToLower(topic)
+	if len(topic) != 66 || !strings.HasPrefix(topic, "0x") {
+		return q.fail("topic%d %q is not a 32-byte hex string", i, topic)
+	}
+	if _, err := hex.DecodeString(topic[2:]); err != nil {
+		return q.fail("topic%d %q is not a 32-byte hex string", i, topic)
+	}
+
+	if len(q.order) > 0 {
+		op := TopicOpAnd
+		if q.pending != "" {
+			op = q.pending
+		}
+		q.operators[topicPair(q.order[len(q.order)-1], i)] = op
+	} else if q.pending != "" {
+		return q.fail("%s before the first topic", q.pending)
+	}
+	q.pending = ""
+	q.topics[i] = topic
+	q.order = append(q.order, i)
+	return q
+}
+
+// And combines the next topic with the previous one by "and", the default
+func (q *LogQuery) And() *LogQuery {
+	return q.operator(TopicOpAnd)
+}
+
+// Or combines the next topic with the previous one by "or"
+func (q *LogQuery) Or() *LogQuery {
+	return q.operator(TopicOpOr)
+}
+
+// operator sets the operator of the next topic
+func (q *LogQuery) operator(op TopicOperator) *LogQuery {
+	if q.pending != "" {
+		return q.fail("%s right after %s", op, q.pending)
+	}
+	q.pending = op
+	return q
+}
+
+// Between restricts the query to blocks from to to inclusive
+func (q *LogQuery) Between(fromBlock, toBlock int64) *LogQuery {
+	if fromBlock < 0 || toBlock < fromBlock {
+		return q.fail("invalid block range %d-%d", fromBlock, toBlock)
+	}
+	q.fromBlock, q.toBlock = fromBlock, toBlock
+	return q
+}
+
+// Page selects the page and its size, at most 1000 records
+func (q *LogQuery) Page(page, offset int64) *LogQuery {
+	if page < 1 || offset < 1 || offset > logsPerCall {
+		return q.fail("invalid page %d of %d records", page, offset)
+	}
+	q.page, q.offset = page, offset
+	return q
+}
+
+// ChainID selects the chain to query, 0 for the client default
+func (q *LogQuery) ChainID(chainID int64) *LogQuery {
+	q.chainID = chainID
+	return q
+}
+
+// OnLimitExceeded sets the behavior when the rate limit is exceeded
+func (q *LogQuery) OnLimitExceeded(behavior RateLimitBehavior) *LogQuery {
+	q.onExceeded = behavior
+	return q
+}
+
+// topicPair returns the positions of two topics in ascending order, the key of their operator
+func topicPair(a, b int) [2]int {
+	if a > b {
+		a, b = b, a
+	}
+	return [2]int{a, b}
+}
+
+// compile checks the query and returns its topics and the operators between every pair of set topics
+func (q *LogQuery) compile() ([maxTopics]string, map[[2]int]TopicOperator, error) {
+	if q.err != nil {
+		return q.topics, nil, q.err
+	}
+	if q.pending != "" {
+		return q.topics, nil, fmt.Errorf("etherscan: log query: %s without a following topic", q.pending)
+	}
+	if q.address == "" && len(q.order) == 0 {
+		return q.topics, nil, fmt.Errorf("etherscan: log query: an address or a topic is required")
+	}
+	operators := make(map[[2]int]TopicOperator)
+	for i := 0; i < len(q.order); i++ {
+		for j := i + 1; j < len(q.order); j++ {
+			pair := topicPair(q.order[i], q.order[j])
+			if op, ok := q.operators[pair]; ok {
+				operators[pair] = op
+			} else {
+				operators[pair] = TopicOpAnd
+			}
+		}
+	}
+	return q.topics, operators, nil
+}
+
+// TopicsOpts compiles the query into GetEventLogsByTopics options
+//
+// It returns an error if the query is invalid or restricted to an address,
+// which GetEventLogsByTopics cannot filter by; use AddressOpts then.
+func (q *LogQuery) TopicsOpts() (*GetEventLogsByTopicsOpts, error) {
+	topics, operators, err := q.compile()
+	if err != nil {
+		return nil, err
+	}
+	if q.address != "" {
+		return nil, fmt.Errorf("etherscan: log query: restricted to %s, use AddressOpts", q.address)
+	}
+	return &GetEventLogsByTopicsOpts{
+		Page:            q.page,
+		Offset:          q.offset,
+		FromBlock:       q.fromBlock,
+		ToBlock:         q.toBlock,
+		Topic0:          topics[0],
+		Topic1:          topics[1],
+		Topic2:          topics[2],
+		Topic3:          topics[3],
+		Topic0_1_Opr:    operators[[2]int{0, 1}],
+		Topic0_2_Opr:    operators[[2]int{0, 2}],
+		Topic0_3_Opr:    operators[[2]int{0, 3}],
+		Topic1_2_Opr:    operators[[2]int{1, 2}],
+		Topic1_3_Opr:    operators[[2]int{1, 3}],
+		Topic2_3_Opr:    operators[[2]int{2, 3}],
+		ChainID:         q.chainID,
+		OnLimitExceeded: q.onExceeded,
+	}, nil
+}
+
+// AddressOpts compiles the query into the address and options of GetEventLogsByAddressFilteredByTopics
+//
+// It returns an error if the query is invalid or has no address.
+func (q *LogQuery) AddressOpts() (string, *GetEventLogsByAddressFilteredByTopicsOpts, error) {
+	topics, operators, err := q.compile()
+	if err != nil {
+		return "", nil, err
+	}
+	if q.address == "" {
+		return "", nil, fmt.Errorf("etherscan: log query: no address, use TopicsOpts")
+	}
+	return q.address, &GetEventLogsByAddressFilteredByTopicsOpts{
+		Page:            q.page,
+		Offset:          q.offset,
+		FromBlock:       q.fromBlock,
+		ToBlock:         q.toBlock,
+		Topic0:          topics[0],
+		Topic1:          topics[1],
+		Topic2:          topics[2],
+		Topic3:          topics[3],
+		Topic0_1_Opr:    operators[[2]int{0, 1}],
+		Topic0_2_Opr:    operators[[2]int{0, 2}],
+		Topic0_3_Opr:    operators[[2]int{0, 3}],
+		Topic1_2_Opr:    operators[[2]int{1, 2}],
+		Topic1_3_Opr:    operators[[2]int{1, 3}],
+		Topic2_3_Opr:    operators[[2]int{2, 3}],
+		ChainID:         q.chainID,
+		OnLimitExceeded: q.onExceeded,
+	}, nil
+}
+
+// QueryLogs fetches one page of the logs matching q
+//
+// Queries with an address call GetEventLogsByAddressFilteredByTopics, the
+// others GetEventLogsByTopics; both return the same fields.
+//
+// Args:
+//   - ctx: Context for request cancellation and timeout
+//   - q: The query, see Logs
+//
+// Returns:
+//   - []RespEventLogByTopics: The matching logs of the page
+//   - error: Error if the query is invalid or the request fails
+//
+// Example:
+//
+//	q := etherscan.Logs().
+//	    Address(token).
+//	    Event("Transfer(address,address,uint256)").
+//	    ArgAddress(1, wallet).Or().ArgAddress(2, wallet).
+//	    Between(18000000, 18100000)
+//	logs, err := client.QueryLogs(ctx, q)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//
+// Note:
+//   - Maximum 1000 records per call; use Page for more
+func (c *HTTPClient) QueryLogs(ctx context.Context, q *LogQuery) ([]RespEventLogByTopics, error) {
+	if q.address == "" {
+		opts, err := q.TopicsOpts()
+		if err != nil {
+			return nil, err
+		}
+		return c.GetEventLogsByTopics(ctx, opts)
+	}
+
+	address, opts, err := q.AddressOpts()
+	if err != nil {
+		return nil, err
+	}
+	logs, err := c.GetEventLogsByAddressFilteredByTopics(ctx, address, opts)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]RespEventLogByTopics, len(logs))
+	for i, log := range logs {
+		result[i] = RespEventLogByTopics(log)
+	}
+	return result, nil
+}
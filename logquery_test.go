@@ -0,0 +1,100 @@
+package etherscan
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestLogQuery(t *testing.T) {
+	from := TestAddresses.VitalikButerin
+	to := "0x" + strings.Repeat("ab", 20)
+
+	opts, err := Logs().
+		Event("Transfer(address,address,uint256)").
+		ArgAddress(1, from).Or().ArgAddress(2, to).
+		Between(100, 200).
+		TopicsOpts()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if opts.Topic0 != TopicTransfer {
+		t.Errorf("expected the Transfer topic, got %s", opts.Topic0)
+	}
+	if opts.Topic1 != "0x000000000000000000000000"+strings.ToLower(from[2:]) || opts.Topic2 != "0x000000000000000000000000"+to[2:] || opts.Topic3 != "" {
+		t.Errorf("unexpected argument topics: %s %s %q", opts.Topic1, opts.Topic2, opts.Topic3)
+	}
+	if opts.Topic0_1_Opr != TopicOpAnd || opts.Topic0_2_Opr != TopicOpAnd || opts.Topic1_2_Opr != TopicOpOr {
+		t.Errorf("expected topic0 AND (topic1 OR topic2), got %s %s %s", opts.Topic0_1_Opr, opts.Topic0_2_Opr, opts.Topic1_2_Opr)
+	}
+	if opts.FromBlock != 100 || opts.ToBlock != 200 || opts.Page != 1 || opts.Offset != 1000 {
+		t.Errorf("unexpected range or page: %+v", opts)
+	}
+
+	// Topics can be set out of position order; the uint topic is left-padded
+	opts, err = Logs().ArgUint(3, 255).Or().Event("Approval(address,address,uint256)").TopicsOpts()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if opts.Topic3 != "0x"+strings.Repeat("0", 62)+"ff" || opts.Topic0_3_Opr != TopicOpOr {
+		t.Errorf("unexpected topic3 %s or operator %s", opts.Topic3, opts.Topic0_3_Opr)
+	}
+
+	invalid := map[string]*LogQuery{
+		"no filter":          Logs(),
+		"bad address":        Logs().ArgAddress(1, "0x1234"),
+		"argument at 0":      Logs().ArgAddress(0, from),
+		"index out of range": Logs().Topic(4, TopicTransfer),
+		"topic set twice":    Logs().ArgUint(1, 1).ArgUint(1, 2),
+		"leading operator":   Logs().Or().ArgUint(1, 1),
+		"trailing operator":  Logs().ArgUint(1, 1).Or(),
+		"double operator":    Logs().ArgUint(1, 1).Or().And().ArgUint(2, 2),
+		"short topic":        Logs().Topic(1, "0x1234"),
+		"negative uint":      Logs().ArgUint(1, -1),
+		"bad range":          Logs().ArgUint(1, 1).Between(10, 5),
+		"address query":      Logs().Address(to).ArgUint(1, 1),
+	}
+	for name, q := range invalid {
+		if _, err := q.TopicsOpts(); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestQueryLogs(t *testing.T) {
+	var queries []url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.Query())
+		w.Write([]byte(`{"status":"1","message":"OK","result":[{"address":"0xtoken","topics":["0x1"],"blockNumber":"0x10"}]}`))
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+		MaxRetries: -1,
+	})
+	ctx := context.Background()
+	token := "0x" + strings.Repeat("cd", 20)
+
+	logs, err := client.QueryLogs(ctx, Logs().Address(token).Event("Transfer(address,address,uint256)").Page(2, 50))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(logs) != 1 || logs[0].Address != "0xtoken" {
+		t.Errorf("unexpected logs: %+v", logs)
+	}
+	if q := queries[0]; q.Get("address") != token || q.Get("topic0") != TopicTransfer || q.Get("page") != "2" || q.Get("offset") != "50" {
+		t.Errorf("unexpected address query: %v", q)
+	}
+
+	if _, err := client.QueryLogs(ctx, Logs().Event("Transfer(address,address,uint256)").ArgUint(2, 7)); err != nil {
+		t.Fatal(err)
+	}
+	if q := queries[1]; q.Has("address") || q.Get("topic2") != "0x"+strings.Repeat("0", 63)+"7" || q.Get("topic0_2_opr") != "and" {
+		t.Errorf("unexpected topics query: %v", q)
+	}
+}
@@ -2,6 +2,8 @@ package etherscan
 
 import (
 	"context"
+	"fmt"
+	"slices"
 )
 
 // ============================================================================
@@ -43,6 +45,14 @@ type GetEventLogsByAddressOpts struct {
 	//   - RateLimitRaise: Return an error when rate limit is exceeded
 	//   - RateLimitSkip: Return false without executing when rate limit is exceeded
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
+
+	// PageInfo, if set, receives the pagination state of the returned page, see PageInfo
+	// Default: nil
+	PageInfo *PageInfo `json:"-"`
 }
 
 // GetEventLogsByAddress returns event logs from an address, with optional filtering by block range
@@ -108,8 +118,12 @@ func (c *HTTPClient) GetEventLogsByAddress(ctx context.Context, address string,
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
+	var pageInfo *PageInfo
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
+		pageInfo = opts.PageInfo
 	}
 
 	data, err := c.request(requestParams{
@@ -119,18 +133,260 @@ func (c *HTTPClient) GetEventLogsByAddress(ctx context.Context, address string,
 		params:          params,
 		noFoundReturn:   []RespEventLogByAddress{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result []RespEventLogByAddress
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
+	recordPageInfo(pageInfo, params, result)
 	return result, nil
 }
 
+// logsPerCall is the maximum number of records the getLogs endpoint returns per call
+const logsPerCall = 1000
+
+// GetAllEventLogsOpts contains optional parameters for GetAllEventLogs
+type GetAllEventLogsOpts struct {
+	// FromBlock is the starting block number to search for logs
+	// Default: 0 (genesis block)
+	FromBlock int64 `default:"0" json:"fromblock"`
+
+	// ToBlock is the ending block number to search for logs
+	// Default: 0 (resolved to the latest block via eth_blockNumber)
+	ToBlock int64 `default:"0" json:"toblock"`
+
+	// Resume continues a crawl from a checkpoint passed to OnBatch, skipping processed logs
+	// Default: nil (start at FromBlock)
+	Resume *Checkpoint `json:"-"`
+
+	// OnBatch receives the logs in block order as they are fetched, together with
+	// the checkpoint to resume after them; batches may be empty when a range has no logs.
+	// Returning an error stops the crawl. When set, GetAllEventLogs returns no logs itself.
+	// Default: nil (collect and return all logs)
+	OnBatch func(logs []RespEventLogByAddress, next Checkpoint) error `json:"-"`
+
+	// ChainID specifies which blockchain network to query
+	// Default: empty (uses client default)
+	ChainID int64 `json:"chainid"`
+
+	// OnLimitExceeded specifies behavior when rate limit is exceeded
+	// Default: RateLimitBlock (wait until a token is available)
+	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+}
+
+// GetAllEventLogs returns every event log emitted by an address within a block range
+//
+// GetEventLogsByAddress silently stops at 1000 records per call. This method
+// detects a full response, bisects the block range and recurses until every
+// sub-range returns fewer than 1000 records, so the result is complete even for
+// busy contracts. A single block holding 1000+ logs is read page by page.
+//
+// For long crawls, set OnBatch to stream the logs and persist the checkpoint
+// it receives; after a restart, pass the last checkpoint as Resume and the
+// crawl continues where it stopped, without downloading finished ranges again
+// or delivering a log twice.
+//
+// Args:
+//   - ctx: Context for request cancellation and timeout
+//   - address: The contract address to get logs from
+//   - opts: Optional parameters (can be nil)
+//
+// Returns:
+//   - []RespEventLogByAddress: All event logs in the range, in block order
+//   - error: Error if any request fails
+//
+// Example:
+//
+//	logs, err := client.GetAllEventLogs(ctx, contractAddr, &GetAllEventLogsOpts{
+//	    FromBlock: 18000000,
+//	    ToBlock:   18100000,
+//	})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("Found %d logs\n", len(logs))
+//
+//	// Resumable crawl
+//	resume, _ := LoadCheckpoint("logs.checkpoint")
+//	_, err = client.GetAllEventLogs(ctx, contractAddr, &GetAllEventLogsOpts{
+//	    Resume: resume,
+//	    OnBatch: func(logs []RespEventLogByAddress, next Checkpoint) error {
+//	        if err := store(logs); err != nil {
+//	            return err
+//	        }
+//	        return SaveCheckpoint("logs.checkpoint", next)
+//	    },
+//	})
+//
+// Note:
+//   - Cost grows with the number of logs: roughly one call per 1000 records, plus one per split
+//   - Narrow the block range when possible to reduce the number of calls
+func (c *HTTPClient) GetAllEventLogs(ctx context.Context, address string, opts *GetAllEventLogsOpts) ([]RespEventLogByAddress, error) {
+	if opts == nil {
+		opts = &GetAllEventLogsOpts{}
+	}
+	if err := ApplyDefaults(opts); err != nil {
+		return nil, err
+	}
+
+	if c.requireExplicitBlockRange && opts.Resume == nil && opts.FromBlock == 0 && opts.ToBlock == 0 {
+		return nil, &BlockRangeError{StartBlock: 0, EndBlock: 0, Reason: "explicit block range required, set FromBlock or ToBlock"}
+	}
+
+	toBlock := opts.ToBlock
+	if toBlock == 0 {
+		latest, err := c.RpcEthBlockNumber(ctx, &RpcEthBlockNumberOpts{
+			ChainID:         opts.ChainID,
+			OnLimitExceeded: opts.OnLimitExceeded,
+		})
+		if err != nil {
+			return nil, err
+		}
+		head, err := parseHexUint64(latest)
+		if err != nil {
+			return nil, fmt.Errorf("etherscan: invalid block number %q: %w", latest, err)
+		}
+		toBlock = int64(head)
+	}
+
+	fetch := func(fromBlock, toBlock, page int64) ([]RespEventLogByAddress, error) {
+		return c.GetEventLogsByAddress(ctx, address, &GetEventLogsByAddressOpts{
+			FromBlock:       fromBlock,
+			ToBlock:         toBlock,
+			Page:            page,
+			Offset:          logsPerCall,
+			ChainID:         opts.ChainID,
+			OnLimitExceeded: opts.OnLimitExceeded,
+		})
+	}
+
+	var (
+		all       []RespEventLogByAddress
+		blockKeys []string
+	)
+	resume := opts.Resume
+	if resume != nil && resume.Page > 0 {
+		blockKeys = slices.Clone(resume.LastKeys)
+	}
+	emit := func(batch []RespEventLogByAddress, next Checkpoint) error {
+		fresh := make([]RespEventLogByAddress, 0, len(batch))
+		for _, log := range batch {
+			block, key, ok := eventLogKey(log)
+			if ok && resume.Seen(block, key) {
+				continue
+			}
+			fresh = append(fresh, log)
+			if next.Page > 0 {
+				blockKeys = append(blockKeys, key)
+			}
+		}
+		if next.Page > 0 {
+			next.LastKeys = slices.Clone(blockKeys)
+		} else {
+			blockKeys = nil
+		}
+
+		if opts.OnBatch != nil {
+			return opts.OnBatch(fresh, next)
+		}
+		all = append(all, fresh...)
+		return nil
+	}
+
+	fromBlock := opts.FromBlock
+	if resume != nil && resume.Block >= fromBlock {
+		fromBlock = resume.Block
+		// Finish the block that was being paged through before moving on
+		if resume.Page > 0 && fromBlock <= toBlock {
+			batch, err := fetch(fromBlock, fromBlock, resume.Page)
+			if err != nil {
+				return nil, err
+			}
+			if err := walkBlockPages(ctx, fromBlock, resume.Page, batch, fetch, emit); err != nil {
+				return nil, err
+			}
+			fromBlock++
+		}
+	}
+	if fromBlock <= toBlock {
+		if err := walkLogsByRange(ctx, fromBlock, toBlock, fetch, emit); err != nil {
+			return nil, err
+		}
+	}
+	return all, nil
+}
+
+// eventLogKey returns the block number of log and the key identifying it within the block
+func eventLogKey(log RespEventLogByAddress) (int64, string, bool) {
+	block, err := parseHexUint64(log.BlockNumber)
+	if err != nil {
+		return 0, "", false
+	}
+	return int64(block), log.TransactionHash + ":" + log.LogIndex, true
+}
+
+// collectLogsByRange fetches [fromBlock, toBlock], bisecting whenever a call returns a full page
+//
+// On error the batches finished before it are returned along with it.
+func collectLogsByRange[T any](ctx context.Context, fromBlock, toBlock int64, fetch func(fromBlock, toBlock, page int64) ([]T, error)) ([]T, error) {
+	var logs []T
+	err := walkLogsByRange(ctx, fromBlock, toBlock, fetch, func(batch []T, _ Checkpoint) error {
+		logs = append(logs, batch...)
+		return nil
+	})
+	return logs, err
+}
+
+// walkLogsByRange fetches [fromBlock, toBlock] in block order, bisecting whenever a call
+// returns a full page, and passes every finished batch to emit with the checkpoint after it
+func walkLogsByRange[T any](ctx context.Context, fromBlock, toBlock int64, fetch func(fromBlock, toBlock, page int64) ([]T, error), emit func(batch []T, next Checkpoint) error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	logs, err := fetch(fromBlock, toBlock, 1)
+	if err != nil {
+		return err
+	}
+	if len(logs) < logsPerCall {
+		return emit(logs, Checkpoint{Block: toBlock + 1})
+	}
+
+	// A single block cannot be split further; page through it instead
+	if fromBlock >= toBlock {
+		return walkBlockPages(ctx, fromBlock, 1, logs, fetch, emit)
+	}
+
+	mid := fromBlock + (toBlock-fromBlock)/2
+	if err := walkLogsByRange(ctx, fromBlock, mid, fetch, emit); err != nil {
+		return err
+	}
+	return walkLogsByRange(ctx, mid+1, toBlock, fetch, emit)
+}
+
+// walkBlockPages pages through a single block, starting with batch, the records of page
+func walkBlockPages[T any](ctx context.Context, block, page int64, batch []T, fetch func(fromBlock, toBlock, page int64) ([]T, error), emit func(batch []T, next Checkpoint) error) error {
+	for len(batch) >= logsPerCall {
+		if err := emit(batch, Checkpoint{Block: block, Page: page + 1}); err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		page++
+		var err error
+		if batch, err = fetch(block, block, page); err != nil {
+			return err
+		}
+	}
+	return emit(batch, Checkpoint{Block: block + 1})
+}
+
 // GetEventLogsByTopicsOpts contains optional parameters for GetEventLogsByTopics
 type GetEventLogsByTopicsOpts struct {
 	// Page number for pagination
@@ -174,32 +430,32 @@ type GetEventLogsByTopicsOpts struct {
 	// Topic0_1_Opr is the operator between topic0 and topic1
 	// Options: "and" or "or"
 	// Default: "and"
-	Topic0_1_Opr string `default:"and" json:"topic0_1_opr"`
+	Topic0_1_Opr TopicOperator `default:"and" json:"topic0_1_opr"`
 
 	// Topic0_2_Opr is the operator between topic0 and topic2
 	// Options: "and" or "or"
 	// Default: "and"
-	Topic0_2_Opr string `default:"and" json:"topic0_2_opr"`
+	Topic0_2_Opr TopicOperator `default:"and" json:"topic0_2_opr"`
 
 	// Topic0_3_Opr is the operator between topic0 and topic3
 	// Options: "and" or "or"
 	// Default: "and"
-	Topic0_3_Opr string `default:"and" json:"topic0_3_opr"`
+	Topic0_3_Opr TopicOperator `default:"and" json:"topic0_3_opr"`
 
 	// Topic1_2_Opr is the operator between topic1 and topic2
 	// Options: "and" or "or"
 	// Default: "and"
-	Topic1_2_Opr string `default:"and" json:"topic1_2_opr"`
+	Topic1_2_Opr TopicOperator `default:"and" json:"topic1_2_opr"`
 
 	// Topic1_3_Opr is the operator between topic1 and topic3
 	// Options: "and" or "or"
 	// Default: "and"
-	Topic1_3_Opr string `default:"and" json:"topic1_3_opr"`
+	Topic1_3_Opr TopicOperator `default:"and" json:"topic1_3_opr"`
 
 	// Topic2_3_Opr is the operator between topic2 and topic3
 	// Options: "and" or "or"
 	// Default: "and"
-	Topic2_3_Opr string `default:"and" json:"topic2_3_opr"`
+	Topic2_3_Opr TopicOperator `default:"and" json:"topic2_3_opr"`
 
 	// ChainID specifies which blockchain network to query
 	// If 0, uses the client's default chain ID (EthereumMainnet = 1)
@@ -213,6 +469,14 @@ type GetEventLogsByTopicsOpts struct {
 	//   - RateLimitRaise: Return an error when rate limit is exceeded
 	//   - RateLimitSkip: Return false without executing when rate limit is exceeded
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
+
+	// PageInfo, if set, receives the pagination state of the returned page, see PageInfo
+	// Default: nil
+	PageInfo *PageInfo `json:"-"`
 }
 
 // GetEventLogsByTopics returns event logs filtered by topics in a block range
@@ -280,8 +544,12 @@ func (c *HTTPClient) GetEventLogsByTopics(ctx context.Context, opts *GetEventLog
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
+	var pageInfo *PageInfo
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
+		pageInfo = opts.PageInfo
 	}
 
 	data, err := c.request(requestParams{
@@ -291,15 +559,17 @@ func (c *HTTPClient) GetEventLogsByTopics(ctx context.Context, opts *GetEventLog
 		params:          params,
 		noFoundReturn:   []RespEventLogByTopics{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result []RespEventLogByTopics
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
+	recordPageInfo(pageInfo, params, result)
 	return result, nil
 }
 
@@ -336,27 +606,27 @@ type GetEventLogsByAddressFilteredByTopicsOpts struct {
 
 	// Topic0_1_Opr is the operator between topic0 and topic1
 	// Options: "and" or "or"
-	Topic0_1_Opr string `default:"and" json:"topic0_1_opr"`
+	Topic0_1_Opr TopicOperator `default:"and" json:"topic0_1_opr"`
 
 	// Topic0_2_Opr is the operator between topic0 and topic2
 	// Options: "and" or "or"
-	Topic0_2_Opr string `default:"and" json:"topic0_2_opr"`
+	Topic0_2_Opr TopicOperator `default:"and" json:"topic0_2_opr"`
 
 	// Topic0_3_Opr is the operator between topic0 and topic3
 	// Options: "and" or "or"
-	Topic0_3_Opr string `default:"and" json:"topic0_3_opr"`
+	Topic0_3_Opr TopicOperator `default:"and" json:"topic0_3_opr"`
 
 	// Topic1_2_Opr is the operator between topic1 and topic2
 	// Options: "and" or "or"
-	Topic1_2_Opr string `default:"and" json:"topic1_2_opr"`
+	Topic1_2_Opr TopicOperator `default:"and" json:"topic1_2_opr"`
 
 	// Topic1_3_Opr is the operator between topic1 and topic3
 	// Options: "and" or "or"
-	Topic1_3_Opr string `default:"and" json:"topic1_3_opr"`
+	Topic1_3_Opr TopicOperator `default:"and" json:"topic1_3_opr"`
 
 	// Topic2_3_Opr is the operator between topic2 and topic3
 	// Options: "and" or "or"
-	Topic2_3_Opr string `default:"and" json:"topic2_3_opr"`
+	Topic2_3_Opr TopicOperator `default:"and" json:"topic2_3_opr"`
 
 	// ChainID specifies which blockchain network to query
 	// If 0, uses the client's default chain ID
@@ -365,6 +635,14 @@ type GetEventLogsByAddressFilteredByTopicsOpts struct {
 	// OnLimitExceeded specifies behavior when rate limit is exceeded
 	// If empty, uses the client's default behavior
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
+
+	// PageInfo, if set, receives the pagination state of the returned page, see PageInfo
+	// Default: nil
+	PageInfo *PageInfo `json:"-"`
 }
 
 // GetEventLogsByAddressFilteredByTopics returns event logs from a specific address filtered by topics and block range
@@ -406,8 +684,12 @@ func (c *HTTPClient) GetEventLogsByAddressFilteredByTopics(ctx context.Context,
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
+	var pageInfo *PageInfo
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
+		pageInfo = opts.PageInfo
 	}
 
 	data, err := c.request(requestParams{
@@ -417,14 +699,16 @@ func (c *HTTPClient) GetEventLogsByAddressFilteredByTopics(ctx context.Context,
 		params:          params,
 		noFoundReturn:   []RespEventLogByAddressFilteredByTopics{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result []RespEventLogByAddressFilteredByTopics
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
+	recordPageInfo(pageInfo, params, result)
 	return result, nil
 }
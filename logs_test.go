@@ -250,3 +250,66 @@ func TestGetEventLogsByAddressFilteredByTopicsWithMultipleTopics(t *testing.T) {
 		t.Logf("Found %d event logs for USDT contract with Transfer topic and Vitalik as sender", len(logs))
 	}
 }
+
+func TestCollectLogsByRange(t *testing.T) {
+	// Simulate a contract emitting 7 logs per block and 2500 logs in block 500
+	logsInBlock := func(block int64) int {
+		if block == 500 {
+			return 2500
+		}
+		return 7
+	}
+	calls := 0
+	fetch := func(fromBlock, toBlock, page int64) ([]int64, error) {
+		calls++
+		var all []int64
+		for b := fromBlock; b <= toBlock; b++ {
+			for range logsInBlock(b) {
+				all = append(all, b)
+			}
+		}
+		start := (page - 1) * logsPerCall
+		if start >= int64(len(all)) {
+			return nil, nil
+		}
+		end := start + logsPerCall
+		if end > int64(len(all)) {
+			end = int64(len(all))
+		}
+		return all[start:end], nil
+	}
+
+	logs, err := collectLogsByRange(context.Background(), 0, 999, fetch)
+	if err != nil {
+		t.Fatalf("collectLogsByRange failed: %v", err)
+	}
+
+	expected := 999*7 + 2500
+	if len(logs) != expected {
+		t.Fatalf("expected %d logs, got %d", expected, len(logs))
+	}
+	for i := 1; i < len(logs); i++ {
+		if logs[i] < logs[i-1] {
+			t.Fatalf("logs out of block order at index %d", i)
+		}
+	}
+	t.Logf("Collected %d logs in %d calls", len(logs), calls)
+}
+
+func TestGetAllEventLogs(t *testing.T) {
+	config := GetTestConfig(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 120*time.Second)
+	defer cancel()
+
+	// USDT emits well over 1000 logs in 100 blocks, forcing at least one split
+	logs, err := config.Client.GetAllEventLogs(ctx, TestAddresses.USDTContract, &GetAllEventLogsOpts{
+		FromBlock: 18000000,
+		ToBlock:   18000100,
+		ChainID:   1, // Ethereum mainnet
+	})
+	if err != nil {
+		t.Fatalf("GetAllEventLogs failed: %v", err)
+	}
+
+	t.Logf("Found %d event logs", len(logs))
+}
@@ -0,0 +1,217 @@
+package etherscan
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// ============================================================================
+// Market Capitalization
+// ============================================================================
+
+// MarketCap is the market capitalization of ETH at one point in time
+//
+// Amounts are decimal strings in whole units, formatted like Portfolio's.
+type MarketCap struct {
+	// Time is when the price was last updated, or the UTC day of a historical entry
+	Time time.Time `json:"time" bson:"time"`
+
+	// SupplyETH is the circulating supply in ETH, empty if it is not known for the day
+	SupplyETH string `json:"supplyEth" bson:"supplyEth"`
+
+	// PriceUSD is the price of 1 ETH in USD
+	PriceUSD string `json:"priceUsd" bson:"priceUsd"`
+
+	// MarketCapUSD is SupplyETH times PriceUSD, empty if the supply is not known
+	MarketCapUSD string `json:"marketCapUsd" bson:"marketCapUsd"`
+}
+
+// Dominance returns the share of this market cap in a total market cap, in percent
+//
+// totalUSD is the market cap of the whole market (or of any reference set of
+// assets) in USD, typically taken from a price data provider.
+//
+// Example:
+//
+//	share, err := mc.Dominance("2400000000000")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("ETH dominance: %s%%\n", share)
+func (m *MarketCap) Dominance(totalUSD string) (string, error) {
+	marketCap, ok := new(big.Rat).SetString(strings.TrimSpace(m.MarketCapUSD))
+	if !ok {
+		return "", fmt.Errorf("etherscan: market cap %q is not a number", m.MarketCapUSD)
+	}
+	total, ok := new(big.Rat).SetString(strings.TrimSpace(totalUSD))
+	if !ok || total.Sign() <= 0 {
+		return "", fmt.Errorf("etherscan: invalid total market cap %q", totalUSD)
+	}
+	share := new(big.Rat).Quo(marketCap, total)
+	return formatRat(share.Mul(share, big.NewRat(100, 1))), nil
+}
+
+// GetEthMarketCapOpts contains optional parameters for GetEthMarketCap and GetEthMarketCapHistory
+type GetEthMarketCapOpts struct {
+	// ChainID specifies which blockchain network to query
+	// Default: empty (uses client default)
+	ChainID int64
+
+	// OnLimitExceeded specifies behavior when rate limit is exceeded
+	// Default: RateLimitBlock (wait until a token is available)
+	OnLimitExceeded RateLimitBehavior `default:""`
+}
+
+// GetEthMarketCap returns the current market capitalization of ETH
+//
+// The total supply from GetTotalEthSupply is converted from wei to ETH and
+// multiplied by the USD price from GetNativeTokenPrice, with exact rational
+// arithmetic.
+//
+// Args:
+//   - ctx: Context for request cancellation and timeout
+//   - opts: Optional parameters (can be nil)
+//
+// Returns:
+//   - *MarketCap: Supply, price and market cap; Time is the price update time
+//   - error: Error if a request fails or a response is malformed
+//
+// Example:
+//
+//	mc, err := client.GetEthMarketCap(ctx, nil)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("%s ETH x $%s = $%s\n", mc.SupplyETH, mc.PriceUSD, mc.MarketCapUSD)
+//
+// Note:
+//   - Costs 2 API calls
+//   - The supply excludes ETH2 staking rewards and EIP-1559 burnt fees, like GetTotalEthSupply
+func (c *HTTPClient) GetEthMarketCap(ctx context.Context, opts *GetEthMarketCapOpts) (*MarketCap, error) {
+	if opts == nil {
+		opts = &GetEthMarketCapOpts{}
+	}
+	if err := ApplyDefaults(opts); err != nil {
+		return nil, err
+	}
+
+	supplyWei, err := c.GetTotalEthSupply(ctx, &GetTotalEthSupplyOpts{
+		ChainID:         opts.ChainID,
+		OnLimitExceeded: opts.OnLimitExceeded,
+	})
+	if err != nil {
+		return nil, err
+	}
+	price, err := c.GetNativeTokenPrice(ctx, &GetNativeTokenPriceOpts{
+		ChainID:         opts.ChainID,
+		OnLimitExceeded: opts.OnLimitExceeded,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	supply, ok := scaleUnits(supplyWei, 18)
+	if !ok {
+		return nil, fmt.Errorf("etherscan: invalid ETH supply %q", supplyWei)
+	}
+	return newMarketCap(price.USDTime, supply, price.USD)
+}
+
+// GetEthMarketCapHistory returns the daily market capitalization of ETH over a date range
+//
+// The daily USD prices from GetEthHistoricalPrices are joined by UTC date with
+// the daily supply from GetEthDailyMarketCaps, and each day's market cap is
+// computed as supply times price. Days without a supply entry are kept with an
+// empty SupplyETH and MarketCapUSD.
+//
+// Args:
+//   - ctx: Context for request cancellation and timeout
+//   - startDate: Starting date in yyyy-MM-dd format (e.g., "2019-02-01")
+//   - endDate: Ending date in yyyy-MM-dd format (e.g., "2019-02-28")
+//   - opts: Optional parameters (can be nil)
+//
+// Returns:
+//   - []MarketCap: One entry per priced day, in ascending date order
+//   - error: Error if a request fails or a response is malformed
+//
+// Example:
+//
+//	series, err := client.GetEthMarketCapHistory(ctx, "2019-02-01", "2019-02-28", nil)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, day := range series {
+//	    fmt.Printf("%s: $%s\n", day.Time.Format(time.DateOnly), day.MarketCapUSD)
+//	}
+//
+// Note:
+//   - Costs 2 API calls
+//   - Requires API Pro (ethdailyprice and ethdailymarketcap are Pro endpoints)
+func (c *HTTPClient) GetEthMarketCapHistory(ctx context.Context, startDate, endDate string, opts *GetEthMarketCapOpts) ([]MarketCap, error) {
+	if opts == nil {
+		opts = &GetEthMarketCapOpts{}
+	}
+	if err := ApplyDefaults(opts); err != nil {
+		return nil, err
+	}
+
+	prices, err := c.GetEthHistoricalPrices(ctx, startDate, endDate, &GetEthHistoricalPricesOpts{
+		ChainID:         opts.ChainID,
+		OnLimitExceeded: opts.OnLimitExceeded,
+	})
+	if err != nil {
+		return nil, err
+	}
+	caps, err := c.GetEthDailyMarketCaps(ctx, startDate, endDate, &GetEthDailyMarketCapsOpts{
+		ChainID:         opts.ChainID,
+		OnLimitExceeded: opts.OnLimitExceeded,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	supplies := make(map[string]string, len(caps))
+	for _, day := range caps {
+		supplies[day.UTCDate] = day.Supply
+	}
+
+	series := make([]MarketCap, 0, len(prices))
+	for _, day := range prices {
+		at, err := time.Parse(time.DateOnly, day.UTCDate)
+		if err != nil {
+			return nil, fmt.Errorf("etherscan: invalid date %q: %w", day.UTCDate, err)
+		}
+		raw, ok := supplies[day.UTCDate]
+		if !ok {
+			series = append(series, MarketCap{Time: at, PriceUSD: day.Value})
+			continue
+		}
+		supply, ok := new(big.Rat).SetString(strings.TrimSpace(raw))
+		if !ok {
+			return nil, fmt.Errorf("etherscan: invalid ETH supply %q on %s", raw, day.UTCDate)
+		}
+		mc, err := newMarketCap(at, supply, day.Value)
+		if err != nil {
+			return nil, err
+		}
+		series = append(series, *mc)
+	}
+	return series, nil
+}
+
+// newMarketCap multiplies supply in ETH by priceUSD
+func newMarketCap(at time.Time, supply *big.Rat, priceUSD string) (*MarketCap, error) {
+	price, ok := new(big.Rat).SetString(strings.TrimSpace(priceUSD))
+	if !ok {
+		return nil, fmt.Errorf("etherscan: invalid ETH price %q", priceUSD)
+	}
+	return &MarketCap{
+		Time:         at,
+		SupplyETH:    formatRat(supply),
+		PriceUSD:     formatRat(price),
+		MarketCapUSD: formatRat(new(big.Rat).Mul(supply, price)),
+	}, nil
+}
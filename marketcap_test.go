@@ -0,0 +1,76 @@
+package etherscan
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newMarketCapTestClient(t *testing.T) *HTTPClient {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("action") {
+		case "ethsupply":
+			w.Write([]byte(`{"status":"1","message":"OK","result":"120000000500000000000000000"}`))
+		case "ethprice":
+			w.Write([]byte(`{"status":"1","message":"OK","result":{"ethbtc":"0.05","ethbtc_timestamp":"1700000000","ethusd":"2000.5","ethusd_timestamp":"1700000000"}}`))
+		case "ethdailyprice":
+			w.Write([]byte(`{"status":"1","message":"OK","result":[{"UTCDate":"2019-02-01","unixTimeStamp":"1548979200","value":"107.46"},{"UTCDate":"2019-02-02","unixTimeStamp":"1549065600","value":"110"}]}`))
+		case "ethdailymarketcap":
+			w.Write([]byte(`{"status":"1","message":"OK","result":[{"UTCDate":"2019-02-01","unixTimeStamp":"1548979200","supply":"104481838.5","marketCap":"11227.6","price":"107.46"}]}`))
+		default:
+			w.Write([]byte(`{"status":"0","message":"NOTOK","result":"unexpected action"}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+	})
+}
+
+func TestGetEthMarketCap(t *testing.T) {
+	client := newMarketCapTestClient(t)
+
+	mc, err := client.GetEthMarketCap(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("GetEthMarketCap failed: %v", err)
+	}
+	if mc.SupplyETH != "120000000.5" || mc.PriceUSD != "2000.5" || mc.MarketCapUSD != "240060001000.25" {
+		t.Errorf("unexpected market cap: %+v", mc)
+	}
+	if mc.Time.Unix() != 1700000000 {
+		t.Errorf("expected the price update time, got %s", mc.Time)
+	}
+
+	share, err := mc.Dominance("480120002000.5")
+	if err != nil {
+		t.Fatalf("Dominance failed: %v", err)
+	}
+	if share != "50" {
+		t.Errorf("expected 50%% dominance, got %s", share)
+	}
+	if _, err := mc.Dominance("0"); err == nil {
+		t.Error("expected an error for a zero total")
+	}
+}
+
+func TestGetEthMarketCapHistory(t *testing.T) {
+	client := newMarketCapTestClient(t)
+
+	series, err := client.GetEthMarketCapHistory(context.Background(), "2019-02-01", "2019-02-02", nil)
+	if err != nil {
+		t.Fatalf("GetEthMarketCapHistory failed: %v", err)
+	}
+	if len(series) != 2 {
+		t.Fatalf("expected 2 days, got %d", len(series))
+	}
+	if series[0].MarketCapUSD != "11227618365.21" || series[0].SupplyETH != "104481838.5" {
+		t.Errorf("unexpected first day: %+v", series[0])
+	}
+	if series[1].PriceUSD != "110" || series[1].SupplyETH != "" || series[1].MarketCapUSD != "" {
+		t.Errorf("expected a day without supply to have no market cap: %+v", series[1])
+	}
+}
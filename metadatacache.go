@@ -0,0 +1,182 @@
+package etherscan
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ============================================================================
+// Metadata Cache
+// ============================================================================
+
+// defaultMetadataTTL is how long cached metadata stays fresh when NewMetadataCache gets a zero TTL
+const defaultMetadataTTL = 24 * time.Hour
+
+const (
+	supportedChainsFile = "supported-chains.json"
+	labelMasterlistFile = "label-masterlist-" // + chain ID + ".json"
+)
+
+// metadataEntry is the on-disk form of one cached result
+type metadataEntry struct {
+	FetchedAt time.Time       `json:"fetchedAt"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// MetadataCache keeps the supported chains and label masterlists on disk for a TTL
+//
+// Both change rarely, so CLI invocations and short-lived processes read them
+// from the cache instead of spending a call and a round trip on every run.
+// Each result is one JSON file in the cache directory, written atomically, so
+// several processes can share a directory. A result older than the TTL is
+// fetched again on its next read; Refresh fetches everything cached at once.
+//
+// A MetadataCache is safe for concurrent use.
+type MetadataCache struct {
+	client *HTTPClient
+	dir    string
+	ttl    time.Duration
+	now    func() time.Time
+	mu     sync.Mutex // serializes fetches so concurrent misses cost one call
+}
+
+// NewMetadataCache creates a cache in dir whose entries are fresh for ttl
+//
+// Args:
+//   - client: Client used to fetch missing and expired entries
+//   - dir: Cache directory, created if missing; empty uses "etherscan-go" in os.UserCacheDir
+//   - ttl: How long an entry is fresh; 0 uses 24 hours
+//
+// Example:
+//
+//	cache, err := etherscan.NewMetadataCache(client, "", 0)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	chains, err := cache.SupportedChains(ctx)
+func NewMetadataCache(client *HTTPClient, dir string, ttl time.Duration) (*MetadataCache, error) {
+	if dir == "" {
+		base, err := os.UserCacheDir()
+		if err != nil {
+			return nil, fmt.Errorf("etherscan: metadata cache: %w", err)
+		}
+		dir = filepath.Join(base, "etherscan-go")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("etherscan: metadata cache: %w", err)
+	}
+	if ttl <= 0 {
+		ttl = defaultMetadataTTL
+	}
+	return &MetadataCache{client: client, dir: dir, ttl: ttl, now: time.Now}, nil
+}
+
+// SupportedChains returns GetSupportedChains, from the cache while it is fresh
+func (m *MetadataCache) SupportedChains(ctx context.Context) (*RespSupportedChains, error) {
+	var chains RespSupportedChains
+	err := m.get(supportedChainsFile, false, &chains, func() (any, error) {
+		return m.client.GetSupportedChains(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &chains, nil
+}
+
+// LabelMasterlist returns GetLabelMasterlist of a chain, from the cache while it is fresh
+//
+// chainID 0 is the client default, cached under that chain.
+func (m *MetadataCache) LabelMasterlist(ctx context.Context, chainID int64) ([]RespLabelMaster, error) {
+	return m.labelMasterlist(ctx, chainID, false)
+}
+
+// labelMasterlist reads the label masterlist of chainID, fetching it if stale or if refresh is set
+func (m *MetadataCache) labelMasterlist(ctx context.Context, chainID int64, refresh bool) ([]RespLabelMaster, error) {
+	if chainID == 0 {
+		chainID = int64(m.client.defaultChainID)
+	}
+	var labels []RespLabelMaster
+	name := labelMasterlistFile + strconv.FormatInt(chainID, 10) + ".json"
+	err := m.get(name, refresh, &labels, func() (any, error) {
+		return m.client.GetLabelMasterlist(ctx, &GetLabelMasterlistOpts{ChainID: chainID})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return labels, nil
+}
+
+// Refresh fetches the supported chains and every cached label masterlist again, whatever their age
+//
+// The entries fetched before an error stay refreshed.
+func (m *MetadataCache) Refresh(ctx context.Context) error {
+	var chains RespSupportedChains
+	err := m.get(supportedChainsFile, true, &chains, func() (any, error) {
+		return m.client.GetSupportedChains(ctx)
+	})
+	if err != nil {
+		return err
+	}
+
+	names, err := filepath.Glob(filepath.Join(m.dir, labelMasterlistFile+"*.json"))
+	if err != nil {
+		return fmt.Errorf("etherscan: metadata cache: %w", err)
+	}
+	for _, name := range names {
+		id := strings.TrimSuffix(strings.TrimPrefix(filepath.Base(name), labelMasterlistFile), ".json")
+		chainID, err := strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			continue
+		}
+		if _, err := m.labelMasterlist(ctx, chainID, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// get decodes the entry name into target, calling fetch and storing its result if the entry is missing, stale or refresh is set
+func (m *MetadataCache) get(name string, refresh bool, target any, fetch func() (any, error)) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	path := filepath.Join(m.dir, name)
+
+	if !refresh {
+		data, err := os.ReadFile(path)
+		switch {
+		case err == nil:
+			var entry metadataEntry
+			if json.Unmarshal(data, &entry) == nil && m.now().Sub(entry.FetchedAt) < m.ttl && json.Unmarshal(entry.Data, target) == nil {
+				return nil
+			}
+			// A corrupt or stale entry is fetched again
+		case !errors.Is(err, os.ErrNotExist):
+			return fmt.Errorf("etherscan: metadata cache: %w", err)
+		}
+	}
+
+	result, err := fetch()
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	entry, err := json.Marshal(metadataEntry{FetchedAt: m.now(), Data: data})
+	if err != nil {
+		return err
+	}
+	if err := writeFileAtomic(path, entry, "metadata cache"); err != nil {
+		return err
+	}
+	return json.Unmarshal(data, target)
+}
@@ -0,0 +1,125 @@
+package etherscan
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// metadataServer answers the chain list and label masterlist requests and counts them
+type metadataServer struct {
+	mu     sync.Mutex
+	calls  map[string]int
+	labels string
+}
+
+func (s *metadataServer) RoundTrip(req *http.Request) (*http.Response, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	body := `{"totalcount":1,"result":[{"chainname":"Ethereum Mainnet","chainid":"1"}]}`
+	key := "chainlist"
+	if req.URL.Query().Get("action") == "getlabelmasterlist" {
+		key = "labels." + req.URL.Query().Get("chainid")
+		body = `{"status":"1","message":"OK","result":[{"labelname":"` + s.labels + `","labelslug":"exchange"}]}`
+	}
+	s.calls[key]++
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}, nil
+}
+
+func TestMetadataCache(t *testing.T) {
+	server := &metadataServer{calls: make(map[string]int), labels: "Exchange"}
+	client := NewHTTPClient(HTTPClientConfig{
+		APIKey:     "test",
+		HTTPClient: &http.Client{Transport: server},
+		MaxRetries: -1,
+	})
+	dir := filepath.Join(t.TempDir(), "cache")
+	ctx := context.Background()
+
+	cache, err := NewMetadataCache(client, dir, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Unix(1700000000, 0)
+	cache.now = func() time.Time { return now }
+
+	for i := 0; i < 2; i++ {
+		chains, err := cache.SupportedChains(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if chains.TotalCount != 1 || len(chains.Result) != 1 {
+			t.Fatalf("unexpected chains: %+v", chains)
+		}
+		labels, err := cache.LabelMasterlist(ctx, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(labels) != 1 || labels[0].LabelName != "Exchange" {
+			t.Fatalf("unexpected labels: %+v", labels)
+		}
+	}
+	if server.calls["chainlist"] != 1 || server.calls["labels.1"] != 1 {
+		t.Errorf("expected one call each while fresh, got %v", server.calls)
+	}
+
+	// Another process sharing the directory reads the same entries
+	other, err := NewMetadataCache(client, dir, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other.now = cache.now
+	if _, err := other.LabelMasterlist(ctx, EthereumMainnet); err != nil {
+		t.Fatal(err)
+	}
+	if server.calls["labels.1"] != 1 {
+		t.Errorf("expected the shared entry to be used, got %v", server.calls)
+	}
+
+	// Expired entries are fetched again
+	now = now.Add(2 * time.Hour)
+	if _, err := cache.SupportedChains(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if server.calls["chainlist"] != 2 {
+		t.Errorf("expected the stale chain list to be fetched again, got %v", server.calls)
+	}
+
+	// Refresh fetches everything cached regardless of age
+	server.labels = "Exchange v2"
+	if err := cache.Refresh(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if server.calls["chainlist"] != 3 || server.calls["labels.1"] != 2 {
+		t.Errorf("expected Refresh to fetch both entries, got %v", server.calls)
+	}
+	labels, err := cache.LabelMasterlist(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if labels[0].LabelName != "Exchange v2" || server.calls["labels.1"] != 2 {
+		t.Errorf("expected the refreshed labels from the cache, got %+v after %v", labels, server.calls)
+	}
+
+	// A corrupt entry is fetched again
+	if err := os.WriteFile(filepath.Join(dir, supportedChainsFile), []byte("{"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cache.SupportedChains(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if server.calls["chainlist"] != 4 {
+		t.Errorf("expected the corrupt entry to be fetched again, got %v", server.calls)
+	}
+}
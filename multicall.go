@@ -0,0 +1,296 @@
+package etherscan
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/dwdwow/etherscan-go/parallel"
+)
+
+// ============================================================================
+// Multicall Token Balance Snapshots
+// ============================================================================
+
+// Multicall3Address is the address Multicall3 is deployed at on most EVM chains
+const Multicall3Address = "0xcA11bde05977b3631167028862bE2a173976CA11"
+
+const (
+	// selectorAggregate3 is the selector of aggregate3((address,bool,bytes)[])
+	selectorAggregate3 = "0x82ad56cb"
+
+	// selectorBalanceOf is the selector of balanceOf(address)
+	selectorBalanceOf = "70a08231"
+)
+
+// BalanceSource identifies how a TokenHolderBalance was obtained
+type BalanceSource string
+
+const (
+	// BalanceSourceMulticall means the balance came from a batched Multicall3 eth_call
+	BalanceSourceMulticall BalanceSource = "multicall"
+
+	// BalanceSourceHistory means the balance came from the tokenbalancehistory endpoint
+	BalanceSourceHistory BalanceSource = "tokenbalancehistory"
+)
+
+// TokenHolderBalance is the token balance of one holder at a block
+type TokenHolderBalance struct {
+	Holder string `json:"holder" bson:"holder"`
+
+	// Balance is in the token's smallest unit
+	Balance *big.Int `json:"balance" bson:"balance"`
+
+	Source BalanceSource `json:"source" bson:"source"`
+}
+
+// GetTokenBalancesAtOpts contains optional parameters for GetTokenBalancesAt
+type GetTokenBalancesAtOpts struct {
+	// BatchSize is the number of balanceOf calls per Multicall3 eth_call
+	// Default: 20 (the call data travels in the query string, so large batches hit URL limits)
+	BatchSize int `default:"20"`
+
+	// Concurrency is the number of batches fetched in parallel
+	// Default: 4
+	Concurrency int `default:"4"`
+
+	// Multicall is the Multicall3 contract address
+	// Default: Multicall3Address
+	Multicall string `default:"0xcA11bde05977b3631167028862bE2a173976CA11"`
+
+	// ChainID specifies which blockchain network to query
+	// Default: empty (uses client default)
+	ChainID int64
+
+	// OnLimitExceeded specifies behavior when rate limit is exceeded
+	// Default: RateLimitBlock (wait until a token is available)
+	OnLimitExceeded RateLimitBehavior `default:""`
+}
+
+// GetTokenBalancesAt returns the ERC-20 balances of many holders at a block
+//
+// Holders are batched into Multicall3 aggregate3 calls executed with eth_call at
+// the given block, so a snapshot of thousands of holders costs one call per
+// BatchSize holders instead of one per holder. If the node cannot serve the
+// historical state (no archive data, or Multicall3 was not deployed yet) or an
+// individual balanceOf fails, the affected holders fall back to
+// GetERC20HistoricalAccountBalance.
+//
+// Args:
+//   - ctx: Context for request cancellation and timeout
+//   - token: The ERC-20 contract address
+//   - holders: Addresses to snapshot
+//   - block: The block number to read balances at
+//   - opts: Optional parameters (can be nil)
+//
+// Returns:
+//   - []TokenHolderBalance: One balance per holder, in the order of holders
+//   - error: Error if a request fails
+//
+// Example:
+//
+//	balances, err := client.GetTokenBalancesAt(ctx, usdcAddr, holders, 18000000, nil)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, b := range balances {
+//	    fmt.Printf("%s: %s (%s)\n", b.Holder, b.Balance, b.Source)
+//	}
+//
+// Note:
+//   - The tokenbalancehistory fallback is a PRO endpoint throttled to 2 calls/second
+//   - Multicall3 must be deployed at opts.Multicall on the queried chain
+func (c *HTTPClient) GetTokenBalancesAt(ctx context.Context, token string, holders []string, block int64, opts *GetTokenBalancesAtOpts) ([]TokenHolderBalance, error) {
+	if opts == nil {
+		opts = &GetTokenBalancesAtOpts{}
+	}
+	if err := ApplyDefaults(opts); err != nil {
+		return nil, err
+	}
+	if block < 0 {
+		return nil, fmt.Errorf("etherscan: invalid block number %d", block)
+	}
+	batchSize := max(opts.BatchSize, 1)
+
+	var batches [][]string
+	for start := 0; start < len(holders); start += batchSize {
+		end := start + batchSize
+		if end > len(holders) {
+			end = len(holders)
+		}
+		batches = append(batches, holders[start:end])
+	}
+	results, err := parallel.Map(ctx, batches, opts.Concurrency, func(ctx context.Context, batch []string) ([]TokenHolderBalance, error) {
+		return c.fetchTokenBalances(ctx, token, batch, block, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	balances := make([]TokenHolderBalance, 0, len(holders))
+	for _, batch := range results {
+		balances = append(balances, batch...)
+	}
+	return balances, nil
+}
+
+// fetchTokenBalances returns the balances of one batch of holders
+func (c *HTTPClient) fetchTokenBalances(ctx context.Context, token string, holders []string, block int64, opts *GetTokenBalancesAtOpts) ([]TokenHolderBalance, error) {
+	calls := make([]string, len(holders))
+	for i, holder := range holders {
+		word, err := addressWord(holder)
+		if err != nil {
+			return nil, err
+		}
+		calls[i] = selectorBalanceOf + word
+	}
+	data, err := encodeAggregate3(token, calls)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.rpcProxyCall(ctx, "eth_call", map[string]string{
+		"to":      opts.Multicall,
+		"data":    data,
+		"tag":     string(BlockNumberTag(block)),
+		"chainid": strconv.FormatInt(opts.ChainID, 10),
+	}, opts.OnLimitExceeded)
+	if err != nil {
+		return nil, err
+	}
+
+	// An RPC error or empty result means the state is unavailable; every holder falls back
+	var results []multicallResult
+	if resp.Error == nil {
+		results = decodeAggregate3(resp.Result)
+	}
+	if len(results) != len(holders) {
+		results = make([]multicallResult, len(holders))
+	}
+
+	balances := make([]TokenHolderBalance, len(holders))
+	for i, holder := range holders {
+		if results[i].success {
+			if balance, ok := new(big.Int).SetString(results[i].returnData, 16); ok {
+				balances[i] = TokenHolderBalance{Holder: holder, Balance: balance, Source: BalanceSourceMulticall}
+				continue
+			}
+		}
+
+		raw, err := c.GetERC20HistoricalAccountBalance(ctx, token, holder, block, &GetERC20HistoricalAccountBalanceOpts{
+			ChainID:         opts.ChainID,
+			OnLimitExceeded: opts.OnLimitExceeded,
+		})
+		if err != nil {
+			return nil, err
+		}
+		balance, err := ParseQuantity(raw)
+		if err != nil {
+			return nil, fmt.Errorf("etherscan: invalid token balance %q for %s", raw, holder)
+		}
+		balances[i] = TokenHolderBalance{Holder: holder, Balance: balance, Source: BalanceSourceHistory}
+	}
+	return balances, nil
+}
+
+// multicallResult is one (bool success, bytes returnData) element of an aggregate3 result
+type multicallResult struct {
+	success    bool
+	returnData string
+}
+
+// addressWord left-pads a hex address to a 32-byte ABI word
+func addressWord(address string) (string, error) {
+	hexAddr := strings.ToLower(strings.TrimPrefix(address, "0x"))
+	if len(hexAddr) != 40 {
+		return "", fmt.Errorf("etherscan: invalid address %q", address)
+	}
+	if _, ok := new(big.Int).SetString(hexAddr, 16); !ok {
+		return "", fmt.Errorf("etherscan: invalid address %q", address)
+	}
+	return strings.Repeat("0", 24) + hexAddr, nil
+}
+
+// encodeAggregate3 encodes aggregate3 calls of target with allowFailure set, calls being hex call data without "0x"
+func encodeAggregate3(target string, calls []string) (string, error) {
+	targetWord, err := addressWord(target)
+	if err != nil {
+		return "", err
+	}
+	word := func(n int) string { return fmt.Sprintf("%064x", n) }
+
+	// Each tuple is (address, bool, offset of bytes, bytes length, bytes padded to whole words)
+	tuples := make([]string, len(calls))
+	for i, call := range calls {
+		length := len(call) / 2
+		padded := call + strings.Repeat("0", (64-len(call)%64)%64)
+		tuples[i] = targetWord + word(1) + word(0x60) + word(length) + padded
+	}
+
+	var b strings.Builder
+	b.WriteString(selectorAggregate3)
+	b.WriteString(word(0x20))
+	b.WriteString(word(len(calls)))
+	offset := 32 * len(calls)
+	for _, tuple := range tuples {
+		b.WriteString(word(offset))
+		offset += len(tuple) / 2
+	}
+	for _, tuple := range tuples {
+		b.WriteString(tuple)
+	}
+	return b.String(), nil
+}
+
+// decodeAggregate3 decodes an aggregate3 return value, returning nil if result is empty or malformed
+func decodeAggregate3(result string) []multicallResult {
+	words := splitWords(result)
+	at := func(i int64) (int64, bool) {
+		if i < 0 || i >= int64(len(words)) {
+			return 0, false
+		}
+		n, ok := new(big.Int).SetString(words[i], 16)
+		if !ok || !n.IsInt64() {
+			return 0, false
+		}
+		return n.Int64(), true
+	}
+
+	offset, ok := at(0)
+	if !ok || offset%32 != 0 {
+		return nil
+	}
+	arrayStart := offset / 32
+	count, ok := at(arrayStart)
+	if !ok || count > int64(len(words)) {
+		return nil
+	}
+
+	results := make([]multicallResult, count)
+	for i := range results {
+		tupleOffset, ok := at(arrayStart + 1 + int64(i))
+		if !ok || tupleOffset%32 != 0 {
+			return nil
+		}
+		tuple := arrayStart + 1 + tupleOffset/32
+		success, ok := at(tuple)
+		if !ok {
+			return nil
+		}
+		dataOffset, ok := at(tuple + 1)
+		if !ok || dataOffset%32 != 0 {
+			return nil
+		}
+		dataStart := tuple + dataOffset/32
+		// at succeeded, so dataStart < len(words) and the bound below cannot overflow
+		length, ok := at(dataStart)
+		if !ok || length > 32*(int64(len(words))-dataStart-1) {
+			return nil
+		}
+		data := strings.Join(words[dataStart+1:dataStart+1+(length+31)/32], "")
+		results[i] = multicallResult{success: success == 1, returnData: data[:2*length]}
+	}
+	return results
+}
@@ -0,0 +1,142 @@
+package etherscan
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+// encodeAggregate3Result ABI-encodes a (bool,bytes)[] aggregate3 return value
+func encodeAggregate3Result(results []multicallResult) string {
+	word := func(n int) string { return fmt.Sprintf("%064x", n) }
+	var offsets, tuples strings.Builder
+	offset := 32 * len(results)
+	for _, r := range results {
+		success := 0
+		if r.success {
+			success = 1
+		}
+		padded := r.returnData + strings.Repeat("0", (64-len(r.returnData)%64)%64)
+		tuple := word(success) + word(0x40) + word(len(r.returnData)/2) + padded
+		offsets.WriteString(word(offset))
+		tuples.WriteString(tuple)
+		offset += len(tuple) / 2
+	}
+	return "0x" + word(0x20) + word(len(results)) + offsets.String() + tuples.String()
+}
+
+func TestGetTokenBalancesAt(t *testing.T) {
+	var calls, historyCalls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		switch query.Get("action") {
+		case "eth_call":
+			calls.Add(1)
+			if !strings.EqualFold(query.Get("to"), Multicall3Address) || !strings.HasPrefix(query.Get("data"), selectorAggregate3) {
+				t.Errorf("unexpected eth_call to %s", query.Get("to"))
+			}
+			if query.Get("tag") == string(BlockNumberTag(1)) {
+				w.Write([]byte(`{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"missing trie node"}}`))
+				return
+			}
+			// Every holder's balance is its last address byte; holder 0x..ff reverts
+			var results []multicallResult
+			for _, part := range strings.Split(query.Get("data"), selectorBalanceOf+strings.Repeat("0", 24))[1:] {
+				var last int
+				fmt.Sscanf(part[38:40], "%02x", &last)
+				results = append(results, multicallResult{success: last != 0xff, returnData: fmt.Sprintf("%064x", last)})
+			}
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":"%s"}`, encodeAggregate3Result(results))
+		case "tokenbalancehistory":
+			historyCalls.Add(1)
+			fmt.Fprintf(w, `{"status":"1","message":"OK","result":"%d"}`, 1000+len(query.Get("address")))
+		}
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+	})
+	ctx := context.Background()
+	token := "0x" + strings.Repeat("ab", 20)
+	var holders []string
+	for i := 1; i <= 7; i++ {
+		holders = append(holders, fmt.Sprintf("0x%038x%02x", 0, i))
+	}
+	holders = append(holders, "0x"+strings.Repeat("0", 38)+"ff")
+
+	balances, err := client.GetTokenBalancesAt(ctx, token, holders, 18000000, &GetTokenBalancesAtOpts{BatchSize: 3})
+	if err != nil {
+		t.Fatalf("GetTokenBalancesAt failed: %v", err)
+	}
+	if calls.Load() != 3 || historyCalls.Load() != 1 {
+		t.Errorf("expected 3 multicalls and 1 history call, got %d and %d", calls.Load(), historyCalls.Load())
+	}
+	for i, b := range balances[:7] {
+		if b.Holder != holders[i] || b.Balance.Int64() != int64(i+1) || b.Source != BalanceSourceMulticall {
+			t.Errorf("holder %d: unexpected balance %+v", i, b)
+		}
+	}
+	if b := balances[7]; b.Balance.Int64() != 1042 || b.Source != BalanceSourceHistory {
+		t.Errorf("reverted balanceOf should fall back to history, got %+v", b)
+	}
+
+	// Without archive state every holder falls back
+	historyCalls.Store(0)
+	balances, err = client.GetTokenBalancesAt(ctx, token, holders[:2], 1, nil)
+	if err != nil {
+		t.Fatalf("GetTokenBalancesAt failed: %v", err)
+	}
+	if historyCalls.Load() != 2 || balances[0].Source != BalanceSourceHistory || balances[1].Balance.Int64() != 1042 {
+		t.Errorf("expected history fallback for all holders, got %+v", balances)
+	}
+
+	if _, err := client.GetTokenBalancesAt(ctx, token, []string{"0xnotanaddress"}, 1, nil); err == nil {
+		t.Error("expected error for invalid holder address")
+	}
+}
+
+func TestAggregate3RoundTrip(t *testing.T) {
+	data, err := encodeAggregate3("0x"+strings.Repeat("11", 20), []string{selectorBalanceOf + strings.Repeat("0", 64), "deadbeef"})
+	if err != nil {
+		t.Fatalf("encodeAggregate3 failed: %v", err)
+	}
+	// selector, array offset and length, 2 offsets, 6-word and 5-word tuples
+	if want := 10 + 64*(2+2+6+5); len(data) != want {
+		t.Errorf("expected %d hex chars, got %d", want, len(data))
+	}
+
+	want := []multicallResult{{success: true, returnData: "01"}, {success: false, returnData: ""}}
+	got := decodeAggregate3(encodeAggregate3Result(want))
+	if len(got) != 2 || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("round trip mismatch: %+v", got)
+	}
+	if decodeAggregate3("0x") != nil {
+		t.Error("empty result should decode to nil")
+	}
+}
+
+func TestDecodeAggregate3Malformed(t *testing.T) {
+	word := func(n uint64) string { return fmt.Sprintf("%064x", n) }
+	huge := "7f" + strings.Repeat("ff", 31)
+	valid := encodeAggregate3Result([]multicallResult{{success: true, returnData: "01"}})
+	for name, result := range map[string]string{
+		"not hex":           "0xzz",
+		"odd offset":        "0x" + word(0x21) + word(1),
+		"huge offset":       "0x" + huge + word(1),
+		"count past end":    "0x" + word(0x20) + word(5) + word(0x20),
+		"huge tuple offset": "0x" + word(0x20) + word(1) + huge,
+		"length near max":   "0x" + word(0x20) + word(1) + word(0x20) + word(1) + word(0x40) + word(1<<63-1),
+		"length past end":   "0x" + word(0x20) + word(1) + word(0x20) + word(1) + word(0x40) + word(33) + word(0),
+		"truncated":         valid[:len(valid)-64],
+	} {
+		if got := decodeAggregate3(result); got != nil {
+			t.Errorf("%s: expected nil, got %+v", name, got)
+		}
+	}
+}
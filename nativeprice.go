@@ -0,0 +1,174 @@
+package etherscan
+
+import (
+	"context"
+	"time"
+)
+
+// ============================================================================
+// Native Token Price
+// ============================================================================
+
+// NativeTokenSymbols maps chain IDs to the symbol of their native gas token
+//
+// Entries can be overridden or extended for chains not listed here.
+var NativeTokenSymbols = map[int]string{
+	EthereumMainnet:         "ETH",
+	SepoliaTestnet:          "ETH",
+	HoleskyTestnet:          "ETH",
+	HoodiTestnet:            "ETH",
+	AbstractMainnet:         "ETH",
+	AbstractSepoliaTestnet:  "ETH",
+	ApechainCurtisTestnet:   "APE",
+	ApechainMainnet:         "APE",
+	ArbitrumNovaMainnet:     "ETH",
+	ArbitrumOneMainnet:      "ETH",
+	ArbitrumSepoliaTestnet:  "ETH",
+	AvalancheCChain:         "AVAX",
+	AvalancheFujiTestnet:    "AVAX",
+	BaseMainnet:             "ETH",
+	BaseSepoliaTestnet:      "ETH",
+	BerachainMainnet:        "BERA",
+	BerachainBepoliaTestnet: "BERA",
+	BittorrentChainMainnet:  "BTT",
+	BittorrentChainTestnet:  "BTT",
+	BlastMainnet:            "ETH",
+	BlastSepoliaTestnet:     "ETH",
+	BNBSmartChainMainnet:    "BNB",
+	BNBSmartChainTestnet:    "BNB",
+	CeloAlfajoresTestnet:    "CELO",
+	CeloMainnet:             "CELO",
+	CronosMainnet:           "CRO",
+	FraxtalMainnet:          "FRAX",
+	FraxtalTestnet:          "FRAX",
+	Gnosis:                  "XDAI",
+	HyperEVMMainnet:         "HYPE",
+	LineaMainnet:            "ETH",
+	LineaSepoliaTestnet:     "ETH",
+	MantleMainnet:           "MNT",
+	MantleSepoliaTestnet:    "MNT",
+	MoonbaseAlphaTestnet:    "DEV",
+	MonadTestnet:            "MON",
+	MoonbeamMainnet:         "GLMR",
+	MoonriverMainnet:        "MOVR",
+	OPMainnet:               "ETH",
+	OPSepoliaTestnet:        "ETH",
+	PolygonMainnet:          "POL",
+	PolygonAmoyTestnet:      "POL",
+	KatanaMainnet:           "ETH",
+	KatanaBokutoTestnet:     "ETH",
+	SeiMainnet:              "SEI",
+	SeiTestnet:              "SEI",
+	ScrollMainnet:           "ETH",
+	ScrollSepoliaTestnet:    "ETH",
+	SonicTestnet:            "S",
+	SonicMainnet:            "S",
+	SophonMainnet:           "SOPH",
+	SophonSepoliaTestnet:    "SOPH",
+	SwellchainMainnet:       "ETH",
+	SwellchainTestnet:       "ETH",
+	TaikoMainnet:            "ETH",
+	TaikoHoodiTestnet:       "ETH",
+	UnichainMainnet:         "ETH",
+	UnichainSepoliaTestnet:  "ETH",
+	WorldMainnet:            "ETH",
+	WorldSepoliaTestnet:     "ETH",
+	XDCApothemTestnet:       "XDC",
+	XDCMainnet:              "XDC",
+	ZKSyncMainnet:           "ETH",
+	ZKSyncSepoliaTestnet:    "ETH",
+	OpBNBMainnet:            "BNB",
+	OpBNBTestnet:            "BNB",
+}
+
+// NativePrice is the latest price of a chain's native token
+type NativePrice struct {
+	ChainID int64 `json:"chainId" bson:"chainId"`
+
+	// Symbol is the native token symbol from NativeTokenSymbols, empty for unknown chains
+	Symbol string `json:"symbol" bson:"symbol"`
+
+	// BTC is the price in BTC and BTCTime when it was last updated
+	BTC     string    `json:"btc" bson:"btc"`
+	BTCTime time.Time `json:"btcTime" bson:"btcTime"`
+
+	// USD is the price in USD and USDTime when it was last updated
+	USD     string    `json:"usd" bson:"usd"`
+	USDTime time.Time `json:"usdTime" bson:"usdTime"`
+}
+
+// GetNativeTokenPriceOpts contains optional parameters for GetNativeTokenPrice
+type GetNativeTokenPriceOpts struct {
+	// ChainID specifies which blockchain network to query
+	// Default: empty (uses client default)
+	ChainID int64
+
+	// OnLimitExceeded specifies behavior when rate limit is exceeded
+	// Default: RateLimitBlock (wait until a token is available)
+	OnLimitExceeded RateLimitBehavior `default:""`
+}
+
+// GetNativeTokenPrice returns the latest price of the chain's native token with neutral field names
+//
+// The stats ethprice endpoint returns the native token price on every chain
+// (POL on Polygon, BNB on BNB Smart Chain, ...) but names its fields ethbtc and
+// ethusd. This helper replaces GetEthPrice, labels the price with the native
+// symbol of the chain and parses the timestamps.
+//
+// Args:
+//   - ctx: Context for request cancellation and timeout
+//   - opts: Optional parameters (can be nil)
+//
+// Returns:
+//   - *NativePrice: Symbol, BTC and USD prices and their update times
+//   - error: Error if the request fails or a timestamp is malformed
+//
+// Example:
+//
+//	price, err := client.GetNativeTokenPrice(ctx, &GetNativeTokenPriceOpts{ChainID: PolygonMainnet})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("%s: $%s as of %s\n", price.Symbol, price.USD, price.USDTime)
+func (c *HTTPClient) GetNativeTokenPrice(ctx context.Context, opts *GetNativeTokenPriceOpts) (*NativePrice, error) {
+	if opts == nil {
+		opts = &GetNativeTokenPriceOpts{}
+	}
+	if err := ApplyDefaults(opts); err != nil {
+		return nil, err
+	}
+
+	raw, err := c.getEthPrice(ctx, &GetEthPriceOpts{
+		ChainID:         opts.ChainID,
+		OnLimitExceeded: opts.OnLimitExceeded,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	chainID := opts.ChainID
+	if chainID == 0 {
+		chainID = int64(c.defaultChainID)
+	}
+	price := &NativePrice{
+		ChainID: chainID,
+		Symbol:  NativeTokenSymbols[int(chainID)],
+		BTC:     raw.EthBTC,
+		USD:     raw.EthUSD,
+	}
+	if price.BTCTime, err = parseUnixTime(raw.EthBTCTimestamp); err != nil {
+		return nil, err
+	}
+	if price.USDTime, err = parseUnixTime(raw.EthUSDTimestamp); err != nil {
+		return nil, err
+	}
+	return price, nil
+}
+
+// parseUnixTime parses a timestamp with ParseTimestamp, returning the zero time if s is empty
+func parseUnixTime(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	return ParseTimestamp(s)
+}
@@ -0,0 +1,37 @@
+package etherscan
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestGetNativeTokenPrice(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":"1","message":"OK","result":{"ethbtc":"0.0000035","ethbtc_timestamp":"1704067200","ethusd":"0.23","ethusd_timestamp":"1704067260"}}`))
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion:     APIVersionV1,
+		DefaultChainID: PolygonMainnet,
+		V1BaseURLs:     map[int]string{PolygonMainnet: server.URL},
+	})
+
+	price, err := client.GetNativeTokenPrice(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("GetNativeTokenPrice failed: %v", err)
+	}
+	if price.ChainID != PolygonMainnet || price.Symbol != "POL" || price.USD != "0.23" || price.BTC != "0.0000035" {
+		t.Errorf("unexpected price: %+v", price)
+	}
+	if !price.USDTime.Equal(time.Date(2024, 1, 1, 0, 1, 0, 0, time.UTC)) || !price.BTCTime.Equal(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected timestamps: %s, %s", price.BTCTime, price.USDTime)
+	}
+
+	if _, err := parseUnixTime("soon"); err == nil {
+		t.Error("expected error for invalid timestamp")
+	}
+}
@@ -0,0 +1,316 @@
+package etherscan
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ============================================================================
+// NFT Metadata
+// ============================================================================
+
+// ErrNoTokenURI is returned when a contract implements neither tokenURI nor uri for a token
+var ErrNoTokenURI = errors.New("token has no metadata uri")
+
+// Function selectors of the metadata getters
+const (
+	// selectorTokenURI is tokenURI(uint256) of ERC-721
+	selectorTokenURI = "0xc87b56dd"
+	// selectorURI is uri(uint256) of ERC-1155
+	selectorURI = "0x0e89341c"
+)
+
+// DefaultIPFSGateway is the gateway used to resolve ipfs:// URIs
+const DefaultIPFSGateway = "https://ipfs.io/ipfs/"
+
+// defaultNFTMetadataTimeout bounds the metadata download when GetNFTMetadataOpts.Timeout is zero
+const defaultNFTMetadataTimeout = 10 * time.Second
+
+// NFTMetadata is the normalized metadata of an ERC-721 or ERC-1155 token
+type NFTMetadata struct {
+	ContractAddress string
+	TokenID         string
+
+	// TokenURI is the URI returned by the contract
+	TokenURI string
+
+	// MetadataURL is the URL the metadata was fetched from, empty for data: URIs
+	MetadataURL string
+
+	Name        string
+	Description string
+
+	// Image is the image URI as found in the metadata
+	Image string
+
+	// ImageURL is Image with ipfs:// and ar:// resolved to HTTP gateways
+	ImageURL string
+
+	ExternalURL  string
+	AnimationURL string
+	Attributes   []NFTAttribute
+
+	// Raw is the undecoded metadata document
+	Raw json.RawMessage
+}
+
+// NFTAttribute is a trait of an NFT
+type NFTAttribute struct {
+	TraitType   string `json:"trait_type"`
+	Value       any    `json:"value"`
+	DisplayType string `json:"display_type,omitempty"`
+}
+
+// GetNFTMetadataOpts contains optional parameters for GetNFTMetadata
+type GetNFTMetadataOpts struct {
+	// IPFSGateway is the gateway prefix ipfs:// URIs are resolved against
+	// Default: DefaultIPFSGateway
+	IPFSGateway string `default:"https://ipfs.io/ipfs/"`
+
+	// MaxSize is the maximum metadata document size in bytes
+	// Default: 1048576 (1 MiB)
+	MaxSize int64 `default:"1048576"`
+
+	// Timeout bounds the metadata download
+	// Default: 10s
+	Timeout time.Duration
+
+	// HTTPClient fetches the metadata; the client's own HTTP client is used if nil
+	HTTPClient *http.Client
+
+	// ChainID specifies which blockchain network to query
+	// Default: empty (uses client default)
+	ChainID int64
+
+	// OnLimitExceeded specifies behavior when rate limit is exceeded
+	// Default: RateLimitBlock (wait until a token is available)
+	OnLimitExceeded RateLimitBehavior `default:""`
+}
+
+// GetNFTMetadata returns the metadata of an NFT
+//
+// The metadata URI is read with tokenURI(uint256) (ERC-721), falling back to
+// uri(uint256) (ERC-1155, with the {id} placeholder substituted). ipfs:// and
+// ar:// URIs are resolved to HTTP gateways and data: URIs are decoded in place.
+// The document is then downloaded with a size limit and timeout, and the
+// common fields are normalized.
+//
+// Args:
+//   - ctx: Context for request cancellation and timeout
+//   - contractAddress: NFT contract address
+//   - tokenID: Token ID in decimal
+//   - opts: Optional parameters (can be nil)
+//
+// Returns:
+//   - *NFTMetadata: The normalized metadata
+//   - error: ErrNoTokenURI if the contract exposes no URI, or a fetch/decode error
+//
+// Example:
+//
+//	meta, err := client.GetNFTMetadata(ctx, "0xbc4ca0eda7647a8ab7c2061c2e118a18a936f13d", "1", nil)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("%s: %s\n", meta.Name, meta.ImageURL)
+//
+// Note:
+//   - Costs 1-2 API calls; the metadata download does not use the Etherscan rate limit
+func (c *HTTPClient) GetNFTMetadata(ctx context.Context, contractAddress, tokenID string, opts *GetNFTMetadataOpts) (*NFTMetadata, error) {
+	if opts == nil {
+		opts = &GetNFTMetadataOpts{}
+	}
+	if err := ApplyDefaults(opts); err != nil {
+		return nil, err
+	}
+
+	id, ok := new(big.Int).SetString(tokenID, 10)
+	if !ok || id.Sign() < 0 {
+		return nil, fmt.Errorf("etherscan: invalid token id %q", tokenID)
+	}
+	idWord := fmt.Sprintf("%064x", id)
+
+	callOpts := &RpcEthCallOpts{ChainID: opts.ChainID, OnLimitExceeded: opts.OnLimitExceeded}
+	var tokenURI string
+	for _, selector := range []string{selectorTokenURI, selectorURI} {
+		result, err := c.RpcEthCall(ctx, contractAddress, selector+idWord, callOpts)
+		if err != nil {
+			return nil, err
+		}
+		if tokenURI = decodeABIString(result); tokenURI != "" {
+			break
+		}
+	}
+	if tokenURI == "" {
+		return nil, ErrNoTokenURI
+	}
+	tokenURI = strings.ReplaceAll(tokenURI, "{id}", idWord)
+
+	meta := &NFTMetadata{ContractAddress: contractAddress, TokenID: tokenID, TokenURI: tokenURI}
+	var doc []byte
+	var err error
+	if strings.HasPrefix(tokenURI, "data:") {
+		doc, err = decodeDataURI(tokenURI)
+	} else {
+		meta.MetadataURL = resolveNFTURI(tokenURI, opts.IPFSGateway)
+		doc, err = c.fetchNFTMetadata(ctx, meta.MetadataURL, opts)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var fields struct {
+		Name         string          `json:"name"`
+		Description  string          `json:"description"`
+		Image        string          `json:"image"`
+		ImageURL     string          `json:"image_url"`
+		ExternalURL  string          `json:"external_url"`
+		AnimationURL string          `json:"animation_url"`
+		Attributes   json.RawMessage `json:"attributes"`
+	}
+	if err := json.Unmarshal(doc, &fields); err != nil {
+		return nil, fmt.Errorf("etherscan: decode nft metadata: %w", err)
+	}
+	meta.Name = fields.Name
+	meta.Description = fields.Description
+	meta.Image = fields.Image
+	if meta.Image == "" {
+		meta.Image = fields.ImageURL
+	}
+	if meta.Image != "" && !strings.HasPrefix(meta.Image, "data:") {
+		meta.ImageURL = resolveNFTURI(meta.Image, opts.IPFSGateway)
+	} else {
+		meta.ImageURL = meta.Image
+	}
+	meta.ExternalURL = fields.ExternalURL
+	meta.AnimationURL = fields.AnimationURL
+	meta.Attributes = decodeNFTAttributes(fields.Attributes)
+	meta.Raw = doc
+	return meta, nil
+}
+
+// fetchNFTMetadata downloads a metadata document, enforcing opts.MaxSize and opts.Timeout
+func (c *HTTPClient) fetchNFTMetadata(ctx context.Context, uri string, opts *GetNFTMetadataOpts) ([]byte, error) {
+	timeout := opts.Timeout
+	if timeout <= 0 {
+		timeout = defaultNFTMetadataTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	httpClient := opts.HTTPClient
+	if httpClient == nil {
+		httpClient = c.httpClient
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, fmt.Errorf("etherscan: nft metadata url %q: %w", uri, err)
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("etherscan: fetch nft metadata: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("etherscan: fetch nft metadata: HTTP %d", resp.StatusCode)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, opts.MaxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("etherscan: fetch nft metadata: %w", err)
+	}
+	if int64(len(body)) > opts.MaxSize {
+		return nil, fmt.Errorf("etherscan: nft metadata exceeds %d bytes", opts.MaxSize)
+	}
+	return body, nil
+}
+
+// resolveNFTURI maps ipfs:// and ar:// URIs to HTTP gateways and returns other URIs unchanged
+func resolveNFTURI(uri, ipfsGateway string) string {
+	if !strings.HasSuffix(ipfsGateway, "/") {
+		ipfsGateway += "/"
+	}
+	switch {
+	case strings.HasPrefix(uri, "ipfs://"):
+		path := strings.TrimPrefix(uri, "ipfs://")
+		path = strings.TrimPrefix(path, "ipfs/")
+		return ipfsGateway + path
+	case strings.HasPrefix(uri, "ar://"):
+		return "https://arweave.net/" + strings.TrimPrefix(uri, "ar://")
+	}
+	return uri
+}
+
+// decodeDataURI returns the payload of a data: URI, base64 or percent-encoded
+func decodeDataURI(uri string) ([]byte, error) {
+	header, payload, ok := strings.Cut(strings.TrimPrefix(uri, "data:"), ",")
+	if !ok {
+		return nil, fmt.Errorf("etherscan: malformed data uri")
+	}
+	if strings.HasSuffix(header, ";base64") {
+		data, err := base64.StdEncoding.DecodeString(payload)
+		if err != nil {
+			return nil, fmt.Errorf("etherscan: decode data uri: %w", err)
+		}
+		return data, nil
+	}
+	data, err := url.PathUnescape(payload)
+	if err != nil {
+		// Many contracts embed raw JSON without escaping "%"
+		return []byte(payload), nil
+	}
+	return []byte(data), nil
+}
+
+// decodeNFTAttributes accepts the standard attribute list as well as a plain trait map
+func decodeNFTAttributes(raw json.RawMessage) []NFTAttribute {
+	if len(raw) == 0 {
+		return nil
+	}
+	var list []NFTAttribute
+	if err := json.Unmarshal(raw, &list); err == nil {
+		return list
+	}
+	var traits map[string]any
+	if err := json.Unmarshal(raw, &traits); err == nil {
+		for trait, value := range traits {
+			list = append(list, NFTAttribute{TraitType: trait, Value: value})
+		}
+		sort.Slice(list, func(i, j int) bool { return list[i].TraitType < list[j].TraitType })
+	}
+	return list
+}
+
+// decodeABIString decodes an ABI-encoded string return value, returning "" if result is empty or malformed
+func decodeABIString(result string) string {
+	words := splitWords(result)
+	if len(words) < 2 {
+		return ""
+	}
+	offset, ok := new(big.Int).SetString(words[0], 16)
+	if !ok || !offset.IsInt64() || offset.Int64()%32 != 0 || offset.Int64()/32 >= int64(len(words)) {
+		return ""
+	}
+	start := offset.Int64() / 32
+	length, ok := new(big.Int).SetString(words[start], 16)
+	if !ok || !length.IsInt64() {
+		return ""
+	}
+	data, err := hex.DecodeString(strings.Join(words[start+1:], ""))
+	if err != nil || int64(len(data)) < length.Int64() {
+		return ""
+	}
+	return string(data[:length.Int64()])
+}
@@ -0,0 +1,138 @@
+package etherscan
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// encodeABIString ABI-encodes s as a single string return value
+func encodeABIString(s string) string {
+	data := hex.EncodeToString([]byte(s))
+	if pad := len(data) % 64; pad != 0 {
+		data += strings.Repeat("0", 64-pad)
+	}
+	return fmt.Sprintf("0x%064x%064x%s", 32, len(s), data)
+}
+
+// newNFTTestClient serves ABI-encoded URIs per selector (SERVER is replaced by the server URL),
+// and metadata documents under /meta/
+func newNFTTestClient(t *testing.T, results map[string]string, docs map[string]string) *HTTPClient {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if doc, ok := strings.CutPrefix(r.URL.Path, "/meta/"); ok {
+			body, ok := docs[doc]
+			if !ok {
+				http.NotFound(w, r)
+				return
+			}
+			w.Write([]byte(body))
+			return
+		}
+		result := "0x"
+		if uri := results[r.URL.Query().Get("data")[:10]]; uri != "" {
+			result = encodeABIString(uri)
+		}
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":%q}`, result)
+	}))
+	t.Cleanup(server.Close)
+
+	for selector, result := range results {
+		results[selector] = strings.ReplaceAll(result, "SERVER", server.URL)
+	}
+	return NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+	})
+}
+
+func TestGetNFTMetadata(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ERC-721 tokenURI", func(t *testing.T) {
+		client := newNFTTestClient(t,
+			map[string]string{selectorTokenURI: "SERVER/meta/7"},
+			map[string]string{"7": `{"name":"Ape #7","image":"ipfs://ipfs/QmImage/7.png",
+				"attributes":[{"trait_type":"Fur","value":"Gold"},{"trait_type":"Level","value":3,"display_type":"number"}]}`},
+		)
+		meta, err := client.GetNFTMetadata(ctx, testContract, "7", &GetNFTMetadataOpts{IPFSGateway: "https://gateway.test/ipfs"})
+		if err != nil {
+			t.Fatalf("GetNFTMetadata failed: %v", err)
+		}
+		if meta.Name != "Ape #7" || meta.ImageURL != "https://gateway.test/ipfs/QmImage/7.png" {
+			t.Errorf("unexpected metadata: %+v", meta)
+		}
+		if len(meta.Attributes) != 2 || meta.Attributes[1].DisplayType != "number" || meta.Attributes[1].Value != float64(3) {
+			t.Errorf("unexpected attributes: %+v", meta.Attributes)
+		}
+	})
+
+	t.Run("ERC-1155 uri with data URI", func(t *testing.T) {
+		doc := base64.StdEncoding.EncodeToString([]byte(`{"name":"Sword","attributes":{"damage":10,"class":"melee"}}`))
+		client := newNFTTestClient(t, map[string]string{
+			selectorTokenURI: "",
+			selectorURI:      "data:application/json;base64," + doc,
+		}, nil)
+		meta, err := client.GetNFTMetadata(ctx, testContract, "255", nil)
+		if err != nil {
+			t.Fatalf("GetNFTMetadata failed: %v", err)
+		}
+		if meta.Name != "Sword" || meta.MetadataURL != "" {
+			t.Errorf("unexpected metadata: %+v", meta)
+		}
+		if len(meta.Attributes) != 2 || meta.Attributes[0].TraitType != "class" {
+			t.Errorf("expected trait map sorted into attributes, got %+v", meta.Attributes)
+		}
+	})
+
+	t.Run("ERC-1155 id substitution", func(t *testing.T) {
+		id := fmt.Sprintf("%064x", 255)
+		client := newNFTTestClient(t,
+			map[string]string{selectorURI: "SERVER/meta/{id}"},
+			map[string]string{id: `{"name":"Shield"}`},
+		)
+		meta, err := client.GetNFTMetadata(ctx, testContract, "255", nil)
+		if err != nil {
+			t.Fatalf("GetNFTMetadata failed: %v", err)
+		}
+		if meta.Name != "Shield" || !strings.HasSuffix(meta.MetadataURL, id) {
+			t.Errorf("unexpected metadata: %+v", meta)
+		}
+	})
+
+	t.Run("size limit", func(t *testing.T) {
+		client := newNFTTestClient(t,
+			map[string]string{selectorTokenURI: "SERVER/meta/big"},
+			map[string]string{"big": `{"name":"` + strings.Repeat("x", 100) + `"}`},
+		)
+		_, err := client.GetNFTMetadata(ctx, testContract, "1", &GetNFTMetadataOpts{MaxSize: 64})
+		if err == nil || !strings.Contains(err.Error(), "exceeds 64 bytes") {
+			t.Errorf("expected size limit error, got %v", err)
+		}
+	})
+
+	t.Run("no uri", func(t *testing.T) {
+		client := newNFTTestClient(t, map[string]string{}, nil)
+		_, err := client.GetNFTMetadata(ctx, testContract, "1", nil)
+		if !errors.Is(err, ErrNoTokenURI) {
+			t.Errorf("expected ErrNoTokenURI, got %v", err)
+		}
+	})
+}
+
+func TestDecodeABIString(t *testing.T) {
+	for _, s := range []string{"", "ipfs://QmHash/1", strings.Repeat("a", 70)} {
+		if got := decodeABIString(encodeABIString(s)); got != s {
+			t.Errorf("decodeABIString(encode(%q)) = %q", s, got)
+		}
+	}
+	if got := decodeABIString("0x"); got != "" {
+		t.Errorf("expected empty result for 0x, got %q", got)
+	}
+}
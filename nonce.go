@@ -0,0 +1,147 @@
+package etherscan
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ============================================================================
+// Nonce Manager
+// ============================================================================
+
+// NonceManager hands out sequential nonces per address for raw transaction broadcasting.
+//
+// The first nonce for an address is read from eth_getTransactionCount with the
+// "pending" tag; later nonces are allocated locally so that concurrent goroutines
+// never sign two transactions with the same nonce. After a failed broadcast call
+// MarkFailed (or Reconcile) to bring the local counter back in line with the chain.
+//
+// A NonceManager is bound to a single chain and is safe for concurrent use.
+type NonceManager struct {
+	client  *HTTPClient
+	chainID int64
+	nonces  map[string]uint64 // lowercased address -> next nonce to hand out
+	mu      sync.Mutex
+}
+
+// NewNonceManager creates a nonce manager for the given chain.
+//
+// Args:
+//   - client: Client used to query eth_getTransactionCount
+//   - chainID: Chain to track nonces on (0 uses the client default)
+//
+// Example:
+//
+//	nm := NewNonceManager(client, EthereumMainnet)
+//	nonce, err := nm.Next(ctx, sender)
+//	// sign tx with nonce ...
+//	if _, err := client.RpcEthSendRawTx(ctx, rawTx, nil); err != nil {
+//	    nm.MarkFailed(ctx, sender, nonce)
+//	}
+func NewNonceManager(client *HTTPClient, chainID int64) *NonceManager {
+	return &NonceManager{
+		client:  client,
+		chainID: chainID,
+		nonces:  make(map[string]uint64),
+	}
+}
+
+// Next returns the next nonce to use for address and reserves it.
+func (nm *NonceManager) Next(ctx context.Context, address string) (uint64, error) {
+	key := strings.ToLower(address)
+
+	nm.mu.Lock()
+	defer nm.mu.Unlock()
+
+	nonce, ok := nm.nonces[key]
+	if !ok {
+		pending, err := nm.pendingNonce(ctx, address)
+		if err != nil {
+			return 0, err
+		}
+		nonce = pending
+	}
+
+	nm.nonces[key] = nonce + 1
+	return nonce, nil
+}
+
+// Peek returns the nonce Next would hand out without reserving it.
+//
+// Returns false if the address is not tracked yet.
+func (nm *NonceManager) Peek(address string) (uint64, bool) {
+	nm.mu.Lock()
+	defer nm.mu.Unlock()
+
+	nonce, ok := nm.nonces[strings.ToLower(address)]
+	return nonce, ok
+}
+
+// MarkFailed reports that the transaction signed with nonce was not broadcast.
+//
+// If nonce is the most recently handed out value it is simply returned to the
+// pool. Otherwise later nonces may already be in flight, so the counter is
+// re-read from the chain.
+func (nm *NonceManager) MarkFailed(ctx context.Context, address string, nonce uint64) error {
+	key := strings.ToLower(address)
+
+	nm.mu.Lock()
+	next, ok := nm.nonces[key]
+	if ok && next == nonce+1 {
+		nm.nonces[key] = nonce
+		nm.mu.Unlock()
+		return nil
+	}
+	nm.mu.Unlock()
+
+	_, err := nm.Reconcile(ctx, address)
+	return err
+}
+
+// Reconcile re-reads the pending nonce from the chain and returns it.
+//
+// The local counter is overwritten with the chain's pending count, which drops
+// any gap left by failed broadcasts. Transactions signed with reserved but not
+// yet broadcast nonces above that count should be re-signed.
+func (nm *NonceManager) Reconcile(ctx context.Context, address string) (uint64, error) {
+	nm.mu.Lock()
+	defer nm.mu.Unlock()
+
+	pending, err := nm.pendingNonce(ctx, address)
+	if err != nil {
+		return 0, err
+	}
+	nm.nonces[strings.ToLower(address)] = pending
+	return pending, nil
+}
+
+// Reset forgets the local counter for address; the next call to Next re-reads it from the chain.
+func (nm *NonceManager) Reset(address string) {
+	nm.mu.Lock()
+	defer nm.mu.Unlock()
+
+	delete(nm.nonces, strings.ToLower(address))
+}
+
+// pendingNonce reads the pending transaction count of address from the chain.
+func (nm *NonceManager) pendingNonce(ctx context.Context, address string) (uint64, error) {
+	count, err := nm.client.RpcEthTxCount(ctx, address, "pending", &RpcEthTxCountOpts{
+		ChainID: nm.chainID,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return parseHexUint64(count)
+}
+
+// parseHexUint64 parses a "0x"-prefixed hex quantity
+func parseHexUint64(s string) (uint64, error) {
+	trimmed := strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X")
+	if trimmed == "" {
+		return 0, fmt.Errorf("etherscan: empty hex quantity %q", s)
+	}
+	return strconv.ParseUint(trimmed, 16, 64)
+}
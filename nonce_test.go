@@ -0,0 +1,77 @@
+package etherscan
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestNonceManager_Sequential(t *testing.T) {
+	nm := NewNonceManager(nil, EthereumMainnet)
+	addr := TestAddresses.VitalikButerin
+	// Seed the counter so no request is made
+	nm.nonces[strings.ToLower(addr)] = 10
+
+	ctx := context.Background()
+	var wg sync.WaitGroup
+	seen := make(map[uint64]bool)
+	var mu sync.Mutex
+	for range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			nonce, err := nm.Next(ctx, addr)
+			if err != nil {
+				t.Errorf("Next failed: %v", err)
+				return
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			if seen[nonce] {
+				t.Errorf("nonce %d handed out twice", nonce)
+			}
+			seen[nonce] = true
+		}()
+	}
+	wg.Wait()
+
+	if next, _ := nm.Peek(addr); next != 60 {
+		t.Fatalf("expected next nonce 60, got %d", next)
+	}
+
+	// Rolling back the latest nonce must not hit the network
+	if err := nm.MarkFailed(ctx, addr, 59); err != nil {
+		t.Fatalf("MarkFailed failed: %v", err)
+	}
+	if next, _ := nm.Peek(addr); next != 59 {
+		t.Fatalf("expected next nonce 59 after rollback, got %d", next)
+	}
+
+	nm.Reset(addr)
+	if _, ok := nm.Peek(addr); ok {
+		t.Fatal("expected address to be untracked after Reset")
+	}
+}
+
+func TestNonceManager_Next(t *testing.T) {
+	config := GetTestConfig(t)
+	nm := NewNonceManager(config.Client, EthereumMainnet)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	first, err := nm.Next(ctx, TestAddresses.VitalikButerin)
+	if err != nil {
+		t.Fatalf("Next failed: %v", err)
+	}
+	second, err := nm.Next(ctx, TestAddresses.VitalikButerin)
+	if err != nil {
+		t.Fatalf("Next failed: %v", err)
+	}
+	if second != first+1 {
+		t.Fatalf("expected sequential nonces, got %d then %d", first, second)
+	}
+	t.Logf("Pending nonce: %d", first)
+}
@@ -0,0 +1,281 @@
+package etherscan
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// ============================================================================
+// Nonce Timeline
+// ============================================================================
+
+const (
+	// defaultNonceGapThreshold is the idle time reported as an ActivityGap when GetNonceTimelineOpts.GapThreshold is zero
+	defaultNonceGapThreshold = 30 * 24 * time.Hour
+
+	// defaultNonceBurstWindow is the window of an ActivityBurst when GetNonceTimelineOpts.BurstWindow is zero
+	defaultNonceBurstWindow = time.Hour
+)
+
+// NoncePoint is the nonce of an address after a block
+type NoncePoint struct {
+	Block int64     `json:"block" bson:"block"`
+	Time  time.Time `json:"time" bson:"time"`
+
+	// Nonce is the cumulative number of transactions sent by the address up to and including Block
+	Nonce uint64 `json:"nonce" bson:"nonce"`
+}
+
+// ActivityGap is a period without any transaction sent or received
+type ActivityGap struct {
+	// From and To are the transactions on either side of the gap
+	From NoncePoint `json:"from" bson:"from"`
+	To   NoncePoint `json:"to" bson:"to"`
+
+	Duration time.Duration `json:"duration" bson:"duration"`
+}
+
+// ActivityBurst is a run of transactions packed into one burst window
+type ActivityBurst struct {
+	Start NoncePoint `json:"start" bson:"start"`
+	End   NoncePoint `json:"end" bson:"end"`
+
+	// TxCount is the number of transactions sent or received from Start to End
+	TxCount int `json:"txCount" bson:"txCount"`
+}
+
+// NonceTimeline is the age and activity pattern of an address
+type NonceTimeline struct {
+	Address string `json:"address" bson:"address"`
+
+	// CreatedAt is the time of the first transaction sent or received, zero if there is none
+	CreatedAt time.Time `json:"createdAt" bson:"createdAt"`
+
+	// FirstSentAt is the time of the first transaction sent, zero if the address never sent one
+	FirstSentAt time.Time `json:"firstSentAt" bson:"firstSentAt"`
+
+	// LastActivityAt is the time of the last transaction sent or received
+	LastActivityAt time.Time `json:"lastActivityAt" bson:"lastActivityAt"`
+
+	// Age is the time from CreatedAt to when the timeline was built
+	Age time.Duration `json:"age" bson:"age"`
+
+	// TxCount and SentCount are the normal transactions involving and sent by the address
+	TxCount   int `json:"txCount" bson:"txCount"`
+	SentCount int `json:"sentCount" bson:"sentCount"`
+
+	// Nonce is the nonce after the last transaction sent
+	Nonce uint64 `json:"nonce" bson:"nonce"`
+
+	// Points samples the nonce growth in block order; the first and last transaction sent are always included
+	Points []NoncePoint `json:"points" bson:"points"`
+
+	// Gaps are the idle periods of at least GapThreshold, in time order
+	Gaps []ActivityGap `json:"gaps" bson:"gaps"`
+
+	// Bursts are the windows of BurstWindow holding at least BurstMinTxs transactions, in time order
+	Bursts []ActivityBurst `json:"bursts" bson:"bursts"`
+}
+
+// GetNonceTimelineOpts contains optional parameters for GetNonceTimeline
+type GetNonceTimelineOpts struct {
+	// GapThreshold is the shortest idle period reported as a gap
+	// Default: 30 days
+	GapThreshold time.Duration
+
+	// BurstWindow is the longest span of one burst
+	// Default: 1 hour
+	BurstWindow time.Duration
+
+	// BurstMinTxs is the number of transactions within BurstWindow that makes a burst
+	// Default: 10
+	BurstMinTxs int `default:"10"`
+
+	// ChainID specifies which blockchain network to query
+	// Default: empty (uses client default)
+	ChainID int64
+
+	// OnLimitExceeded specifies behavior when rate limit is exceeded
+	// Default: RateLimitBlock (wait until a token is available)
+	OnLimitExceeded RateLimitBehavior `default:""`
+}
+
+// GetNonceTimeline reconstructs how the nonce of an address grew over time, with its age, gaps and bursts
+//
+// Every normal transaction of the address is fetched. The nonce after each
+// block is one above the highest nonce the address sent in it, so the points
+// map blocks to the cumulative nonce as the chain counts it. Transactions
+// received count towards the creation date, gaps and bursts, which describe
+// when the address was active at all.
+//
+// Args:
+//   - ctx: Context for request cancellation and timeout
+//   - address: The address to analyze
+//   - samplePoints: Number of nonce points to return; 0 or less returns one per transaction sent
+//   - opts: Optional parameters (can be nil)
+//
+// Returns:
+//   - *NonceTimeline: The timeline of the address
+//   - error: Error if a request fails or a transaction cannot be parsed
+//
+// Example:
+//
+//	timeline, err := client.GetNonceTimeline(ctx, wallet, 50, nil)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("created %s, nonce %d, %d gaps, %d bursts\n",
+//	    etherscan.UTCDate(timeline.CreatedAt), timeline.Nonce, len(timeline.Gaps), len(timeline.Bursts))
+//
+// Note:
+//   - Costs one call per 1000 transactions
+func (c *HTTPClient) GetNonceTimeline(ctx context.Context, address string, samplePoints int, opts *GetNonceTimelineOpts) (*NonceTimeline, error) {
+	if opts == nil {
+		opts = &GetNonceTimelineOpts{}
+	}
+	if err := applyNonceTimelineDefaults(opts); err != nil {
+		return nil, err
+	}
+
+	txs, err := c.normalTxHistory(ctx, address, opts.ChainID, opts.OnLimitExceeded)
+	defer txs.Close()
+	if err != nil {
+		return nil, err
+	}
+	return nonceTimeline(address, txs, samplePoints, opts)
+}
+
+// applyNonceTimelineDefaults applies the defaults of opts, including the durations, and validates the burst size
+func applyNonceTimelineDefaults(opts *GetNonceTimelineOpts) error {
+	if err := ApplyDefaults(opts); err != nil {
+		return err
+	}
+	if opts.GapThreshold <= 0 {
+		opts.GapThreshold = defaultNonceGapThreshold
+	}
+	if opts.BurstWindow <= 0 {
+		opts.BurstWindow = defaultNonceBurstWindow
+	}
+	if opts.BurstMinTxs < 2 {
+		return fmt.Errorf("etherscan: invalid burst size %d", opts.BurstMinTxs)
+	}
+	return nil
+}
+
+// normalTxHistory fetches every normal transaction of address in block order, spilling past the memory budget
+func (c *HTTPClient) normalTxHistory(ctx context.Context, address string, chainID int64, onLimitExceeded RateLimitBehavior) (*SpillBuffer[RespNormalTx], error) {
+	return spillLogsByRange(ctx, c, 0, defaultEndBlock, func(fromBlock, toBlock, page int64) ([]RespNormalTx, error) {
+		return c.GetNormalTxs(ctx, address, &GetNormalTxsOpts{
+			StartBlock:      fromBlock,
+			EndBlock:        toBlock,
+			Page:            page,
+			Offset:          logsPerCall,
+			Sort:            SortAsc,
+			ChainID:         chainID,
+			OnLimitExceeded: onLimitExceeded,
+		})
+	})
+}
+
+// nonceTimeline builds the timeline of address from its transactions; opts must have its defaults applied
+func nonceTimeline(address string, txs *SpillBuffer[RespNormalTx], samplePoints int, opts *GetNonceTimelineOpts) (*NonceTimeline, error) {
+	// activity holds every transaction, sent holds the nonce after each one sent
+	var activity, sent []NoncePoint
+	var nonce uint64
+	sentCount := 0
+	sentBy := TxFrom(address)
+	err := txs.Each(func(tx RespNormalTx) error {
+		block, err := strconv.ParseInt(tx.BlockNumber, 10, 64)
+		if err != nil {
+			return fmt.Errorf("etherscan: invalid block number %q in tx %s", tx.BlockNumber, tx.Hash)
+		}
+		at, err := ParseTimestamp(tx.TimeStamp)
+		if err != nil {
+			return fmt.Errorf("%w in tx %s", err, tx.Hash)
+		}
+		if sentBy(tx) {
+			n, err := strconv.ParseUint(tx.Nonce, 10, 64)
+			if err != nil {
+				return fmt.Errorf("etherscan: invalid nonce %q in tx %s", tx.Nonce, tx.Hash)
+			}
+			sentCount++
+			nonce = max(nonce, n+1)
+			if len(sent) > 0 && sent[len(sent)-1].Block == block {
+				sent[len(sent)-1].Nonce = nonce
+			} else {
+				sent = append(sent, NoncePoint{Block: block, Time: at, Nonce: nonce})
+			}
+		}
+		activity = append(activity, NoncePoint{Block: block, Time: at, Nonce: nonce})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	timeline := &NonceTimeline{
+		Address:   address,
+		TxCount:   len(activity),
+		SentCount: sentCount,
+		Nonce:     nonce,
+		Points:    sampleNoncePoints(sent, samplePoints),
+	}
+	if len(activity) == 0 {
+		return timeline, nil
+	}
+	timeline.CreatedAt = activity[0].Time
+	timeline.LastActivityAt = activity[len(activity)-1].Time
+	timeline.Age = time.Since(timeline.CreatedAt)
+	if len(sent) > 0 {
+		timeline.FirstSentAt = sent[0].Time
+	}
+	timeline.Gaps = activityGaps(activity, opts.GapThreshold)
+	timeline.Bursts = activityBursts(activity, opts.BurstWindow, opts.BurstMinTxs)
+	return timeline, nil
+}
+
+// sampleNoncePoints picks n evenly spaced points, the first and last included, or all of them if n is not positive
+func sampleNoncePoints(points []NoncePoint, n int) []NoncePoint {
+	if n <= 0 || n >= len(points) {
+		return points
+	}
+	if n == 1 {
+		return points[len(points)-1:]
+	}
+	sampled := make([]NoncePoint, n)
+	for i := range sampled {
+		sampled[i] = points[i*(len(points)-1)/(n-1)]
+	}
+	return sampled
+}
+
+// activityGaps returns the periods of at least threshold between consecutive transactions
+func activityGaps(activity []NoncePoint, threshold time.Duration) []ActivityGap {
+	var gaps []ActivityGap
+	for i := 1; i < len(activity); i++ {
+		if d := activity[i].Time.Sub(activity[i-1].Time); d >= threshold {
+			gaps = append(gaps, ActivityGap{From: activity[i-1], To: activity[i], Duration: d})
+		}
+	}
+	return gaps
+}
+
+// activityBursts returns the maximal runs of at least minTxs transactions within window, without overlap
+func activityBursts(activity []NoncePoint, window time.Duration, minTxs int) []ActivityBurst {
+	var bursts []ActivityBurst
+	for i := 0; i < len(activity); {
+		j := i + 1
+		for j < len(activity) && activity[j].Time.Sub(activity[i].Time) <= window {
+			j++
+		}
+		if j-i < minTxs {
+			i++
+			continue
+		}
+		bursts = append(bursts, ActivityBurst{Start: activity[i], End: activity[j-1], TxCount: j - i})
+		i = j
+	}
+	return bursts
+}
@@ -0,0 +1,88 @@
+package etherscan
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGetNonceTimeline(t *testing.T) {
+	wallet := TestAddresses.VitalikButerin
+	other := "0x" + strings.Repeat("ab", 20)
+	const start = 1700000000
+
+	// Funded at block 10, two sends in block 11, a 60-day pause, then a burst of 12 sends a minute apart
+	txs := []RespNormalTx{
+		{Hash: "0x01", BlockNumber: "10", TimeStamp: strconv.Itoa(start), From: other, To: wallet, Nonce: "7"},
+		{Hash: "0x02", BlockNumber: "11", TimeStamp: strconv.Itoa(start + 60), From: wallet, To: other, Nonce: "0"},
+		{Hash: "0x03", BlockNumber: "11", TimeStamp: strconv.Itoa(start + 60), From: wallet, To: other, Nonce: "1"},
+	}
+	later := start + 60*86400
+	for i := 0; i < 12; i++ {
+		txs = append(txs, RespNormalTx{
+			Hash:        fmt.Sprintf("0x1%02d", i),
+			BlockNumber: strconv.Itoa(100 + i),
+			TimeStamp:   strconv.Itoa(later + 60*i),
+			From:        wallet,
+			To:          other,
+			Nonce:       strconv.Itoa(2 + i),
+		})
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := json.Marshal(txs)
+		fmt.Fprintf(w, `{"status":"1","message":"OK","result":%s}`, data)
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+		MaxRetries: -1,
+	})
+
+	timeline, err := client.GetNonceTimeline(context.Background(), wallet, 3, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if timeline.TxCount != 15 || timeline.SentCount != 14 || timeline.Nonce != 14 {
+		t.Errorf("unexpected counts: %+v", timeline)
+	}
+	if !timeline.CreatedAt.Equal(time.Unix(start, 0)) || !timeline.FirstSentAt.Equal(time.Unix(start+60, 0)) || timeline.Age <= 0 {
+		t.Errorf("unexpected age: created %s, first sent %s, age %s", timeline.CreatedAt, timeline.FirstSentAt, timeline.Age)
+	}
+
+	// 13 blocks with sends, sampled at the first, middle and last
+	if len(timeline.Points) != 3 {
+		t.Fatalf("expected 3 points, got %+v", timeline.Points)
+	}
+	if p := timeline.Points[0]; p.Block != 11 || p.Nonce != 2 {
+		t.Errorf("expected both sends of block 11 in the first point, got %+v", p)
+	}
+	if p := timeline.Points[1]; p.Block != 105 || p.Nonce != 8 {
+		t.Errorf("unexpected middle point: %+v", p)
+	}
+	if p := timeline.Points[2]; p.Block != 111 || p.Nonce != 14 {
+		t.Errorf("unexpected last point: %+v", p)
+	}
+
+	if len(timeline.Gaps) != 1 || timeline.Gaps[0].From.Block != 11 || timeline.Gaps[0].To.Block != 100 {
+		t.Errorf("expected the 60-day pause as the only gap, got %+v", timeline.Gaps)
+	}
+	if len(timeline.Bursts) != 1 || timeline.Bursts[0].Start.Block != 100 || timeline.Bursts[0].TxCount != 12 {
+		t.Errorf("expected one burst of 12, got %+v", timeline.Bursts)
+	}
+
+	all, err := client.GetNonceTimeline(context.Background(), wallet, 0, &GetNonceTimelineOpts{BurstMinTxs: 13})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all.Points) != 13 || len(all.Bursts) != 0 {
+		t.Errorf("expected every point and no burst, got %d points and %+v", len(all.Points), all.Bursts)
+	}
+}
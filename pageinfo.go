@@ -0,0 +1,103 @@
+package etherscan
+
+import (
+	"reflect"
+	"strconv"
+)
+
+// ============================================================================
+// Pagination Metadata
+// ============================================================================
+
+// resultWindow is the most records Etherscan serves for one query across all pages, page × offset
+const resultWindow = 10000
+
+// PageInfo describes the page a list call returned
+//
+// Set the PageInfo field of the options of a paginated list endpoint to
+// receive it, then drive the next call from it instead of re-deriving the
+// state from the slice length:
+//
+//	info := &etherscan.PageInfo{}
+//	opts := &etherscan.GetNormalTxsOpts{Offset: 1000, PageInfo: info}
+//	for {
+//	    txs, err := client.GetNormalTxs(ctx, address, opts)
+//	    if err != nil {
+//	        return err
+//	    }
+//	    process(txs)
+//	    if !info.HasMore || info.WindowExhausted {
+//	        break
+//	    }
+//	    opts.Page = info.NextPage()
+//	}
+//
+// Helpers calling an endpoint for several pages leave the info of the last one.
+type PageInfo struct {
+	// Page and Offset are the page number and page size the call was sent with, after defaults
+	Page   int64
+	Offset int64
+
+	// Returned is the number of rows the API returned, before any client-side filtering
+	Returned int
+
+	// HasMore reports a full page, so the next page may hold more rows
+	// A list ending exactly on a page boundary is only known to be complete
+	// when the next page comes back empty.
+	HasMore bool
+
+	// WindowExhausted reports that Page × Offset reached the 10000 record
+	// window Etherscan serves per query; later pages are rejected, so narrow
+	// the block range to read further
+	WindowExhausted bool
+
+	// HeadBlock is the chain head at fetch time, as derived from the
+	// confirmations of the rows: a row in the head block has one confirmation
+	// Default: 0 when the rows carry no confirmations, or there are none
+	HeadBlock int64
+}
+
+// NextPage returns the page number to request after this one
+func (p *PageInfo) NextPage() int64 {
+	return p.Page + 1
+}
+
+// recordPageInfo fills info, if set, from the page and offset params of a call and the rows it returned
+func recordPageInfo[T any](info *PageInfo, params map[string]string, rows []T) {
+	if info == nil {
+		return
+	}
+	page, _ := strconv.ParseInt(params["page"], 10, 64)
+	offset, _ := strconv.ParseInt(params["offset"], 10, 64)
+	*info = PageInfo{
+		Page:            page,
+		Offset:          offset,
+		Returned:        len(rows),
+		HasMore:         offset > 0 && int64(len(rows)) >= offset,
+		WindowExhausted: offset > 0 && page*offset >= resultWindow,
+	}
+	if len(rows) > 0 {
+		info.HeadBlock = headBlock(reflect.ValueOf(rows[0]))
+	}
+}
+
+// headBlock derives the chain head from the BlockNumber and Confirmations fields of a row, 0 if it has none
+func headBlock(row reflect.Value) int64 {
+	if row.Kind() != reflect.Struct {
+		return 0
+	}
+	blockField := row.FieldByName("BlockNumber")
+	confirmationsField := row.FieldByName("Confirmations")
+	if blockField.Kind() != reflect.String || confirmationsField.Kind() != reflect.String {
+		return 0
+	}
+	block, err := strconv.ParseInt(blockField.String(), 10, 64)
+	if err != nil {
+		return 0
+	}
+	confirmations, err := strconv.ParseInt(confirmationsField.String(), 10, 64)
+	if err != nil || confirmations <= 0 {
+		return 0
+	}
+	return block + confirmations - 1
+}
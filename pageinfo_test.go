@@ -0,0 +1,58 @@
+package etherscan
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestPageInfo(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Pages of three rows, the second one partial
+		rows := 3
+		if r.URL.Query().Get("page") == "2" {
+			rows = 1
+		}
+		w.Write(listPageBody(rows))
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+		MaxRetries: -1,
+	})
+	ctx := context.Background()
+	info := &PageInfo{}
+	opts := &GetNormalTxsOpts{Offset: 3, PageInfo: info}
+
+	if _, err := client.GetNormalTxs(ctx, TestAddresses.VitalikButerin, opts); err != nil {
+		t.Fatal(err)
+	}
+	// The first row is in block 18000000 with 100 confirmations
+	want := PageInfo{Page: 1, Offset: 3, Returned: 3, HasMore: true, HeadBlock: 18000099}
+	if *info != want {
+		t.Fatalf("expected %+v, got %+v", want, *info)
+	}
+
+	opts.Page = info.NextPage()
+	if _, err := client.GetNormalTxs(ctx, TestAddresses.VitalikButerin, opts); err != nil {
+		t.Fatal(err)
+	}
+	if info.Page != 2 || info.Returned != 1 || info.HasMore {
+		t.Errorf("expected a partial last page, got %+v", *info)
+	}
+}
+
+func TestRecordPageInfo(t *testing.T) {
+	// Full pages at the end of the result window cannot be followed
+	info := &PageInfo{}
+	recordPageInfo(info, map[string]string{"page": "10", "offset": "1000"}, make([]RespEventLogByAddress, 1000))
+	if !info.HasMore || !info.WindowExhausted || info.HeadBlock != 0 {
+		t.Errorf("expected an exhausted window without a head block, got %+v", *info)
+	}
+
+	// Unset info is left alone
+	recordPageInfo[RespEventLogByAddress](nil, map[string]string{}, nil)
+}
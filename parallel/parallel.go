@@ -0,0 +1,99 @@
+// Package parallel runs bounded concurrent work for etherscan-go workflows
+//
+// The etherscan client uses it for its own fan-out helpers; it is exported so
+// that callers orchestrating their own multi-call workflows get the same
+// semantics: results in input order, at most limit calls in flight, and the
+// first error cancelling the rest.
+package parallel
+
+import (
+	"context"
+	"sync"
+)
+
+// Limiter paces calls, e.g. *etherscan.HTTPClient whose Wait takes a token from the client's rate limiter
+type Limiter interface {
+	Wait(ctx context.Context) error
+}
+
+// Map calls fn for every item with at most limit calls running at once
+//
+// Results are returned in the order of items. The first error cancels the
+// context passed to the other calls, stops new calls from starting and is
+// returned once all running calls have finished.
+//
+// Example:
+//
+//	receipts, err := parallel.Map(ctx, hashes, 4, func(ctx context.Context, hash string) (*etherscan.RespEthTxReceiptInfo, error) {
+//	    return client.RpcEthTxReceipt(ctx, hash, nil)
+//	})
+func Map[T, R any](ctx context.Context, items []T, limit int, fn func(ctx context.Context, item T) (R, error)) ([]R, error) {
+	return MapWithLimiter(ctx, items, limit, nil, fn)
+}
+
+// MapWithLimiter is Map with limiter.Wait called before every call to fn
+//
+// Use it when fn makes requests that do not go through the client, such as raw
+// HTTP calls against the same API key, so they share the client's rate limit:
+//
+//	results, err := parallel.MapWithLimiter(ctx, urls, 8, client, fetch)
+//
+// A nil limiter behaves like Map.
+func MapWithLimiter[T, R any](ctx context.Context, items []T, limit int, limiter Limiter, fn func(ctx context.Context, item T) (R, error)) ([]R, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	if limit < 1 {
+		limit = 1
+	}
+
+	results := make([]R, len(items))
+	sem := make(chan struct{}, limit)
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+	fail := func(err error) {
+		errOnce.Do(func() {
+			firstErr = err
+			cancel()
+		})
+	}
+
+	for i := range items {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			fail(ctx.Err())
+			break
+		}
+
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if limiter != nil {
+				if err := limiter.Wait(ctx); err != nil {
+					fail(err)
+					return
+				}
+			}
+			result, err := fn(ctx, items[i])
+			if err != nil {
+				fail(err)
+				return
+			}
+			results[i] = result
+		}(i)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return results, nil
+}
@@ -0,0 +1,83 @@
+package parallel
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestMap(t *testing.T) {
+	var running, peak atomic.Int32
+	items := []int{5, 4, 3, 2, 1, 0, 9, 8}
+	results, err := Map(context.Background(), items, 3, func(ctx context.Context, n int) (int, error) {
+		now := running.Add(1)
+		defer running.Add(-1)
+		for {
+			old := peak.Load()
+			if now <= old || peak.CompareAndSwap(old, now) {
+				break
+			}
+		}
+		time.Sleep(time.Duration(n) * time.Millisecond)
+		return n * n, nil
+	})
+	if err != nil {
+		t.Fatalf("Map failed: %v", err)
+	}
+	for i, n := range items {
+		if results[i] != n*n {
+			t.Errorf("result %d = %d, want %d", i, results[i], n*n)
+		}
+	}
+	if peak.Load() > 3 {
+		t.Errorf("expected at most 3 concurrent calls, got %d", peak.Load())
+	}
+}
+
+func TestMapError(t *testing.T) {
+	errBoom := errors.New("boom")
+	var started atomic.Int32
+	_, err := Map(context.Background(), make([]int, 100), 2, func(ctx context.Context, _ int) (int, error) {
+		// With limit 2 only the first two calls can start; one of them fails
+		if started.Add(1) == 2 {
+			return 0, errBoom
+		}
+		<-ctx.Done()
+		return 0, ctx.Err()
+	})
+	if !errors.Is(err, errBoom) {
+		t.Fatalf("expected the first error, got %v", err)
+	}
+	if n := started.Load(); n > 2 {
+		t.Errorf("expected the error to stop new calls, %d started", n)
+	}
+}
+
+type countingLimiter struct{ waits atomic.Int32 }
+
+func (l *countingLimiter) Wait(ctx context.Context) error {
+	l.waits.Add(1)
+	return ctx.Err()
+}
+
+func TestMapWithLimiter(t *testing.T) {
+	limiter := &countingLimiter{}
+	if _, err := MapWithLimiter(context.Background(), []string{"a", "b", "c"}, 0, limiter, func(ctx context.Context, s string) (string, error) {
+		return s, nil
+	}); err != nil {
+		t.Fatalf("MapWithLimiter failed: %v", err)
+	}
+	if limiter.waits.Load() != 3 {
+		t.Errorf("expected one Wait per item, got %d", limiter.waits.Load())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := MapWithLimiter(ctx, []int{1}, 1, limiter, func(ctx context.Context, n int) (int, error) {
+		return n, nil
+	}); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
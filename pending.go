@@ -0,0 +1,230 @@
+package etherscan
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// ============================================================================
+// Pending Transactions
+// ============================================================================
+
+// MempoolSource supplies hashes of transactions that may be pending for an address
+//
+// Etherscan has no mempool endpoint, so pending transactions can only be found
+// by probing hashes known from elsewhere: the caller's own broadcasts (see
+// BroadcastLog), a node's txpool or a mempool API.
+type MempoolSource interface {
+	PendingTxHashes(ctx context.Context, address string) ([]string, error)
+}
+
+// BroadcastLog is a MempoolSource remembering the transactions broadcast by this process
+//
+// Record every hash returned by RpcEthSendRawTx; GetPendingTxsForAddress reports
+// which of them are still pending. BroadcastLog is safe for concurrent use.
+type BroadcastLog struct {
+	hashes map[string][]string // lowercased sender -> tx hashes in broadcast order
+	mu     sync.Mutex
+}
+
+// NewBroadcastLog creates an empty broadcast log
+func NewBroadcastLog() *BroadcastLog {
+	return &BroadcastLog{hashes: make(map[string][]string)}
+}
+
+// Record remembers that txHash was broadcast from sender
+func (l *BroadcastLog) Record(sender, txHash string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	key := strings.ToLower(sender)
+	l.hashes[key] = append(l.hashes[key], txHash)
+}
+
+// Forget drops txHashes of sender, e.g. the Mined and Dropped hashes of a PendingTxs
+func (l *BroadcastLog) Forget(sender string, txHashes ...string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	key := strings.ToLower(sender)
+	kept := l.hashes[key][:0]
+	for _, hash := range l.hashes[key] {
+		forget := false
+		for _, h := range txHashes {
+			if strings.EqualFold(hash, h) {
+				forget = true
+				break
+			}
+		}
+		if !forget {
+			kept = append(kept, hash)
+		}
+	}
+	if len(kept) == 0 {
+		delete(l.hashes, key)
+		return
+	}
+	l.hashes[key] = kept
+}
+
+// PendingTxHashes implements MempoolSource
+func (l *BroadcastLog) PendingTxHashes(_ context.Context, address string) ([]string, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return append([]string(nil), l.hashes[strings.ToLower(address)]...), nil
+}
+
+// PendingTxs is the pending transaction state of an address
+type PendingTxs struct {
+	Address string
+
+	// LatestNonce and PendingNonce are eth_getTransactionCount at "latest" and "pending"
+	LatestNonce  uint64
+	PendingNonce uint64
+
+	// Count is PendingNonce - LatestNonce, the number of pending transactions the node knows of
+	Count uint64
+
+	// Txs are the pending transactions found by probing the sources' hashes, ordered by nonce
+	Txs []RespEthTxInfo
+
+	// MissingNonces are the nonces in [LatestNonce, PendingNonce) none of Txs uses
+	MissingNonces []uint64
+
+	// Mined are source hashes already included in a block
+	Mined []string
+
+	// Dropped are source hashes the node does not know, i.e. evicted or never propagated
+	Dropped []string
+}
+
+// GetPendingTxsForAddressOpts contains optional parameters for GetPendingTxsForAddress
+type GetPendingTxsForAddressOpts struct {
+	// Sources supply the transaction hashes to probe
+	// Default: nil (only the nonce gap is reported)
+	Sources []MempoolSource
+
+	// ChainID specifies which blockchain network to query
+	// Default: empty (uses client default)
+	ChainID int64
+
+	// OnLimitExceeded specifies behavior when rate limit is exceeded
+	// Default: RateLimitBlock (wait until a token is available)
+	OnLimitExceeded RateLimitBehavior `default:""`
+}
+
+// GetPendingTxsForAddress returns what is known about the pending transactions of an address
+//
+// The number of pending transactions is the difference between the "pending" and
+// "latest" transaction counts. Hashes supplied by opts.Sources are probed with
+// eth_getTransactionByHash to recover the pending transactions themselves and to
+// report the ones that were mined or dropped in the meantime.
+//
+// Args:
+//   - ctx: Context for request cancellation and timeout
+//   - address: The sender address
+//   - opts: Optional parameters (can be nil)
+//
+// Returns:
+//   - *PendingTxs: Nonce gap, known pending transactions and resolved hashes
+//   - error: Error if a request fails
+//
+// Example:
+//
+//	broadcasts := NewBroadcastLog()
+//	hash, err := client.RpcEthSendRawTx(ctx, rawTx, nil)
+//	if err == nil {
+//	    broadcasts.Record(sender, hash)
+//	}
+//
+//	pending, err := client.GetPendingTxsForAddress(ctx, sender, &GetPendingTxsForAddressOpts{
+//	    Sources: []MempoolSource{broadcasts},
+//	})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("%d pending, %d unknown nonces\n", pending.Count, len(pending.MissingNonces))
+//	broadcasts.Forget(sender, append(pending.Mined, pending.Dropped...)...)
+//
+// Note:
+//   - Costs two calls plus one per probed hash
+//   - Pending transactions from other senders of a source are ignored
+func (c *HTTPClient) GetPendingTxsForAddress(ctx context.Context, address string, opts *GetPendingTxsForAddressOpts) (*PendingTxs, error) {
+	if opts == nil {
+		opts = &GetPendingTxsForAddressOpts{}
+	}
+	if err := ApplyDefaults(opts); err != nil {
+		return nil, err
+	}
+
+	countOpts := &RpcEthTxCountOpts{ChainID: opts.ChainID, OnLimitExceeded: opts.OnLimitExceeded}
+	latest, err := c.RpcEthTxCount(ctx, address, BlockTagLatest, countOpts)
+	if err != nil {
+		return nil, err
+	}
+	pending, err := c.RpcEthTxCount(ctx, address, BlockTagPending, countOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PendingTxs{Address: address}
+	if result.LatestNonce, err = parseHexUint64(latest); err != nil {
+		return nil, err
+	}
+	if result.PendingNonce, err = parseHexUint64(pending); err != nil {
+		return nil, err
+	}
+	if result.PendingNonce > result.LatestNonce {
+		result.Count = result.PendingNonce - result.LatestNonce
+	}
+
+	seen := make(map[string]bool)
+	usedNonces := make(map[uint64]bool)
+	for _, source := range opts.Sources {
+		hashes, err := source.PendingTxHashes(ctx, address)
+		if err != nil {
+			return nil, err
+		}
+		for _, hash := range hashes {
+			key := strings.ToLower(hash)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+
+			tx, err := c.RpcEthTxByHash(ctx, hash, &RpcEthTxByHashOpts{
+				ChainID:         opts.ChainID,
+				OnLimitExceeded: opts.OnLimitExceeded,
+			})
+			if err != nil {
+				return nil, err
+			}
+			switch {
+			case tx == nil || tx.Hash == "":
+				result.Dropped = append(result.Dropped, hash)
+			case tx.BlockNumber != "":
+				result.Mined = append(result.Mined, hash)
+			case strings.EqualFold(tx.From, address):
+				result.Txs = append(result.Txs, *tx)
+				if nonce, err := parseHexUint64(tx.Nonce); err == nil {
+					usedNonces[nonce] = true
+				}
+			}
+		}
+	}
+
+	sort.SliceStable(result.Txs, func(i, j int) bool {
+		a, _ := parseHexUint64(result.Txs[i].Nonce)
+		b, _ := parseHexUint64(result.Txs[j].Nonce)
+		return a < b
+	})
+	for nonce := result.LatestNonce; nonce < result.PendingNonce; nonce++ {
+		if !usedNonces[nonce] {
+			result.MissingNonces = append(result.MissingNonces, nonce)
+		}
+	}
+	return result, nil
+}
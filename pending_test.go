@@ -0,0 +1,79 @@
+package etherscan
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetPendingTxsForAddress(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		switch query.Get("action") {
+		case "eth_getTransactionCount":
+			if query.Get("tag") == "pending" {
+				w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0xd"}`))
+			} else {
+				w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0xa"}`))
+			}
+		case "eth_getTransactionByHash":
+			switch query.Get("txhash") {
+			case "0xmined":
+				w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":{"hash":"0xmined","from":"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa","nonce":"0x9","blockNumber":"0x10"}}`))
+			case "0xp12":
+				w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":{"hash":"0xp12","from":"0xAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA","nonce":"0xc","blockNumber":null}}`))
+			case "0xp10":
+				w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":{"hash":"0xp10","from":"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa","nonce":"0xa","blockNumber":null}}`))
+			default:
+				w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":null}`))
+			}
+		}
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+	})
+	ctx := context.Background()
+
+	broadcasts := NewBroadcastLog()
+	for _, hash := range []string{"0xmined", "0xp12", "0xgone", "0xp10"} {
+		broadcasts.Record("0xAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA", hash)
+	}
+	broadcasts.Record(testPeer, "0xp11")
+
+	pending, err := client.GetPendingTxsForAddress(ctx, testAddr, &GetPendingTxsForAddressOpts{
+		Sources: []MempoolSource{broadcasts, broadcasts},
+	})
+	if err != nil {
+		t.Fatalf("GetPendingTxsForAddress failed: %v", err)
+	}
+	if pending.LatestNonce != 10 || pending.PendingNonce != 13 || pending.Count != 3 {
+		t.Errorf("unexpected nonces: %+v", pending)
+	}
+	if len(pending.Txs) != 2 || pending.Txs[0].Hash != "0xp10" || pending.Txs[1].Hash != "0xp12" {
+		t.Errorf("expected pending txs ordered by nonce, got %+v", pending.Txs)
+	}
+	if len(pending.MissingNonces) != 1 || pending.MissingNonces[0] != 11 {
+		t.Errorf("expected nonce 11 missing, got %v", pending.MissingNonces)
+	}
+	if len(pending.Mined) != 1 || pending.Mined[0] != "0xmined" || len(pending.Dropped) != 1 || pending.Dropped[0] != "0xgone" {
+		t.Errorf("unexpected mined %v / dropped %v", pending.Mined, pending.Dropped)
+	}
+
+	broadcasts.Forget("0xAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA", append(pending.Mined, pending.Dropped...)...)
+	if hashes, _ := broadcasts.PendingTxHashes(ctx, testAddr); len(hashes) != 2 || hashes[0] != "0xp12" || hashes[1] != "0xp10" {
+		t.Errorf("unexpected hashes after Forget: %v", hashes)
+	}
+
+	// Without sources only the nonce gap is known
+	pending, err = client.GetPendingTxsForAddress(ctx, testAddr, nil)
+	if err != nil {
+		t.Fatalf("GetPendingTxsForAddress failed: %v", err)
+	}
+	if pending.Count != 3 || len(pending.MissingNonces) != 3 || len(pending.Txs) != 0 {
+		t.Errorf("unexpected result without sources: %+v", pending)
+	}
+}
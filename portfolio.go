@@ -0,0 +1,271 @@
+package etherscan
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// ============================================================================
+// Portfolio Valuation
+// ============================================================================
+
+// PortfolioAsset is a single valued position in a portfolio
+type PortfolioAsset struct {
+	// TokenAddress is the ERC-20 contract address (empty for the native coin)
+	TokenAddress string `json:"tokenAddress" bson:"tokenAddress"`
+	TokenName    string `json:"tokenName" bson:"tokenName"`
+	TokenSymbol  string `json:"tokenSymbol" bson:"tokenSymbol"`
+	Decimals     int64  `json:"decimals" bson:"decimals"`
+
+	// RawQuantity is the balance in the token's smallest unit
+	RawQuantity string `json:"rawQuantity" bson:"rawQuantity"`
+
+	// Quantity is the balance in whole tokens (RawQuantity / 10^Decimals)
+	Quantity string `json:"quantity" bson:"quantity"`
+
+	// PriceUSD is the unit price in USD, empty if the token is unpriced
+	PriceUSD string `json:"priceUSD" bson:"priceUSD"`
+
+	// ValueUSD is Quantity * PriceUSD, empty if the token is unpriced
+	ValueUSD string `json:"valueUSD" bson:"valueUSD"`
+
+	// Priced reports whether a USD price was available
+	Priced bool `json:"priced" bson:"priced"`
+}
+
+// Portfolio is the valued set of holdings of an address
+type Portfolio struct {
+	Address string `json:"address" bson:"address"`
+
+	// Native is the native coin position, labelled with the chain's NativeTokenSymbols entry
+	Native PortfolioAsset `json:"native" bson:"native"`
+
+	// Tokens are the ERC-20 positions, in the order returned by the API
+	Tokens []PortfolioAsset `json:"tokens" bson:"tokens"`
+
+	// TotalValueUSD is the sum of all priced positions
+	TotalValueUSD string `json:"totalValueUSD" bson:"totalValueUSD"`
+
+	// UnpricedCount is the number of positions excluded from TotalValueUSD
+	UnpricedCount int `json:"unpricedCount" bson:"unpricedCount"`
+}
+
+// GetPortfolioValueOpts contains optional parameters for GetPortfolioValue
+type GetPortfolioValueOpts struct {
+	// PageSize is the number of holdings fetched per addresstokenbalance page
+	// Default: 100
+	PageSize int64 `default:"100" json:"page_size"`
+
+	// MaxRetries is the number of times a tokeninfo call failing with a transient error is repeated
+	// Default: 3
+	MaxRetries int `default:"3" json:"max_retries"`
+
+	// ChainID specifies which blockchain network to query
+	// Default: empty (uses client default)
+	ChainID int64 `json:"chainid"`
+
+	// OnLimitExceeded specifies behavior when rate limit is exceeded
+	// Default: RateLimitBlock (wait until a token is available)
+	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+}
+
+// GetPortfolioValue returns the USD-valued ETH and ERC-20 holdings of an address
+//
+// This helper combines GetAccountERC20Holdings, GetTokenInfo (for decimals and
+// price), GetEthBalance and GetNativeTokenPrice into a single Portfolio. Tokens without a
+// price are kept in the result with Priced=false and are excluded from the total.
+// A tokeninfo call failing with a transient error (see IsTransientError) is
+// repeated MaxRetries times; any other failure fails the whole call, so the
+// total never silently misses a token that has a price.
+//
+// Args:
+//   - ctx: Context for request cancellation and timeout
+//   - address: The address to value
+//   - opts: Optional parameters (can be nil)
+//
+// Returns:
+//   - *Portfolio: Per-asset quantity, price and value, plus the total in USD
+//   - error: Error if holdings, ETH balance, ETH price or a token's info cannot be fetched
+//
+// Example:
+//
+//	portfolio, err := client.GetPortfolioValue(ctx, addr, nil)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("Total: $%s (%d unpriced)\n", portfolio.TotalValueUSD, portfolio.UnpricedCount)
+//	for _, token := range portfolio.Tokens {
+//	    fmt.Printf("%s: %s @ $%s\n", token.TokenSymbol, token.Quantity, token.PriceUSD)
+//	}
+//
+// Note:
+//   - Requires API Pro (addresstokenbalance and tokeninfo are Pro endpoints)
+//   - Makes one tokeninfo call per held token, throttled to 2 calls/second across all calls of the client
+//   - Only tokens for which tokeninfo has no price are unpriced
+func (c *HTTPClient) GetPortfolioValue(ctx context.Context, address string, opts *GetPortfolioValueOpts) (*Portfolio, error) {
+	if opts == nil {
+		opts = &GetPortfolioValueOpts{}
+	}
+	if err := ApplyDefaults(opts); err != nil {
+		return nil, err
+	}
+
+	portfolio := &Portfolio{Address: address}
+	total := new(big.Rat)
+
+	// Native balance
+	balance, err := c.GetEthBalance(ctx, address, &GetEthBalanceOpts{
+		ChainID:         opts.ChainID,
+		OnLimitExceeded: opts.OnLimitExceeded,
+	})
+	if err != nil {
+		return nil, err
+	}
+	nativePrice, err := c.GetNativeTokenPrice(ctx, &GetNativeTokenPriceOpts{
+		ChainID:         opts.ChainID,
+		OnLimitExceeded: opts.OnLimitExceeded,
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Native balances are in wei-like units with 18 decimals on every EVM chain
+	portfolio.Native = valueAsset(PortfolioAsset{
+		TokenName:   nativePrice.Symbol,
+		TokenSymbol: nativePrice.Symbol,
+		Decimals:    18,
+		RawQuantity: balance,
+	}, nativePrice.USD, total)
+
+	// ERC-20 holdings, all pages
+	var holdings []RespERC20Holding
+	for page := int64(1); ; page++ {
+		batch, err := c.GetAccountERC20Holdings(ctx, address, &GetAccountERC20HoldingsOpts{
+			Page:            page,
+			Offset:          opts.PageSize,
+			ChainID:         opts.ChainID,
+			OnLimitExceeded: opts.OnLimitExceeded,
+		})
+		if err != nil {
+			return nil, err
+		}
+		holdings = append(holdings, batch...)
+		if int64(len(batch)) < opts.PageSize {
+			break
+		}
+	}
+
+	for _, holding := range holdings {
+		asset := PortfolioAsset{
+			TokenAddress: holding.TokenAddress,
+			TokenName:    holding.TokenName,
+			TokenSymbol:  holding.TokenSymbol,
+			Decimals:     -1,
+			RawQuantity:  holding.TokenQuantity,
+		}
+		if decimals, err := strconv.ParseInt(holding.TokenDivisor, 10, 64); err == nil {
+			asset.Decimals = decimals
+		}
+
+		info, err := c.portfolioTokenInfo(ctx, holding.TokenAddress, opts)
+		if err != nil {
+			return nil, fmt.Errorf("etherscan: token info of %s: %w", holding.TokenAddress, err)
+		}
+		price := info.TokenPriceUSD
+		if asset.Decimals < 0 {
+			if decimals, err := strconv.ParseInt(info.Divisor, 10, 64); err == nil {
+				asset.Decimals = decimals
+			}
+		}
+		if asset.Decimals < 0 {
+			// Without decimals the quantity cannot be valued
+			asset.Decimals = 0
+			price = ""
+		}
+
+		asset = valueAsset(asset, price, total)
+		portfolio.Tokens = append(portfolio.Tokens, asset)
+	}
+
+	if !portfolio.Native.Priced {
+		portfolio.UnpricedCount++
+	}
+	for _, token := range portfolio.Tokens {
+		if !token.Priced {
+			portfolio.UnpricedCount++
+		}
+	}
+	portfolio.TotalValueUSD = formatRat(total)
+
+	return portfolio, nil
+}
+
+// tokenInfoRateLimit is the throttle Etherscan applies to tokeninfo regardless of tier
+const tokenInfoRateLimit = 2
+
+// portfolioTokenInfo calls GetTokenInfo paced to the tokeninfo throttle, repeating transient failures
+func (c *HTTPClient) portfolioTokenInfo(ctx context.Context, contract string, opts *GetPortfolioValueOpts) (*RespTokenInfo, error) {
+	for attempt := 0; ; attempt++ {
+		if err := waitThrottle(ctx, c.tokenInfoLimiter); err != nil {
+			return nil, err
+		}
+		info, err := c.GetTokenInfo(ctx, contract, &GetTokenInfoOpts{
+			ChainID:         opts.ChainID,
+			OnLimitExceeded: opts.OnLimitExceeded,
+		})
+		if err == nil {
+			return info, nil
+		}
+		if !IsTransientError(err) || attempt >= opts.MaxRetries || ctx.Err() != nil {
+			return nil, err
+		}
+	}
+}
+
+// valueAsset fills in Quantity, PriceUSD and ValueUSD and adds the value to total
+func valueAsset(asset PortfolioAsset, priceUSD string, total *big.Rat) PortfolioAsset {
+	quantity, ok := scaleUnits(asset.RawQuantity, asset.Decimals)
+	if !ok {
+		return asset
+	}
+	asset.Quantity = formatRat(quantity)
+
+	price, ok := new(big.Rat).SetString(strings.TrimSpace(priceUSD))
+	if !ok || price.Sign() <= 0 {
+		return asset
+	}
+	value := new(big.Rat).Mul(quantity, price)
+	total.Add(total, value)
+
+	asset.PriceUSD = formatRat(price)
+	asset.ValueUSD = formatRat(value)
+	asset.Priced = true
+	return asset
+}
+
+// scaleUnits converts an integer amount in the smallest unit to whole units
+func scaleUnits(raw string, decimals int64) (*big.Rat, bool) {
+	amount, ok := new(big.Int).SetString(strings.TrimSpace(raw), 10)
+	if !ok {
+		return nil, false
+	}
+	if decimals < 0 {
+		return nil, false
+	}
+	divisor := new(big.Int).Exp(big.NewInt(10), big.NewInt(decimals), nil)
+	return new(big.Rat).SetFrac(amount, divisor), true
+}
+
+// formatRat formats r with up to 18 decimals, trimming trailing zeros
+func formatRat(r *big.Rat) string {
+	s := r.FloatString(18)
+	if strings.Contains(s, ".") {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+	if s == "-0" {
+		return "0"
+	}
+	return s
+}
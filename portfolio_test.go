@@ -0,0 +1,130 @@
+package etherscan
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestValueAsset(t *testing.T) {
+	total := new(big.Rat)
+
+	priced := valueAsset(PortfolioAsset{TokenSymbol: "USDC", Decimals: 6, RawQuantity: "2500000"}, "1.0001", total)
+	if !priced.Priced || priced.Quantity != "2.5" || priced.ValueUSD != "2.50025" {
+		t.Errorf("unexpected priced asset: %+v", priced)
+	}
+
+	unpriced := valueAsset(PortfolioAsset{TokenSymbol: "SPAM", Decimals: 18, RawQuantity: "1000000000000000000"}, "", total)
+	if unpriced.Priced || unpriced.Quantity != "1" || unpriced.ValueUSD != "" {
+		t.Errorf("unexpected unpriced asset: %+v", unpriced)
+	}
+
+	if got := formatRat(total); got != "2.50025" {
+		t.Errorf("expected total 2.50025, got %s", got)
+	}
+}
+
+func TestGetPortfolioValue(t *testing.T) {
+	config := GetTestConfig(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 120*time.Second)
+	defer cancel()
+
+	portfolio, err := config.Client.GetPortfolioValue(ctx, TestAddresses.VitalikButerin, &GetPortfolioValueOpts{
+		ChainID: 1, // Ethereum mainnet
+	})
+	if err != nil {
+		t.Fatalf("GetPortfolioValue failed: %v", err)
+	}
+	if !portfolio.Native.Priced {
+		t.Error("expected ETH position to be priced")
+	}
+
+	t.Logf("Total value: $%s across %d tokens (%d unpriced)", portfolio.TotalValueUSD, len(portfolio.Tokens), portfolio.UnpricedCount)
+}
+
+func TestGetPortfolioValueNativeSymbol(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("action") {
+		case "balance":
+			w.Write([]byte(`{"status":"1","message":"OK","result":"2000000000000000000"}`))
+		case "ethprice":
+			w.Write([]byte(`{"status":"1","message":"OK","result":{"ethbtc":"0.000005","ethbtc_timestamp":"1700000000","ethusd":"0.5","ethusd_timestamp":"1700000000"}}`))
+		default:
+			w.Write([]byte(`{"status":"1","message":"OK","result":[]}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{PolygonMainnet: server.URL},
+	})
+	portfolio, err := client.GetPortfolioValue(context.Background(), TestAddresses.VitalikButerin, &GetPortfolioValueOpts{ChainID: PolygonMainnet})
+	if err != nil {
+		t.Fatalf("GetPortfolioValue failed: %v", err)
+	}
+	if portfolio.Native.TokenSymbol != "POL" || portfolio.Native.ValueUSD != "1" {
+		t.Errorf("expected a POL position worth $1, got %+v", portfolio.Native)
+	}
+}
+
+func TestGetPortfolioValueTokenInfoErrors(t *testing.T) {
+	var tokenInfoCalls atomic.Int32
+	var failing atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		switch query.Get("action") {
+		case "balance":
+			w.Write([]byte(`{"status":"1","message":"OK","result":"0"}`))
+		case "ethprice":
+			w.Write([]byte(`{"status":"1","message":"OK","result":{"ethbtc":"0.05","ethbtc_timestamp":"1700000000","ethusd":"2000","ethusd_timestamp":"1700000000"}}`))
+		case "addresstokenbalance":
+			w.Write([]byte(`{"status":"1","message":"OK","result":[` +
+				`{"TokenAddress":"` + TestAddresses.USDTContract + `","TokenName":"Tether","TokenSymbol":"USDT","TokenQuantity":"2000000","TokenDivisor":"6"},` +
+				`{"TokenAddress":"` + TestAddresses.USDCContract + `","TokenName":"USD Coin","TokenSymbol":"USDC","TokenQuantity":"3000000","TokenDivisor":"6"}]}`))
+		case "tokeninfo":
+			// The first call is throttled, a transient error to retry
+			if tokenInfoCalls.Add(1) == 1 {
+				w.Write([]byte(`{"status":"0","message":"Maximum rate limit reached","result":null}`))
+				return
+			}
+			if failing.Load() {
+				w.Write([]byte(`{"status":"0","message":"NOTOK","result":"Invalid API Key"}`))
+				return
+			}
+			w.Write([]byte(`{"status":"1","message":"OK","result":[{"tokenPriceUSD":"1"}]}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+		MaxRetries: -1,
+	})
+	ctx := context.Background()
+
+	start := time.Now()
+	portfolio, err := client.GetPortfolioValue(ctx, TestAddresses.VitalikButerin, &GetPortfolioValueOpts{ChainID: EthereumMainnet})
+	if err != nil {
+		t.Fatalf("GetPortfolioValue failed: %v", err)
+	}
+	if portfolio.TotalValueUSD != "5" || !portfolio.Tokens[0].Priced {
+		t.Errorf("expected the throttled token to be retried and priced, got %+v", portfolio)
+	}
+	// The third tokeninfo call waits for the 2/s throttle
+	if elapsed := time.Since(start); elapsed < 400*time.Millisecond {
+		t.Errorf("expected tokeninfo calls to be paced, took %s", elapsed)
+	}
+
+	// A non-transient error fails the call instead of leaving the token unpriced
+	failing.Store(true)
+	if _, err := client.GetPortfolioValue(ctx, TestAddresses.VitalikButerin, &GetPortfolioValueOpts{ChainID: EthereumMainnet}); err == nil || !strings.Contains(err.Error(), "Invalid API Key") {
+		t.Errorf("expected the tokeninfo error, got %v", err)
+	}
+}
@@ -0,0 +1,293 @@
+package etherscan
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// ============================================================================
+// Transaction Preflight
+// ============================================================================
+
+// Revert selectors defined by Solidity
+const (
+	// RevertSelectorError is the selector of Error(string), used by require/revert with a message
+	RevertSelectorError = "0x08c379a0"
+	// RevertSelectorPanic is the selector of Panic(uint256), used by assert and compiler checks
+	RevertSelectorPanic = "0x4e487b71"
+)
+
+// panicReasons maps Solidity panic codes to human-readable descriptions
+var panicReasons = map[uint64]string{
+	0x00: "generic compiler panic",
+	0x01: "assertion failed",
+	0x11: "arithmetic overflow or underflow",
+	0x12: "division or modulo by zero",
+	0x21: "invalid enum value",
+	0x22: "incorrectly encoded storage byte array",
+	0x31: "pop on empty array",
+	0x32: "array index out of bounds",
+	0x41: "out of memory",
+	0x51: "call to zero-initialized internal function",
+}
+
+// TxRevert describes why a simulated call reverted
+type TxRevert struct {
+	// Message is the raw JSON-RPC error message (e.g., "execution reverted")
+	Message string
+
+	// Data is the raw revert data in hex, if the node returned any
+	Data string
+
+	// Reason is the decoded Error(string) message, or a description of the panic code
+	Reason string
+
+	// PanicCode is set when the revert was a Panic(uint256)
+	PanicCode *big.Int
+}
+
+// Error implements the error interface
+func (r *TxRevert) Error() string {
+	if r.Reason != "" {
+		return fmt.Sprintf("etherscan: execution reverted: %s", r.Reason)
+	}
+	if r.Message != "" {
+		return fmt.Sprintf("etherscan: %s", r.Message)
+	}
+	return "etherscan: execution reverted"
+}
+
+// DecodeRevertData decodes Solidity revert data into a TxRevert
+//
+// Supports Error(string) and Panic(uint256). For custom errors the selector is
+// reported as the reason so callers can match it against their ABI.
+//
+// Example:
+//
+//	revert := DecodeRevertData("0x08c379a0...")
+//	fmt.Println(revert.Reason)
+func DecodeRevertData(data string) *TxRevert {
+	revert := &TxRevert{Data: data}
+
+	raw, err := hex.DecodeString(strings.TrimPrefix(data, "0x"))
+	if err != nil || len(raw) < 4 {
+		return revert
+	}
+
+	selector := "0x" + hex.EncodeToString(raw[:4])
+	payload := raw[4:]
+
+	switch selector {
+	case RevertSelectorError:
+		// abi.encode(string): offset (32) | length (32) | bytes
+		if len(payload) < 64 {
+			return revert
+		}
+		// Bounds are checked without adding so huge offsets and lengths cannot wrap around
+		offsetWord := new(big.Int).SetBytes(payload[:32])
+		if !offsetWord.IsUint64() || offsetWord.Uint64() > uint64(len(payload)-32) {
+			return revert
+		}
+		offset := offsetWord.Uint64()
+		start := offset + 32
+		lengthWord := new(big.Int).SetBytes(payload[offset:start])
+		if !lengthWord.IsUint64() || lengthWord.Uint64() > uint64(len(payload))-start {
+			return revert
+		}
+		length := lengthWord.Uint64()
+		revert.Reason = string(payload[start : start+length])
+
+	case RevertSelectorPanic:
+		if len(payload) < 32 {
+			return revert
+		}
+		revert.PanicCode = new(big.Int).SetBytes(payload[:32])
+		desc, ok := panicReasons[revert.PanicCode.Uint64()]
+		if !ok || !revert.PanicCode.IsUint64() {
+			desc = "unknown panic"
+		}
+		revert.Reason = fmt.Sprintf("panic 0x%x: %s", revert.PanicCode, desc)
+
+	default:
+		revert.Reason = fmt.Sprintf("custom error %s", selector)
+	}
+
+	return revert
+}
+
+// revertFromRpcError converts a JSON-RPC error object into a TxRevert
+func revertFromRpcError(rpcErr *RespJsonRpcError) *TxRevert {
+	var revert *TxRevert
+	if data, ok := rpcErr.Data.(string); ok && data != "" {
+		revert = DecodeRevertData(data)
+	} else {
+		revert = &TxRevert{}
+	}
+	revert.Message = rpcErr.Message
+
+	// Some nodes only embed the reason in the message
+	if revert.Reason == "" {
+		if reason, ok := strings.CutPrefix(rpcErr.Message, "execution reverted: "); ok {
+			revert.Reason = reason
+		}
+	}
+	return revert
+}
+
+// PreflightTxOpts contains optional parameters for PreflightTx
+type PreflightTxOpts struct {
+	// Tag specifies the block parameter for the simulated call
+	// Options: "latest", "earliest", "pending", or block number in hex
+	// Default: "latest"
+	Tag BlockTag `default:"latest" json:"tag"`
+
+	// Gas is the amount of gas provided for the simulation (hex string)
+	// Default: "" (node default)
+	Gas string `default:"" json:"gas"`
+
+	// GasPrice is the gas price in wei (hex string)
+	// Default: "" (node default)
+	GasPrice string `default:"" json:"gasprice"`
+
+	// ChainID specifies which blockchain network to query
+	// Default: empty (uses client default)
+	ChainID int64 `json:"chainid"`
+
+	// OnLimitExceeded specifies behavior when rate limit is exceeded
+	// Default: RateLimitBlock (wait until a token is available)
+	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+}
+
+// PreflightResult contains the outcome of a simulated transaction
+type PreflightResult struct {
+	// GasEstimate is the eth_estimateGas result in hex (empty if estimation failed)
+	GasEstimate string
+
+	// ReturnData is the eth_call result in hex (empty if the call reverted)
+	ReturnData string
+
+	// Revert is set when either the call or the estimation reverted
+	Revert *TxRevert
+}
+
+// Err returns the revert as an error, or nil if the simulation succeeded
+func (r *PreflightResult) Err() error {
+	if r.Revert == nil {
+		return nil
+	}
+	return r.Revert
+}
+
+// PreflightTx simulates a transaction with eth_call and eth_estimateGas before it is broadcast
+//
+// Both calls are executed against the same parameters. If either reverts, the
+// revert data is decoded (Error(string) and Panic(uint256)) into PreflightResult.Revert
+// so callers get an actionable reason instead of an opaque "execution reverted".
+//
+// Args:
+//   - ctx: Context for request cancellation and timeout
+//   - from: Sender address (can be empty)
+//   - to: Address to interact with
+//   - data: Hash of the method signature and encoded parameters
+//   - value: Value sent with the transaction in wei (hex string, can be empty)
+//   - opts: Optional parameters (can be nil)
+//
+// Returns:
+//   - *PreflightResult: Gas estimate, return data and decoded revert information
+//   - error: Error if a request fails (a revert is NOT returned here, see PreflightResult.Err)
+//
+// Example:
+//
+//	result, err := client.PreflightTx(ctx, sender, contractAddr, callData, "0x0", nil)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	if err := result.Err(); err != nil {
+//	    fmt.Printf("Transaction would revert: %v\n", err)
+//	    return
+//	}
+//	fmt.Printf("Estimated gas: %s\n", result.GasEstimate)
+//
+// Note:
+//   - Costs two API calls
+//   - Whether "from" is honoured depends on the upstream node
+func (c *HTTPClient) PreflightTx(ctx context.Context, from, to, data, value string, opts *PreflightTxOpts) (*PreflightResult, error) {
+	// Apply defaults and extract API parameters
+	params, err := ApplyDefaultsAndExtractParams(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add required parameters
+	params["from"] = from
+	params["to"] = to
+	params["data"] = data
+	params["value"] = value
+
+	// Handle rate limiting
+	var onLimitExceeded RateLimitBehavior
+	if opts != nil {
+		onLimitExceeded = opts.OnLimitExceeded
+	}
+
+	result := &PreflightResult{}
+
+	callParams := make(map[string]string, len(params))
+	for k, v := range params {
+		callParams[k] = v
+	}
+	callResp, err := c.rpcProxyCall(ctx, "eth_call", callParams, onLimitExceeded)
+	if err != nil {
+		return nil, err
+	}
+	if callResp.Error != nil {
+		result.Revert = revertFromRpcError(callResp.Error)
+	} else {
+		result.ReturnData = callResp.Result
+	}
+
+	// eth_estimateGas does not take a block tag
+	estimateParams := make(map[string]string, len(params))
+	for k, v := range params {
+		if k != "tag" {
+			estimateParams[k] = v
+		}
+	}
+	estimateResp, err := c.rpcProxyCall(ctx, "eth_estimateGas", estimateParams, onLimitExceeded)
+	if err != nil {
+		return nil, err
+	}
+	if estimateResp.Error != nil {
+		if result.Revert == nil {
+			result.Revert = revertFromRpcError(estimateResp.Error)
+		}
+	} else {
+		result.GasEstimate = estimateResp.Result
+	}
+
+	return result, nil
+}
+
+// rpcProxyCall executes a proxy module action and returns the full JSON-RPC envelope, including errors
+func (c *HTTPClient) rpcProxyCall(ctx context.Context, action string, params map[string]string, onLimitExceeded RateLimitBehavior) (*RespJsonRpc[string], error) {
+	data, err := c.request(requestParams{
+		ctx:             ctx,
+		module:          "proxy",
+		action:          action,
+		params:          params,
+		noFoundReturn:   RespJsonRpc[string]{},
+		onLimitExceeded: onLimitExceeded,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var result RespJsonRpc[string]
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
@@ -0,0 +1,79 @@
+package etherscan
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDecodeRevertData(t *testing.T) {
+	// Error("Ownable: caller is not the owner")
+	errorData := "0x08c379a0" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e6572"
+	revert := DecodeRevertData(errorData)
+	if revert.Reason != "Ownable: caller is not the owner" {
+		t.Errorf("unexpected Error(string) reason: %q", revert.Reason)
+	}
+
+	// Panic(0x11)
+	panicData := "0x4e487b71" +
+		"0000000000000000000000000000000000000000000000000000000000000011"
+	revert = DecodeRevertData(panicData)
+	if revert.PanicCode == nil || revert.PanicCode.Int64() != 0x11 {
+		t.Fatalf("unexpected panic code: %v", revert.PanicCode)
+	}
+	if revert.Reason != "panic 0x11: arithmetic overflow or underflow" {
+		t.Errorf("unexpected panic reason: %q", revert.Reason)
+	}
+
+	// Custom error
+	revert = DecodeRevertData("0xdeadbeef")
+	if revert.Reason != "custom error 0xdeadbeef" {
+		t.Errorf("unexpected custom error reason: %q", revert.Reason)
+	}
+
+	// Malformed
+	revert = DecodeRevertData("0x08c379a0")
+	if revert.Reason != "" {
+		t.Errorf("expected empty reason for truncated data, got %q", revert.Reason)
+	}
+
+	// Offsets and lengths that would wrap around must not panic
+	ff := strings.Repeat("ff", 32)
+	for _, data := range []string{
+		"0x08c379a0" + ff,
+		"0x08c379a0" + ff + ff,
+		"0x08c379a0" + strings.Repeat("0", 62) + "20" + ff,
+		"0x08c379a0" + strings.Repeat("0", 48) + "ffffffffffffffe0" + ff,
+		"0x08c379a0" + strings.Repeat("0", 62) + "20" + strings.Repeat("0", 48) + "ffffffffffffffe1",
+	} {
+		if revert := DecodeRevertData(data); revert.Reason != "" {
+			t.Errorf("expected empty reason for malformed data %s, got %q", data, revert.Reason)
+		}
+	}
+}
+
+func TestPreflightTx(t *testing.T) {
+	config := GetTestConfig(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// balanceOf(vitalik) on USDT never reverts
+	callData := "0x70a08231000000000000000000000000d8da6bf26964af9d7eed9e03e53415d37aa96045"
+	result, err := config.Client.PreflightTx(ctx, "", TestAddresses.USDTContract, callData, "", &PreflightTxOpts{
+		ChainID: 1, // Ethereum mainnet
+	})
+	if err != nil {
+		t.Fatalf("PreflightTx failed: %v", err)
+	}
+	if err := result.Err(); err != nil {
+		t.Fatalf("unexpected revert: %v", err)
+	}
+	if result.GasEstimate == "" {
+		t.Error("GasEstimate is empty")
+	}
+	t.Logf("Preflight: gas=%s return=%s", result.GasEstimate, result.ReturnData)
+}
@@ -0,0 +1,214 @@
+package etherscan
+
+import (
+	"context"
+	"strings"
+
+	"github.com/dwdwow/etherscan-go/parallel"
+)
+
+// ============================================================================
+// Address Profile
+// ============================================================================
+
+// ChainProfile is what an AddressProfile found about the address on one chain
+type ChainProfile struct {
+	ChainActivity
+
+	// IsContract reports that the address has code on the chain
+	IsContract bool `json:"isContract" bson:"isContract"`
+
+	// Creation is the deployer and deployment transaction of a contract, nil for EOAs
+	Creation *RespContractCreationAndCreation `json:"creation" bson:"creation"`
+
+	// FundedBy is the first funding of an EOA, nil if unknown or skipped
+	FundedBy *RespAddressFundedBy `json:"fundedBy" bson:"fundedBy"`
+}
+
+// AddressProfileReport combines what is known about an address across chains
+type AddressProfileReport struct {
+	Address string `json:"address" bson:"address"`
+
+	// ENSName is the verified primary ENS name, "" if none; ENSErr is set if the lookup failed
+	ENSName string `json:"ensName" bson:"ensName"`
+	ENSErr  error  `json:"-" bson:"-"`
+
+	// Tag is the address's name tag and labels, nil if none; TagErr is set if the lookup failed
+	Tag    *RespAddressTag `json:"tag" bson:"tag"`
+	TagErr error           `json:"-" bson:"-"`
+
+	// Chains are in the order of the requested chain IDs
+	Chains []ChainProfile `json:"chains" bson:"chains"`
+}
+
+// ActiveChains returns the chains on which the address is active
+func (r *AddressProfileReport) ActiveChains() []ChainProfile {
+	var active []ChainProfile
+	for _, chain := range r.Chains {
+		if chain.Active() {
+			active = append(active, chain)
+		}
+	}
+	return active
+}
+
+// AddressProfileOpts contains optional parameters for AddressProfile
+type AddressProfileOpts struct {
+	// ChainIDs are the chains to profile
+	// Default: nil (every chain listed by GetSupportedChains)
+	ChainIDs []int64
+
+	// Concurrency is the number of chains queried in parallel
+	// Default: 4
+	Concurrency int `default:"4"`
+
+	// LabelDB is consulted for addresses Etherscan has no tag for, or when the tag lookup is throttled
+	// Default: nil (Etherscan tags only)
+	LabelDB *LabelDB
+
+	// SkipTag, SkipENS and SkipFunding leave out the name tag, the ENS lookup
+	// and the per-chain funding origin
+	// Default: false
+	SkipTag     bool
+	SkipENS     bool
+	SkipFunding bool
+
+	// OnLimitExceeded specifies behavior when rate limit is exceeded
+	// Default: RateLimitBlock (wait until a token is available)
+	OnLimitExceeded RateLimitBehavior `default:""`
+}
+
+// AddressProfile returns a multi-chain profile of an address, the first artifact of an investigation
+//
+// Every chain gets the activity of CompareAddressAcrossChains, whether the
+// address is a contract and, for contracts, who deployed it, or, for EOAs,
+// who funded it first. Chains without activity are not looked into further.
+// The name tag (with LabelDB as fallback) and the ENS primary name are added
+// on top. As with CompareAddressAcrossChains a failing chain sets its Err,
+// and a failing tag or ENS lookup sets TagErr or ENSErr, instead of failing
+// the profile.
+//
+// Args:
+//   - ctx: Context for request cancellation and timeout
+//   - address: The address to profile
+//   - opts: Optional parameters (can be nil)
+//
+// Returns:
+//   - *AddressProfileReport: The profile
+//   - error: Error if the address is invalid or the supported chains cannot be listed
+//
+// Example:
+//
+//	profile, err := client.AddressProfile(ctx, suspect, &etherscan.AddressProfileOpts{
+//	    ChainIDs: []int64{EthereumMainnet, BaseMainnet, ArbitrumOneMainnet},
+//	})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(profile.ENSName)
+//	for _, chain := range profile.ActiveChains() {
+//	    if chain.FundedBy != nil {
+//	        fmt.Printf("%d: funded by %s in %s\n", chain.ChainID, chain.FundedBy.FundingAddress, chain.FundedBy.FundingTxn)
+//	    }
+//	}
+//
+// Note:
+//   - Costs six calls per active chain, four per inactive one, plus up to
+//     four eth_call requests for ENS and one tag lookup
+//   - Name tags require an API Pro Plus key; TagErr reports the rejection otherwise
+func (c *HTTPClient) AddressProfile(ctx context.Context, address string, opts *AddressProfileOpts) (*AddressProfileReport, error) {
+	if opts == nil {
+		opts = &AddressProfileOpts{}
+	}
+	if err := ApplyDefaults(opts); err != nil {
+		return nil, err
+	}
+	address, err := NormalizeAddress(address)
+	if err != nil {
+		return nil, err
+	}
+
+	activity, err := c.CompareAddressAcrossChains(ctx, address, opts.ChainIDs, &CompareAddressAcrossChainsOpts{
+		Concurrency:     opts.Concurrency,
+		OnLimitExceeded: opts.OnLimitExceeded,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Per-chain failures are recorded in ChainProfile.Err; only cancelling ctx fails the fan-out
+	chains, err := parallel.Map(ctx, activity.Chains, opts.Concurrency, func(ctx context.Context, activity ChainActivity) (ChainProfile, error) {
+		chain := ChainProfile{ChainActivity: activity}
+		if chain.Err == nil && chain.Active() {
+			chain.Err = c.fetchChainProfile(ctx, address, &chain, opts)
+		}
+		return chain, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	report := &AddressProfileReport{Address: address, Chains: chains}
+	if !opts.SkipTag {
+		var tags []RespAddressTag
+		tags, report.TagErr = c.GetAddressTagWithFallback(ctx, []string{address}, opts.LabelDB, &GetAddressTagOpts{
+			OnLimitExceeded: opts.OnLimitExceeded,
+		})
+		for i := range tags {
+			if strings.EqualFold(tags[i].Address, address) {
+				report.Tag = &tags[i]
+				break
+			}
+		}
+	}
+	if !opts.SkipENS {
+		report.ENSName, report.ENSErr = c.LookupENSName(ctx, address, &LookupENSNameOpts{
+			OnLimitExceeded: opts.OnLimitExceeded,
+		})
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// fetchChainProfile fills in the code, deployment and funding of address on chain.ChainID
+func (c *HTTPClient) fetchChainProfile(ctx context.Context, address string, chain *ChainProfile, opts *AddressProfileOpts) error {
+	code, err := c.RpcEthGetCode(ctx, address, &RpcEthGetCodeOpts{
+		ChainID:         chain.ChainID,
+		OnLimitExceeded: opts.OnLimitExceeded,
+	})
+	if err != nil {
+		return err
+	}
+	chain.IsContract = code != "" && code != "0x"
+
+	if chain.IsContract {
+		creations, err := c.GetContractCreatorAndCreation(ctx, []string{address}, &GetContractCreatorAndCreationOpts{
+			ChainID:         chain.ChainID,
+			OnLimitExceeded: opts.OnLimitExceeded,
+		})
+		if err != nil {
+			return err
+		}
+		if len(creations) > 0 {
+			chain.Creation = &creations[0]
+		}
+		return nil
+	}
+
+	if opts.SkipFunding {
+		return nil
+	}
+	funding, err := c.GetAddressFundedBy(ctx, address, &GetAddressFundedByOpts{
+		ChainID:         chain.ChainID,
+		OnLimitExceeded: opts.OnLimitExceeded,
+	})
+	if err != nil {
+		return err
+	}
+	if funding != nil && funding.FundingAddress != "" {
+		chain.FundedBy = funding
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+package etherscan
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+// activityHandler answers the CompareAddressAcrossChains calls of an active address
+func activityHandler(w http.ResponseWriter, query url.Values) bool {
+	switch query.Get("action") {
+	case "eth_getTransactionCount":
+		w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x2"}`))
+	case "balance":
+		w.Write([]byte(`{"status":"1","message":"OK","result":"1000"}`))
+	case "txlist":
+		w.Write([]byte(`{"status":"1","message":"OK","result":[{"hash":"0xtx","timeStamp":"1704067200"}]}`))
+	default:
+		return false
+	}
+	return true
+}
+
+func TestAddressProfile(t *testing.T) {
+	address := strings.ToLower(TestAddresses.VitalikButerin)
+	resolver := "0x4976fb03c32e5b8cfe2b6ccb31c09ba78ebaba41"
+	name := "vitalik.eth"
+
+	mainnet := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if activityHandler(w, query) {
+			return
+		}
+		switch query.Get("action") {
+		case "eth_getCode":
+			w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x"}`))
+		case "fundedby":
+			fmt.Fprint(w, `{"status":"1","message":"OK","result":{"block":100,"fundingAddress":"0xfunder","fundingTxn":"0xfund","value":"5"}}`)
+		case "getaddresstag":
+			fmt.Fprintf(w, `{"status":"1","message":"OK","result":[{"address":"%s","nametag":"Vitalik Buterin","labels":["ens"]}]}`, address)
+		case "eth_call":
+			var result string
+			switch data := query.Get("data"); {
+			case strings.HasPrefix(data, MethodSelector("resolver(bytes32)")):
+				result = fmt.Sprintf("0x%064s", resolver[2:])
+			case strings.HasPrefix(data, MethodSelector("name(bytes32)")):
+				result = fmt.Sprintf("0x%064x%064x%s", 32, len(name), padRight(hex.EncodeToString([]byte(name))))
+			case strings.HasPrefix(data, MethodSelector("addr(bytes32)")):
+				result = fmt.Sprintf("0x%064s", address[2:])
+			}
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":"%s"}`, result)
+		}
+	}))
+	defer mainnet.Close()
+	base := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if activityHandler(w, query) {
+			return
+		}
+		switch query.Get("action") {
+		case "eth_getCode":
+			w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x6080"}`))
+		case "getcontractcreation":
+			fmt.Fprintf(w, `{"status":"1","message":"OK","result":[{"contractAddress":"%s","contractCreator":"0xdeployer","txHash":"0xdeploy"}]}`, address)
+		case "fundedby":
+			t.Error("expected no funding lookup for a contract")
+		}
+	}))
+	defer base.Close()
+	fresh := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch action := r.URL.Query().Get("action"); action {
+		case "eth_getTransactionCount":
+			w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x0"}`))
+		case "balance":
+			w.Write([]byte(`{"status":"1","message":"OK","result":"0"}`))
+		case "txlist":
+			w.Write([]byte(`{"status":"0","message":"No transactions found","result":[]}`))
+		default:
+			t.Errorf("expected no %s call for an inactive chain", action)
+		}
+	}))
+	defer fresh.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: mainnet.URL, BaseMainnet: base.URL, ArbitrumOneMainnet: fresh.URL},
+		MaxRetries: -1,
+	})
+	profile, err := client.AddressProfile(context.Background(), TestAddresses.VitalikButerin, &AddressProfileOpts{
+		ChainIDs: []int64{EthereumMainnet, BaseMainnet, ArbitrumOneMainnet},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if profile.Address != address || profile.ENSName != name || profile.ENSErr != nil {
+		t.Errorf("unexpected address or ENS name: %s %q %v", profile.Address, profile.ENSName, profile.ENSErr)
+	}
+	if profile.TagErr != nil || profile.Tag == nil || profile.Tag.Nametag != "Vitalik Buterin" {
+		t.Errorf("unexpected tag: %+v %v", profile.Tag, profile.TagErr)
+	}
+	if len(profile.Chains) != 3 || len(profile.ActiveChains()) != 2 {
+		t.Fatalf("expected 2 of 3 chains active, got %+v", profile.Chains)
+	}
+
+	eoa, contract, inactive := profile.Chains[0], profile.Chains[1], profile.Chains[2]
+	if eoa.Err != nil || eoa.IsContract || eoa.FundedBy == nil || eoa.FundedBy.FundingAddress != "0xfunder" || eoa.Creation != nil {
+		t.Errorf("unexpected mainnet profile: %+v", eoa)
+	}
+	if contract.Err != nil || !contract.IsContract || contract.Creation == nil || contract.Creation.ContractCreator != "0xdeployer" || contract.FundedBy != nil {
+		t.Errorf("unexpected Base profile: %+v", contract)
+	}
+	if inactive.Err != nil || inactive.Active() || inactive.IsContract {
+		t.Errorf("unexpected Arbitrum profile: %+v", inactive)
+	}
+
+	if _, err := client.AddressProfile(context.Background(), "0xnot-an-address", nil); err == nil {
+		t.Error("expected an invalid address to be rejected")
+	}
+}
+
+func TestENSNamehash(t *testing.T) {
+	// EIP-137 test vectors
+	for name, want := range map[string]string{
+		"":        "0000000000000000000000000000000000000000000000000000000000000000",
+		"eth":     "93cdeb708b7545dc668eb9280176169d1c33cfd8ed6f04690a0bcc88a93fc4ae",
+		"foo.eth": "de9b09fd7c5f901e23a3f19fecc54828e9c848539801e86591bd9801b019f84f",
+	} {
+		if got := hex.EncodeToString(ENSNamehash(name)); got != want {
+			t.Errorf("ENSNamehash(%q) = %s, want %s", name, got, want)
+		}
+	}
+}
@@ -2,6 +2,8 @@ package etherscan
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 )
 
 // ============================================================================
@@ -17,6 +19,10 @@ type RpcEthBlockNumberOpts struct {
 	// OnLimitExceeded specifies behavior when rate limit is exceeded
 	// Default: RateLimitBlock (wait until a token is available)
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // RpcEthBlockNumber returns the number of the most recent block
@@ -57,8 +63,10 @@ func (c *HTTPClient) RpcEthBlockNumber(ctx context.Context, opts *RpcEthBlockNum
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -68,13 +76,14 @@ func (c *HTTPClient) RpcEthBlockNumber(ctx context.Context, opts *RpcEthBlockNum
 		params:          params,
 		noFoundReturn:   RespEthBlockNumberHex{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return "", err
 	}
 
 	var result RespEthBlockNumberHex
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return "", err
 	}
 	return result.Result, nil
@@ -89,6 +98,10 @@ type RpcEthBlockByNumberOpts struct {
 	// OnLimitExceeded specifies behavior when rate limit is exceeded
 	// Default: RateLimitBlock (wait until a token is available)
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // RpcEthBlockByNumber returns information about a block by block number
@@ -125,7 +138,7 @@ type RpcEthBlockByNumberOpts struct {
 //   - Equivalent to eth_getBlockByNumber JSON-RPC method
 //   - Tag can be block number in hex or "latest", "earliest", "pending"
 //   - Boolean parameter controls transaction detail level
-func (c *HTTPClient) RpcEthBlockByNumber(ctx context.Context, tag string, opts *RpcEthBlockByNumberOpts) (*RespEthBlockInfo, error) {
+func (c *HTTPClient) RpcEthBlockByNumber(ctx context.Context, tag BlockTag, opts *RpcEthBlockByNumberOpts) (*RespEthBlockInfo, error) {
 	// Apply defaults and extract API parameters
 	params, err := ApplyDefaultsAndExtractParams(opts)
 	if err != nil {
@@ -133,13 +146,18 @@ func (c *HTTPClient) RpcEthBlockByNumber(ctx context.Context, tag string, opts *
 	}
 
 	// Add required parameters
-	params["tag"] = tag
+	if err := tag.Validate(); err != nil {
+		return nil, err
+	}
+	params["tag"] = string(tag)
 	params["boolean"] = "false"
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -149,13 +167,14 @@ func (c *HTTPClient) RpcEthBlockByNumber(ctx context.Context, tag string, opts *
 		params:          params,
 		noFoundReturn:   RespEthBlock{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result RespEthBlock
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
 	return &result.Result, nil
@@ -188,7 +207,7 @@ func (c *HTTPClient) RpcEthBlockByNumber(ctx context.Context, tag string, opts *
 //   - For full transaction objects, use RpcEthBlockByNumberWithFullTxs instead
 //   - Block tag can be block number in hex or "latest", "earliest", "pending"
 
-func (c *HTTPClient) RpcEthBlockByNumberWithFullTxs(ctx context.Context, tag string, opts *RpcEthBlockByNumberOpts) (*RespEthBlockInfoWithFullTxs, error) {
+func (c *HTTPClient) RpcEthBlockByNumberWithFullTxs(ctx context.Context, tag BlockTag, opts *RpcEthBlockByNumberOpts) (*RespEthBlockInfoWithFullTxs, error) {
 	// Apply defaults and extract API parameters
 	params, err := ApplyDefaultsAndExtractParams(opts)
 	if err != nil {
@@ -196,13 +215,18 @@ func (c *HTTPClient) RpcEthBlockByNumberWithFullTxs(ctx context.Context, tag str
 	}
 
 	// Add required parameters
-	params["tag"] = tag
+	if err := tag.Validate(); err != nil {
+		return nil, err
+	}
+	params["tag"] = string(tag)
 	params["boolean"] = "true"
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -212,13 +236,14 @@ func (c *HTTPClient) RpcEthBlockByNumberWithFullTxs(ctx context.Context, tag str
 		params:          params,
 		noFoundReturn:   RespEthBlockWithFullTxs{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result RespEthBlockWithFullTxs
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
 	return &result.Result, nil
@@ -233,6 +258,10 @@ type RpcEthUncleByBlockNumberAndIndexOpts struct {
 	// OnLimitExceeded specifies behavior when rate limit is exceeded
 	// Default: RateLimitBlock (wait until a token is available)
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // RpcEthUncleByBlockNumberAndIndex returns information about an uncle block by block number and index
@@ -266,7 +295,7 @@ type RpcEthUncleByBlockNumberAndIndexOpts struct {
 // Note:
 //   - Equivalent to eth_getUncleByBlockNumberAndIndex JSON-RPC method
 //   - Uncle blocks are blocks that were mined but not included in the main chain
-func (c *HTTPClient) RpcEthUncleByBlockNumberAndIndex(ctx context.Context, tag, index string, opts *RpcEthUncleByBlockNumberAndIndexOpts) (*RespEthUncleBlockInfo, error) {
+func (c *HTTPClient) RpcEthUncleByBlockNumberAndIndex(ctx context.Context, tag BlockTag, index string, opts *RpcEthUncleByBlockNumberAndIndexOpts) (*RespEthUncleBlockInfo, error) {
 	// Apply defaults and extract API parameters
 	params, err := ApplyDefaultsAndExtractParams(opts)
 	if err != nil {
@@ -274,13 +303,18 @@ func (c *HTTPClient) RpcEthUncleByBlockNumberAndIndex(ctx context.Context, tag,
 	}
 
 	// Add required parameters
-	params["tag"] = tag
+	if err := tag.Validate(); err != nil {
+		return nil, err
+	}
+	params["tag"] = string(tag)
 	params["index"] = index
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -290,13 +324,14 @@ func (c *HTTPClient) RpcEthUncleByBlockNumberAndIndex(ctx context.Context, tag,
 		params:          params,
 		noFoundReturn:   RespEthUncleBlock{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result RespEthUncleBlock
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
 	return &result.Result, nil
@@ -311,6 +346,10 @@ type RpcEthBlockTxCountByNumberOpts struct {
 	// OnLimitExceeded specifies behavior when rate limit is exceeded
 	// Default: RateLimitBlock (wait until a token is available)
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // RpcEthBlockTxCountByNumber returns the number of transactions in a block by block number
@@ -344,7 +383,7 @@ type RpcEthBlockTxCountByNumberOpts struct {
 // Note:
 //   - Equivalent to eth_getBlockTransactionCountByNumber JSON-RPC method
 //   - Returns count in hex format with "0x" prefix
-func (c *HTTPClient) RpcEthBlockTxCountByNumber(ctx context.Context, tag string, opts *RpcEthBlockTxCountByNumberOpts) (string, error) {
+func (c *HTTPClient) RpcEthBlockTxCountByNumber(ctx context.Context, tag BlockTag, opts *RpcEthBlockTxCountByNumberOpts) (string, error) {
 	// Apply defaults and extract API parameters
 	params, err := ApplyDefaultsAndExtractParams(opts)
 	if err != nil {
@@ -352,12 +391,17 @@ func (c *HTTPClient) RpcEthBlockTxCountByNumber(ctx context.Context, tag string,
 	}
 
 	// Add required parameters
-	params["tag"] = tag
+	if err := tag.Validate(); err != nil {
+		return "", err
+	}
+	params["tag"] = string(tag)
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -367,13 +411,14 @@ func (c *HTTPClient) RpcEthBlockTxCountByNumber(ctx context.Context, tag string,
 		params:          params,
 		noFoundReturn:   RespEthBlockTxCount{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return "", err
 	}
 
 	var result RespEthBlockTxCount
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return "", err
 	}
 	return result.Result, nil
@@ -388,6 +433,10 @@ type RpcEthTxByHashOpts struct {
 	// OnLimitExceeded specifies behavior when rate limit is exceeded
 	// Default: RateLimitBlock (wait until a token is available)
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // RpcEthTxByHash returns information about a transaction by its hash
@@ -436,8 +485,10 @@ func (c *HTTPClient) RpcEthTxByHash(ctx context.Context, txHash string, opts *Rp
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -447,13 +498,14 @@ func (c *HTTPClient) RpcEthTxByHash(ctx context.Context, txHash string, opts *Rp
 		params:          params,
 		noFoundReturn:   RespEthTx{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result RespEthTx
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
 	return &result.Result, nil
@@ -468,6 +520,10 @@ type RpcEthTxByBlockNumberAndIndexOpts struct {
 	// OnLimitExceeded specifies behavior when rate limit is exceeded
 	// Default: RateLimitBlock (wait until a token is available)
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // RpcEthTxByBlockNumberAndIndex returns information about a transaction by block number and transaction index position
@@ -504,7 +560,7 @@ type RpcEthTxByBlockNumberAndIndexOpts struct {
 //   - Equivalent to eth_getTransactionByBlockNumberAndIndex JSON-RPC method
 //   - Returns nil if transaction not found
 //   - Index must be within the block's transaction count
-func (c *HTTPClient) RpcEthTxByBlockNumberAndIndex(ctx context.Context, tag, index string, opts *RpcEthTxByBlockNumberAndIndexOpts) (*RespEthTxInfo, error) {
+func (c *HTTPClient) RpcEthTxByBlockNumberAndIndex(ctx context.Context, tag BlockTag, index string, opts *RpcEthTxByBlockNumberAndIndexOpts) (*RespEthTxInfo, error) {
 	// Apply defaults and extract API parameters
 	params, err := ApplyDefaultsAndExtractParams(opts)
 	if err != nil {
@@ -512,13 +568,18 @@ func (c *HTTPClient) RpcEthTxByBlockNumberAndIndex(ctx context.Context, tag, ind
 	}
 
 	// Add required parameters
-	params["tag"] = tag
+	if err := tag.Validate(); err != nil {
+		return nil, err
+	}
+	params["tag"] = string(tag)
 	params["index"] = index
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -528,13 +589,14 @@ func (c *HTTPClient) RpcEthTxByBlockNumberAndIndex(ctx context.Context, tag, ind
 		params:          params,
 		noFoundReturn:   RespEthTx{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result RespEthTx
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
 	return &result.Result, nil
@@ -549,6 +611,10 @@ type RpcEthTxCountOpts struct {
 	// OnLimitExceeded specifies behavior when rate limit is exceeded
 	// Default: RateLimitBlock (wait until a token is available)
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // RpcEthTxCount returns the number of transactions performed by an address
@@ -585,7 +651,7 @@ type RpcEthTxCountOpts struct {
 //   - Equivalent to eth_getTransactionCount JSON-RPC method
 //   - Returns nonce in hex format with "0x" prefix
 //   - Nonce represents the number of transactions sent from this address
-func (c *HTTPClient) RpcEthTxCount(ctx context.Context, address, tag string, opts *RpcEthTxCountOpts) (string, error) {
+func (c *HTTPClient) RpcEthTxCount(ctx context.Context, address string, tag BlockTag, opts *RpcEthTxCountOpts) (string, error) {
 	// Apply defaults and extract API parameters
 	params, err := ApplyDefaultsAndExtractParams(opts)
 	if err != nil {
@@ -594,12 +660,17 @@ func (c *HTTPClient) RpcEthTxCount(ctx context.Context, address, tag string, opt
 
 	// Add required parameters
 	params["address"] = address
-	params["tag"] = tag
+	if err := tag.Validate(); err != nil {
+		return "", err
+	}
+	params["tag"] = string(tag)
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -609,13 +680,14 @@ func (c *HTTPClient) RpcEthTxCount(ctx context.Context, address, tag string, opt
 		params:          params,
 		noFoundReturn:   RespEthTxCount{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return "", err
 	}
 
 	var result RespEthTxCount
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return "", err
 	}
 	return result.Result, nil
@@ -630,6 +702,10 @@ type RpcEthSendRawTxOpts struct {
 	// OnLimitExceeded specifies behavior when rate limit is exceeded
 	// Default: RateLimitBlock (wait until a token is available)
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // RpcEthSendRawTx submits a pre-signed transaction for broadcast to the Ethereum network
@@ -674,8 +750,10 @@ func (c *HTTPClient) RpcEthSendRawTx(ctx context.Context, hex string, opts *RpcE
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -686,13 +764,14 @@ func (c *HTTPClient) RpcEthSendRawTx(ctx context.Context, hex string, opts *RpcE
 		method:          "POST",
 		noFoundReturn:   RespEthSendRawTx{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return "", err
 	}
 
 	var result RespEthSendRawTx
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return "", err
 	}
 	return result.Result, nil
@@ -707,6 +786,10 @@ type RpcEthTxReceiptOpts struct {
 	// OnLimitExceeded specifies behavior when rate limit is exceeded
 	// Default: RateLimitBlock (wait until a token is available)
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // RpcEthTxReceipt returns the receipt of a transaction by transaction hash
@@ -755,8 +838,10 @@ func (c *HTTPClient) RpcEthTxReceipt(ctx context.Context, txHash string, opts *R
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -766,13 +851,14 @@ func (c *HTTPClient) RpcEthTxReceipt(ctx context.Context, txHash string, opts *R
 		params:          params,
 		noFoundReturn:   RespEthTxReceipt{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result RespEthTxReceipt
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
 	return &result.Result, nil
@@ -783,7 +869,7 @@ type RpcEthCallOpts struct {
 	// Tag specifies the block parameter for the call
 	// Options: "latest", "earliest", "pending", or block number in hex
 	// Default: "latest"
-	Tag string `default:"latest" json:"tag"`
+	Tag BlockTag `default:"latest" json:"tag"`
 
 	// ChainID specifies which blockchain network to query
 	// Default: empty (uses client default)
@@ -792,6 +878,10 @@ type RpcEthCallOpts struct {
 	// OnLimitExceeded specifies behavior when rate limit is exceeded
 	// Default: RateLimitBlock (wait until a token is available)
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // RpcEthCall executes a new message call immediately without creating a transaction on the block chain
@@ -850,8 +940,10 @@ func (c *HTTPClient) RpcEthCall(ctx context.Context, to, data string, opts *RpcE
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	result, err := c.request(requestParams{
@@ -861,13 +953,14 @@ func (c *HTTPClient) RpcEthCall(ctx context.Context, to, data string, opts *RpcE
 		params:          params,
 		noFoundReturn:   RespEthCall{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return "", err
 	}
 
 	var resp RespEthCall
-	if err := unmarshalResponse(result, &resp); err != nil {
+	if err := c.unmarshalResponse(ctx, result, &resp); err != nil {
 		return "", err
 	}
 	return resp.Result, nil
@@ -878,7 +971,7 @@ type RpcEthGetCodeOpts struct {
 	// Tag specifies the block parameter for the call
 	// Options: "latest", "earliest", "pending", or block number in hex
 	// Default: "latest"
-	Tag string `default:"latest" json:"tag"`
+	Tag BlockTag `default:"latest" json:"tag"`
 
 	// ChainID specifies which blockchain network to query
 	// Default: empty (uses client default)
@@ -887,6 +980,10 @@ type RpcEthGetCodeOpts struct {
 	// OnLimitExceeded specifies behavior when rate limit is exceeded
 	// Default: RateLimitBlock (wait until a token is available)
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // RpcEthGetCode returns code at a given address
@@ -935,8 +1032,10 @@ func (c *HTTPClient) RpcEthGetCode(ctx context.Context, address string, opts *Rp
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -946,13 +1045,14 @@ func (c *HTTPClient) RpcEthGetCode(ctx context.Context, address string, opts *Rp
 		params:          params,
 		noFoundReturn:   RespEthGetCode{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return "", err
 	}
 
 	var result RespEthGetCode
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return "", err
 	}
 	return result.Result, nil
@@ -963,7 +1063,7 @@ type RpcEthGetStorageAtOpts struct {
 	// Tag specifies the block parameter for the call
 	// Options: "latest", "earliest", "pending", or block number in hex
 	// Default: "latest"
-	Tag string `default:"latest" json:"tag"`
+	Tag BlockTag `default:"latest" json:"tag"`
 
 	// ChainID specifies which blockchain network to query
 	// Default: empty (uses client default)
@@ -972,6 +1072,10 @@ type RpcEthGetStorageAtOpts struct {
 	// OnLimitExceeded specifies behavior when rate limit is exceeded
 	// Default: RateLimitBlock (wait until a token is available)
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // RpcEthGetStorageAt returns the value from a storage position at a given address
@@ -1018,8 +1122,10 @@ func (c *HTTPClient) RpcEthGetStorageAt(ctx context.Context, address, position s
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -1029,18 +1135,120 @@ func (c *HTTPClient) RpcEthGetStorageAt(ctx context.Context, address, position s
 		params:          params,
 		noFoundReturn:   RespEthGetStorageAt{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return "", err
 	}
 
 	var result RespEthGetStorageAt
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return "", err
 	}
 	return result.Result, nil
 }
 
+// RpcEthGetProofOpts contains optional parameters for RpcEthGetProof
+type RpcEthGetProofOpts struct {
+	// ChainID specifies which blockchain network to query
+	// Default: empty (uses client default)
+	ChainID int64 `json:"chainid"`
+
+	// OnLimitExceeded specifies behavior when rate limit is exceeded
+	// Default: RateLimitBlock (wait until a token is available)
+	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
+}
+
+// RpcEthGetProof returns the Merkle proof of an account and some of its storage slots
+//
+// This endpoint returns the account and storage proofs defined by EIP-1186. This is
+// equivalent to the eth_getProof JSON-RPC method. The proofs can be verified against
+// the state root of the block, which light clients and bridges use to trust balances
+// and storage values without trusting the API.
+//
+// Args:
+//   - ctx: Context for request cancellation and timeout
+//   - address: Account address
+//   - storageKeys: Storage slots to prove (32-byte hex); may be empty for the account proof only
+//   - tag: Block number in hex or "latest", "earliest", "pending"
+//   - opts: Optional parameters (can be nil)
+//
+// Returns:
+//   - *RespEthProofInfo: Balance, nonce, code hash, storage hash and the proofs
+//   - error: Error if the request fails or the node rejects the call
+//
+// Example:
+//
+//	proof, err := client.RpcEthGetProof(ctx, contractAddr, []string{"0x0"}, BlockNumberTag(18000000), nil)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("Storage hash: %s, slot 0: %s\n", proof.StorageHash, proof.StorageProof[0].Value)
+//
+// Note:
+//   - Equivalent to eth_getProof JSON-RPC method
+//   - Not every chain's proxy serves eth_getProof; unsupported chains return an error
+//   - Nodes usually only serve proofs for recent blocks
+func (c *HTTPClient) RpcEthGetProof(ctx context.Context, address string, storageKeys []string, tag BlockTag, opts *RpcEthGetProofOpts) (*RespEthProofInfo, error) {
+	// Apply defaults and extract API parameters
+	params, err := ApplyDefaultsAndExtractParams(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add required parameters
+	if err := tag.Validate(); err != nil {
+		return nil, err
+	}
+	if tag == "" {
+		tag = BlockTagLatest
+	}
+	if storageKeys == nil {
+		storageKeys = []string{}
+	}
+	keys, err := json.Marshal(storageKeys)
+	if err != nil {
+		return nil, err
+	}
+	params["address"] = address
+	params["storageKeys"] = string(keys)
+	params["tag"] = string(tag)
+
+	// Handle rate limiting
+	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
+	if opts != nil {
+		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
+	}
+
+	data, err := c.request(requestParams{
+		ctx:             ctx,
+		module:          "proxy",
+		action:          "eth_getProof",
+		params:          params,
+		noFoundReturn:   RespEthGetProof{},
+		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var result RespEthGetProof
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
+		return nil, err
+	}
+	if result.Error != nil {
+		return nil, fmt.Errorf("etherscan: eth_getProof failed: %s (code %d)", result.Error.Message, result.Error.Code)
+	}
+	return &result.Result, nil
+}
+
 // RpcEthGetGasPriceOpts contains optional parameters for RpcEthGetGasPrice
 type RpcEthGetGasPriceOpts struct {
 	// ChainID specifies which blockchain network to query
@@ -1050,6 +1258,10 @@ type RpcEthGetGasPriceOpts struct {
 	// OnLimitExceeded specifies behavior when rate limit is exceeded
 	// Default: RateLimitBlock (wait until a token is available)
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // RpcEthGetGasPrice returns the current price per gas in wei
@@ -1093,8 +1305,10 @@ func (c *HTTPClient) RpcEthGetGasPrice(ctx context.Context, opts *RpcEthGetGasPr
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -1104,13 +1318,14 @@ func (c *HTTPClient) RpcEthGetGasPrice(ctx context.Context, opts *RpcEthGetGasPr
 		params:          params,
 		noFoundReturn:   RespEthGetGasPrice{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return "", err
 	}
 
 	var result RespEthGetGasPrice
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return "", err
 	}
 	return result.Result, nil
@@ -1137,6 +1352,10 @@ type RpcEthEstimateGasOpts struct {
 	// OnLimitExceeded specifies behavior when rate limit is exceeded
 	// Default: RateLimitBlock (wait until a token is available)
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // RpcEthEstimateGas makes a call or transaction, which won't be added to the blockchain and returns the used gas
@@ -1194,8 +1413,10 @@ func (c *HTTPClient) RpcEthEstimateGas(ctx context.Context, to, data string, opt
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	result, err := c.request(requestParams{
@@ -1205,13 +1426,14 @@ func (c *HTTPClient) RpcEthEstimateGas(ctx context.Context, to, data string, opt
 		params:          params,
 		noFoundReturn:   RespEthEstimateGas{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return "", err
 	}
 
 	var resp RespEthEstimateGas
-	if err := unmarshalResponse(result, &resp); err != nil {
+	if err := c.unmarshalResponse(ctx, result, &resp); err != nil {
 		return "", err
 	}
 	return resp.Result, nil
@@ -2,6 +2,8 @@ package etherscan
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 )
@@ -398,3 +400,46 @@ func TestRpcEthGetGasPriceWithNilOpts(t *testing.T) {
 		t.Logf("Gas price (with default opts): %s", gasPrice)
 	}
 }
+
+func TestRpcEthGetProofOffline(t *testing.T) {
+	var gotKeys, gotTag string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		gotKeys, gotTag = query.Get("storageKeys"), query.Get("tag")
+		if query.Get("address") == testPeer {
+			w.Write([]byte(`{"jsonrpc":"2.0","id":1,"error":{"code":-32601,"message":"the method eth_getProof does not exist"}}`))
+			return
+		}
+		w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":{"address":"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa","accountProof":["0xf901","0xf851"],
+			"balance":"0x1","codeHash":"0xc5d2","nonce":"0x2","storageHash":"0x56e8",
+			"storageProof":[{"key":"0x0","value":"0x2a","proof":["0xe2a0"]}]}}`))
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+	})
+	ctx := context.Background()
+
+	proof, err := client.RpcEthGetProof(ctx, testAddr, []string{"0x0"}, "", nil)
+	if err != nil {
+		t.Fatalf("RpcEthGetProof failed: %v", err)
+	}
+	if gotKeys != `["0x0"]` || gotTag != "latest" {
+		t.Errorf("unexpected params storageKeys=%q tag=%q", gotKeys, gotTag)
+	}
+	if proof.Nonce != "0x2" || len(proof.AccountProof) != 2 || len(proof.StorageProof) != 1 || proof.StorageProof[0].Value != "0x2a" {
+		t.Errorf("unexpected proof: %+v", proof)
+	}
+
+	if _, err := client.RpcEthGetProof(ctx, testAddr, nil, "bogus", nil); err == nil {
+		t.Error("expected error for invalid tag")
+	}
+	if _, err := client.RpcEthGetProof(ctx, testPeer, nil, BlockTagLatest, nil); err == nil {
+		t.Error("expected error for JSON-RPC error response")
+	}
+	if gotKeys != "[]" {
+		t.Errorf("expected empty key list, got %q", gotKeys)
+	}
+}
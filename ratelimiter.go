@@ -104,15 +104,25 @@ func (rl *RateLimiter) Acquire(ctx context.Context, tokens int64, onLimitExceede
 	rl.mu.Lock()
 	defer rl.mu.Unlock()
 
-	rl.refillTokens()
+	for {
+		rl.refillTokens()
+		if rl.tokens >= float64(tokens) {
+			rl.tokens -= float64(tokens)
+			return true, nil
+		}
 
-	if rl.tokens >= float64(tokens) {
-		rl.tokens -= float64(tokens)
-		return true, nil
-	}
+		switch behavior {
+		case RateLimitBlock:
+			if tokens > rl.limit {
+				// The bucket never holds that many tokens
+				return false, nil
+			}
+		case RateLimitRaise:
+			return false, ErrRateLimitExceeded
+		default: // RateLimitSkip
+			return false, nil
+		}
 
-	switch behavior {
-	case RateLimitBlock:
 		// Calculate wait time for next token
 		tokensNeeded := float64(tokens) - rl.tokens
 		waitTime := time.Duration(tokensNeeded * rl.period.Seconds() / float64(rl.limit) * float64(time.Second))
@@ -131,22 +141,8 @@ func (rl *RateLimiter) Acquire(ctx context.Context, tokens int64, onLimitExceede
 			return false, ctx.Err()
 		}
 
-		// Re-acquire lock
+		// Re-acquire lock and try again; a concurrent caller may have taken the refilled tokens first
 		rl.mu.Lock()
-
-		// Refill and try again
-		rl.refillTokens()
-		if rl.tokens >= float64(tokens) {
-			rl.tokens -= float64(tokens)
-			return true, nil
-		}
-		return false, nil
-
-	case RateLimitRaise:
-		return false, ErrRateLimitExceeded
-
-	default: // RateLimitSkip
-		return false, nil
 	}
 }
 
@@ -291,6 +287,17 @@ type MultiRateLimiter struct {
 	limiters        []*RateLimiter
 	onLimitExceeded RateLimitBehavior
 	mu              sync.RWMutex
+
+	// pausedUntil holds back every caller until a server-requested cooldown ends
+	pausedUntil time.Time
+
+	// Counters reported by Stats, guarded by statsMu since mu is released while waiting
+	acquired   int64
+	waiting    int64
+	waits      int64
+	totalWait  time.Duration
+	rejections map[RateLimitBehavior]int64
+	statsMu    sync.Mutex
 }
 
 // NewMultiRateLimiter creates a new multi-tiered rate limiter.
@@ -319,6 +326,7 @@ func NewMultiRateLimiter(limits []RateLimit, onLimitExceeded RateLimitBehavior)
 		limits:          limits,
 		limiters:        make([]*RateLimiter, 0, len(limits)),
 		onLimitExceeded: onLimitExceeded,
+		rejections:      make(map[RateLimitBehavior]int64),
 	}
 
 	// Create a RateLimiter for each limit
@@ -341,79 +349,151 @@ func (mrl *MultiRateLimiter) Acquire(ctx context.Context, tokens int64, onLimitE
 		behavior = *onLimitExceeded
 	}
 
-	mrl.mu.Lock()
-	defer mrl.mu.Unlock()
+	acquired, err := mrl.acquire(ctx, tokens, behavior)
 
-	// Check if all limiters can satisfy the request
-	canProceed := true
-	for _, limiter := range mrl.limiters {
-		if limiter.GetAvailableTokens() < float64(tokens) {
-			canProceed = false
-			break
-		}
+	mrl.statsMu.Lock()
+	if acquired {
+		mrl.acquired++
+	} else {
+		mrl.rejections[behavior]++
 	}
+	mrl.statsMu.Unlock()
 
-	if canProceed {
-		// Acquire from all limiters
-		for _, limiter := range mrl.limiters {
-			limiter.TryAcquire(tokens)
+	return acquired, err
+}
+
+// acquire implements Acquire for a resolved behavior
+func (mrl *MultiRateLimiter) acquire(ctx context.Context, tokens int64, behavior RateLimitBehavior) (bool, error) {
+	for {
+		if ok, err := mrl.waitPause(ctx, behavior); !ok {
+			return false, err
 		}
-		return true, nil
-	}
 
-	switch behavior {
-	case RateLimitBlock:
-		// Calculate maximum wait time needed across all limiters
-		var maxWaitTime time.Duration
-		for _, limiter := range mrl.limiters {
-			if limiter.GetAvailableTokens() < float64(tokens) {
-				tokensNeeded := float64(tokens) - limiter.GetAvailableTokens()
-				waitTime := time.Duration(tokensNeeded * limiter.period.Seconds() / float64(limiter.limit) * float64(time.Second))
-				if waitTime > maxWaitTime {
-					maxWaitTime = waitTime
-				}
+		mrl.mu.Lock()
+		waitTime, ok := mrl.reserve(tokens)
+		mrl.mu.Unlock()
+		if ok {
+			return true, nil
+		}
+
+		switch behavior {
+		case RateLimitBlock:
+			if waitTime < 0 {
+				return false, nil
 			}
+		case RateLimitRaise:
+			return false, ErrRateLimitExceeded
+		default: // RateLimitSkip
+			return false, nil
 		}
 
-		// Release lock while waiting
-		mrl.mu.Unlock()
+		mrl.statsMu.Lock()
+		mrl.waiting++
+		mrl.statsMu.Unlock()
+		waitStart := time.Now()
 
 		// Wait with context support
-		timer := time.NewTimer(maxWaitTime)
+		timer := time.NewTimer(waitTime)
 		select {
 		case <-timer.C:
-			// Continue after wait
 		case <-ctx.Done():
 			timer.Stop()
-			mrl.mu.Lock()
-			return false, ctx.Err()
 		}
+		mrl.statsMu.Lock()
+		mrl.waiting--
+		mrl.waits++
+		mrl.totalWait += time.Since(waitStart)
+		mrl.statsMu.Unlock()
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+		// Try again: a concurrent caller may have taken the refilled tokens, or a pause may have started
+	}
+}
 
-		// Re-acquire lock
-		mrl.mu.Lock()
-
-		// Try again after waiting
-		allReady := true
-		for _, limiter := range mrl.limiters {
-			if limiter.GetAvailableTokens() < float64(tokens) {
-				allReady = false
-				break
-			}
+// reserve takes tokens from every limiter if all hold them, otherwise returns the longest wait until they do
+//
+// The wait is negative if some limit can never hold tokens. The caller must hold mu.
+func (mrl *MultiRateLimiter) reserve(tokens int64) (time.Duration, bool) {
+	var maxWaitTime time.Duration
+	ready := true
+	for _, limiter := range mrl.limiters {
+		available := limiter.GetAvailableTokens()
+		if available >= float64(tokens) {
+			continue
 		}
+		if tokens > limiter.limit {
+			return -1, false
+		}
+		ready = false
+		tokensNeeded := float64(tokens) - available
+		waitTime := time.Duration(tokensNeeded * limiter.period.Seconds() / float64(limiter.limit) * float64(time.Second))
+		if waitTime > maxWaitTime {
+			maxWaitTime = waitTime
+		}
+	}
+	if !ready {
+		return maxWaitTime, false
+	}
 
-		if allReady {
-			for _, limiter := range mrl.limiters {
-				limiter.TryAcquire(tokens)
-			}
+	// Acquire from all limiters
+	for _, limiter := range mrl.limiters {
+		limiter.TryAcquire(tokens)
+	}
+	return 0, true
+}
+
+// waitPause holds the caller back while a cooldown set by PauseUntil is in effect
+func (mrl *MultiRateLimiter) waitPause(ctx context.Context, behavior RateLimitBehavior) (bool, error) {
+	for {
+		mrl.mu.RLock()
+		wait := time.Until(mrl.pausedUntil)
+		mrl.mu.RUnlock()
+		if wait <= 0 {
 			return true, nil
 		}
-		return false, nil
 
-	case RateLimitRaise:
-		return false, ErrRateLimitExceeded
+		switch behavior {
+		case RateLimitBlock:
+		case RateLimitRaise:
+			return false, ErrRateLimitExceeded
+		default: // RateLimitSkip
+			return false, nil
+		}
+
+		mrl.statsMu.Lock()
+		mrl.waiting++
+		mrl.statsMu.Unlock()
+		waitStart := time.Now()
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+		}
+		mrl.statsMu.Lock()
+		mrl.waiting--
+		mrl.waits++
+		mrl.totalWait += time.Since(waitStart)
+		mrl.statsMu.Unlock()
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+		// The cooldown may have been extended meanwhile
+	}
+}
+
+// PauseUntil stops all acquisitions until t, e.g. for a Retry-After sent by the server.
+//
+// The pause applies to every goroutine sharing the limiter: blocking callers
+// wait for it to end, the others are refused as if no token were available.
+// An earlier t than a pause already in effect is ignored.
+func (mrl *MultiRateLimiter) PauseUntil(t time.Time) {
+	mrl.mu.Lock()
+	defer mrl.mu.Unlock()
 
-	default: // RateLimitSkip
-		return false, nil
+	if t.After(mrl.pausedUntil) {
+		mrl.pausedUntil = t
 	}
 }
 
@@ -432,6 +512,7 @@ func (mrl *MultiRateLimiter) Reset() {
 	for _, limiter := range mrl.limiters {
 		limiter.Reset()
 	}
+	mrl.pausedUntil = time.Time{}
 }
 
 // GetStatus returns the status of all rate limiters.
@@ -458,12 +539,73 @@ func (mrl *MultiRateLimiter) GetStatus() []LimiterStatus {
 	return status
 }
 
+// RateLimitStats is a snapshot of a MultiRateLimiter's state and cumulative counters
+type RateLimitStats struct {
+	// Limits is the status of each configured limit
+	Limits []LimiterStatus
+
+	// TokensAvailable is the number of tokens available across all limits (the minimum)
+	TokensAvailable float64
+
+	// Acquired is the number of successful acquisitions
+	Acquired int64
+
+	// Waiting is the number of callers currently blocked waiting for a token
+	Waiting int64
+
+	// Waits is the number of acquisitions that had to wait
+	Waits int64
+
+	// TotalWait is the cumulative time callers spent waiting
+	TotalWait time.Duration
+
+	// Rejections counts failed acquisitions by the behavior in effect:
+	// RateLimitRaise and RateLimitSkip calls that found no token, and
+	// RateLimitBlock calls that were cancelled or asked for more tokens than a limit holds
+	Rejections map[RateLimitBehavior]int64
+
+	// PausedUntil is the end of the cooldown set by PauseUntil, zero if none was ever set
+	PausedUntil time.Time
+}
+
+// Stats returns the current state and cumulative counters of the limiter.
+//
+// Counters are not cleared by Reset.
+func (mrl *MultiRateLimiter) Stats() RateLimitStats {
+	limits := mrl.GetStatus()
+	stats := RateLimitStats{Limits: limits}
+	mrl.mu.RLock()
+	stats.PausedUntil = mrl.pausedUntil
+	mrl.mu.RUnlock()
+	for i, limit := range limits {
+		if i == 0 || limit.AvailableTokens < stats.TokensAvailable {
+			stats.TokensAvailable = limit.AvailableTokens
+		}
+	}
+
+	mrl.statsMu.Lock()
+	defer mrl.statsMu.Unlock()
+
+	stats.Acquired = mrl.acquired
+	stats.Waiting = mrl.waiting
+	stats.Waits = mrl.waits
+	stats.TotalWait = mrl.totalWait
+	stats.Rejections = make(map[RateLimitBehavior]int64, len(mrl.rejections))
+	for behavior, n := range mrl.rejections {
+		stats.Rejections[behavior] = n
+	}
+	return stats
+}
+
 // TimeUntilReady calculates time until all limiters are ready.
 func (mrl *MultiRateLimiter) TimeUntilReady() time.Duration {
 	mrl.mu.RLock()
 	defer mrl.mu.RUnlock()
 
-	var maxWait time.Duration
+	maxWait := time.Until(mrl.pausedUntil)
+	if maxWait < 0 {
+		maxWait = 0
+	}
 	for _, limiter := range mrl.limiters {
 		wait := limiter.TimeUntilNextToken()
 		if wait > maxWait {
@@ -236,6 +236,41 @@ func TestMultiRateLimiter(t *testing.T) {
 			t.Logf("Warning: Expected ~5 tokens, got %.2f", status[0].AvailableTokens)
 		}
 	})
+
+	t.Run("Stats", func(t *testing.T) {
+		limiter, err := NewMultiRateLimiter(
+			[]RateLimit{{Limit: 2, Period: 100 * time.Millisecond}},
+			RateLimitBlock,
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		raise := RateLimitRaise
+		limiter.TryAcquire(1)
+		limiter.TryAcquire(1)
+		limiter.TryAcquire(1)
+		if _, err := limiter.Acquire(context.Background(), 1, &raise); err != ErrRateLimitExceeded {
+			t.Fatalf("Expected ErrRateLimitExceeded, got %v", err)
+		}
+		if ok, err := limiter.Acquire(context.Background(), 1, nil); !ok || err != nil {
+			t.Fatalf("Blocking acquire failed: %v, %v", ok, err)
+		}
+
+		stats := limiter.Stats()
+		if stats.Acquired != 3 || stats.Waits != 1 || stats.Waiting != 0 {
+			t.Errorf("Unexpected counters: %+v", stats)
+		}
+		if stats.TotalWait <= 0 {
+			t.Errorf("Expected positive total wait, got %s", stats.TotalWait)
+		}
+		if stats.Rejections[RateLimitSkip] != 1 || stats.Rejections[RateLimitRaise] != 1 {
+			t.Errorf("Unexpected rejections: %v", stats.Rejections)
+		}
+		if len(stats.Limits) != 1 || stats.TokensAvailable >= 1 {
+			t.Errorf("Unexpected token status: %+v", stats)
+		}
+	})
 }
 
 func TestConcurrentAccess(t *testing.T) {
@@ -269,6 +304,58 @@ func TestConcurrentAccess(t *testing.T) {
 	}
 }
 
+func TestBlockingUnderContention(t *testing.T) {
+	// Blocking callers outnumbering the tokens all get one eventually, even when a
+	// concurrent caller takes the token they waited for
+	single, err := NewRateLimiter(50, 100*time.Millisecond, RateLimitBlock)
+	if err != nil {
+		t.Fatal(err)
+	}
+	multi, err := NewMultiRateLimiter([]RateLimit{
+		{Limit: 50, Period: 100 * time.Millisecond},
+		{Limit: 1000, Period: time.Minute},
+	}, RateLimitBlock)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	for name, acquire := range map[string]func() (bool, error){
+		"RateLimiter":      func() (bool, error) { return single.Acquire(ctx, 1, nil) },
+		"MultiRateLimiter": func() (bool, error) { return multi.Acquire(ctx, 1, nil) },
+	} {
+		var wg sync.WaitGroup
+		var mu sync.Mutex
+		failures := 0
+		for range 200 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if ok, err := acquire(); !ok || err != nil {
+					mu.Lock()
+					failures++
+					mu.Unlock()
+				}
+			}()
+		}
+		wg.Wait()
+		if failures != 0 {
+			t.Errorf("%s: %d of 200 blocking acquisitions failed", name, failures)
+		}
+	}
+
+	stats := multi.Stats()
+	if stats.Acquired != 200 || stats.Waiting != 0 || stats.Waits == 0 {
+		t.Errorf("unexpected stats: %+v", stats)
+	}
+
+	// A request larger than a limit can never succeed
+	if ok, err := multi.Acquire(ctx, 100, nil); ok || err != nil {
+		t.Errorf("expected an oversized acquisition to be refused, got %v %v", ok, err)
+	}
+}
+
 func TestContextCancellation(t *testing.T) {
 	limiter, err := NewRateLimiter(1, time.Second, RateLimitBlock)
 	if err != nil {
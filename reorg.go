@@ -0,0 +1,141 @@
+package etherscan
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+)
+
+// ============================================================================
+// Reorg Detection
+// ============================================================================
+
+// ErrNoCommonAncestor is returned when none of the local blocks is on the canonical chain
+var ErrNoCommonAncestor = errors.New("no common ancestor found")
+
+// BlockRef identifies a block by number and hash, as stored by a consumer
+type BlockRef struct {
+	Number int64
+	Hash   string
+}
+
+// ReorgOpts contains optional parameters for VerifyCanonical and FindCommonAncestor
+type ReorgOpts struct {
+	// ChainID specifies which blockchain network to query
+	// Default: empty (uses client default)
+	ChainID int64
+
+	// OnLimitExceeded specifies behavior when rate limit is exceeded
+	// Default: RateLimitBlock (wait until a token is available)
+	OnLimitExceeded RateLimitBehavior `default:""`
+}
+
+// VerifyCanonical reports whether expectedHash is the canonical block at blockNumber
+//
+// A block number past the current head (for example after a reorg shortened
+// the chain) is reported as not canonical. Hashes are compared case-insensitively.
+//
+// Args:
+//   - ctx: Context for request cancellation and timeout
+//   - blockNumber: Block number to check
+//   - expectedHash: Hash stored for the block
+//   - opts: Optional parameters (can be nil)
+//
+// Returns:
+//   - bool: True if the chain still has expectedHash at blockNumber
+//   - error: Error if the request fails
+//
+// Example:
+//
+//	ok, err := client.VerifyCanonical(ctx, last.Number, last.Hash, nil)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	if !ok {
+//	    // roll back with FindCommonAncestor
+//	}
+func (c *HTTPClient) VerifyCanonical(ctx context.Context, blockNumber int64, expectedHash string, opts *ReorgOpts) (bool, error) {
+	if opts == nil {
+		opts = &ReorgOpts{}
+	}
+	if err := ApplyDefaults(opts); err != nil {
+		return false, err
+	}
+
+	block, err := c.RpcEthBlockByNumber(ctx, BlockNumberTag(blockNumber), &RpcEthBlockByNumberOpts{
+		ChainID:         opts.ChainID,
+		OnLimitExceeded: opts.OnLimitExceeded,
+	})
+	if err != nil {
+		return false, err
+	}
+	if block == nil || block.Hash == "" {
+		return false, nil
+	}
+	return strings.EqualFold(block.Hash, expectedHash), nil
+}
+
+// FindCommonAncestor returns the highest block of localChain that is still canonical
+//
+// localChain may be sparse and in any order. Since a canonical block implies
+// canonical ancestors, the blocks are binary searched: the head is checked
+// first, so an unaffected chain costs a single call, and a reorg costs
+// O(log n) calls. Everything above the returned block should be rolled back.
+//
+// Args:
+//   - ctx: Context for request cancellation and timeout
+//   - localChain: Blocks stored by the consumer
+//   - opts: Optional parameters (can be nil)
+//
+// Returns:
+//   - BlockRef: The highest local block on the canonical chain
+//   - error: ErrNoCommonAncestor if no local block is canonical, or a request error
+//
+// Example:
+//
+//	ancestor, err := client.FindCommonAncestor(ctx, stored, nil)
+//	if errors.Is(err, ErrNoCommonAncestor) {
+//	    // resync from scratch
+//	}
+//	db.DeleteAbove(ancestor.Number)
+//
+// Note:
+//   - Assumes localChain is internally consistent, i.e. it was not itself recorded across a reorg
+func (c *HTTPClient) FindCommonAncestor(ctx context.Context, localChain []BlockRef, opts *ReorgOpts) (BlockRef, error) {
+	refs := make([]BlockRef, len(localChain))
+	copy(refs, localChain)
+	sort.Slice(refs, func(i, j int) bool { return refs[i].Number < refs[j].Number })
+
+	if len(refs) == 0 {
+		return BlockRef{}, ErrNoCommonAncestor
+	}
+
+	head := refs[len(refs)-1]
+	ok, err := c.VerifyCanonical(ctx, head.Number, head.Hash, opts)
+	if err != nil {
+		return BlockRef{}, err
+	}
+	if ok {
+		return head, nil
+	}
+
+	// refs[:lo] are known canonical and refs[hi:] known reorged
+	lo, hi := 0, len(refs)-1
+	for lo < hi {
+		mid := (lo + hi) / 2
+		ok, err := c.VerifyCanonical(ctx, refs[mid].Number, refs[mid].Hash, opts)
+		if err != nil {
+			return BlockRef{}, err
+		}
+		if ok {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	if lo == 0 {
+		return BlockRef{}, ErrNoCommonAncestor
+	}
+	return refs[lo-1], nil
+}
@@ -0,0 +1,118 @@
+package etherscan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+)
+
+// newReorgTestClient serves canonical hashes "0xc<n>" for blocks up to head
+func newReorgTestClient(t *testing.T, head int64) (*HTTPClient, *int32) {
+	t.Helper()
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		number, err := strconv.ParseInt(r.URL.Query().Get("tag")[2:], 16, 64)
+		if err != nil || number > head {
+			w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":null}`))
+			return
+		}
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":{"number":"0x%x","hash":"0xC%d"}}`, number, number)
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+	})
+	return client, &calls
+}
+
+// localChain returns blocks from..to, with hashes diverging from the canonical ones after forkAfter
+func localChain(from, to, forkAfter int64) []BlockRef {
+	var refs []BlockRef
+	for n := to; n >= from; n-- {
+		hash := fmt.Sprintf("0xc%d", n)
+		if n > forkAfter {
+			hash = fmt.Sprintf("0xdead%d", n)
+		}
+		refs = append(refs, BlockRef{Number: n, Hash: hash})
+	}
+	return refs
+}
+
+func TestVerifyCanonical(t *testing.T) {
+	client, _ := newReorgTestClient(t, 100)
+	ctx := context.Background()
+
+	for _, tt := range []struct {
+		number int64
+		hash   string
+		want   bool
+	}{
+		{100, "0xc100", true},
+		{100, "0xdead", false},
+		{101, "0xc101", false},
+	} {
+		ok, err := client.VerifyCanonical(ctx, tt.number, tt.hash, nil)
+		if err != nil {
+			t.Fatalf("VerifyCanonical(%d) failed: %v", tt.number, err)
+		}
+		if ok != tt.want {
+			t.Errorf("VerifyCanonical(%d, %s) = %v, want %v", tt.number, tt.hash, ok, tt.want)
+		}
+	}
+}
+
+func TestFindCommonAncestor(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("no reorg", func(t *testing.T) {
+		client, calls := newReorgTestClient(t, 200)
+		ancestor, err := client.FindCommonAncestor(ctx, localChain(100, 131, 200), nil)
+		if err != nil {
+			t.Fatalf("FindCommonAncestor failed: %v", err)
+		}
+		if ancestor.Number != 131 || *calls != 1 {
+			t.Errorf("got ancestor %d after %d calls, want 131 after 1 call", ancestor.Number, *calls)
+		}
+	})
+
+	t.Run("reorg", func(t *testing.T) {
+		client, calls := newReorgTestClient(t, 200)
+		ancestor, err := client.FindCommonAncestor(ctx, localChain(100, 131, 117), nil)
+		if err != nil {
+			t.Fatalf("FindCommonAncestor failed: %v", err)
+		}
+		if ancestor.Number != 117 {
+			t.Errorf("got ancestor %d, want 117", ancestor.Number)
+		}
+		if *calls > 7 {
+			t.Errorf("expected a binary search, got %d calls", *calls)
+		}
+	})
+
+	t.Run("shortened chain", func(t *testing.T) {
+		client, _ := newReorgTestClient(t, 120)
+		ancestor, err := client.FindCommonAncestor(ctx, localChain(100, 131, 131), nil)
+		if err != nil {
+			t.Fatalf("FindCommonAncestor failed: %v", err)
+		}
+		if ancestor.Number != 120 {
+			t.Errorf("got ancestor %d, want 120", ancestor.Number)
+		}
+	})
+
+	t.Run("no common ancestor", func(t *testing.T) {
+		client, _ := newReorgTestClient(t, 200)
+		_, err := client.FindCommonAncestor(ctx, localChain(100, 131, 0), nil)
+		if !errors.Is(err, ErrNoCommonAncestor) {
+			t.Errorf("expected ErrNoCommonAncestor, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,111 @@
+package etherscan
+
+import (
+	"context"
+	"sync"
+)
+
+// ============================================================================
+// Request Tags
+// ============================================================================
+
+// requestTagKey is the context key of the request tag
+type requestTagKey struct{}
+
+// WithRequestTag returns a context whose requests are attributed to tag
+//
+// The tag names the internal job or customer a call is made for. Every
+// request made with the returned context, or a context derived from it, is
+// counted under the tag in HTTPClient.UsageByTag, carries it as the
+// etherscan.request_tag span attribute and in its AuditRecord, and has it in
+// the client's log lines. A nested WithRequestTag replaces the outer tag.
+//
+// Example:
+//
+//	ctx := etherscan.WithRequestTag(ctx, "customer-42/portfolio-sync")
+//	if _, err := client.GetNormalTxs(ctx, addr, nil); err != nil {
+//	    log.Fatal(err)
+//	}
+//	for tag, usage := range client.UsageByTag() {
+//	    fmt.Printf("%s: %d calls, %d credits\n", tag, usage.Calls, usage.Credits)
+//	}
+func WithRequestTag(ctx context.Context, tag string) context.Context {
+	return context.WithValue(ctx, requestTagKey{}, tag)
+}
+
+// RequestTag returns the tag set on ctx by WithRequestTag, "" if none
+func RequestTag(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	tag, _ := ctx.Value(requestTagKey{}).(string)
+	return tag
+}
+
+// TagUsage is the API consumption attributed to one request tag
+type TagUsage struct {
+	// Calls counts the requests sent to Etherscan, retries included
+	Calls int64 `json:"calls" bson:"calls"`
+
+	// Credits weighs the calls by ActionCosts, like EstimateCredits
+	Credits int64 `json:"credits" bson:"credits"`
+
+	// PerAction is the credits per "module.action"
+	PerAction map[string]int64 `json:"perAction" bson:"perAction"`
+}
+
+// tagUsage accumulates the TagUsage of every tag seen by a client
+type tagUsage struct {
+	mu    sync.Mutex
+	usage map[string]*TagUsage
+}
+
+// record counts one call of module.action under the tag of ctx
+func (u *tagUsage) record(ctx context.Context, module, action string) {
+	tag := RequestTag(ctx)
+	key := module + "." + action
+	credits := actionCredits(key)
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if u.usage == nil {
+		u.usage = make(map[string]*TagUsage)
+	}
+	usage, ok := u.usage[tag]
+	if !ok {
+		usage = &TagUsage{PerAction: make(map[string]int64)}
+		u.usage[tag] = usage
+	}
+	usage.Calls++
+	usage.Credits += credits
+	usage.PerAction[key] += credits
+}
+
+// UsageByTag returns the calls and credits sent so far, keyed by request tag
+//
+// Requests without a tag are counted under "". Proxy calls routed to an own
+// JSON-RPC endpoint (HTTPClientConfig.RPCURLs) do not consume Etherscan
+// credits and are not counted; rejected calls, such as those refused by the
+// local rate limiter or a request budget, are not counted either.
+func (c *HTTPClient) UsageByTag() map[string]TagUsage {
+	c.tagUsage.mu.Lock()
+	defer c.tagUsage.mu.Unlock()
+
+	usage := make(map[string]TagUsage, len(c.tagUsage.usage))
+	for tag, u := range c.tagUsage.usage {
+		perAction := make(map[string]int64, len(u.PerAction))
+		for key, credits := range u.PerAction {
+			perAction[key] = credits
+		}
+		usage[tag] = TagUsage{Calls: u.Calls, Credits: u.Credits, PerAction: perAction}
+	}
+	return usage
+}
+
+// logPrefix returns the prefix of the client's log lines for a request made with ctx
+func logPrefix(ctx context.Context) string {
+	if tag := RequestTag(ctx); tag != "" {
+		return "etherscan: [" + tag + "] "
+	}
+	return "etherscan: "
+}
@@ -0,0 +1,58 @@
+package etherscan
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRequestTagUsage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":"1","message":"OK","result":"1000"}`))
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+		MaxRetries: -1,
+	})
+	ctx := context.Background()
+	if RequestTag(ctx) != "" {
+		t.Error("expected no tag on a plain context")
+	}
+
+	jobA := WithRequestTag(ctx, "job-a")
+	auditCtx, trail := WithAuditTrail(jobA, nil)
+	for range 2 {
+		if _, err := client.GetEthBalance(auditCtx, TestAddresses.VitalikButerin, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := client.GetEthBalance(WithRequestTag(jobA, "job-b"), TestAddresses.VitalikButerin, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.GetEthBalance(ctx, TestAddresses.VitalikButerin, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	usage := client.UsageByTag()
+	if len(usage) != 3 {
+		t.Fatalf("expected usage for job-a, job-b and untagged calls, got %v", usage)
+	}
+	if a := usage["job-a"]; a.Calls != 2 || a.Credits != 2 || a.PerAction["account.balance"] != 2 {
+		t.Errorf("unexpected job-a usage: %+v", a)
+	}
+	if b := usage["job-b"]; b.Calls != 1 {
+		t.Errorf("expected the inner tag to win, got %+v", b)
+	}
+	if untagged := usage[""]; untagged.Calls != 1 {
+		t.Errorf("expected one untagged call, got %+v", untagged)
+	}
+	for _, r := range trail.Records() {
+		if r.Tag != "job-a" {
+			t.Errorf("expected audit records to carry the tag, got %q", r.Tag)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package etherscan
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// ============================================================================
+// Request URLs
+// ============================================================================
+
+// requestQuery returns the query string of a GET request
+func requestQuery(module, action, apiKey string, params map[string]string) url.Values {
+	query := url.Values{}
+	query.Set("module", module)
+	query.Set("action", action)
+	query.Set("apikey", apiKey)
+	for k, v := range params {
+		query.Set(k, v)
+	}
+	return query
+}
+
+// BuildRequestURL returns the GET URL the client would request for module/action
+//
+// The URL is built like a real request: empty params are dropped, the
+// default chain ID and the per-chain API key are applied, and V1 clients use
+// the legacy domain of the chain. It can be pasted into a browser or cURL to
+// reproduce a call while debugging. Unless includeKey is set the API key is
+// replaced by "REDACTED", so the URL is safe to share or log.
+//
+// Args:
+//   - module: The API module (e.g. "account")
+//   - action: The API action (e.g. "txlist")
+//   - params: The remaining query parameters (can be nil)
+//   - includeKey: Whether to keep the API key in the URL
+//
+// Returns:
+//   - string: The request URL
+//   - error: Error if module or action is empty or the chain has no endpoint
+//
+// Example:
+//
+//	uri, err := client.BuildRequestURL("account", "txlist", map[string]string{
+//	    "address": "0x742d35Cc6634C0532925a3b844Bc9e7595f0bEb",
+//	    "sort":    "desc",
+//	}, false)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println("curl '" + uri + "'")
+//
+// Note:
+//   - No request is made, and neither the rate limiter nor the request budget is charged
+//   - Proxy calls routed to a JSON-RPC endpoint (RPCURLs) still get their Etherscan URL
+//   - Chains with Upstreams get the URL of the APIVersion endpoint, not of the upstream serving them
+func (c *HTTPClient) BuildRequestURL(module, action string, params map[string]string, includeKey bool) (string, error) {
+	if module == "" || action == "" {
+		return "", errors.New("etherscan: module and action are required")
+	}
+
+	query := make(map[string]string, len(params)+1)
+	for k, v := range params {
+		if v != "" {
+			query[k] = v
+		}
+	}
+	if chainID := query["chainid"]; chainID == "" || chainID == "0" {
+		query["chainid"] = strconv.Itoa(c.defaultChainID)
+	}
+	baseURL, err := c.resolveBaseURL(query["chainid"])
+	if err != nil {
+		return "", err
+	}
+	apiKey := c.apiKeyFor(query["chainid"])
+	// Legacy endpoints are selected by domain and do not accept chainid
+	if c.apiVersion == APIVersionV1 {
+		delete(query, "chainid")
+	}
+
+	values := requestQuery(module, action, apiKey, query)
+	if !includeKey && values.Get("apikey") != "" {
+		values.Set("apikey", "REDACTED")
+	}
+	return fmt.Sprintf("%s?%s", baseURL, values.Encode()), nil
+}
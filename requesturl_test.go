@@ -0,0 +1,71 @@
+package etherscan
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestBuildRequestURL(t *testing.T) {
+	client := NewHTTPClient(HTTPClientConfig{
+		APIKey:       "secret",
+		ChainAPIKeys: map[int]string{PolygonMainnet: "polygon-secret"},
+	})
+
+	uri, err := client.BuildRequestURL("account", "txlist", map[string]string{"address": testAddr, "sort": ""}, false)
+	if err != nil {
+		t.Fatalf("BuildRequestURL failed: %v", err)
+	}
+	parsed, _ := url.Parse(uri)
+	query := parsed.Query()
+	if !strings.HasPrefix(uri, BaseURL+"?") || query.Get("chainid") != "1" || query.Get("address") != testAddr || query.Has("sort") {
+		t.Errorf("unexpected URL: %s", uri)
+	}
+	if strings.Contains(uri, "secret") || query.Get("apikey") != "REDACTED" {
+		t.Errorf("expected the API key to be redacted: %s", uri)
+	}
+
+	uri, _ = client.BuildRequestURL("account", "balance", map[string]string{"chainid": "137"}, true)
+	if parsed, _ := url.Parse(uri); parsed.Query().Get("apikey") != "polygon-secret" {
+		t.Errorf("expected the chain's API key: %s", uri)
+	}
+
+	if _, err := client.BuildRequestURL("", "txlist", nil, false); err == nil {
+		t.Error("expected an empty module to fail")
+	}
+}
+
+func TestBuildRequestURLMatchesRequest(t *testing.T) {
+	var requested string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = r.URL.RawQuery
+		w.Write([]byte(`{"status":"1","message":"OK","result":[]}`))
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIKey:     "secret",
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+	})
+	if _, err := client.GetNormalTxs(context.Background(), testAddr, &GetNormalTxsOpts{Sort: SortDesc}); err != nil {
+		t.Fatalf("GetNormalTxs failed: %v", err)
+	}
+	built, err := client.BuildRequestURL("account", "txlist", map[string]string{
+		"address":    testAddr,
+		"startblock": "0",
+		"endblock":   "999999999999",
+		"page":       "1",
+		"offset":     "100",
+		"sort":       "desc",
+	}, true)
+	if err != nil {
+		t.Fatalf("BuildRequestURL failed: %v", err)
+	}
+	if built != server.URL+"?"+requested {
+		t.Errorf("built URL differs from the request:\n%s\n%s", built, requested)
+	}
+}
@@ -0,0 +1,313 @@
+package etherscan
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+)
+
+// ============================================================================
+// Address Risk Features
+// ============================================================================
+
+// defaultMixerLabels and defaultBridgeLabels are matched against counterparty labels when the opts leave them empty
+var (
+	defaultMixerLabels  = []string{"mixer", "tornado"}
+	defaultBridgeLabels = []string{"bridge"}
+)
+
+// maxAddressTagBatch is the number of addresses one GetAddressTag call accepts
+const maxAddressTagBatch = 100
+
+// RiskFeatureNames names the entries of RiskFeatures.Vector, in order
+var RiskFeatureNames = []string{
+	"age_days",
+	"tx_count",
+	"sent_count",
+	"failed_tx_ratio",
+	"counterparties",
+	"labeled_counterparties",
+	"mixer_interactions",
+	"bridge_interactions",
+	"gap_count",
+	"longest_gap_days",
+	"burst_count",
+	"largest_burst",
+}
+
+// RiskFeatures describes the behavior of an address for a downstream risk scoring model
+//
+// Interactions count normal transactions, sent or received, with a
+// counterparty whose labels match. Only the counterparties looked up, the
+// most frequent ones, can be labeled; see ExtractRiskFeaturesOpts.
+type RiskFeatures struct {
+	Address string `json:"address" bson:"address"`
+
+	// AgeDays is the time since the first transaction sent or received, in days
+	AgeDays float64 `json:"ageDays" bson:"ageDays"`
+
+	TxCount   int `json:"txCount" bson:"txCount"`
+	SentCount int `json:"sentCount" bson:"sentCount"`
+
+	// FailedTxRatio is the share of transactions sent that failed, 0 if none was sent
+	FailedTxRatio float64 `json:"failedTxRatio" bson:"failedTxRatio"`
+
+	// Counterparties is the number of distinct addresses the address transacted with
+	Counterparties int `json:"counterparties" bson:"counterparties"`
+
+	// LabeledCounterparties is the number of counterparties with at least one label
+	LabeledCounterparties int `json:"labeledCounterparties" bson:"labeledCounterparties"`
+
+	MixerInteractions  int `json:"mixerInteractions" bson:"mixerInteractions"`
+	BridgeInteractions int `json:"bridgeInteractions" bson:"bridgeInteractions"`
+
+	// CounterpartyLabels counts the interactions per counterparty label
+	CounterpartyLabels map[string]int `json:"counterpartyLabels" bson:"counterpartyLabels"`
+
+	GapCount       int     `json:"gapCount" bson:"gapCount"`
+	LongestGapDays float64 `json:"longestGapDays" bson:"longestGapDays"`
+	BurstCount     int     `json:"burstCount" bson:"burstCount"`
+
+	// LargestBurst is the transaction count of the largest burst
+	LargestBurst int `json:"largestBurst" bson:"largestBurst"`
+}
+
+// Vector returns the numeric features in RiskFeatureNames order
+func (f *RiskFeatures) Vector() []float64 {
+	return []float64{
+		f.AgeDays,
+		float64(f.TxCount),
+		float64(f.SentCount),
+		f.FailedTxRatio,
+		float64(f.Counterparties),
+		float64(f.LabeledCounterparties),
+		float64(f.MixerInteractions),
+		float64(f.BridgeInteractions),
+		float64(f.GapCount),
+		f.LongestGapDays,
+		float64(f.BurstCount),
+		float64(f.LargestBurst),
+	}
+}
+
+// ExtractRiskFeaturesOpts contains optional parameters for ExtractRiskFeatures
+type ExtractRiskFeaturesOpts struct {
+	// Labels labels counterparties locally; with SkipTagLookup it is the only label source
+	// Default: nil
+	Labels *LabelDB
+
+	// SkipTagLookup labels counterparties from Labels only, without the GetAddressTag call
+	// Default: false
+	SkipTagLookup bool
+
+	// MaxTagLookups is how many of the most frequent counterparties are labeled
+	// Default: 100 (one GetAddressTag call)
+	MaxTagLookups int `default:"100"`
+
+	// MixerLabels and BridgeLabels are matched, case-insensitively, as substrings of counterparty labels
+	// Default: "mixer", "tornado" and "bridge"
+	MixerLabels  []string
+	BridgeLabels []string
+
+	// Timeline configures the gaps and bursts, see GetNonceTimelineOpts; its ChainID and OnLimitExceeded are ignored
+	// Default: nil (30-day gaps, bursts of 10 transactions within an hour)
+	Timeline *GetNonceTimelineOpts
+
+	// ChainID specifies which blockchain network to query
+	// Default: empty (uses client default)
+	ChainID int64
+
+	// OnLimitExceeded specifies behavior when rate limit is exceeded
+	// Default: RateLimitBlock (wait until a token is available)
+	OnLimitExceeded RateLimitBehavior `default:""`
+}
+
+// ExtractRiskFeatures computes behavioral features of an address from its transactions and counterparty labels
+//
+// The normal transaction history is read once to derive the age, activity
+// gaps and bursts (see GetNonceTimeline), the failed transaction ratio and
+// the counterparties. The most frequent counterparties are labeled with
+// GetAddressTagWithFallback, falling back to Labels, and interactions with
+// mixers and bridges are counted from those labels. The features describe;
+// they do not score, and are meant as input to a model.
+//
+// Args:
+//   - ctx: Context for request cancellation and timeout
+//   - address: The address to describe
+//   - opts: Optional parameters (can be nil)
+//
+// Returns:
+//   - *RiskFeatures: The features of the address
+//   - error: Error if a request fails or a transaction cannot be parsed
+//
+// Example:
+//
+//	features, err := client.ExtractRiskFeatures(ctx, wallet, &etherscan.ExtractRiskFeaturesOpts{Labels: db})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	score := model.Predict(features.Vector())
+//
+// Note:
+//   - GetAddressTag is a PRO endpoint; set SkipTagLookup to label from Labels only
+//   - Costs one call per 1000 transactions plus one GetAddressTag call
+func (c *HTTPClient) ExtractRiskFeatures(ctx context.Context, address string, opts *ExtractRiskFeaturesOpts) (*RiskFeatures, error) {
+	if opts == nil {
+		opts = &ExtractRiskFeaturesOpts{}
+	}
+	if err := ApplyDefaults(opts); err != nil {
+		return nil, err
+	}
+	mixerLabels, bridgeLabels := opts.MixerLabels, opts.BridgeLabels
+	if len(mixerLabels) == 0 {
+		mixerLabels = defaultMixerLabels
+	}
+	if len(bridgeLabels) == 0 {
+		bridgeLabels = defaultBridgeLabels
+	}
+	timelineOpts := &GetNonceTimelineOpts{}
+	if opts.Timeline != nil {
+		*timelineOpts = *opts.Timeline
+	}
+	if err := applyNonceTimelineDefaults(timelineOpts); err != nil {
+		return nil, err
+	}
+
+	txs, err := c.normalTxHistory(ctx, address, opts.ChainID, opts.OnLimitExceeded)
+	defer txs.Close()
+	if err != nil {
+		return nil, err
+	}
+	timeline, err := nonceTimeline(address, txs, 1, timelineOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	features := &RiskFeatures{
+		Address:            address,
+		TxCount:            timeline.TxCount,
+		SentCount:          timeline.SentCount,
+		GapCount:           len(timeline.Gaps),
+		BurstCount:         len(timeline.Bursts),
+		CounterpartyLabels: make(map[string]int),
+	}
+	if !timeline.CreatedAt.IsZero() {
+		features.AgeDays = timeline.Age.Hours() / 24
+	}
+	for _, gap := range timeline.Gaps {
+		features.LongestGapDays = max(features.LongestGapDays, gap.Duration.Hours()/24)
+	}
+	for _, burst := range timeline.Bursts {
+		features.LargestBurst = max(features.LargestBurst, burst.TxCount)
+	}
+
+	// interactions counts the transactions per counterparty
+	interactions := make(map[string]int)
+	failed := 0
+	sentBy := TxFrom(address)
+	err = txs.Each(func(tx RespNormalTx) error {
+		counterparty := tx.To
+		if sentBy(tx) {
+			if TxFailed(tx) {
+				failed++
+			}
+		} else {
+			counterparty = tx.From
+		}
+		if counterparty = strings.ToLower(counterparty); counterparty != "" && !strings.EqualFold(counterparty, address) {
+			interactions[counterparty]++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if features.SentCount > 0 {
+		features.FailedTxRatio = float64(failed) / float64(features.SentCount)
+	}
+	features.Counterparties = len(interactions)
+
+	tags, err := c.counterpartyTags(ctx, interactions, opts)
+	if err != nil {
+		return nil, err
+	}
+	for counterparty, labels := range tags {
+		if len(labels) == 0 {
+			continue
+		}
+		n := interactions[counterparty]
+		features.LabeledCounterparties++
+		for _, label := range labels {
+			features.CounterpartyLabels[label] += n
+		}
+		if matchesLabel(labels, mixerLabels) {
+			features.MixerInteractions += n
+		}
+		if matchesLabel(labels, bridgeLabels) {
+			features.BridgeInteractions += n
+		}
+	}
+	return features, nil
+}
+
+// counterpartyTags returns the labels of the MaxTagLookups most frequent counterparties, keyed by lowercase address
+func (c *HTTPClient) counterpartyTags(ctx context.Context, interactions map[string]int, opts *ExtractRiskFeaturesOpts) (map[string][]string, error) {
+	counterparties := make([]string, 0, len(interactions))
+	for counterparty := range interactions {
+		counterparties = append(counterparties, counterparty)
+	}
+	slices.SortFunc(counterparties, func(a, b string) int {
+		if n := cmp.Compare(interactions[b], interactions[a]); n != 0 {
+			return n
+		}
+		return strings.Compare(a, b)
+	})
+	if len(counterparties) > opts.MaxTagLookups {
+		counterparties = counterparties[:opts.MaxTagLookups]
+	}
+
+	var tags []RespAddressTag
+	switch {
+	case len(counterparties) == 0:
+	case opts.SkipTagLookup:
+		if opts.Labels != nil {
+			tags = opts.Labels.Match(counterparties)
+		}
+	default:
+		for start := 0; start < len(counterparties); start += maxAddressTagBatch {
+			batch := counterparties[start:]
+			if len(batch) > maxAddressTagBatch {
+				batch = batch[:maxAddressTagBatch]
+			}
+			found, err := c.GetAddressTagWithFallback(ctx, batch, opts.Labels, &GetAddressTagOpts{
+				ChainID:         opts.ChainID,
+				OnLimitExceeded: opts.OnLimitExceeded,
+			})
+			if err != nil {
+				return nil, err
+			}
+			tags = append(tags, found...)
+		}
+	}
+
+	labels := make(map[string][]string, len(tags))
+	for _, tag := range tags {
+		key := strings.ToLower(tag.Address)
+		labels[key] = unionStrings(labels[key], unionStrings(tag.Labels, tag.LabelsSlug))
+	}
+	return labels, nil
+}
+
+// matchesLabel reports whether any label contains any of the keywords, ignoring case
+func matchesLabel(labels, keywords []string) bool {
+	for _, label := range labels {
+		label = strings.ToLower(label)
+		for _, keyword := range keywords {
+			if strings.Contains(label, strings.ToLower(keyword)) {
+				return true
+			}
+		}
+	}
+	return false
+}
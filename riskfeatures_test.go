@@ -0,0 +1,84 @@
+package etherscan
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestExtractRiskFeatures(t *testing.T) {
+	wallet := TestAddresses.VitalikButerin
+	mixer := "0x" + strings.Repeat("aa", 20)
+	bridge := "0x" + strings.Repeat("bb", 20)
+	friend := "0x" + strings.Repeat("cc", 20)
+	const start = 1700000000
+
+	// Funded by a friend, two deposits to a mixer (one reverted), then a bridge transfer
+	txs := []RespNormalTx{
+		{Hash: "0x01", BlockNumber: "10", TimeStamp: strconv.Itoa(start), From: friend, To: wallet},
+		{Hash: "0x02", BlockNumber: "11", TimeStamp: strconv.Itoa(start + 60), From: wallet, To: mixer, Nonce: "0"},
+		{Hash: "0x03", BlockNumber: "12", TimeStamp: strconv.Itoa(start + 120), From: wallet, To: mixer, IsError: "1", Nonce: "1"},
+		{Hash: "0x04", BlockNumber: "500", TimeStamp: strconv.Itoa(start + 60*86400), From: wallet, To: bridge, Nonce: "2"},
+	}
+	var tagRequests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		switch q.Get("action") {
+		case "txlist":
+			data, _ := json.Marshal(txs)
+			fmt.Fprintf(w, `{"status":"1","message":"OK","result":%s}`, data)
+		case "getaddresstag":
+			tagRequests = append(tagRequests, q.Get("address"))
+			fmt.Fprintf(w, `{"status":"1","message":"OK","result":[{"address":"%s","labels":["Tornado.Cash"]}]}`, mixer)
+		default:
+			t.Errorf("unexpected action %q", q.Get("action"))
+		}
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+		MaxRetries: -1,
+	})
+	db := NewLabelDB()
+	db.Add(RespAddressTag{Address: bridge, Labels: []string{"Bridge"}})
+
+	features, err := client.ExtractRiskFeatures(context.Background(), wallet, &ExtractRiskFeaturesOpts{Labels: db})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if features.TxCount != 4 || features.SentCount != 3 || features.FailedTxRatio != 1.0/3 || features.Counterparties != 3 {
+		t.Errorf("unexpected counts: %+v", features)
+	}
+	if features.MixerInteractions != 2 || features.BridgeInteractions != 1 || features.LabeledCounterparties != 2 {
+		t.Errorf("unexpected label features: %+v", features)
+	}
+	if features.CounterpartyLabels["Tornado.Cash"] != 2 || features.CounterpartyLabels["Bridge"] != 1 {
+		t.Errorf("unexpected labels: %v", features.CounterpartyLabels)
+	}
+	if features.GapCount != 1 || features.LongestGapDays < 59 || features.BurstCount != 0 || features.AgeDays < 60 {
+		t.Errorf("unexpected timeline features: %+v", features)
+	}
+	// The most frequent counterparty is looked up first
+	if len(tagRequests) != 1 || !strings.HasPrefix(tagRequests[0], mixer+",") {
+		t.Errorf("expected one lookup led by the mixer, got %v", tagRequests)
+	}
+	if vector := features.Vector(); len(vector) != len(RiskFeatureNames) || vector[3] != features.FailedTxRatio {
+		t.Errorf("vector %v does not match %v", vector, RiskFeatureNames)
+	}
+
+	// Without the lookup only the local labels count
+	features, err = client.ExtractRiskFeatures(context.Background(), wallet, &ExtractRiskFeaturesOpts{Labels: db, SkipTagLookup: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tagRequests) != 1 || features.MixerInteractions != 0 || features.BridgeInteractions != 1 {
+		t.Errorf("expected local labels only, got %+v after %d lookups", features, len(tagRequests))
+	}
+}
@@ -0,0 +1,90 @@
+package etherscan
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestRPCFallback(t *testing.T) {
+	var requests []rpcRequest
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req rpcRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("invalid JSON-RPC request: %v", err)
+		}
+		requests = append(requests, req)
+		switch req.Method {
+		case "eth_blockNumber":
+			w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x10"}`))
+		case "eth_call":
+			w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x2a"}`))
+		default:
+			w.Write([]byte(`{"jsonrpc":"2.0","id":1,"error":{"code":-32601,"message":"method not found"}}`))
+		}
+	}))
+	defer node.Close()
+
+	explorer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("module") == "proxy" {
+			t.Errorf("proxy call %s reached Etherscan", r.URL.Query().Get("action"))
+		}
+		w.Write([]byte(`{"status":"1","message":"OK","result":"7"}`))
+	}))
+	defer explorer.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: explorer.URL},
+		RPCURLs:    map[int]string{EthereumMainnet: node.URL},
+	})
+	ctx := context.Background()
+
+	if block, err := client.RpcEthBlockNumber(ctx, nil); err != nil || block != "0x10" {
+		t.Fatalf("expected block 0x10, got %q, %v", block, err)
+	}
+	result, err := client.RpcEthCall(ctx, testContract, "0x70a08231", &RpcEthCallOpts{Tag: BlockNumberTag(100)})
+	if err != nil || result != "0x2a" {
+		t.Fatalf("expected 0x2a, got %q, %v", result, err)
+	}
+	wantCall := []any{map[string]any{"to": testContract, "data": "0x70a08231"}, "0x64"}
+	if len(requests) != 2 || requests[1].Method != "eth_call" || !reflect.DeepEqual(requests[1].Params, wantCall) {
+		t.Errorf("unexpected JSON-RPC requests: %+v", requests)
+	}
+
+	// JSON-RPC errors reach the caller in the envelope, as they do through Etherscan
+	resp, err := client.rpcProxyCall(ctx, "eth_getCode", map[string]string{"address": testContract}, "")
+	if err != nil || resp.Error == nil || resp.Error.Code != -32601 {
+		t.Errorf("expected the node's error in the envelope, got %+v, %v", resp, err)
+	}
+
+	// Other modules still go to Etherscan
+	if balance, err := client.GetEthBalance(ctx, testAddr, nil); err != nil || balance != "7" {
+		t.Errorf("expected balance from Etherscan, got %q, %v", balance, err)
+	}
+}
+
+func TestRPCPositionalParams(t *testing.T) {
+	cases := []struct {
+		action string
+		params map[string]string
+		want   []any
+	}{
+		{"eth_getBlockByNumber", map[string]string{"tag": "0x1", "boolean": "true"}, []any{"0x1", true}},
+		{"eth_getTransactionCount", map[string]string{"address": "0xa"}, []any{"0xa", "latest"}},
+		{"eth_getStorageAt", map[string]string{"address": "0xa", "position": "0x0", "tag": "pending"}, []any{"0xa", "0x0", "pending"}},
+		{"eth_estimateGas", map[string]string{"to": "0xa", "gasprice": "0x1", "tag": "latest"}, []any{map[string]string{"to": "0xa", "gasPrice": "0x1"}}},
+	}
+	for _, c := range cases {
+		got, err := rpcPositionalParams(c.action, c.params)
+		if err != nil || !reflect.DeepEqual(got, c.want) {
+			t.Errorf("%s: got %v, %v, want %v", c.action, got, err, c.want)
+		}
+	}
+	if _, err := rpcPositionalParams("eth_unknown", nil); err == nil {
+		t.Error("expected error for unmapped action")
+	}
+}
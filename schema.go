@@ -0,0 +1,207 @@
+package etherscan
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// ============================================================================
+// Response Schema Versions
+// ============================================================================
+
+// DefaultSchemaVersion is the API version the responses of endpoints missing from SchemaVersions are decoded as
+const DefaultSchemaVersion = APIVersionV2
+
+// SchemaVersions lists endpoints whose Resp* types target another API version than DefaultSchemaVersion, keyed by "module.action"
+//
+// Like ChainCapabilities, the table can be edited at init time.
+var SchemaVersions = map[string]APIVersion{
+	// RespEthPrice keeps the V1 field names; GetNativeTokenPrice decodes the same response with neutral ones
+	"stats.ethprice": APIVersionV1,
+}
+
+// SchemaMigrationNotes links each targeted API version to the notes on migrating away from it
+var SchemaMigrationNotes = map[APIVersion]string{
+	APIVersionV1: "https://docs.etherscan.io/v2-migration",
+	APIVersionV2: "https://docs.etherscan.io/",
+}
+
+// SchemaVersion returns the API version the response of module/action is decoded as
+func SchemaVersion(module, action string) APIVersion {
+	if version, ok := SchemaVersions[module+"."+action]; ok {
+		return version
+	}
+	return DefaultSchemaVersion
+}
+
+// SchemaWarningKind is the marker of a newer response format a SchemaWarning reports
+type SchemaWarningKind string
+
+const (
+	// SchemaUnknownStatus is a status other than "0" and "1"
+	SchemaUnknownStatus SchemaWarningKind = "unknown-status"
+
+	// SchemaUnknownField is a record field the Resp* type does not declare, new or renamed
+	SchemaUnknownField SchemaWarningKind = "unknown-field"
+
+	// SchemaDeprecated is a message or result announcing the endpoint is deprecated
+	SchemaDeprecated SchemaWarningKind = "deprecated"
+)
+
+// SchemaWarning reports a response that does not match the version its endpoint targets
+//
+// Warnings come before breakage: the response still decoded, but a status,
+// field or notice this package does not know suggests Etherscan moved on.
+type SchemaWarning struct {
+	Kind SchemaWarningKind
+
+	// Endpoint is the "module.action" of the response; empty for SchemaUnknownField,
+	// whose record types are shared by endpoints
+	Endpoint string
+
+	// Type is the Resp* type of the record with an unknown field
+	Type string
+
+	// Field is the unknown field
+	Field string
+
+	// Value is the unknown status or the deprecation notice
+	Value string
+
+	// TargetVersion is the API version the response was decoded as, see SchemaVersion
+	TargetVersion APIVersion
+
+	// MigrationURL links the notes for TargetVersion, see SchemaMigrationNotes
+	MigrationURL string
+}
+
+// String formats the warning as key=value pairs for logs
+func (w SchemaWarning) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "kind=%s", w.Kind)
+	for _, kv := range [][2]string{
+		{"endpoint", w.Endpoint},
+		{"type", w.Type},
+		{"field", w.Field},
+		{"value", w.Value},
+		{"target", string(w.TargetVersion)},
+		{"migration", w.MigrationURL},
+	} {
+		if kv[1] != "" {
+			fmt.Fprintf(&b, " %s=%q", kv[0], kv[1])
+		}
+	}
+	return b.String()
+}
+
+// schemaMonitor reports each distinct schema warning once; a nil monitor checks nothing
+type schemaMonitor struct {
+	onWarning func(SchemaWarning)
+
+	mu   sync.Mutex
+	seen map[SchemaWarning]struct{}
+}
+
+// newSchemaMonitor returns a monitor calling onWarning, or logging without one; nil unless enabled or onWarning is set
+func newSchemaMonitor(enabled bool, onWarning func(SchemaWarning)) *schemaMonitor {
+	if !enabled && onWarning == nil {
+		return nil
+	}
+	if onWarning == nil {
+		onWarning = func(w SchemaWarning) {
+			log.Printf("etherscan: schema warning %s", w)
+		}
+	}
+	return &schemaMonitor{onWarning: onWarning, seen: make(map[SchemaWarning]struct{})}
+}
+
+// warn reports w unless it was reported before
+func (m *schemaMonitor) warn(w SchemaWarning) {
+	if w.TargetVersion == "" {
+		w.TargetVersion = DefaultSchemaVersion
+	}
+	w.MigrationURL = SchemaMigrationNotes[w.TargetVersion]
+
+	m.mu.Lock()
+	_, seen := m.seen[w]
+	m.seen[w] = struct{}{}
+	m.mu.Unlock()
+	if !seen {
+		m.onWarning(w)
+	}
+}
+
+// checkEnvelope reports unknown statuses and deprecation notices in the response of module/action
+func (m *schemaMonitor) checkEnvelope(module, action, status, message string, result any) {
+	if m == nil {
+		return
+	}
+	endpoint := module + "." + action
+	target := SchemaVersion(module, action)
+	if status != "" && status != "0" && status != "1" {
+		m.warn(SchemaWarning{Kind: SchemaUnknownStatus, Endpoint: endpoint, Value: status, TargetVersion: target})
+	}
+	notice, _ := result.(string)
+	for _, text := range []string{message, notice} {
+		if strings.Contains(strings.ToLower(text), "deprecated") {
+			m.warn(SchemaWarning{Kind: SchemaDeprecated, Endpoint: endpoint, Value: text, TargetVersion: target})
+			break
+		}
+	}
+}
+
+// checkFields reports the fields of the JSON result raw that the type of target does not declare
+//
+// Only the first element of each list is checked, which finds a format change
+// without walking every row of a large page.
+func (m *schemaMonitor) checkFields(raw json.RawMessage, target any) {
+	if m == nil {
+		return
+	}
+	m.checkType(raw, reflect.TypeOf(target))
+}
+
+// checkType walks raw alongside type t, see checkFields
+func (m *schemaMonitor) checkType(raw json.RawMessage, t reflect.Type) {
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nil {
+		return
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		info := structInfo(t)
+		if info.unknown == nil {
+			// Only Resp* records, which carry UnknownFields, have a known schema
+			return
+		}
+		var object map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &object); err != nil {
+			return
+		}
+		for key, value := range object {
+			index, ok := info.fields[strings.ToLower(key)]
+			if !ok {
+				m.warn(SchemaWarning{Kind: SchemaUnknownField, Type: t.Name(), Field: key})
+				continue
+			}
+			m.checkType(value, t.FieldByIndex(index).Type)
+		}
+
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return
+		}
+		var items []json.RawMessage
+		if err := json.Unmarshal(raw, &items); err != nil || len(items) == 0 {
+			return
+		}
+		m.checkType(items[0], t.Elem())
+	}
+}
@@ -0,0 +1,71 @@
+package etherscan
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestSchemaWarnings(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("action") {
+		case "txlist":
+			// The first record has a field RespNormalTx does not declare; later records are not checked
+			w.Write([]byte(`{"status":"1","message":"OK","result":[
+				{"hash":"0x01","blockNumber":"1","txType":"0x4"},
+				{"hash":"0x02","blockNumber":"2","other":"x"}]}`))
+		case "balance":
+			w.Write([]byte(`{"status":"2","message":"OK","result":"100"}`))
+		case "ethprice":
+			w.Write([]byte(`{"status":"0","message":"NOTOK","result":"You are using a deprecated V1 endpoint, switch to Etherscan API V2"}`))
+		}
+	}))
+	defer server.Close()
+
+	var warnings []SchemaWarning
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion:      APIVersionV1,
+		V1BaseURLs:      map[int]string{EthereumMainnet: server.URL},
+		MaxRetries:      -1,
+		OnSchemaWarning: func(w SchemaWarning) { warnings = append(warnings, w) },
+	})
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.GetNormalTxs(ctx, testAddr, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(warnings) != 1 {
+		t.Fatalf("expected one warning for the repeated field, got %v", warnings)
+	}
+	if w := warnings[0]; w.Kind != SchemaUnknownField || w.Type != "RespNormalTx" || w.Field != "txType" ||
+		w.TargetVersion != APIVersionV2 || w.MigrationURL == "" {
+		t.Errorf("unexpected field warning: %+v", w)
+	}
+
+	if _, err := client.GetEthBalance(ctx, testAddr, nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(warnings) != 2 || warnings[1].Kind != SchemaUnknownStatus || warnings[1].Endpoint != "account.balance" || warnings[1].Value != "2" {
+		t.Errorf("expected an unknown status warning, got %v", warnings)
+	}
+
+	// The deprecation notice is reported even though the call fails, linking the notes of the endpoint's target version
+	if _, err := client.GetEthPrice(ctx, nil); err == nil {
+		t.Fatal("expected the NOTOK response to fail")
+	}
+	if len(warnings) != 3 || warnings[2].Kind != SchemaDeprecated || warnings[2].MigrationURL != SchemaMigrationNotes[APIVersionV1] {
+		t.Errorf("expected a deprecation warning, got %v", warnings)
+	}
+	if s := warnings[2].String(); !strings.Contains(s, `endpoint="stats.ethprice"`) || !strings.Contains(s, "kind=deprecated") {
+		t.Errorf("unexpected log form %s", s)
+	}
+
+	// Without the option nothing is checked
+	if newSchemaMonitor(false, nil) != nil {
+		t.Error("expected no monitor by default")
+	}
+}
@@ -0,0 +1,115 @@
+package etherscan
+
+import (
+	"context"
+	"strings"
+)
+
+// ============================================================================
+// Similar Match Sources
+// ============================================================================
+
+// SourceProvenance tells where the sources of an EffectiveSourceCode come from
+type SourceProvenance string
+
+const (
+	// SourceExact means the contract itself is verified
+	SourceExact SourceProvenance = "exact"
+
+	// SourceSimilar means the contract is not verified and the sources are
+	// those of the verified contract Etherscan matched its bytecode to
+	SourceSimilar SourceProvenance = "similar"
+)
+
+// EffectiveSourceCode is the source code to read for a contract, with its provenance
+type EffectiveSourceCode struct {
+	// Address is the contract asked for
+	Address string `json:"address" bson:"address"`
+
+	// SourceAddress is the contract the sources were verified at, Address unless the match is similar
+	SourceAddress string `json:"sourceAddress" bson:"sourceAddress"`
+
+	Provenance SourceProvenance `json:"provenance" bson:"provenance"`
+
+	// Source is the GetContractSourceCode result of SourceAddress
+	// Constructor arguments and library addresses of a similar match are
+	// those of the matched contract, not of Address.
+	Source RespContractSourceCode `json:"source" bson:"source"`
+}
+
+// GetEffectiveSourceCodeOpts contains optional parameters for GetEffectiveSourceCode
+type GetEffectiveSourceCodeOpts struct {
+	// ChainID specifies which blockchain network to query
+	// Default: empty (uses client default)
+	ChainID int64
+
+	// OnLimitExceeded specifies behavior when rate limit is exceeded
+	// Default: RateLimitBlock (wait until a token is available)
+	OnLimitExceeded RateLimitBehavior `default:""`
+}
+
+// GetEffectiveSourceCode returns the sources of a contract, falling back to its similar match
+//
+// A contract that is not verified itself, but whose bytecode Etherscan
+// matched to a verified contract, has an empty SourceCode and the matched
+// address in SimilarMatch. The sources of that contract are then fetched
+// instead and flagged SourceSimilar, so analysis tools always get code to
+// read and can tell it apart from an exact verification.
+//
+// Args:
+//   - ctx: Context for request cancellation and timeout
+//   - address: The contract address
+//   - opts: Optional parameters (can be nil)
+//
+// Returns:
+//   - *EffectiveSourceCode: The sources and where they come from
+//   - error: ErrContractNotVerified if neither the contract nor a similar match is verified, or an error if a request fails
+//
+// Example:
+//
+//	code, err := client.GetEffectiveSourceCode(ctx, addr, nil)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	if code.Provenance == etherscan.SourceSimilar {
+//	    fmt.Printf("showing the sources of similar contract %s\n", code.SourceAddress)
+//	}
+//	fmt.Println(code.Source.SourceCode)
+//
+// Note:
+//   - Costs one call, two for a similar match
+func (c *HTTPClient) GetEffectiveSourceCode(ctx context.Context, address string, opts *GetEffectiveSourceCodeOpts) (*EffectiveSourceCode, error) {
+	if opts == nil {
+		opts = &GetEffectiveSourceCodeOpts{}
+	}
+	if err := ApplyDefaults(opts); err != nil {
+		return nil, err
+	}
+	sourceOpts := &GetContractSourceCodeOpts{
+		ChainID:         opts.ChainID,
+		OnLimitExceeded: opts.OnLimitExceeded,
+	}
+	sources, err := c.GetContractSourceCode(ctx, address, sourceOpts)
+	if err != nil {
+		return nil, err
+	}
+	if len(sources) == 0 {
+		return nil, ErrContractNotVerified
+	}
+	if sources[0].SourceCode != "" {
+		return &EffectiveSourceCode{Address: address, SourceAddress: address, Provenance: SourceExact, Source: sources[0]}, nil
+	}
+
+	match := sources[0].SimilarMatch
+	if match == "" || strings.EqualFold(match, address) {
+		return nil, ErrContractNotVerified
+	}
+	matched, err := c.GetContractSourceCode(ctx, match, sourceOpts)
+	if err != nil {
+		return nil, err
+	}
+	if len(matched) == 0 || matched[0].SourceCode == "" {
+		return nil, ErrContractNotVerified
+	}
+	return &EffectiveSourceCode{Address: address, SourceAddress: match, Provenance: SourceSimilar, Source: matched[0]}, nil
+}
@@ -0,0 +1,53 @@
+package etherscan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestGetEffectiveSourceCode(t *testing.T) {
+	verified := "0x" + strings.Repeat("aa", 20)
+	clone := "0x" + strings.Repeat("bb", 20)
+	orphan := "0x" + strings.Repeat("cc", 20)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch address := r.URL.Query().Get("address"); address {
+		case verified:
+			w.Write([]byte(`{"status":"1","message":"OK","result":[{"SourceCode":"contract Token {}","ContractName":"Token"}]}`))
+		case clone:
+			fmt.Fprintf(w, `{"status":"1","message":"OK","result":[{"SourceCode":"","ABI":"Contract source code not verified","SimilarMatch":"%s"}]}`, verified)
+		default:
+			w.Write([]byte(`{"status":"1","message":"OK","result":[{"SourceCode":"","ABI":"Contract source code not verified"}]}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+		MaxRetries: -1,
+	})
+	ctx := context.Background()
+
+	code, err := client.GetEffectiveSourceCode(ctx, verified, nil)
+	if err != nil || code.Provenance != SourceExact || code.SourceAddress != verified {
+		t.Fatalf("expected the exact sources, got %+v, %v", code, err)
+	}
+
+	// A similar match reads the sources of the matched contract
+	code, err = client.GetEffectiveSourceCode(ctx, clone, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if code.Provenance != SourceSimilar || code.Address != clone || code.SourceAddress != verified || code.Source.ContractName != "Token" {
+		t.Errorf("unexpected similar match: %+v", code)
+	}
+
+	if _, err := client.GetEffectiveSourceCode(ctx, orphan, nil); !errors.Is(err, ErrContractNotVerified) {
+		t.Errorf("expected ErrContractNotVerified, got %v", err)
+	}
+}
@@ -0,0 +1,167 @@
+package etherscan
+
+import (
+	"context"
+	"strings"
+)
+
+// ============================================================================
+// Spam Token Filter
+// ============================================================================
+
+// suspiciousTokenWords are the name and symbol fragments of tokens that advertise a site or a claim,
+// the usual lure of airdropped spam
+var suspiciousTokenWords = []string{"http", "www.", ".com", ".io", ".net", ".org", ".xyz", ".app", "t.me/", "visit", "claim"}
+
+// SpamFilter decides which token transfers are spam
+//
+// A transfer is spam when its token is on the Blocklist, when it moves zero
+// tokens (the address poisoning pattern, where a look-alike address appears in
+// the history through a zero-value transferFrom), when the token name or
+// symbol advertises a URL or a claim, or, with MaxAirdropRecipients set, when
+// its transaction airdropped the token to more addresses than that. The zero
+// value enables every heuristic; tokens on the Allowlist are never spam.
+//
+// Set it as HTTPClientConfig.SpamFilter and pass ExcludeSpam to the token
+// transfer queries, or call the predicates on transfers fetched elsewhere.
+type SpamFilter struct {
+	// Blocklist holds token contracts that are always spam
+	Blocklist []string
+
+	// Allowlist holds token contracts that are never spam, whatever the heuristics say
+	Allowlist []string
+
+	// AllowZeroValue keeps zero-value transfers
+	// Default: false (zero-value transfers are spam)
+	AllowZeroValue bool
+
+	// AllowSuspiciousNames keeps tokens whose name or symbol contains a URL or a claim
+	// Default: false (such tokens are spam)
+	AllowSuspiciousNames bool
+
+	// MaxAirdropRecipients, if positive, marks incoming transfers as spam when their transaction
+	// sent the token to more distinct addresses; checking costs one API call per transaction
+	// Default: 0 (no airdrop check)
+	MaxAirdropRecipients int
+}
+
+// listed reports whether contract is in addresses, ignoring case
+func listed(addresses []string, contract string) bool {
+	for _, address := range addresses {
+		if strings.EqualFold(address, contract) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsSpamToken reports whether the token is blocklisted or has a suspicious name or symbol
+func (f *SpamFilter) IsSpamToken(contract, name, symbol string) bool {
+	if f == nil {
+		f = &SpamFilter{}
+	}
+	if listed(f.Allowlist, contract) {
+		return false
+	}
+	if listed(f.Blocklist, contract) {
+		return true
+	}
+	if !f.AllowSuspiciousNames {
+		text := strings.ToLower(name + " " + symbol)
+		for _, word := range suspiciousTokenWords {
+			if strings.Contains(text, word) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isSpamTransfer applies IsSpamToken and the zero-value check
+func (f *SpamFilter) isSpamTransfer(contract, name, symbol, value string) bool {
+	if f == nil {
+		f = &SpamFilter{}
+	}
+	if listed(f.Allowlist, contract) {
+		return false
+	}
+	if !f.AllowZeroValue && value != "" && strings.TrimLeft(value, "0") == "" {
+		return true
+	}
+	return f.IsSpamToken(contract, name, symbol)
+}
+
+// IsSpamERC20Transfer reports whether an ERC-20 transfer is spam, without the airdrop check
+func (f *SpamFilter) IsSpamERC20Transfer(t RespERC20TokenTransfer) bool {
+	return f.isSpamTransfer(t.ContractAddress, t.TokenName, t.TokenSymbol, t.Value)
+}
+
+// IsSpamERC721Transfer reports whether an ERC-721 transfer is spam, without the airdrop check
+func (f *SpamFilter) IsSpamERC721Transfer(t RespERC721TokenTransfer) bool {
+	return f.IsSpamToken(t.ContractAddress, t.TokenName, t.TokenSymbol)
+}
+
+// IsSpamERC1155Transfer reports whether an ERC-1155 transfer is spam, without the airdrop check
+func (f *SpamFilter) IsSpamERC1155Transfer(t RespERC1155TokenTransfer) bool {
+	return f.isSpamTransfer(t.ContractAddress, t.TokenName, t.TokenSymbol, t.TokenValue)
+}
+
+// FilterTransfers returns the transfers for which isSpam is false, in order
+//
+// Example:
+//
+//	filter := &SpamFilter{Blocklist: myBlocklist}
+//	clean := FilterTransfers(transfers, filter.IsSpamERC20Transfer)
+func FilterTransfers[T any](transfers []T, isSpam func(T) bool) []T {
+	kept := make([]T, 0, len(transfers))
+	for _, t := range transfers {
+		if !isSpam(t) {
+			kept = append(kept, t)
+		}
+	}
+	return kept
+}
+
+// excludeSpam drops the spam among transfers fetched for address, running the airdrop check if enabled
+//
+// fields extracts the token contract, recipient and transaction hash the
+// airdrop check needs; only transfers into address are checked, and each
+// transaction is fetched once.
+func excludeSpam[T any](ctx context.Context, c *HTTPClient, transfers []T, address string, chainID int64, isSpam func(T) bool, fields func(T) (contract, to, txHash string)) ([]T, error) {
+	kept := FilterTransfers(transfers, isSpam)
+	f := c.spamFilter
+	if f == nil || f.MaxAirdropRecipients <= 0 || address == "" {
+		return kept, nil
+	}
+
+	recipients := make(map[string]map[string]int) // tx hash -> token -> distinct recipients
+	clean := make([]T, 0, len(kept))
+	for _, t := range kept {
+		contract, to, txHash := fields(t)
+		if !strings.EqualFold(to, address) || listed(f.Allowlist, contract) {
+			clean = append(clean, t)
+			continue
+		}
+		counts, ok := recipients[txHash]
+		if !ok {
+			inTx, err := c.GetTokenTransfersInTx(ctx, txHash, &GetTokenTransfersInTxOpts{ChainID: chainID})
+			if err != nil {
+				return nil, err
+			}
+			seen := make(map[string]bool)
+			counts = make(map[string]int)
+			for _, transfer := range inTx {
+				token, recipient := strings.ToLower(transfer.Token), strings.ToLower(transfer.To)
+				if !seen[token+recipient] {
+					seen[token+recipient] = true
+					counts[token]++
+				}
+			}
+			recipients[txHash] = counts
+		}
+		if counts[strings.ToLower(contract)] <= f.MaxAirdropRecipients {
+			clean = append(clean, t)
+		}
+	}
+	return clean, nil
+}
@@ -0,0 +1,95 @@
+package etherscan
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSpamFilterPredicates(t *testing.T) {
+	filter := &SpamFilter{Blocklist: []string{"0xBAD"}, Allowlist: []string{"0xgood"}}
+	cases := []struct {
+		transfer RespERC20TokenTransfer
+		spam     bool
+	}{
+		{RespERC20TokenTransfer{ContractAddress: "0xusdc", TokenName: "USD Coin", TokenSymbol: "USDC", Value: "100"}, false},
+		{RespERC20TokenTransfer{ContractAddress: "0xusdc", TokenName: "USD Coin", TokenSymbol: "USDC", Value: "000"}, true},
+		{RespERC20TokenTransfer{ContractAddress: "0xbad", TokenName: "Fine", TokenSymbol: "FINE", Value: "1"}, true},
+		{RespERC20TokenTransfer{ContractAddress: "0xlure", TokenName: "Visit usdc-rewards.com", TokenSymbol: "$", Value: "1"}, true},
+		{RespERC20TokenTransfer{ContractAddress: "0xlure", TokenName: "Gift", TokenSymbol: "CLAIM AT T.ME/GIFT", Value: "1"}, true},
+		{RespERC20TokenTransfer{ContractAddress: "0xGOOD", TokenName: "claim.io", TokenSymbol: "GOOD", Value: "0"}, false},
+	}
+	for i, tc := range cases {
+		if got := filter.IsSpamERC20Transfer(tc.transfer); got != tc.spam {
+			t.Errorf("case %d: expected spam=%v, got %v", i, tc.spam, got)
+		}
+	}
+
+	lenient := &SpamFilter{AllowZeroValue: true, AllowSuspiciousNames: true}
+	if lenient.IsSpamERC1155Transfer(RespERC1155TokenTransfer{TokenName: "www.drop.xyz", TokenValue: "0"}) {
+		t.Error("expected the lenient filter to keep the transfer")
+	}
+	var none *SpamFilter
+	if !none.IsSpamERC721Transfer(RespERC721TokenTransfer{TokenName: "https://mint.app"}) {
+		t.Error("expected a nil filter to apply the default heuristics")
+	}
+
+	kept := FilterTransfers([]RespERC20TokenTransfer{cases[0].transfer, cases[1].transfer, cases[2].transfer}, filter.IsSpamERC20Transfer)
+	if len(kept) != 1 || kept[0].Value != "100" {
+		t.Errorf("unexpected filtered transfers: %+v", kept)
+	}
+}
+
+func TestGetERC20TokenTransfersExcludeSpam(t *testing.T) {
+	const bob = "0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"
+	receipts := map[string]string{
+		// A plain payment to bob
+		"0x1": `{"address":"0xusdc","topics":["` + TopicTransfer + `","` + testTopicAlice + `","` + testTopicBob + `"],"data":"0x0000000000000000000000000000000000000000000000000000000000000064"}`,
+		// One transaction dropping the token on three addresses
+		"0x3": `{"address":"0xdrop","topics":["` + TopicTransfer + `","` + testTopicAlice + `","` + testTopicBob + `"],"data":"0x0000000000000000000000000000000000000000000000000000000000000001"},` +
+			`{"address":"0xdrop","topics":["` + TopicTransfer + `","` + testTopicAlice + `","0x000000000000000000000000cccccccccccccccccccccccccccccccccccccccc"],"data":"0x0000000000000000000000000000000000000000000000000000000000000001"},` +
+			`{"address":"0xdrop","topics":["` + TopicTransfer + `","` + testTopicAlice + `","0x000000000000000000000000dddddddddddddddddddddddddddddddddddddddd"],"data":"0x0000000000000000000000000000000000000000000000000000000000000001"}`,
+	}
+	var receiptCalls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		switch query.Get("action") {
+		case "tokentx":
+			w.Write([]byte(`{"status":"1","message":"OK","result":[
+				{"hash":"0x1","from":"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa","to":"` + bob + `","contractAddress":"0xusdc","tokenName":"USD Coin","tokenSymbol":"USDC","value":"100"},
+				{"hash":"0x2","from":"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa","to":"` + bob + `","contractAddress":"0xusdc","tokenName":"USD Coin","tokenSymbol":"USDC","value":"0"},
+				{"hash":"0x3","from":"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa","to":"` + bob + `","contractAddress":"0xdrop","tokenName":"Drop","tokenSymbol":"DROP","value":"1"},
+				{"hash":"0x4","from":"` + bob + `","to":"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa","contractAddress":"0xdrop","tokenName":"Drop","tokenSymbol":"DROP","value":"1"},
+				{"hash":"0x5","from":"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa","to":"` + bob + `","contractAddress":"0xbad","tokenName":"Bad","tokenSymbol":"BAD","value":"1"}
+			]}`))
+		case "eth_getTransactionReceipt":
+			receiptCalls++
+			hash := query.Get("txhash")
+			w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":{"transactionHash":"` + hash + `","status":"0x1","logs":[` + receipts[hash] + `]}}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+		SpamFilter: &SpamFilter{Blocklist: []string{"0xbad"}, MaxAirdropRecipients: 2},
+	})
+
+	all, err := client.GetERC20TokenTransfers(context.Background(), &GetERC20TokenTransfersOpts{Address: bob})
+	if err != nil || len(all) != 5 {
+		t.Fatalf("expected 5 unfiltered transfers: %d, %v", len(all), err)
+	}
+
+	clean, err := client.GetERC20TokenTransfers(context.Background(), &GetERC20TokenTransfersOpts{Address: bob, ExcludeSpam: true})
+	if err != nil {
+		t.Fatalf("GetERC20TokenTransfers failed: %v", err)
+	}
+	if len(clean) != 2 || clean[0].Hash != "0x1" || clean[1].Hash != "0x4" {
+		t.Errorf("expected the payment and the outgoing transfer, got %+v", clean)
+	}
+	if receiptCalls != 2 {
+		t.Errorf("expected receipts of the 2 incoming candidates only, got %d calls", receiptCalls)
+	}
+}
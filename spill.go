@@ -0,0 +1,173 @@
+package etherscan
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// ============================================================================
+// Spill Buffers
+// ============================================================================
+
+// defaultResultMemoryBudget is the HTTPClientConfig.ResultMemoryBudget default
+const defaultResultMemoryBudget = 256 << 20
+
+// SpillBuffer holds a result set under a memory budget, spilling it to disk beyond that
+//
+// Records are kept JSON-encoded in memory until the encoded size reaches the
+// budget, then written to a JSON Lines segment in a temporary directory and
+// dropped from memory, so a result set of millions of rows costs at most the
+// budget in memory. Each reads the segments back in append order, followed by
+// the records still in memory; callers do not see where the data lives.
+//
+// Records round-trip through encoding/json: fields tagged json:"-", such as
+// UnknownFields, are not kept. A SpillBuffer is not safe for concurrent use
+// and must be closed to remove its segments.
+type SpillBuffer[T any] struct {
+	budget   int64
+	dir      string
+	tmpDir   string
+	mem      bytes.Buffer
+	enc      *json.Encoder
+	segments []string
+	n        int
+}
+
+// NewSpillBuffer creates an empty SpillBuffer
+//
+// Args:
+//   - memoryBudget: The encoded bytes held in memory before spilling; 0 or less never spills
+//   - dir: The directory of the temporary segments, "" for os.TempDir
+//
+// Example:
+//
+//	buf := etherscan.NewSpillBuffer[etherscan.RespNormalTx](64<<20, "")
+//	defer buf.Close()
+//	for _, batch := range batches {
+//	    if err := buf.Append(batch...); err != nil {
+//	        log.Fatal(err)
+//	    }
+//	}
+//	err := buf.Each(func(tx etherscan.RespNormalTx) error {
+//	    total += etherscan.TxValue(tx).Int64()
+//	    return nil
+//	})
+func NewSpillBuffer[T any](memoryBudget int64, dir string) *SpillBuffer[T] {
+	b := &SpillBuffer[T]{budget: memoryBudget, dir: dir}
+	b.enc = json.NewEncoder(&b.mem)
+	b.enc.SetEscapeHTML(false)
+	return b
+}
+
+// Append adds items to the buffer, spilling the in-memory records once they exceed the budget
+func (b *SpillBuffer[T]) Append(items ...T) error {
+	for _, item := range items {
+		if err := b.enc.Encode(item); err != nil {
+			return fmt.Errorf("etherscan: encode spill record: %w", err)
+		}
+		b.n++
+		if b.budget > 0 && int64(b.mem.Len()) >= b.budget {
+			if err := b.spill(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// spill writes the in-memory records to a new segment and releases them
+func (b *SpillBuffer[T]) spill() error {
+	if b.tmpDir == "" {
+		tmpDir, err := os.MkdirTemp(b.dir, "etherscan-spill-")
+		if err != nil {
+			return fmt.Errorf("etherscan: create spill directory: %w", err)
+		}
+		b.tmpDir = tmpDir
+	}
+	path := filepath.Join(b.tmpDir, fmt.Sprintf("segment-%06d.jsonl", len(b.segments)))
+	if err := os.WriteFile(path, b.mem.Bytes(), 0o600); err != nil {
+		return fmt.Errorf("etherscan: write spill segment: %w", err)
+	}
+	b.segments = append(b.segments, path)
+	b.mem = bytes.Buffer{}
+	b.enc = json.NewEncoder(&b.mem)
+	b.enc.SetEscapeHTML(false)
+	return nil
+}
+
+// Len returns the number of records appended
+func (b *SpillBuffer[T]) Len() int {
+	return b.n
+}
+
+// Spilled reports whether part of the buffer lives on disk
+func (b *SpillBuffer[T]) Spilled() bool {
+	return len(b.segments) > 0
+}
+
+// Each calls fn with every record in append order, stopping at the first error
+func (b *SpillBuffer[T]) Each(fn func(T) error) error {
+	for _, path := range b.segments {
+		if err := b.eachSegment(path, fn); err != nil {
+			return err
+		}
+	}
+	return eachRecord(bytes.NewReader(b.mem.Bytes()), fn)
+}
+
+// eachSegment calls fn with every record of one spilled segment
+func (b *SpillBuffer[T]) eachSegment(path string, fn func(T) error) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("etherscan: open spill segment: %w", err)
+	}
+	defer file.Close()
+	return eachRecord(bufio.NewReaderSize(file, exportBufferSize), fn)
+}
+
+// eachRecord decodes JSON Lines records from r and calls fn with each
+func eachRecord[T any](r io.Reader, fn func(T) error) error {
+	dec := json.NewDecoder(r)
+	for {
+		var record T
+		if err := dec.Decode(&record); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("etherscan: decode spill record: %w", err)
+		}
+		if err := fn(record); err != nil {
+			return err
+		}
+	}
+}
+
+// Close releases the buffer and removes its segments
+func (b *SpillBuffer[T]) Close() error {
+	b.mem = bytes.Buffer{}
+	b.segments = nil
+	b.n = 0
+	if b.tmpDir == "" {
+		return nil
+	}
+	err := os.RemoveAll(b.tmpDir)
+	b.tmpDir = ""
+	return err
+}
+
+// spillLogsByRange is collectLogsByRange into a SpillBuffer bounded by the client's result memory budget
+//
+// The buffer is returned even on error, holding the records fetched before
+// it, and must be closed by the caller.
+func spillLogsByRange[T any](ctx context.Context, c *HTTPClient, fromBlock, toBlock int64, fetch func(fromBlock, toBlock, page int64) ([]T, error)) (*SpillBuffer[T], error) {
+	buf := NewSpillBuffer[T](c.resultMemoryBudget, c.spillDir)
+	err := walkLogsByRange(ctx, fromBlock, toBlock, fetch, func(batch []T, _ Checkpoint) error {
+		return buf.Append(batch...)
+	})
+	return buf, err
+}
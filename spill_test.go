@@ -0,0 +1,66 @@
+package etherscan
+
+import (
+	"errors"
+	"os"
+	"testing"
+)
+
+func TestSpillBuffer(t *testing.T) {
+	dir := t.TempDir()
+	txs := []RespNormalTx{
+		{Hash: "0x1", From: "0xa", Value: "1"},
+		{Hash: "0x2", From: "0xb", Value: "2"},
+		{Hash: "0x3", From: "0xc", Value: "3"},
+		{Hash: "0x4", From: "0xd", Value: "4"},
+		{Hash: "0x5", From: "0xe", Value: "5"},
+	}
+
+	// A budget of about two records spills twice and keeps the last one in memory
+	buf := NewSpillBuffer[RespNormalTx](400, dir)
+	for _, tx := range txs {
+		if err := buf.Append(tx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if buf.Len() != len(txs) || !buf.Spilled() {
+		t.Fatalf("expected %d records with some spilled, got %d spilled=%v", len(txs), buf.Len(), buf.Spilled())
+	}
+
+	var hashes []string
+	if err := buf.Each(func(tx RespNormalTx) error {
+		hashes = append(hashes, tx.Hash)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if len(hashes) != len(txs) || hashes[0] != "0x1" || hashes[4] != "0x5" {
+		t.Errorf("expected the records in append order, got %v", hashes)
+	}
+
+	stop := errors.New("stop")
+	calls := 0
+	if err := buf.Each(func(RespNormalTx) error {
+		calls++
+		return stop
+	}); err != stop || calls != 1 {
+		t.Errorf("expected Each to stop at the first error, got %v after %d calls", err, calls)
+	}
+
+	if err := buf.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+		t.Errorf("expected Close to remove the segments, got %d entries", len(entries))
+	}
+
+	// Without a budget nothing touches the disk
+	unbounded := NewSpillBuffer[RespNormalTx](0, dir)
+	if err := unbounded.Append(txs...); err != nil {
+		t.Fatal(err)
+	}
+	if unbounded.Spilled() {
+		t.Error("expected an unbounded buffer to stay in memory")
+	}
+	unbounded.Close()
+}
@@ -0,0 +1,344 @@
+package etherscan
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ============================================================================
+// Staking Deposits
+// ============================================================================
+
+// BeaconDepositContract is the beacon chain deposit contract on Ethereum mainnet
+const BeaconDepositContract = "0x00000000219ab540356cbb839cbe05303d7705fa"
+
+// TopicDepositEvent is DepositEvent(bytes,bytes,bytes,bytes,bytes) of the beacon deposit contract
+const TopicDepositEvent = "0x649bbc62d0e31342afea4e5cd82d4049e7e1ee912fc0889aa790803be39038c5"
+
+// StakingDeposit is one deposit to the beacon deposit contract
+type StakingDeposit struct {
+	// Pubkey is the validator's BLS public key
+	Pubkey string `json:"pubkey" bson:"pubkey"`
+
+	// WithdrawalCredentials is the raw 32-byte credential; WithdrawalAddress is the
+	// execution address it names (0x01 and 0x02 credentials), empty for BLS credentials
+	WithdrawalCredentials string `json:"withdrawalCredentials" bson:"withdrawalCredentials"`
+	WithdrawalAddress     string `json:"withdrawalAddress" bson:"withdrawalAddress"`
+
+	// AmountGwei is the deposited amount; 32 ETH is 32000000000
+	AmountGwei *big.Int `json:"amountGwei" bson:"amountGwei"`
+
+	// Index is the deposit contract's running deposit count
+	Index uint64 `json:"index" bson:"index"`
+
+	// Depositor is the address that sent the deposit transaction, empty if the deposit
+	// was found through its withdrawal credentials only
+	Depositor string `json:"depositor" bson:"depositor"`
+
+	TxHash      string    `json:"txHash" bson:"txHash"`
+	BlockNumber int64     `json:"blockNumber" bson:"blockNumber"`
+	Time        time.Time `json:"time" bson:"time"`
+	LogIndex    int64     `json:"logIndex" bson:"logIndex"`
+}
+
+// StakingPosition groups the deposits paying out to one withdrawal address with its withdrawals
+type StakingPosition struct {
+	// WithdrawalAddress is empty for the deposits with BLS credentials, which cannot withdraw yet
+	WithdrawalAddress string `json:"withdrawalAddress" bson:"withdrawalAddress"`
+
+	Deposits    []StakingDeposit            `json:"deposits" bson:"deposits"`
+	Withdrawals []RespBeaconChainWithdrawal `json:"withdrawals" bson:"withdrawals"`
+
+	// DepositedGwei and WithdrawnGwei sum the deposits and withdrawals
+	DepositedGwei *big.Int `json:"depositedGwei" bson:"depositedGwei"`
+	WithdrawnGwei *big.Int `json:"withdrawnGwei" bson:"withdrawnGwei"`
+}
+
+// StakingLifecycle is the result of GetStakingDeposits
+type StakingLifecycle struct {
+	Address string `json:"address" bson:"address"`
+
+	// Deposits are sorted by block and log index
+	Deposits []StakingDeposit `json:"deposits" bson:"deposits"`
+
+	// Positions are sorted by withdrawal address
+	Positions []StakingPosition `json:"positions" bson:"positions"`
+
+	TotalDepositedGwei *big.Int `json:"totalDepositedGwei" bson:"totalDepositedGwei"`
+	TotalWithdrawnGwei *big.Int `json:"totalWithdrawnGwei" bson:"totalWithdrawnGwei"`
+}
+
+// GetStakingDepositsOpts contains optional parameters for GetStakingDeposits
+type GetStakingDepositsOpts struct {
+	// ByWithdrawalCredentials also matches deposits whose withdrawal credentials name the address,
+	// whoever sent them, by scanning every deposit in [FromBlock, ToBlock]
+	// Default: false (only deposits sent by the address)
+	ByWithdrawalCredentials bool
+
+	// FromBlock is the first block searched
+	// Default: 0 (genesis block)
+	FromBlock int64 `default:"0"`
+
+	// ToBlock is the last block searched
+	// Default: 999999999999 (latest block)
+	ToBlock int64 `default:"999999999999"`
+
+	// DepositContract is the deposit contract, for testnets
+	// Default: BeaconDepositContract
+	DepositContract string `default:"0x00000000219ab540356cbb839cbe05303d7705fa"`
+
+	// ChainID specifies which blockchain network to query
+	// Default: empty (uses client default)
+	ChainID int64
+
+	// OnLimitExceeded specifies behavior when rate limit is exceeded
+	// Default: RateLimitBlock (wait until a token is available)
+	OnLimitExceeded RateLimitBehavior `default:""`
+}
+
+// GetStakingDeposits returns the beacon chain deposits of an address with the withdrawals they led to
+//
+// DepositEvent has no indexed fields, so deposits cannot be filtered by topic.
+// The deposits sent by the address are found through its transactions to the
+// deposit contract, whose blocks are then searched for the DepositEvent logs.
+// With ByWithdrawalCredentials, every deposit in the block range is scanned as
+// well and kept if its 0x01 or 0x02 withdrawal credentials name the address,
+// which finds deposits made on its behalf by staking services.
+//
+// Deposits are grouped by withdrawal address, and each group is joined with
+// the beacon chain withdrawals to that address from the first deposit on.
+//
+// Args:
+//   - ctx: Context for request cancellation and timeout
+//   - address: The depositor or withdrawal address
+//   - opts: Optional parameters (can be nil)
+//
+// Returns:
+//   - *StakingLifecycle: Deposits, positions and totals in Gwei
+//   - error: Error if any request fails; with ErrBudgetExhausted the deposits and withdrawals
+//     fetched before it
+//
+// Example:
+//
+//	life, err := client.GetStakingDeposits(ctx, staker, nil)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, pos := range life.Positions {
+//	    fmt.Printf("%s: %d deposits, %s gwei in, %s gwei out\n", pos.WithdrawalAddress, len(pos.Deposits), pos.DepositedGwei, pos.WithdrawnGwei)
+//	}
+//
+// Note:
+//   - Deposits relayed through batch deposit contracts are only found with ByWithdrawalCredentials
+//   - Withdrawals are matched by address, not validator: a withdrawal address shared with
+//     validators funded elsewhere reports their withdrawals too
+//   - ByWithdrawalCredentials costs one call per 1000 deposits in range; narrow the block range
+func (c *HTTPClient) GetStakingDeposits(ctx context.Context, address string, opts *GetStakingDepositsOpts) (*StakingLifecycle, error) {
+	if opts == nil {
+		opts = &GetStakingDepositsOpts{}
+	}
+	if err := ApplyDefaults(opts); err != nil {
+		return nil, err
+	}
+	address = strings.ToLower(address)
+	contract := strings.ToLower(opts.DepositContract)
+
+	depositLogs := func(fromBlock, toBlock int64) ([]RespEventLogByAddressFilteredByTopics, error) {
+		return collectLogsByRange(ctx, fromBlock, toBlock, func(fromBlock, toBlock, page int64) ([]RespEventLogByAddressFilteredByTopics, error) {
+			return c.GetEventLogsByAddressFilteredByTopics(ctx, contract, &GetEventLogsByAddressFilteredByTopicsOpts{
+				FromBlock:       fromBlock,
+				ToBlock:         toBlock,
+				Page:            page,
+				Offset:          logsPerCall,
+				Topic0:          TopicDepositEvent,
+				ChainID:         opts.ChainID,
+				OnLimitExceeded: opts.OnLimitExceeded,
+			})
+		})
+	}
+
+	found := make(map[string]StakingDeposit) // tx hash:log index -> deposit
+	add := func(logs []RespEventLogByAddressFilteredByTopics, keep func(*StakingDeposit) bool) {
+		for _, log := range logs {
+			if deposit, ok := decodeStakingDeposit(log); ok && keep(&deposit) {
+				key := deposit.TxHash + ":" + log.LogIndex
+				if prev, ok := found[key]; ok && prev.Depositor != "" {
+					deposit.Depositor = prev.Depositor
+				}
+				found[key] = deposit
+			}
+		}
+	}
+
+	// Deposits sent by the address, found through its transactions to the contract
+	txs, err := collectLogsByRange(ctx, opts.FromBlock, opts.ToBlock, func(fromBlock, toBlock, page int64) ([]RespNormalTx, error) {
+		return c.GetNormalTxs(ctx, address, &GetNormalTxsOpts{
+			StartBlock:      fromBlock,
+			EndBlock:        toBlock,
+			Page:            page,
+			Offset:          logsPerCall,
+			Sort:            SortAsc,
+			ChainID:         opts.ChainID,
+			OnLimitExceeded: opts.OnLimitExceeded,
+		})
+	})
+	sent := make(map[string]bool)
+	var blocks []int64
+	for _, tx := range txs {
+		block, parseErr := strconv.ParseInt(tx.BlockNumber, 10, 64)
+		if parseErr != nil || !strings.EqualFold(tx.To, contract) || tx.IsError == "1" {
+			continue
+		}
+		if len(blocks) == 0 || blocks[len(blocks)-1] != block {
+			blocks = append(blocks, block)
+		}
+		sent[strings.ToLower(tx.Hash)] = true
+	}
+	for _, block := range blocks {
+		if err != nil {
+			break
+		}
+		var logs []RespEventLogByAddressFilteredByTopics
+		logs, err = depositLogs(block, block)
+		add(logs, func(d *StakingDeposit) bool {
+			if !sent[strings.ToLower(d.TxHash)] {
+				return false
+			}
+			d.Depositor = address
+			return true
+		})
+	}
+
+	// Deposits paying out to the address, whoever sent them
+	if opts.ByWithdrawalCredentials && err == nil {
+		var logs []RespEventLogByAddressFilteredByTopics
+		logs, err = depositLogs(opts.FromBlock, opts.ToBlock)
+		add(logs, func(d *StakingDeposit) bool { return d.WithdrawalAddress == address })
+	}
+	if err != nil && !errors.Is(err, ErrBudgetExhausted) {
+		return nil, err
+	}
+
+	life := &StakingLifecycle{
+		Address:            address,
+		Deposits:           make([]StakingDeposit, 0, len(found)),
+		Positions:          []StakingPosition{},
+		TotalDepositedGwei: new(big.Int),
+		TotalWithdrawnGwei: new(big.Int),
+	}
+	for _, deposit := range found {
+		life.Deposits = append(life.Deposits, deposit)
+	}
+	sort.Slice(life.Deposits, func(i, j int) bool {
+		if life.Deposits[i].BlockNumber != life.Deposits[j].BlockNumber {
+			return life.Deposits[i].BlockNumber < life.Deposits[j].BlockNumber
+		}
+		return life.Deposits[i].LogIndex < life.Deposits[j].LogIndex
+	})
+
+	positions := make(map[string]*StakingPosition)
+	var withdrawalAddresses []string
+	for _, deposit := range life.Deposits {
+		pos, ok := positions[deposit.WithdrawalAddress]
+		if !ok {
+			pos = &StakingPosition{
+				WithdrawalAddress: deposit.WithdrawalAddress,
+				Withdrawals:       []RespBeaconChainWithdrawal{},
+				DepositedGwei:     new(big.Int),
+				WithdrawnGwei:     new(big.Int),
+			}
+			positions[deposit.WithdrawalAddress] = pos
+			withdrawalAddresses = append(withdrawalAddresses, deposit.WithdrawalAddress)
+		}
+		pos.Deposits = append(pos.Deposits, deposit)
+		pos.DepositedGwei.Add(pos.DepositedGwei, deposit.AmountGwei)
+		life.TotalDepositedGwei.Add(life.TotalDepositedGwei, deposit.AmountGwei)
+	}
+	sort.Strings(withdrawalAddresses)
+
+	for _, withdrawalAddress := range withdrawalAddresses {
+		pos := positions[withdrawalAddress]
+		if withdrawalAddress != "" && err == nil {
+			// Deposits are sorted, so the first one of the position is its earliest
+			pos.Withdrawals, err = collectLogsByRange(ctx, pos.Deposits[0].BlockNumber, opts.ToBlock, func(fromBlock, toBlock, page int64) ([]RespBeaconChainWithdrawal, error) {
+				return c.GetBeaconChainWithdrawals(ctx, withdrawalAddress, &GetBeaconChainWithdrawalsOpts{
+					StartBlock:      fromBlock,
+					EndBlock:        toBlock,
+					Page:            page,
+					Offset:          logsPerCall,
+					Sort:            SortAsc,
+					ChainID:         opts.ChainID,
+					OnLimitExceeded: opts.OnLimitExceeded,
+				})
+			})
+			if err != nil && !errors.Is(err, ErrBudgetExhausted) {
+				return nil, err
+			}
+			if pos.Withdrawals == nil {
+				pos.Withdrawals = []RespBeaconChainWithdrawal{}
+			}
+			for _, w := range pos.Withdrawals {
+				if amount, ok := new(big.Int).SetString(w.Amount, 10); ok {
+					pos.WithdrawnGwei.Add(pos.WithdrawnGwei, amount)
+				}
+			}
+			life.TotalWithdrawnGwei.Add(life.TotalWithdrawnGwei, pos.WithdrawnGwei)
+		}
+		life.Positions = append(life.Positions, *pos)
+	}
+	return life, err
+}
+
+// decodeStakingDeposit decodes a DepositEvent log, reporting false if it is malformed
+//
+// The five fields are ABI-encoded bytes; amount and index are little-endian
+// uint64s as in the beacon chain's SSZ encoding.
+func decodeStakingDeposit(log RespEventLogByAddressFilteredByTopics) (StakingDeposit, bool) {
+	if len(log.Topics) == 0 || !strings.EqualFold(log.Topics[0], TopicDepositEvent) {
+		return StakingDeposit{}, false
+	}
+	words := splitWords(log.Data)
+	if len(words) < 5 {
+		return StakingDeposit{}, false
+	}
+	fields := make([][]byte, 5)
+	for i := range fields {
+		var err error
+		if fields[i], err = decodeABIDynamic(words, words[i]); err != nil {
+			return StakingDeposit{}, false
+		}
+	}
+	pubkey, credentials, amount, index := fields[0], fields[1], fields[2], fields[4]
+	if len(pubkey) != 48 || len(credentials) != 32 || len(amount) != 8 || len(index) != 8 {
+		return StakingDeposit{}, false
+	}
+
+	deposit := StakingDeposit{
+		Pubkey:                "0x" + hex.EncodeToString(pubkey),
+		WithdrawalCredentials: "0x" + hex.EncodeToString(credentials),
+		AmountGwei:            new(big.Int).SetUint64(binary.LittleEndian.Uint64(amount)),
+		Index:                 binary.LittleEndian.Uint64(index),
+		TxHash:                log.TransactionHash,
+	}
+	// 0x01 and 0x02 credentials are the prefix, 11 zero bytes and the execution address
+	if credentials[0] == 0x01 || credentials[0] == 0x02 {
+		deposit.WithdrawalAddress = "0x" + hex.EncodeToString(credentials[12:])
+	}
+	if block, err := parseHexUint64(log.BlockNumber); err == nil {
+		deposit.BlockNumber = int64(block)
+	}
+	if logIndex, err := parseHexUint64(log.LogIndex); err == nil {
+		deposit.LogIndex = int64(logIndex)
+	}
+	if at, err := ParseTimestamp(log.TimeStamp); err == nil {
+		deposit.Time = at
+	}
+	return deposit, true
+}
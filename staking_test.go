@@ -0,0 +1,101 @@
+package etherscan
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// depositEventLog encodes a DepositEvent of 32 ETH with 0x01 credentials naming withdrawTo
+func depositEventLog(txHash, logIndex, withdrawTo string, index uint64) string {
+	word := func(n int) string { return fmt.Sprintf("%064x", n) }
+	bytesField := func(b []byte) string {
+		padded := make([]byte, (len(b)+31)/32*32)
+		copy(padded, b)
+		return word(len(b)) + hex.EncodeToString(padded)
+	}
+	credentials, _ := hex.DecodeString("01" + strings.Repeat("00", 11) + strings.TrimPrefix(withdrawTo, "0x"))
+	amount, indexLE := make([]byte, 8), make([]byte, 8)
+	binary.LittleEndian.PutUint64(amount, 32000000000)
+	binary.LittleEndian.PutUint64(indexLE, index)
+	pubkey := make([]byte, 48)
+	pubkey[0] = byte(index)
+
+	data := word(0xa0) + word(0x100) + word(0x140) + word(0x180) + word(0x200) +
+		bytesField(pubkey) + bytesField(credentials) + bytesField(amount) + bytesField(make([]byte, 96)) + bytesField(indexLE)
+	return `{"address":"` + BeaconDepositContract + `","topics":["` + TopicDepositEvent + `"],"data":"0x` + data + `",` +
+		`"blockNumber":"0x64","timeStamp":"0x6553f100","logIndex":"` + logIndex + `","transactionHash":"` + txHash + `"}`
+}
+
+func TestGetStakingDeposits(t *testing.T) {
+	const (
+		bob   = "0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"
+		alice = "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		switch query.Get("action") {
+		case "txlist":
+			w.Write([]byte(`{"status":"1","message":"OK","result":[
+				{"blockNumber":"100","hash":"0xd1","from":"` + bob + `","to":"0x00000000219ab540356cBB839Cbe05303d7705Fa","isError":"0"},
+				{"blockNumber":"101","hash":"0xe1","from":"` + bob + `","to":"` + alice + `","isError":"0"}
+			]}`))
+		case "getLogs":
+			if query.Get("address") != BeaconDepositContract || query.Get("topic0") != TopicDepositEvent {
+				t.Errorf("unexpected log filter %v", query)
+			}
+			logs := depositEventLog("0xd1", "0x1", bob, 7) + `,` + depositEventLog("0xf1", "0x2", alice, 8)
+			if query.Get("fromblock") != query.Get("toblock") {
+				// The full scan also sees a deposit a staking service made for bob
+				logs += `,` + depositEventLog("0xf2", "0x3", bob, 9)
+			}
+			w.Write([]byte(`{"status":"1","message":"OK","result":[` + logs + `]}`))
+		case "txsBeaconWithdrawal":
+			if query.Get("address") != bob || query.Get("startblock") != "100" {
+				t.Errorf("unexpected withdrawal query %v", query)
+			}
+			w.Write([]byte(`{"status":"1","message":"OK","result":[{"validatorIndex":"5","address":"` + bob + `","amount":"1000000"},{"validatorIndex":"5","address":"` + bob + `","amount":"2000000"}]}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+	})
+
+	life, err := client.GetStakingDeposits(context.Background(), bob, nil)
+	if err != nil {
+		t.Fatalf("GetStakingDeposits failed: %v", err)
+	}
+	if len(life.Deposits) != 1 {
+		t.Fatalf("expected only the deposit bob sent, got %+v", life.Deposits)
+	}
+	d := life.Deposits[0]
+	if d.Depositor != bob || d.WithdrawalAddress != bob || d.AmountGwei.Int64() != 32000000000 || d.Index != 7 || d.BlockNumber != 100 {
+		t.Errorf("unexpected deposit %+v", d)
+	}
+	if !strings.HasPrefix(d.Pubkey, "0x07") || len(d.Pubkey) != 2+96 || d.Time.Unix() != 1700000000 {
+		t.Errorf("unexpected pubkey or time %+v", d)
+	}
+	if life.TotalWithdrawnGwei.Int64() != 3000000 || len(life.Positions) != 1 || len(life.Positions[0].Withdrawals) != 2 {
+		t.Errorf("unexpected withdrawals %+v", life)
+	}
+
+	life, err = client.GetStakingDeposits(context.Background(), bob, &GetStakingDepositsOpts{ByWithdrawalCredentials: true})
+	if err != nil {
+		t.Fatalf("GetStakingDeposits by credentials failed: %v", err)
+	}
+	if len(life.Deposits) != 2 || life.Deposits[0].Depositor != bob || life.Deposits[1].TxHash != "0xf2" || life.Deposits[1].Depositor != "" {
+		t.Fatalf("expected bob's own deposit and the one made for him, got %+v", life.Deposits)
+	}
+	pos := life.Positions[0]
+	if len(life.Positions) != 1 || pos.WithdrawalAddress != bob || pos.DepositedGwei.Int64() != 64000000000 || life.TotalDepositedGwei.Cmp(pos.DepositedGwei) != 0 {
+		t.Errorf("unexpected position %+v", pos)
+	}
+}
@@ -0,0 +1,271 @@
+package etherscan
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+	"time"
+)
+
+// ============================================================================
+// Staking Income Report
+// ============================================================================
+
+// likelyExitGwei is the smallest withdrawal treated as a validator exit
+//
+// Partial withdrawals only skim the balance above 32 ETH, a fraction of an
+// ETH; a full withdrawal pays out the whole balance, which is at least the
+// 16 ETH ejection balance.
+const likelyExitGwei = 16_000_000_000
+
+// StakingIncomeRow is one beacon chain withdrawal valued at the ETH/USD price of its day
+type StakingIncomeRow struct {
+	Time            time.Time `json:"time" bson:"time"`
+	BlockNumber     int64     `json:"blockNumber" bson:"blockNumber"`
+	WithdrawalIndex string    `json:"withdrawalIndex" bson:"withdrawalIndex"`
+	ValidatorIndex  string    `json:"validatorIndex" bson:"validatorIndex"`
+
+	AmountGwei *big.Int   `json:"amountGwei" bson:"amountGwei"`
+	AmountETH  RatDecimal `json:"amountEth" bson:"amountEth"`
+
+	// PriceUSD is the ETH/USD price of the UTC day of Time; ValueUSD is AmountETH at that price
+	// Both are zero if Priced is false
+	PriceUSD RatDecimal `json:"priceUsd" bson:"priceUsd"`
+	ValueUSD RatDecimal `json:"valueUsd" bson:"valueUsd"`
+	Priced   bool       `json:"priced" bson:"priced"`
+
+	// LikelyExit marks withdrawals of at least 16 ETH, which return the validator's principal
+	// rather than rewards
+	LikelyExit bool `json:"likelyExit" bson:"likelyExit"`
+}
+
+// StakingIncomeReport is the beacon chain withdrawals an address received in one year
+type StakingIncomeReport struct {
+	Address string             `json:"address" bson:"address"`
+	Year    int                `json:"year" bson:"year"`
+	Rows    []StakingIncomeRow `json:"rows" bson:"rows"`
+
+	// TotalETH and TotalValueUSD sum every row; the Rewards totals leave out likely exits
+	TotalETH        RatDecimal `json:"totalEth" bson:"totalEth"`
+	TotalValueUSD   RatDecimal `json:"totalValueUsd" bson:"totalValueUsd"`
+	RewardsETH      RatDecimal `json:"rewardsEth" bson:"rewardsEth"`
+	RewardsValueUSD RatDecimal `json:"rewardsValueUsd" bson:"rewardsValueUsd"`
+
+	// Unpriced counts rows without a price for their day, left out of the USD totals
+	Unpriced int `json:"unpriced" bson:"unpriced"`
+}
+
+// stakingIncomeCSVHeader is the header row written by StakingIncomeReport.WriteCSV
+var stakingIncomeCSVHeader = []string{
+	"date", "time", "block", "withdrawal_index", "validator_index",
+	"amount_eth", "eth_usd", "value_usd", "likely_exit",
+}
+
+// WriteCSV writes the report as CSV: a header, one row per withdrawal and a yearly total row
+//
+// Times are RFC 3339 in UTC. Prices and values of unpriced rows are empty.
+func (r *StakingIncomeReport) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(stakingIncomeCSVHeader); err != nil {
+		return err
+	}
+	for _, row := range r.Rows {
+		record := []string{
+			UTCDate(row.Time),
+			row.Time.Format(time.RFC3339),
+			strconv.FormatInt(row.BlockNumber, 10),
+			row.WithdrawalIndex,
+			row.ValidatorIndex,
+			row.AmountETH.String(),
+			"",
+			"",
+			strconv.FormatBool(row.LikelyExit),
+		}
+		if row.Priced {
+			record[6], record[7] = row.PriceUSD.String(), row.ValueUSD.String()
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	total := []string{"total", strconv.Itoa(r.Year), "", "", "", r.TotalETH.String(), "", r.TotalValueUSD.String(), ""}
+	if err := writer.Write(total); err != nil {
+		return err
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// GenerateStakingIncomeReportOpts contains optional parameters for GenerateStakingIncomeReport
+type GenerateStakingIncomeReportOpts struct {
+	// ChainID specifies which blockchain network to query
+	// Default: empty (uses client default)
+	ChainID int64
+
+	// OnLimitExceeded specifies behavior when rate limit is exceeded
+	// Default: RateLimitBlock (wait until a token is available)
+	OnLimitExceeded RateLimitBehavior `default:""`
+}
+
+// GenerateStakingIncomeReport values the beacon chain withdrawals of an address in a calendar year
+//
+// The year is resolved to its block range in UTC, every withdrawal in it is
+// fetched and each one is valued at the daily ETH/USD price of its UTC day.
+// The report carries yearly totals, separately for all withdrawals and for
+// rewards only, and exports to CSV with WriteCSV.
+//
+// Args:
+//   - ctx: Context for request cancellation and timeout
+//   - address: The withdrawal address
+//   - year: The calendar year, in UTC
+//   - opts: Optional parameters (can be nil)
+//
+// Returns:
+//   - *StakingIncomeReport: The withdrawals of the year in block order, with totals
+//   - error: Error if the year has not started or a request fails
+//
+// Example:
+//
+//	report, err := client.GenerateStakingIncomeReport(ctx, withdrawalAddr, 2024, nil)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("%s ETH of rewards worth $%s\n", report.RewardsETH, report.RewardsValueUSD)
+//	file, _ := os.Create("staking-2024.csv")
+//	defer file.Close()
+//	if err := report.WriteCSV(file); err != nil {
+//	    log.Fatal(err)
+//	}
+//
+// Note:
+//   - Costs 3 API calls plus one per 1000 withdrawals
+//   - Requires API Pro (ethdailyprice is a Pro endpoint)
+//   - Only available for Ethereum mainnet
+//   - Whether exits count as income depends on the jurisdiction; LikelyExit only flags them
+func (c *HTTPClient) GenerateStakingIncomeReport(ctx context.Context, address string, year int, opts *GenerateStakingIncomeReportOpts) (*StakingIncomeReport, error) {
+	if opts == nil {
+		opts = &GenerateStakingIncomeReportOpts{}
+	}
+	if err := ApplyDefaults(opts); err != nil {
+		return nil, err
+	}
+
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(year+1, time.January, 1, 0, 0, 0, 0, time.UTC).Add(-time.Second)
+	now := time.Now().UTC()
+	if start.After(now) {
+		return nil, fmt.Errorf("etherscan: year %d has not started", year)
+	}
+	if end.After(now) {
+		end = now
+	}
+
+	blockOpts := &GetBlockNumberByTimestampOpts{ChainID: opts.ChainID, OnLimitExceeded: opts.OnLimitExceeded}
+	fromBlock, err := c.GetBlockNumberByTimestamp(ctx, start.Unix(), ClosestAfter, blockOpts)
+	if err != nil {
+		return nil, err
+	}
+	toBlock, err := c.GetBlockNumberByTimestamp(ctx, end.Unix(), ClosestBefore, blockOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &StakingIncomeReport{Address: address, Year: year, Rows: []StakingIncomeRow{}}
+	if fromBlock < 0 || toBlock < fromBlock {
+		return report, nil
+	}
+	withdrawals, err := collectLogsByRange(ctx, int64(fromBlock), int64(toBlock), func(fromBlock, toBlock, page int64) ([]RespBeaconChainWithdrawal, error) {
+		return c.GetBeaconChainWithdrawals(ctx, address, &GetBeaconChainWithdrawalsOpts{
+			StartBlock:      fromBlock,
+			EndBlock:        toBlock,
+			Page:            page,
+			Offset:          logsPerCall,
+			Sort:            SortAsc,
+			ChainID:         opts.ChainID,
+			OnLimitExceeded: opts.OnLimitExceeded,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(withdrawals) == 0 {
+		return report, nil
+	}
+
+	prices, err := c.GetEthHistoricalPrices(ctx, UTCDate(start), UTCDate(end), &GetEthHistoricalPricesOpts{
+		ChainID:         opts.ChainID,
+		OnLimitExceeded: opts.OnLimitExceeded,
+	})
+	if err != nil {
+		return nil, err
+	}
+	dailyPrice := make(map[string]RatDecimal, len(prices))
+	for _, day := range prices {
+		at, err := ParseTimestamp(day.UTCDate)
+		if err != nil {
+			return nil, fmt.Errorf("etherscan: invalid date %q: %w", day.UTCDate, err)
+		}
+		price, err := ParseRatDecimal(day.Value)
+		if err != nil {
+			return nil, fmt.Errorf("etherscan: invalid ETH price %q on %s: %w", day.Value, day.UTCDate, err)
+		}
+		dailyPrice[UTCDate(at)] = price
+	}
+
+	for _, w := range withdrawals {
+		row, err := newStakingIncomeRow(w)
+		if err != nil {
+			return nil, err
+		}
+		// The block range can reach just past the year boundaries
+		if row.Time.Before(start) || row.Time.After(end) {
+			continue
+		}
+		if price, ok := dailyPrice[UTCDate(row.Time)]; ok {
+			row.PriceUSD, row.ValueUSD, row.Priced = price, row.AmountETH.Mul(price), true
+		}
+
+		report.TotalETH = report.TotalETH.Add(row.AmountETH)
+		if !row.LikelyExit {
+			report.RewardsETH = report.RewardsETH.Add(row.AmountETH)
+		}
+		if row.Priced {
+			report.TotalValueUSD = report.TotalValueUSD.Add(row.ValueUSD)
+			if !row.LikelyExit {
+				report.RewardsValueUSD = report.RewardsValueUSD.Add(row.ValueUSD)
+			}
+		} else {
+			report.Unpriced++
+		}
+		report.Rows = append(report.Rows, row)
+	}
+	return report, nil
+}
+
+// newStakingIncomeRow converts a withdrawal to an unpriced row
+func newStakingIncomeRow(w RespBeaconChainWithdrawal) (StakingIncomeRow, error) {
+	gwei, ok := new(big.Int).SetString(w.Amount, 10)
+	if !ok {
+		return StakingIncomeRow{}, fmt.Errorf("etherscan: invalid withdrawal amount %q", w.Amount)
+	}
+	at, err := ParseTimestamp(w.Timestamp)
+	if err != nil {
+		return StakingIncomeRow{}, err
+	}
+	block, err := strconv.ParseInt(w.BlockNumber, 10, 64)
+	if err != nil {
+		return StakingIncomeRow{}, fmt.Errorf("etherscan: invalid withdrawal block %q: %w", w.BlockNumber, err)
+	}
+	return StakingIncomeRow{
+		Time:            at,
+		BlockNumber:     block,
+		WithdrawalIndex: w.WithdrawalIndex,
+		ValidatorIndex:  w.ValidatorIndex,
+		AmountGwei:      gwei,
+		AmountETH:       RatDecimal{r: new(big.Rat).SetInt(gwei)}.Shift(-9),
+		LikelyExit:      gwei.Cmp(big.NewInt(likelyExitGwei)) >= 0,
+	}, nil
+}
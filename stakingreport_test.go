@@ -0,0 +1,90 @@
+package etherscan
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGenerateStakingIncomeReport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		switch query.Get("action") {
+		case "getblocknobytime":
+			if query.Get("closest") == "after" {
+				w.Write([]byte(`{"status":"1","message":"OK","result":"18908895"}`))
+			} else {
+				w.Write([]byte(`{"status":"1","message":"OK","result":"21525890"}`))
+			}
+		case "txsBeaconWithdrawal":
+			if query.Get("startblock") != "18908895" || query.Get("endblock") != "21525890" {
+				t.Errorf("unexpected block range %s-%s", query.Get("startblock"), query.Get("endblock"))
+			}
+			w.Write([]byte(`{"status":"1","message":"OK","result":[
+				{"withdrawalIndex":"1","validatorIndex":"7","amount":"12300000","blockNumber":"19340000","timestamp":"1709294400"},
+				{"withdrawalIndex":"2","validatorIndex":"7","amount":"32010000000","blockNumber":"20000000","timestamp":"1717243200"},
+				{"withdrawalIndex":"3","validatorIndex":"8","amount":"5000000","blockNumber":"20200000","timestamp":"1719835200"}
+			]}`))
+		case "ethdailyprice":
+			w.Write([]byte(`{"status":"1","message":"OK","result":[
+				{"UTCDate":"2024-03-01","unixTimeStamp":"1709251200","value":"3000.5"},
+				{"UTCDate":"2024-06-01","unixTimeStamp":"1717200000","value":"3500"}
+			]}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+	})
+	report, err := client.GenerateStakingIncomeReport(context.Background(), testAddr, 2024, nil)
+	if err != nil {
+		t.Fatalf("GenerateStakingIncomeReport failed: %v", err)
+	}
+
+	if len(report.Rows) != 3 || report.Unpriced != 1 {
+		t.Fatalf("expected 3 rows with 1 unpriced: %+v", report)
+	}
+	first := report.Rows[0]
+	if first.AmountETH.String() != "0.0123" || first.ValueUSD.String() != "36.90615" || first.LikelyExit {
+		t.Errorf("unexpected partial withdrawal row: %+v", first)
+	}
+	if !report.Rows[1].LikelyExit || report.Rows[1].ValueUSD.String() != "112035" {
+		t.Errorf("expected the 32 ETH withdrawal to be a priced exit: %+v", report.Rows[1])
+	}
+	if report.TotalETH.String() != "32.0273" || report.RewardsETH.String() != "0.0173" {
+		t.Errorf("unexpected ETH totals: %s, %s", report.TotalETH, report.RewardsETH)
+	}
+	if report.TotalValueUSD.String() != "112071.90615" || report.RewardsValueUSD.String() != "36.90615" {
+		t.Errorf("unexpected USD totals: %s, %s", report.TotalValueUSD, report.RewardsValueUSD)
+	}
+
+	var buf bytes.Buffer
+	if err := report.WriteCSV(&buf); err != nil {
+		t.Fatalf("WriteCSV failed: %v", err)
+	}
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil || len(records) != 5 {
+		t.Fatalf("expected header, 3 rows and a total: %v, %v", records, err)
+	}
+	if got := records[1]; got[0] != "2024-03-01" || got[5] != "0.0123" || got[6] != "3000.5" || got[8] != "false" {
+		t.Errorf("unexpected CSV row: %v", got)
+	}
+	if got := records[3]; got[6] != "" || got[7] != "" {
+		t.Errorf("expected an empty price on the unpriced row: %v", got)
+	}
+	if got := records[4]; got[0] != "total" || got[5] != "32.0273" || got[7] != "112071.90615" {
+		t.Errorf("unexpected total row: %v", got)
+	}
+
+	data, _ := json.Marshal(report.Rows[0])
+	var decoded StakingIncomeRow
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded.AmountETH.Cmp(first.AmountETH) != 0 {
+		t.Errorf("expected decimals to round-trip through JSON: %s, %v", data, err)
+	}
+}
@@ -3,7 +3,6 @@ package etherscan
 import (
 	"context"
 	"fmt"
-	"strconv"
 )
 
 // ============================================================================
@@ -16,7 +15,7 @@ type GetDailyBlockCountRewardsOpts struct {
 	// Options:
 	//   - "asc" (default): Sort by date in ascending order (oldest first)
 	//   - "desc": Sort by date in descending order (newest first)
-	Sort string `default:"asc" json:"sort"`
+	Sort SortOrder `default:"asc" json:"sort"`
 
 	// ChainID specifies which blockchain network to query
 	// If nil, uses the client's default chain ID (EthereumMainnet = 1)
@@ -30,6 +29,10 @@ type GetDailyBlockCountRewardsOpts struct {
 	//   - RateLimitRaise: Return an error when rate limit is exceeded
 	//   - RateLimitSkip: Return false without executing when rate limit is exceeded
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // GetDailyBlockCountRewards returns daily block count and rewards within a date range
@@ -87,8 +90,10 @@ func (c *HTTPClient) GetDailyBlockCountRewards(ctx context.Context, startDate, e
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -98,13 +103,14 @@ func (c *HTTPClient) GetDailyBlockCountRewards(ctx context.Context, startDate, e
 		params:          params,
 		noFoundReturn:   []RespDailyBlockCountReward{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result []RespDailyBlockCountReward
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
 	return result, nil
@@ -114,7 +120,7 @@ func (c *HTTPClient) GetDailyBlockCountRewards(ctx context.Context, startDate, e
 type GetDailyBlockRewardsOpts struct {
 	// Sort order for the results
 	// Options: "asc" (default) or "desc"
-	Sort string `default:"asc" json:"sort"`
+	Sort SortOrder `default:"asc" json:"sort"`
 
 	// ChainID specifies which blockchain network to query
 	// Default: empty (uses client default)
@@ -123,6 +129,10 @@ type GetDailyBlockRewardsOpts struct {
 	// OnLimitExceeded specifies behavior when rate limit is exceeded
 	// Default: RateLimitBlock (wait until a token is available)
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // GetDailyBlockRewards returns daily block rewards distributed to miners within a date range
@@ -163,8 +173,10 @@ func (c *HTTPClient) GetDailyBlockRewards(ctx context.Context, startDate, endDat
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -174,13 +186,14 @@ func (c *HTTPClient) GetDailyBlockRewards(ctx context.Context, startDate, endDat
 		params:          params,
 		noFoundReturn:   []RespDailyBlockReward{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result []RespDailyBlockReward
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
 	return result, nil
@@ -190,7 +203,7 @@ func (c *HTTPClient) GetDailyBlockRewards(ctx context.Context, startDate, endDat
 type GetDailyAvgBlockTimeOpts struct {
 	// Sort order for the results
 	// Options: "asc" (default) or "desc"
-	Sort string `default:"asc" json:"sort"`
+	Sort SortOrder `default:"asc" json:"sort"`
 
 	// ChainID specifies which blockchain network to query
 	// Default: empty (uses client default)
@@ -199,6 +212,10 @@ type GetDailyAvgBlockTimeOpts struct {
 	// OnLimitExceeded specifies behavior when rate limit is exceeded
 	// Default: RateLimitBlock (wait until a token is available)
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // GetDailyAvgBlockTime returns daily average time for a block to be included in the blockchain
@@ -240,8 +257,10 @@ func (c *HTTPClient) GetDailyAvgBlockTime(ctx context.Context, startDate, endDat
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -251,13 +270,14 @@ func (c *HTTPClient) GetDailyAvgBlockTime(ctx context.Context, startDate, endDat
 		params:          params,
 		noFoundReturn:   []RespDailyAvgTimeBlockMined{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result []RespDailyAvgTimeBlockMined
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
 	return result, nil
@@ -267,7 +287,7 @@ func (c *HTTPClient) GetDailyAvgBlockTime(ctx context.Context, startDate, endDat
 type GetDailyUncleBlockCountAndRewardsOpts struct {
 	// Sort order for the results
 	// Options: "asc" (default) or "desc"
-	Sort string `default:"asc" json:"sort"`
+	Sort SortOrder `default:"asc" json:"sort"`
 
 	// ChainID specifies which blockchain network to query
 	// Default: empty (uses client default)
@@ -276,6 +296,10 @@ type GetDailyUncleBlockCountAndRewardsOpts struct {
 	// OnLimitExceeded specifies behavior when rate limit is exceeded
 	// Default: RateLimitBlock (wait until a token is available)
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // GetDailyUncleBlockCountAndRewards returns daily uncle block count and rewards
@@ -316,8 +340,10 @@ func (c *HTTPClient) GetDailyUncleBlockCountAndRewards(ctx context.Context, star
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -327,13 +353,14 @@ func (c *HTTPClient) GetDailyUncleBlockCountAndRewards(ctx context.Context, star
 		params:          params,
 		noFoundReturn:   []RespDailyUncleBlockCountAndReward{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result []RespDailyUncleBlockCountAndReward
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
 	return result, nil
@@ -347,6 +374,10 @@ func (c *HTTPClient) GetDailyUncleBlockCountAndRewards(ctx context.Context, star
 type GetTotalEthSupplyOpts struct {
 	ChainID         int64             `json:"chainid"`
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // GetTotalEthSupply returns the current amount of Eth in circulation excluding ETH2 Staking rewards and EIP1559 burnt fees
@@ -383,8 +414,10 @@ func (c *HTTPClient) GetTotalEthSupply(ctx context.Context, opts *GetTotalEthSup
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -394,6 +427,7 @@ func (c *HTTPClient) GetTotalEthSupply(ctx context.Context, opts *GetTotalEthSup
 		params:          params,
 		noFoundReturn:   "",
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return "", err
@@ -409,6 +443,10 @@ func (c *HTTPClient) GetTotalEthSupply(ctx context.Context, opts *GetTotalEthSup
 type GetTotalEth2SupplyOpts struct {
 	ChainID         int64             `json:"chainid"`
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // GetTotalEth2Supply returns the current amount of Eth in circulation, ETH2 Staking rewards, EIP1559 burnt fees
@@ -445,8 +483,10 @@ func (c *HTTPClient) GetTotalEth2Supply(ctx context.Context, opts *GetTotalEth2S
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -456,6 +496,7 @@ func (c *HTTPClient) GetTotalEth2Supply(ctx context.Context, opts *GetTotalEth2S
 		params:          params,
 		noFoundReturn:   "",
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return "", err
@@ -476,6 +517,10 @@ type GetEthPriceOpts struct {
 	// OnLimitExceeded specifies behavior when rate limit is exceeded
 	// Default: RateLimitBlock (wait until a token is available)
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // GetEthPrice returns the latest price of the native/gas token
@@ -505,7 +550,18 @@ type GetEthPriceOpts struct {
 // Note:
 //   - Returns current token price in USD
 //   - Includes market cap information
+//
+// Deprecated: Use GetNativeTokenPrice, which names the fields after the chain's
+// native token and parses the timestamps:
+//
+//	price, err := client.GetNativeTokenPrice(ctx, &GetNativeTokenPriceOpts{ChainID: PolygonMainnet})
+//	fmt.Printf("%s: $%s\n", price.Symbol, price.USD)
 func (c *HTTPClient) GetEthPrice(ctx context.Context, opts *GetEthPriceOpts) (*RespEthPrice, error) {
+	return c.getEthPrice(ctx, opts)
+}
+
+// getEthPrice calls stats ethprice
+func (c *HTTPClient) getEthPrice(ctx context.Context, opts *GetEthPriceOpts) (*RespEthPrice, error) {
 	// Apply defaults and extract API parameters
 	params, err := ApplyDefaultsAndExtractParams(opts)
 	if err != nil {
@@ -514,8 +570,10 @@ func (c *HTTPClient) GetEthPrice(ctx context.Context, opts *GetEthPriceOpts) (*R
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -525,13 +583,14 @@ func (c *HTTPClient) GetEthPrice(ctx context.Context, opts *GetEthPriceOpts) (*R
 		params:          params,
 		noFoundReturn:   RespEthPrice{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result RespEthPrice
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
@@ -541,7 +600,7 @@ func (c *HTTPClient) GetEthPrice(ctx context.Context, opts *GetEthPriceOpts) (*R
 type GetEthHistoricalPricesOpts struct {
 	// Sort order for the results
 	// Options: "asc" (default) or "desc"
-	Sort string `default:"asc" json:"sort"`
+	Sort SortOrder `default:"asc" json:"sort"`
 
 	// ChainID specifies which blockchain network to query
 	// Default: empty (uses client default)
@@ -550,6 +609,10 @@ type GetEthHistoricalPricesOpts struct {
 	// OnLimitExceeded specifies behavior when rate limit is exceeded
 	// Default: RateLimitBlock (wait until a token is available)
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // GetEthHistoricalPrices returns the historical price of 1 ETH
@@ -590,8 +653,10 @@ func (c *HTTPClient) GetEthHistoricalPrices(ctx context.Context, startDate, endD
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -601,13 +666,101 @@ func (c *HTTPClient) GetEthHistoricalPrices(ctx context.Context, startDate, endD
 		params:          params,
 		noFoundReturn:   []RespEthHistoricalPrice{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result []RespEthHistoricalPrice
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetEthDailyMarketCapsOpts contains optional parameters for GetEthDailyMarketCaps
+type GetEthDailyMarketCapsOpts struct {
+	// Sort order for the results
+	// Options: "asc" (default) or "desc"
+	Sort SortOrder `default:"asc" json:"sort"`
+
+	// ChainID specifies which blockchain network to query
+	// Default: empty (uses client default)
+	ChainID int64 `json:"chainid"`
+
+	// OnLimitExceeded specifies behavior when rate limit is exceeded
+	// Default: RateLimitBlock (wait until a token is available)
+	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
+}
+
+// GetEthDailyMarketCaps returns the daily ETH supply, price and market capitalization
+//
+// This endpoint returns, for every day in the date range, the ETH supply, the
+// USD price and the market capitalization in millions of USD.
+//
+// Args:
+//   - ctx: Context for request cancellation and timeout
+//   - startDate: Starting date in yyyy-MM-dd format (e.g., "2019-02-01")
+//   - endDate: Ending date in yyyy-MM-dd format (e.g., "2019-02-28")
+//   - opts: Optional parameters (can be nil)
+//
+// Returns:
+//   - []RespEthDailyMarketCap: List of daily supply, price and market cap entries
+//   - error: Error if the request fails
+//
+// Example:
+//
+//	caps, err := client.GetEthDailyMarketCaps(ctx, "2019-02-01", "2019-02-28", nil)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, day := range caps {
+//	    fmt.Printf("%s: supply %s ETH, market cap $%sM\n", day.UTCDate, day.Supply, day.MarketCap)
+//	}
+//
+// Note:
+//   - Date format must be yyyy-MM-dd
+//   - Returns empty slice if no data found
+//   - This is an API Pro endpoint
+func (c *HTTPClient) GetEthDailyMarketCaps(ctx context.Context, startDate, endDate string, opts *GetEthDailyMarketCapsOpts) ([]RespEthDailyMarketCap, error) {
+	// Apply defaults and extract API parameters
+	params, err := ApplyDefaultsAndExtractParams(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add required parameters
+	params["startdate"] = startDate
+	params["enddate"] = endDate
+
+	// Handle rate limiting
+	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
+	if opts != nil {
+		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
+	}
+
+	data, err := c.request(requestParams{
+		ctx:             ctx,
+		module:          "stats",
+		action:          "ethdailymarketcap",
+		params:          params,
+		noFoundReturn:   []RespEthDailyMarketCap{},
+		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var result []RespEthDailyMarketCap
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
 	return result, nil
@@ -621,6 +774,10 @@ func (c *HTTPClient) GetEthHistoricalPrices(ctx context.Context, startDate, endD
 type GetEthereumNodesSizeOpts struct {
 	ChainID         int64             `json:"chainid"`
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // GetEthereumNodesSize returns the size of the Ethereum blockchain, in bytes, over a date range
@@ -654,7 +811,7 @@ type GetEthereumNodesSizeOpts struct {
 // Note:
 //   - Date format must be yyyy-MM-dd
 //   - Returns empty slice if no data found
-func (c *HTTPClient) GetEthereumNodesSize(ctx context.Context, startDate, endDate, clientType, syncMode, sort string, opts *GetEthereumNodesSizeOpts) ([]RespEtheumNodeSize, error) {
+func (c *HTTPClient) GetEthereumNodesSize(ctx context.Context, startDate, endDate, clientType, syncMode string, sort SortOrder, opts *GetEthereumNodesSizeOpts) ([]RespEtheumNodeSize, error) {
 	// Apply defaults and extract API parameters
 	params, err := ApplyDefaultsAndExtractParams(opts)
 	if err != nil {
@@ -666,14 +823,18 @@ func (c *HTTPClient) GetEthereumNodesSize(ctx context.Context, startDate, endDat
 	params["enddate"] = endDate
 	params["clienttype"] = clientType
 	params["syncmode"] = syncMode
-	params["sort"] = sort
-
-	if opts.ChainID != 0 {
-		params["chainid"] = strconv.FormatInt(opts.ChainID, 10)
+	if err := sort.Validate(); err != nil {
+		return nil, err
 	}
+	params["sort"] = string(sort)
 
 	// Handle rate limiting
-	onLimitExceeded := opts.OnLimitExceeded
+	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
+	if opts != nil {
+		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
+	}
 
 	data, err := c.request(requestParams{
 		ctx:             ctx,
@@ -682,13 +843,14 @@ func (c *HTTPClient) GetEthereumNodesSize(ctx context.Context, startDate, endDat
 		params:          params,
 		noFoundReturn:   []RespEtheumNodeSize{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result []RespEtheumNodeSize
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
 	return result, nil
@@ -703,6 +865,10 @@ type GetNodeCountOpts struct {
 	// OnLimitExceeded specifies behavior when rate limit is exceeded
 	// Default: RateLimitBlock (wait until a token is available)
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // GetNodeCount returns the total number of discoverable Ethereum nodes
@@ -739,8 +905,10 @@ func (c *HTTPClient) GetNodeCount(ctx context.Context, opts *GetNodeCountOpts) (
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -750,13 +918,14 @@ func (c *HTTPClient) GetNodeCount(ctx context.Context, opts *GetNodeCountOpts) (
 		params:          params,
 		noFoundReturn:   RespNodeCount{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result RespNodeCount
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
@@ -766,7 +935,7 @@ func (c *HTTPClient) GetNodeCount(ctx context.Context, opts *GetNodeCountOpts) (
 type GetDailyTxFeesOpts struct {
 	// Sort order for the results
 	// Options: "asc" (default) or "desc"
-	Sort string `default:"asc" json:"sort"`
+	Sort SortOrder `default:"asc" json:"sort"`
 
 	// ChainID specifies which blockchain network to query
 	// Default: empty (uses client default)
@@ -775,6 +944,10 @@ type GetDailyTxFeesOpts struct {
 	// OnLimitExceeded specifies behavior when rate limit is exceeded
 	// Default: RateLimitBlock (wait until a token is available)
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // GetDailyTxFees returns the amount of transaction fees paid to miners per day
@@ -815,8 +988,10 @@ func (c *HTTPClient) GetDailyTxFees(ctx context.Context, startDate, endDate stri
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -826,13 +1001,14 @@ func (c *HTTPClient) GetDailyTxFees(ctx context.Context, startDate, endDate stri
 		params:          params,
 		noFoundReturn:   []RespDailyTxFee{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result []RespDailyTxFee
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
 	return result, nil
@@ -842,7 +1018,7 @@ func (c *HTTPClient) GetDailyTxFees(ctx context.Context, startDate, endDate stri
 type GetDailyNewAddressesOpts struct {
 	// Sort order for the results
 	// Options: "asc" (default) or "desc"
-	Sort string `default:"asc" json:"sort"`
+	Sort SortOrder `default:"asc" json:"sort"`
 
 	// ChainID specifies which blockchain network to query
 	// Default: empty (uses client default)
@@ -851,6 +1027,10 @@ type GetDailyNewAddressesOpts struct {
 	// OnLimitExceeded specifies behavior when rate limit is exceeded
 	// Default: RateLimitBlock (wait until a token is available)
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // GetDailyNewAddresses returns the number of new Ethereum addresses created per day
@@ -891,8 +1071,10 @@ func (c *HTTPClient) GetDailyNewAddresses(ctx context.Context, startDate, endDat
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -902,13 +1084,14 @@ func (c *HTTPClient) GetDailyNewAddresses(ctx context.Context, startDate, endDat
 		params:          params,
 		noFoundReturn:   []RespDailyNewAddress{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result []RespDailyNewAddress
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
 	return result, nil
@@ -918,7 +1101,7 @@ func (c *HTTPClient) GetDailyNewAddresses(ctx context.Context, startDate, endDat
 type GetDailyNetworkUtilizationsOpts struct {
 	// Sort order for the results
 	// Options: "asc" (default) or "desc"
-	Sort string `default:"asc" json:"sort"`
+	Sort SortOrder `default:"asc" json:"sort"`
 
 	// ChainID specifies which blockchain network to query
 	// Default: empty (uses client default)
@@ -927,6 +1110,10 @@ type GetDailyNetworkUtilizationsOpts struct {
 	// OnLimitExceeded specifies behavior when rate limit is exceeded
 	// Default: RateLimitBlock (wait until a token is available)
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // GetDailyNetworkUtilizations returns the daily average gas used over gas limit, in percentage
@@ -967,8 +1154,10 @@ func (c *HTTPClient) GetDailyNetworkUtilizations(ctx context.Context, startDate,
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -978,13 +1167,14 @@ func (c *HTTPClient) GetDailyNetworkUtilizations(ctx context.Context, startDate,
 		params:          params,
 		noFoundReturn:   []RespDailyNetworkUtilization{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result []RespDailyNetworkUtilization
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
 	return result, nil
@@ -994,7 +1184,7 @@ func (c *HTTPClient) GetDailyNetworkUtilizations(ctx context.Context, startDate,
 type GetDailyAvgHashratesOpts struct {
 	// Sort order for the results
 	// Options: "asc" (default) or "desc"
-	Sort string `default:"asc" json:"sort"`
+	Sort SortOrder `default:"asc" json:"sort"`
 
 	// ChainID specifies which blockchain network to query
 	// Default: empty (uses client default)
@@ -1003,6 +1193,10 @@ type GetDailyAvgHashratesOpts struct {
 	// OnLimitExceeded specifies behavior when rate limit is exceeded
 	// Default: RateLimitBlock (wait until a token is available)
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // GetDailyAvgHashrates returns the historical measure of processing power of the Ethereum network
@@ -1043,8 +1237,10 @@ func (c *HTTPClient) GetDailyAvgHashrates(ctx context.Context, startDate, endDat
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -1054,13 +1250,14 @@ func (c *HTTPClient) GetDailyAvgHashrates(ctx context.Context, startDate, endDat
 		params:          params,
 		noFoundReturn:   []RespDailyAvgHashrate{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result []RespDailyAvgHashrate
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
 	return result, nil
@@ -1070,7 +1267,7 @@ func (c *HTTPClient) GetDailyAvgHashrates(ctx context.Context, startDate, endDat
 type GetDailyTxCountsOpts struct {
 	// Sort order for the results
 	// Options: "asc" (default) or "desc"
-	Sort string `default:"asc" json:"sort"`
+	Sort SortOrder `default:"asc" json:"sort"`
 
 	// ChainID specifies which blockchain network to query
 	// Default: empty (uses client default)
@@ -1079,6 +1276,10 @@ type GetDailyTxCountsOpts struct {
 	// OnLimitExceeded specifies behavior when rate limit is exceeded
 	// Default: RateLimitBlock (wait until a token is available)
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // GetDailyTxCounts returns the number of transactions performed on the Ethereum blockchain per day
@@ -1119,8 +1320,10 @@ func (c *HTTPClient) GetDailyTxCounts(ctx context.Context, startDate, endDate st
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -1130,13 +1333,14 @@ func (c *HTTPClient) GetDailyTxCounts(ctx context.Context, startDate, endDate st
 		params:          params,
 		noFoundReturn:   []RespDailyTxCount{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result []RespDailyTxCount
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
 	return result, nil
@@ -1146,7 +1350,7 @@ func (c *HTTPClient) GetDailyTxCounts(ctx context.Context, startDate, endDate st
 type GetDailyAvgDifficultiesOpts struct {
 	// Sort order for the results
 	// Options: "asc" (default) or "desc"
-	Sort string `default:"asc" json:"sort"`
+	Sort SortOrder `default:"asc" json:"sort"`
 
 	// ChainID specifies which blockchain network to query
 	// Default: empty (uses client default)
@@ -1155,6 +1359,10 @@ type GetDailyAvgDifficultiesOpts struct {
 	// OnLimitExceeded specifies behavior when rate limit is exceeded
 	// Default: RateLimitBlock (wait until a token is available)
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // GetDailyAvgDifficulties returns the historical mining difficulty of the Ethereum network
@@ -1195,8 +1403,10 @@ func (c *HTTPClient) GetDailyAvgDifficulties(ctx context.Context, startDate, end
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -1206,13 +1416,14 @@ func (c *HTTPClient) GetDailyAvgDifficulties(ctx context.Context, startDate, end
 		params:          params,
 		noFoundReturn:   []RespDailyAvgDifficulty{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result []RespDailyAvgDifficulty
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
 	return result, nil
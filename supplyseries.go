@@ -0,0 +1,139 @@
+package etherscan
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+)
+
+// ============================================================================
+// Token Supply Series
+// ============================================================================
+
+// supplyHistoryRateLimit is the throttle Etherscan applies to tokensupplyhistory regardless of tier
+const supplyHistoryRateLimit = 2
+
+// TokenSupplyPoint is the total supply of a token at one point of a sampled series
+type TokenSupplyPoint struct {
+	// Time is the sampled time in UTC; Block is the last block mined at or before it
+	Time  time.Time `json:"time" bson:"time"`
+	Block int64     `json:"block" bson:"block"`
+
+	// Supply is in the token's smallest unit
+	Supply *big.Int `json:"supply" bson:"supply"`
+
+	// Change is Supply minus the supply of the previous point, nil for the first point
+	Change *big.Int `json:"change" bson:"change"`
+}
+
+// TokenSupplySeries is the total supply of a token sampled at a fixed interval
+type TokenSupplySeries struct {
+	Contract string        `json:"contract" bson:"contract"`
+	Interval time.Duration `json:"interval" bson:"interval"`
+
+	// Points are in time order
+	Points []TokenSupplyPoint `json:"points" bson:"points"`
+}
+
+// GetTokenSupplySeriesOpts contains optional parameters for GetTokenSupplySeries
+type GetTokenSupplySeriesOpts struct {
+	// ChainID specifies which blockchain network to query
+	// Default: empty (uses client default)
+	ChainID int64
+
+	// OnLimitExceeded specifies behavior when rate limit is exceeded
+	// Default: RateLimitBlock (wait until a token is available)
+	OnLimitExceeded RateLimitBehavior `default:""`
+}
+
+// GetTokenSupplySeries returns the total supply of an ERC-20 token every interval from from to to
+//
+// The sample times are from, from + interval, and so on up to to. Each is
+// resolved to the last block mined before it with GetBlockNumberByTimestamp,
+// and the supply at that block is fetched with
+// GetERC20HistoricalTotalSupplyBig, paced to the tokensupplyhistory throttle
+// shared by all series of the client.
+//
+// Args:
+//   - ctx: Context for request cancellation and timeout
+//   - contract: The ERC-20 token contract
+//   - from: Time of the first sample
+//   - to: Latest time to sample
+//   - interval: Time between samples
+//   - opts: Optional parameters (can be nil)
+//
+// Returns:
+//   - *TokenSupplySeries: One point per sample time, in time order
+//   - error: Error if the time range or interval are invalid, or a request fails
+//
+// Example:
+//
+//	// Daily USDC supply over the last 90 days
+//	to := time.Now()
+//	series, err := client.GetTokenSupplySeries(ctx, usdc, to.AddDate(0, 0, -90), to, 24*time.Hour, nil)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, p := range series.Points {
+//	    fmt.Printf("%s %s (%+d)\n", p.Time.Format(time.DateOnly), p.Supply, p.Change)
+//	}
+//
+// Note:
+//   - Requires API Pro (tokensupplyhistory is a Pro endpoint)
+//   - Costs two calls per point; tokensupplyhistory calls are paced to 2 per second,
+//     and points resolving to the same block are fetched once
+func (c *HTTPClient) GetTokenSupplySeries(ctx context.Context, contract string, from, to time.Time, interval time.Duration, opts *GetTokenSupplySeriesOpts) (*TokenSupplySeries, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("etherscan: invalid interval %s", interval)
+	}
+	if to.Before(from) {
+		return nil, fmt.Errorf("etherscan: invalid time range %s-%s", from, to)
+	}
+	if opts == nil {
+		opts = &GetTokenSupplySeriesOpts{}
+	}
+	if err := ApplyDefaults(opts); err != nil {
+		return nil, err
+	}
+
+	series := &TokenSupplySeries{Contract: contract, Interval: interval}
+	supplies := make(map[int64]*big.Int)
+	var previous *big.Int
+	for at := from; !at.After(to); at = at.Add(interval) {
+		block, err := c.GetBlockNumberByTimestamp(ctx, at.Unix(), ClosestBefore, &GetBlockNumberByTimestampOpts{
+			ChainID:         opts.ChainID,
+			OnLimitExceeded: opts.OnLimitExceeded,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if block < 0 {
+			return nil, fmt.Errorf("etherscan: no block before %s", at)
+		}
+
+		blockNo := int64(block)
+		supply, ok := supplies[blockNo]
+		if !ok {
+			if err := waitThrottle(ctx, c.supplyHistoryLimiter); err != nil {
+				return nil, err
+			}
+			supply, err = c.GetERC20HistoricalTotalSupplyBig(ctx, contract, blockNo, &GetERC20HistoricalTotalSupplyOpts{
+				ChainID:         opts.ChainID,
+				OnLimitExceeded: opts.OnLimitExceeded,
+			})
+			if err != nil {
+				return nil, err
+			}
+			supplies[blockNo] = supply
+		}
+
+		point := TokenSupplyPoint{Time: at.UTC(), Block: blockNo, Supply: new(big.Int).Set(supply)}
+		if previous != nil {
+			point.Change = new(big.Int).Sub(supply, previous)
+		}
+		previous = supply
+		series.Points = append(series.Points, point)
+	}
+	return series, nil
+}
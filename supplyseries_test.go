@@ -0,0 +1,74 @@
+package etherscan
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestGetTokenSupplySeries(t *testing.T) {
+	var supplyCalls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		switch q.Get("action") {
+		case "getblocknobytime":
+			// One block every 100 seconds
+			ts, _ := strconv.ParseInt(q.Get("timestamp"), 10, 64)
+			fmt.Fprintf(w, `{"status":"1","message":"OK","result":"%d"}`, ts/100)
+		case "tokensupplyhistory":
+			supplyCalls++
+			block, _ := strconv.ParseInt(q.Get("blockno"), 10, 64)
+			// Supply grows by 5 per block, answered in hex as some explorers do
+			fmt.Fprintf(w, `{"status":"1","message":"OK","result":"0x%x"}`, 1000+block*5)
+		default:
+			t.Errorf("unexpected action %q", q.Get("action"))
+		}
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+	})
+	ctx := context.Background()
+
+	// 10000 to 10350 every 100 seconds: 4 points, the range end is not a sample time
+	from := time.Unix(10000, 0)
+	series, err := client.GetTokenSupplySeries(ctx, testContract, from, from.Add(350*time.Second), 100*time.Second, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(series.Points) != 4 || series.Interval != 100*time.Second {
+		t.Fatalf("expected 4 points, got %+v", series)
+	}
+	for i, p := range series.Points {
+		block := int64(100 + i)
+		if p.Block != block || p.Supply.Int64() != 1000+block*5 || !p.Time.Equal(from.Add(time.Duration(i)*100*time.Second)) {
+			t.Errorf("point %d = %+v", i, p)
+		}
+		if i == 0 && p.Change != nil || i > 0 && p.Change.Int64() != 5 {
+			t.Errorf("point %d change = %v", i, p.Change)
+		}
+	}
+
+	// Points within one block share a single tokensupplyhistory call
+	supplyCalls = 0
+	series, err = client.GetTokenSupplySeries(ctx, testContract, from, from.Add(50*time.Second), 20*time.Second, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(series.Points) != 3 || supplyCalls != 1 || series.Points[2].Change.Sign() != 0 {
+		t.Errorf("expected 3 unchanged points from 1 call, got %+v from %d", series.Points, supplyCalls)
+	}
+
+	if _, err := client.GetTokenSupplySeries(ctx, testContract, from, from, 0, nil); err == nil {
+		t.Error("expected a zero interval to be rejected")
+	}
+	if _, err := client.GetTokenSupplySeries(ctx, testContract, from, from.Add(-time.Second), time.Second, nil); err == nil {
+		t.Error("expected a reversed range to be rejected")
+	}
+}
@@ -0,0 +1,52 @@
+package etherscan
+
+import (
+	"context"
+	"time"
+)
+
+// ============================================================================
+// Per-Endpoint Timeouts
+// ============================================================================
+
+// defaultRequestTimeout is the timeout of the default HTTP client
+const defaultRequestTimeout = 30 * time.Second
+
+// TimeoutPolicy maps endpoints to request timeouts
+//
+// Keys are "module.action" (e.g. "contract.verifysourcecode"), "module"
+// (e.g. "proxy") or "" for every other endpoint; the most specific key wins.
+// A timeout covers one call including its transport retries, but not the
+// wait for the rate limiter.
+//
+// Example:
+//
+//	client := NewHTTPClient(HTTPClientConfig{
+//	    APIKey: "YOUR_API_KEY",
+//	    Timeouts: TimeoutPolicy{
+//	        "":                          30 * time.Second,
+//	        "proxy":                     5 * time.Second,
+//	        "logs.getLogs":              90 * time.Second,
+//	        "contract.verifysourcecode": 2 * time.Minute,
+//	    },
+//	})
+type TimeoutPolicy map[string]time.Duration
+
+// For returns the timeout of an endpoint, 0 if the policy has none for it
+func (p TimeoutPolicy) For(module, action string) time.Duration {
+	if timeout, ok := p[module+"."+action]; ok {
+		return timeout
+	}
+	if timeout, ok := p[module]; ok {
+		return timeout
+	}
+	return p[""]
+}
+
+// withEndpointTimeout bounds ctx by the client's timeout for the endpoint, if it has one
+func (c *HTTPClient) withEndpointTimeout(ctx context.Context, module, action string) (context.Context, context.CancelFunc) {
+	if timeout := c.timeouts.For(module, action); timeout > 0 {
+		return context.WithTimeout(ctx, timeout)
+	}
+	return ctx, func() {}
+}
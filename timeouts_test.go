@@ -0,0 +1,56 @@
+package etherscan
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestTimeoutPolicyFor(t *testing.T) {
+	policy := TimeoutPolicy{"": time.Second, "proxy": 2 * time.Second, "proxy.eth_call": 3 * time.Second}
+	cases := map[[2]string]time.Duration{
+		{"proxy", "eth_call"}:        3 * time.Second,
+		{"proxy", "eth_blockNumber"}: 2 * time.Second,
+		{"account", "balance"}:       time.Second,
+	}
+	for endpoint, want := range cases {
+		if got := policy.For(endpoint[0], endpoint[1]); got != want {
+			t.Errorf("%s.%s: expected %s, got %s", endpoint[0], endpoint[1], want, got)
+		}
+	}
+	if got := (TimeoutPolicy{"proxy": time.Second}).For("account", "balance"); got != 0 {
+		t.Errorf("expected no timeout, got %s", got)
+	}
+}
+
+func TestEndpointTimeouts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(100 * time.Millisecond)
+		if r.URL.Query().Get("module") == "proxy" {
+			w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x10"}`))
+			return
+		}
+		w.Write([]byte(`{"status":"1","message":"OK","result":"1000"}`))
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+		MaxRetries: -1,
+		Timeouts:   TimeoutPolicy{"proxy": 20 * time.Millisecond, "account.balance": 5 * time.Second},
+	})
+	if client.httpClient.Timeout != 0 || client.timeouts[""] != defaultRequestTimeout {
+		t.Errorf("expected the policy to replace the client timeout, got %s and %v", client.httpClient.Timeout, client.timeouts)
+	}
+
+	if _, err := client.RpcEthBlockNumber(context.Background(), nil); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the proxy call to time out, got %v", err)
+	}
+	if _, err := client.GetEthBalance(context.Background(), TestAddresses.VitalikButerin, nil); err != nil {
+		t.Errorf("expected the balance call to outlast the proxy timeout, got %v", err)
+	}
+}
@@ -0,0 +1,115 @@
+package etherscan
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ============================================================================
+// Time Parsing and UTC Days
+// ============================================================================
+
+// timestampLayouts are the date layouts Etherscan uses, tried in order
+var timestampLayouts = []string{
+	time.DateOnly,
+	time.DateTime,
+	time.RFC3339,
+}
+
+// ParseTimestamp parses a timestamp in any of the formats Etherscan returns, in UTC
+//
+// Accepted formats are decimal Unix seconds ("1700000000", timeStamp and
+// unixTimeStamp fields), hex Unix seconds ("0x6553f100", JSON-RPC block
+// timestamps), dates ("2024-01-31", UTCDate fields), date times
+// ("2024-01-31 12:00:00") and RFC 3339. Dates without a zone are read as UTC,
+// which is how Etherscan reports them. The result is always in UTC, so that
+// formatting it or taking its day never depends on the local time zone.
+//
+// Example:
+//
+//	at, err := etherscan.ParseTimestamp(tx.TimeStamp)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(etherscan.UTCDate(at))
+func ParseTimestamp(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return time.Time{}, fmt.Errorf("etherscan: empty timestamp")
+	}
+	if hex, ok := strings.CutPrefix(strings.ToLower(s), "0x"); ok {
+		sec, err := strconv.ParseInt(hex, 16, 64)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("etherscan: invalid timestamp %q: %w", s, err)
+		}
+		return time.Unix(sec, 0).UTC(), nil
+	}
+	if sec, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Unix(sec, 0).UTC(), nil
+	}
+	for _, layout := range timestampLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t.UTC(), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("etherscan: invalid timestamp %q", s)
+}
+
+// UTCDate returns the UTC day of t in yyyy-MM-dd format
+//
+// Etherscan's daily stats (GetDailyTxFees, GetEthHistoricalPrices, ...) are
+// keyed by UTC day, so this is the key to join them with transactions on, and
+// the startdate and enddate to query them with. Formatting t in local time
+// instead shifts transactions near midnight into the neighbouring day.
+func UTCDate(t time.Time) string {
+	return t.UTC().Format(time.DateOnly)
+}
+
+// UTCDayStart returns midnight UTC of the day of t
+func UTCDayStart(t time.Time) time.Time {
+	y, m, d := t.UTC().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// DayBucket is the items of one UTC day, as returned by BucketByUTCDay
+type DayBucket[T any] struct {
+	// Date is the UTC day in yyyy-MM-dd format, matching the UTCDate field of daily stats
+	Date string `json:"date" bson:"date"`
+
+	Items []T `json:"items" bson:"items"`
+}
+
+// BucketByUTCDay groups items by the UTC day of their time, in ascending date order
+//
+// Days without items are left out. Items keep their relative order within a day.
+//
+// Example:
+//
+//	days := etherscan.BucketByUTCDay(txs, func(tx etherscan.RespNormalTx) time.Time {
+//	    at, _ := etherscan.ParseTimestamp(tx.TimeStamp)
+//	    return at
+//	})
+//	for _, day := range days {
+//	    fmt.Printf("%s: %d txs\n", day.Date, len(day.Items))
+//	}
+func BucketByUTCDay[T any](items []T, at func(T) time.Time) []DayBucket[T] {
+	index := make(map[string]int)
+	var buckets []DayBucket[T]
+	for _, item := range items {
+		date := UTCDate(at(item))
+		i, ok := index[date]
+		if !ok {
+			i = len(buckets)
+			index[date] = i
+			buckets = append(buckets, DayBucket[T]{Date: date})
+		}
+		buckets[i].Items = append(buckets[i].Items, item)
+	}
+	slices.SortStableFunc(buckets, func(a, b DayBucket[T]) int {
+		return strings.Compare(a.Date, b.Date)
+	})
+	return buckets
+}
@@ -0,0 +1,56 @@
+package etherscan
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseTimestamp(t *testing.T) {
+	want := time.Date(2023, 11, 14, 22, 13, 20, 0, time.UTC)
+	for _, s := range []string{"1700000000", " 1700000000 ", "0x6553f100", "0X6553F100", "2023-11-14 22:13:20", "2023-11-14T23:13:20+01:00"} {
+		got, err := ParseTimestamp(s)
+		if err != nil {
+			t.Errorf("ParseTimestamp(%q) failed: %v", s, err)
+			continue
+		}
+		if !got.Equal(want) || got.Location() != time.UTC {
+			t.Errorf("ParseTimestamp(%q) = %s, want %s", s, got, want)
+		}
+	}
+
+	day, err := ParseTimestamp("2023-11-14")
+	if err != nil || !day.Equal(time.Date(2023, 11, 14, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected date: %s, %v", day, err)
+	}
+
+	for _, s := range []string{"", "0x", "0xzz", "yesterday", "14/11/2023"} {
+		if _, err := ParseTimestamp(s); err == nil {
+			t.Errorf("expected an error for %q", s)
+		}
+	}
+}
+
+func TestUTCDays(t *testing.T) {
+	// 23:30 on Jan 1 in New York is already Jan 2 in UTC
+	ny := time.FixedZone("EST", -5*3600)
+	late := time.Date(2024, 1, 1, 23, 30, 0, 0, ny)
+	if got := UTCDate(late); got != "2024-01-02" {
+		t.Errorf("expected 2024-01-02, got %s", got)
+	}
+	if got := UTCDayStart(late); !got.Equal(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected day start %s", got)
+	}
+
+	times := []time.Time{
+		time.Date(2024, 1, 2, 1, 0, 0, 0, time.UTC),
+		late,
+		time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+	}
+	buckets := BucketByUTCDay(times, func(at time.Time) time.Time { return at })
+	if len(buckets) != 2 || buckets[0].Date != "2024-01-01" || buckets[1].Date != "2024-01-02" {
+		t.Fatalf("unexpected buckets: %+v", buckets)
+	}
+	if len(buckets[0].Items) != 1 || len(buckets[1].Items) != 2 || !buckets[1].Items[1].Equal(late) {
+		t.Errorf("unexpected bucket items: %+v", buckets)
+	}
+}
@@ -3,7 +3,9 @@ package etherscan
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"strconv"
+	"strings"
 )
 
 // ============================================================================
@@ -19,11 +21,15 @@ type GetERC20TotalSupplyOpts struct {
 	// OnLimitExceeded specifies behavior when rate limit is exceeded
 	// Default: RateLimitBlock (wait until a token is available)
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // GetERC20TotalSupply returns the current amount of an ERC-20 token in circulation
 //
-// This endpoint returns the total supply of an ERC-20 token in hex format.
+// This endpoint returns the total supply of an ERC-20 token as a decimal string.
 // The total supply represents the total number of tokens that have been minted.
 //
 // Args:
@@ -32,7 +38,7 @@ type GetERC20TotalSupplyOpts struct {
 //   - opts: Optional parameters (can be nil)
 //
 // Returns:
-//   - string: The total supply in hex format (e.g., "0x1b1ae4d6e2ef500000")
+//   - string: The total supply as a decimal string (e.g., "500000000000000000000")
 //   - error: Error if the request fails
 //
 // Example:
@@ -46,7 +52,7 @@ type GetERC20TotalSupplyOpts struct {
 //	fmt.Printf("Total supply: %s\n", supply)
 //
 // Note:
-//   - Returns supply in hex format with "0x" prefix
+//   - Returns a decimal string; some explorers answer in hex, use GetERC20TotalSupplyBig to handle both
 //   - Value is in the token's smallest unit
 //   - Use token decimals to convert to human-readable format
 func (c *HTTPClient) GetERC20TotalSupply(ctx context.Context, contractAddress string, opts *GetERC20TotalSupplyOpts) (string, error) {
@@ -61,8 +67,10 @@ func (c *HTTPClient) GetERC20TotalSupply(ctx context.Context, contractAddress st
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -72,6 +80,7 @@ func (c *HTTPClient) GetERC20TotalSupply(ctx context.Context, contractAddress st
 		params:          params,
 		noFoundReturn:   "0",
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return "", err
@@ -85,6 +94,14 @@ func (c *HTTPClient) GetERC20TotalSupply(ctx context.Context, contractAddress st
 
 // GetERC20AccountBalanceOpts contains optional parameters for GetERC20AccountBalance
 type GetERC20AccountBalanceOpts struct {
+	// Tag specifies the block parameter to get balance at
+	// Default: "latest"
+	// Options:
+	//   - "latest": Get balance at the most recent block (default)
+	//   - "earliest": Get balance at the earliest block
+	//   - "pending": Get balance at the pending block
+	Tag BlockTag `default:"latest" json:"tag"`
+
 	// ChainID specifies which blockchain network to query
 	// Default: empty (uses client default)
 	ChainID int64 `json:"chainid"`
@@ -92,6 +109,10 @@ type GetERC20AccountBalanceOpts struct {
 	// OnLimitExceeded specifies behavior when rate limit is exceeded
 	// Default: RateLimitBlock (wait until a token is available)
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // GetERC20AccountBalance returns the current balance of an ERC-20 token of an address
@@ -106,7 +127,7 @@ type GetERC20AccountBalanceOpts struct {
 //   - opts: Optional parameters (can be nil)
 //
 // Returns:
-//   - string: The token balance in hex format
+//   - string: The token balance as a decimal string
 //   - error: Error if the request fails
 //
 // Example:
@@ -121,15 +142,19 @@ type GetERC20AccountBalanceOpts struct {
 //	fmt.Printf("Token balance: %s\n", balance)
 //
 // Note:
-//   - Returns balance in hex format
+//   - Returns a decimal string; some explorers answer in hex, use GetERC20AccountBalanceBig to handle both
 //   - Value is in the token's smallest unit
 //   - Use token decimals to convert to human-readable format
+//   - Tag must be latest, earliest or pending; use GetERC20HistoricalAccountBalance for a past block
 func (c *HTTPClient) GetERC20AccountBalance(ctx context.Context, contractAddress, address string, opts *GetERC20AccountBalanceOpts) (string, error) {
 	// Apply defaults and extract API parameters
 	params, err := ApplyDefaultsAndExtractParams(opts)
 	if err != nil {
 		return "", err
 	}
+	if err := checkBalanceTag(BlockTag(params["tag"])); err != nil {
+		return "", err
+	}
 
 	// Add required parameters
 	params["contractaddress"] = contractAddress
@@ -137,8 +162,10 @@ func (c *HTTPClient) GetERC20AccountBalance(ctx context.Context, contractAddress
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -148,6 +175,7 @@ func (c *HTTPClient) GetERC20AccountBalance(ctx context.Context, contractAddress
 		params:          params,
 		noFoundReturn:   "0",
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return "", err
@@ -168,6 +196,10 @@ type GetERC20HistoricalTotalSupplyOpts struct {
 	// OnLimitExceeded specifies behavior when rate limit is exceeded
 	// Default: RateLimitBlock (wait until a token is available)
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // GetERC20HistoricalTotalSupply returns the amount of an ERC-20 token in circulation at a certain block height
@@ -182,7 +214,7 @@ type GetERC20HistoricalTotalSupplyOpts struct {
 //   - opts: Optional parameters (can be nil)
 //
 // Returns:
-//   - string: The historical total supply in hex format
+//   - string: The historical total supply as a decimal string
 //   - error: Error if the request fails
 //
 // Example:
@@ -198,7 +230,7 @@ type GetERC20HistoricalTotalSupplyOpts struct {
 //
 // Note:
 //   - This endpoint is throttled to 2 calls/second regardless of API Pro tier
-//   - Returns supply in hex format
+//   - Returns a decimal string; some explorers answer in hex, use GetERC20HistoricalTotalSupplyBig to handle both
 //   - Value is in the token's smallest unit
 func (c *HTTPClient) GetERC20HistoricalTotalSupply(ctx context.Context, contractAddress string, blockNo int64, opts *GetERC20HistoricalTotalSupplyOpts) (string, error) {
 	// Apply defaults and extract API parameters
@@ -213,8 +245,10 @@ func (c *HTTPClient) GetERC20HistoricalTotalSupply(ctx context.Context, contract
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -224,6 +258,7 @@ func (c *HTTPClient) GetERC20HistoricalTotalSupply(ctx context.Context, contract
 		params:          params,
 		noFoundReturn:   "0",
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return "", err
@@ -244,6 +279,10 @@ type GetERC20HistoricalAccountBalanceOpts struct {
 	// OnLimitExceeded specifies behavior when rate limit is exceeded
 	// Default: RateLimitBlock (wait until a token is available)
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // GetERC20HistoricalAccountBalance returns the balance of an ERC-20 token of an address at a certain block height
@@ -259,7 +298,7 @@ type GetERC20HistoricalAccountBalanceOpts struct {
 //   - opts: Optional parameters (can be nil)
 //
 // Returns:
-//   - string: The historical token balance in hex format
+//   - string: The historical token balance as a decimal string
 //   - error: Error if the request fails
 //
 // Example:
@@ -276,7 +315,7 @@ type GetERC20HistoricalAccountBalanceOpts struct {
 //
 // Note:
 //   - This endpoint is throttled to 2 calls/second regardless of API Pro tier
-//   - Returns balance in hex format
+//   - Returns a decimal string; some explorers answer in hex, use GetERC20HistoricalAccountBalanceBig to handle both
 //   - Value is in the token's smallest unit
 func (c *HTTPClient) GetERC20HistoricalAccountBalance(ctx context.Context, contractAddress, address string, blockNo int64, opts *GetERC20HistoricalAccountBalanceOpts) (string, error) {
 	// Apply defaults and extract API parameters
@@ -292,8 +331,10 @@ func (c *HTTPClient) GetERC20HistoricalAccountBalance(ctx context.Context, contr
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -303,6 +344,7 @@ func (c *HTTPClient) GetERC20HistoricalAccountBalance(ctx context.Context, contr
 		params:          params,
 		noFoundReturn:   "0",
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return "", err
@@ -331,6 +373,14 @@ type GetERC20HoldersOpts struct {
 	// OnLimitExceeded specifies behavior when rate limit is exceeded
 	// Default: RateLimitBlock (wait until a token is available)
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
+
+	// PageInfo, if set, receives the pagination state of the returned page, see PageInfo
+	// Default: nil
+	PageInfo *PageInfo `json:"-"`
 }
 
 // GetERC20Holders returns the current ERC20 token holders and number of tokens held
@@ -374,8 +424,12 @@ func (c *HTTPClient) GetERC20Holders(ctx context.Context, contractAddress string
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
+	var pageInfo *PageInfo
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
+		pageInfo = opts.PageInfo
 	}
 
 	data, err := c.request(requestParams{
@@ -385,15 +439,17 @@ func (c *HTTPClient) GetERC20Holders(ctx context.Context, contractAddress string
 		params:          params,
 		noFoundReturn:   []RespERC20HolderInfo{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result []RespERC20HolderInfo
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
+	recordPageInfo(pageInfo, params, result)
 	return result, nil
 }
 
@@ -405,6 +461,10 @@ type GetERC20HolderCountOpts struct {
 	// OnLimitExceeded specifies behavior when rate limit is exceeded
 	// Default: RateLimitBlock (wait until a token is available)
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // GetERC20HolderCount returns the total number of holders for an ERC-20 token
@@ -418,7 +478,7 @@ type GetERC20HolderCountOpts struct {
 //   - opts: Optional parameters (can be nil)
 //
 // Returns:
-//   - string: The total number of token holders in hex format
+//   - string: The total number of token holders as a decimal string
 //   - error: Error if the request fails
 //
 // Example:
@@ -432,7 +492,7 @@ type GetERC20HolderCountOpts struct {
 //	fmt.Printf("Total holders: %s\n", count)
 //
 // Note:
-//   - Returns count in hex format
+//   - Returns a decimal string
 //   - Useful for token distribution analysis
 func (c *HTTPClient) GetERC20HolderCount(ctx context.Context, contractAddress string, opts *GetERC20HolderCountOpts) (string, error) {
 	// Apply defaults and extract API parameters
@@ -446,8 +506,10 @@ func (c *HTTPClient) GetERC20HolderCount(ctx context.Context, contractAddress st
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -457,6 +519,7 @@ func (c *HTTPClient) GetERC20HolderCount(ctx context.Context, contractAddress st
 		params:          params,
 		noFoundReturn:   "0",
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return "", err
@@ -468,6 +531,90 @@ func (c *HTTPClient) GetERC20HolderCount(ctx context.Context, contractAddress st
 	return fmt.Sprintf("%v", data), nil
 }
 
+// GetERC20HolderDistributionOpts contains optional parameters for GetERC20HolderDistribution
+type GetERC20HolderDistributionOpts struct {
+	// ChainID specifies which blockchain network to query
+	// Default: empty (uses client default)
+	ChainID int64 `json:"chainid"`
+
+	// OnLimitExceeded specifies behavior when rate limit is exceeded
+	// Default: RateLimitBlock (wait until a token is available)
+	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
+}
+
+// GetERC20HolderDistribution returns the holder count of an ERC-20 token over time
+//
+// This endpoint returns the daily number of holders for an ERC-20 token over the
+// requested range. This is useful for charting adoption and spotting airdrop or
+// distribution events.
+//
+// Args:
+//   - ctx: Context for request cancellation and timeout
+//   - contractAddress: The contract address of the ERC-20 token
+//   - timeRange: The period to chart, passed as the "range" parameter (e.g., "30d")
+//   - opts: Optional parameters (can be nil)
+//
+// Returns:
+//   - []RespERC20HolderChartPoint: Holder count per day, oldest first
+//   - error: Error if the request fails
+//
+// Example:
+//
+//	// Get USDT holder count for the last 30 days
+//	points, err := client.GetERC20HolderDistribution(ctx, "0xdac17f958d2ee523a2206206994597c13d831ec7", "30d", nil)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, point := range points {
+//	    fmt.Printf("%s: %s holders\n", point.UTCDate, point.HolderCount)
+//	}
+//
+// Note:
+//   - This endpoint requires API Pro subscription
+//   - Returns empty slice if no data is available for the range
+func (c *HTTPClient) GetERC20HolderDistribution(ctx context.Context, contractAddress, timeRange string, opts *GetERC20HolderDistributionOpts) ([]RespERC20HolderChartPoint, error) {
+	// Apply defaults and extract API parameters
+	params, err := ApplyDefaultsAndExtractParams(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add required parameters
+	params["contractaddress"] = contractAddress
+	params["range"] = timeRange
+
+	// Handle rate limiting
+	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
+	if opts != nil {
+		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
+	}
+
+	data, err := c.request(requestParams{
+		ctx:             ctx,
+		module:          "token",
+		action:          "tokenholderchart",
+		params:          params,
+		noFoundReturn:   []RespERC20HolderChartPoint{},
+		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var result []RespERC20HolderChartPoint
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 // GetTopERC20HoldersOpts contains optional parameters for GetTopERC20Holders
 type GetTopERC20HoldersOpts struct {
 	// ChainID specifies which blockchain network to query
@@ -477,6 +624,10 @@ type GetTopERC20HoldersOpts struct {
 	// OnLimitExceeded specifies behavior when rate limit is exceeded
 	// Default: RateLimitBlock (wait until a token is available)
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // GetTopERC20Holders returns the top token holders of an ERC-20 token
@@ -528,8 +679,10 @@ func (c *HTTPClient) GetTopERC20Holders(ctx context.Context, contractAddress str
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -539,13 +692,14 @@ func (c *HTTPClient) GetTopERC20Holders(ctx context.Context, contractAddress str
 		params:          params,
 		noFoundReturn:   []RespTopTokenHolder{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result []RespTopTokenHolder
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
 	return result, nil
@@ -560,6 +714,10 @@ type GetTokenInfoOpts struct {
 	// OnLimitExceeded specifies behavior when rate limit is exceeded
 	// Default: RateLimitBlock (wait until a token is available)
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // GetTokenInfo returns project information and social media links of an ERC20/ERC721/ERC1155 token
@@ -604,8 +762,10 @@ func (c *HTTPClient) GetTokenInfo(ctx context.Context, contractAddress string, o
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -615,6 +775,7 @@ func (c *HTTPClient) GetTokenInfo(ctx context.Context, contractAddress string, o
 		params:          params,
 		noFoundReturn:   RespTokenInfo{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
@@ -622,10 +783,10 @@ func (c *HTTPClient) GetTokenInfo(ctx context.Context, contractAddress string, o
 
 	// The API returns a list, but we only need the first element
 	var resultList []RespTokenInfo
-	if err := unmarshalResponse(data, &resultList); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &resultList); err != nil {
 		// Try unmarshaling as single object
 		var result RespTokenInfo
-		if err := unmarshalResponse(data, &result); err != nil {
+		if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 			return nil, err
 		}
 		return &result, nil
@@ -655,6 +816,14 @@ type GetAccountERC20HoldingsOpts struct {
 	// OnLimitExceeded specifies behavior when rate limit is exceeded
 	// Default: RateLimitBlock (wait until a token is available)
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
+
+	// PageInfo, if set, receives the pagination state of the returned page, see PageInfo
+	// Default: nil
+	PageInfo *PageInfo `json:"-"`
 }
 
 // GetAccountERC20Holdings returns the ERC-20 tokens and amount held by an address
@@ -698,8 +867,12 @@ func (c *HTTPClient) GetAccountERC20Holdings(ctx context.Context, address string
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
+	var pageInfo *PageInfo
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
+		pageInfo = opts.PageInfo
 	}
 
 	data, err := c.request(requestParams{
@@ -709,15 +882,17 @@ func (c *HTTPClient) GetAccountERC20Holdings(ctx context.Context, address string
 		params:          params,
 		noFoundReturn:   []RespERC20Holding{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result []RespERC20Holding
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
+	recordPageInfo(pageInfo, params, result)
 	return result, nil
 }
 
@@ -738,6 +913,14 @@ type GetAccountNFTHoldingsOpts struct {
 	// OnLimitExceeded specifies behavior when rate limit is exceeded
 	// Default: RateLimitBlock (wait until a token is available)
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
+
+	// PageInfo, if set, receives the pagination state of the returned page, see PageInfo
+	// Default: nil
+	PageInfo *PageInfo `json:"-"`
 }
 
 // GetAccountNFTHoldings returns the ERC-721 tokens and amount held by an address
@@ -781,8 +964,12 @@ func (c *HTTPClient) GetAccountNFTHoldings(ctx context.Context, address string,
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
+	var pageInfo *PageInfo
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
+		pageInfo = opts.PageInfo
 	}
 
 	data, err := c.request(requestParams{
@@ -792,15 +979,17 @@ func (c *HTTPClient) GetAccountNFTHoldings(ctx context.Context, address string,
 		params:          params,
 		noFoundReturn:   []RespNFTHolding{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result []RespNFTHolding
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
+	recordPageInfo(pageInfo, params, result)
 	return result, nil
 }
 
@@ -821,6 +1010,14 @@ type GetAccountNFTInventoriesOpts struct {
 	// OnLimitExceeded specifies behavior when rate limit is exceeded
 	// Default: RateLimitBlock (wait until a token is available)
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
+
+	// PageInfo, if set, receives the pagination state of the returned page, see PageInfo
+	// Default: nil
+	PageInfo *PageInfo `json:"-"`
 }
 
 // GetAccountNFTInventories returns the ERC-721 token inventory of an address, filtered by contract address
@@ -868,8 +1065,12 @@ func (c *HTTPClient) GetAccountNFTInventories(ctx context.Context, address, cont
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
+	var pageInfo *PageInfo
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
+		pageInfo = opts.PageInfo
 	}
 
 	data, err := c.request(requestParams{
@@ -879,14 +1080,89 @@ func (c *HTTPClient) GetAccountNFTInventories(ctx context.Context, address, cont
 		params:          params,
 		noFoundReturn:   []RespNFTTokenInventory{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result []RespNFTTokenInventory
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
+	recordPageInfo(pageInfo, params, result)
 	return result, nil
 }
+
+// ============================================================================
+// Typed Token Amounts
+// ============================================================================
+
+// ParseQuantity parses a non-negative integer given in decimal or as "0x"-prefixed hex
+//
+// The token endpoints return decimal strings on Etherscan but hex quantities
+// on some explorers of the same API, so amounts are parsed from either form.
+//
+// Example:
+//
+//	n, _ := etherscan.ParseQuantity("1000000")  // 1000000
+//	n, _ = etherscan.ParseQuantity("0xf4240")   // 1000000
+func ParseQuantity(s string) (*big.Int, error) {
+	s = strings.TrimSpace(s)
+	digits, base := s, 10
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		digits, base = s[2:], 16
+	}
+	if digits == "" || digits[0] == '-' || digits[0] == '+' {
+		return nil, fmt.Errorf("etherscan: invalid quantity %q", s)
+	}
+	n, ok := new(big.Int).SetString(digits, base)
+	if !ok {
+		return nil, fmt.Errorf("etherscan: invalid quantity %q", s)
+	}
+	return n, nil
+}
+
+// GetERC20TotalSupplyBig is GetERC20TotalSupply returning the supply as a *big.Int
+//
+// Example:
+//
+//	supply, err := client.GetERC20TotalSupplyBig(ctx, usdcAddr, nil)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("Total supply: %s\n", supply)
+func (c *HTTPClient) GetERC20TotalSupplyBig(ctx context.Context, contractAddress string, opts *GetERC20TotalSupplyOpts) (*big.Int, error) {
+	raw, err := c.GetERC20TotalSupply(ctx, contractAddress, opts)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQuantity(raw)
+}
+
+// GetERC20AccountBalanceBig is GetERC20AccountBalance returning the balance as a *big.Int
+func (c *HTTPClient) GetERC20AccountBalanceBig(ctx context.Context, contractAddress, address string, opts *GetERC20AccountBalanceOpts) (*big.Int, error) {
+	raw, err := c.GetERC20AccountBalance(ctx, contractAddress, address, opts)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQuantity(raw)
+}
+
+// GetERC20HistoricalTotalSupplyBig is GetERC20HistoricalTotalSupply returning the supply as a *big.Int
+func (c *HTTPClient) GetERC20HistoricalTotalSupplyBig(ctx context.Context, contractAddress string, blockNo int64, opts *GetERC20HistoricalTotalSupplyOpts) (*big.Int, error) {
+	raw, err := c.GetERC20HistoricalTotalSupply(ctx, contractAddress, blockNo, opts)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQuantity(raw)
+}
+
+// GetERC20HistoricalAccountBalanceBig is GetERC20HistoricalAccountBalance returning the balance as a *big.Int
+func (c *HTTPClient) GetERC20HistoricalAccountBalanceBig(ctx context.Context, contractAddress, address string, blockNo int64, opts *GetERC20HistoricalAccountBalanceOpts) (*big.Int, error) {
+	raw, err := c.GetERC20HistoricalAccountBalance(ctx, contractAddress, address, blockNo, opts)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQuantity(raw)
+}
@@ -2,6 +2,8 @@ package etherscan
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 )
@@ -135,6 +137,25 @@ func TestGetERC20HolderCount(t *testing.T) {
 	}
 }
 
+func TestGetERC20HolderDistribution(t *testing.T) {
+	config := GetTestConfig(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Test with USDT contract
+	points, err := config.Client.GetERC20HolderDistribution(ctx, TestAddresses.USDTContract, "30d", &GetERC20HolderDistributionOpts{
+		ChainID: 1, // Ethereum mainnet
+	})
+	if err != nil {
+		t.Fatalf("GetERC20HolderDistribution failed: %v", err)
+	}
+
+	t.Logf("Got %d holder chart points", len(points))
+	if len(points) > 0 {
+		t.Logf("First point: %s - %s holders", points[0].UTCDate, points[0].HolderCount)
+	}
+}
+
 func TestGetTopERC20Holders(t *testing.T) {
 	config := GetTestConfig(t)
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
@@ -387,3 +408,60 @@ func TestGetERC20AccountBalanceWithDifferentChain(t *testing.T) {
 		t.Logf("USDT balance on Polygon: %s", balance)
 	}
 }
+
+func TestParseQuantity(t *testing.T) {
+	cases := map[string]string{
+		"1000000":               "1000000",
+		"0xf4240":               "1000000",
+		"0X0":                   "0",
+		" 42 ":                  "42",
+		"0x1b1ae4d6e2ef500000":  "500000000000000000000",
+		"500000000000000000000": "500000000000000000000",
+	}
+	for in, want := range cases {
+		got, err := ParseQuantity(in)
+		if err != nil || got.String() != want {
+			t.Errorf("ParseQuantity(%q) = %v, %v; want %s", in, got, err, want)
+		}
+	}
+	for _, in := range []string{"", "0x", "-1", "0x-1", "12ab", "1.5"} {
+		if _, err := ParseQuantity(in); err == nil {
+			t.Errorf("ParseQuantity(%q) should fail", in)
+		}
+	}
+}
+
+func TestGetERC20AmountsBig(t *testing.T) {
+	results := map[string]string{
+		"tokensupply":         "500000000000000000000",
+		"tokenbalance":        "0x1b1ae4d6e2ef500000",
+		"tokensupplyhistory":  "0x0",
+		"tokenbalancehistory": "bogus",
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":"1","message":"OK","result":"` + results[r.URL.Query().Get("action")] + `"}`))
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+	})
+	ctx := context.Background()
+
+	supply, err := client.GetERC20TotalSupplyBig(ctx, testContract, nil)
+	if err != nil || supply.String() != "500000000000000000000" {
+		t.Errorf("unexpected decimal supply: %v, %v", supply, err)
+	}
+	balance, err := client.GetERC20AccountBalanceBig(ctx, testContract, testAddr, nil)
+	if err != nil || balance.String() != "500000000000000000000" {
+		t.Errorf("unexpected hex balance: %v, %v", balance, err)
+	}
+	historical, err := client.GetERC20HistoricalTotalSupplyBig(ctx, testContract, 100, nil)
+	if err != nil || historical.Sign() != 0 {
+		t.Errorf("unexpected historical supply: %v, %v", historical, err)
+	}
+	if _, err := client.GetERC20HistoricalAccountBalanceBig(ctx, testContract, testAddr, 100, nil); err == nil {
+		t.Error("expected an invalid balance to fail")
+	}
+}
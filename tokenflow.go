@@ -0,0 +1,254 @@
+package etherscan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ============================================================================
+// Token Flow by Counterparty
+// ============================================================================
+
+// CounterpartyFlow is the ERC-20 volume an address exchanged with one counterparty
+//
+// Inflow, Outflow and Net are decimal strings in whole tokens, formatted like
+// Portfolio's; the Raw amounts are in the token's smallest unit.
+type CounterpartyFlow struct {
+	// Counterparty is the lowercased address on the other side of the transfers
+	Counterparty string `json:"counterparty" bson:"counterparty"`
+
+	// Inflow is the amount received from the counterparty
+	Inflow    string   `json:"inflow" bson:"inflow"`
+	InflowRaw *big.Int `json:"inflowRaw" bson:"inflowRaw"`
+
+	// Outflow is the amount sent to the counterparty
+	Outflow    string   `json:"outflow" bson:"outflow"`
+	OutflowRaw *big.Int `json:"outflowRaw" bson:"outflowRaw"`
+
+	// Net is Inflow minus Outflow, negative if the address sent more than it received
+	Net string `json:"net" bson:"net"`
+
+	// InCount and OutCount are the number of transfers in each direction
+	InCount  int `json:"inCount" bson:"inCount"`
+	OutCount int `json:"outCount" bson:"outCount"`
+}
+
+// TokenFlowReport is the result of TokenFlowByCounterparty
+type TokenFlowReport struct {
+	Address    string `json:"address" bson:"address"`
+	Token      string `json:"token" bson:"token"`
+	StartBlock int64  `json:"startBlock" bson:"startBlock"`
+	EndBlock   int64  `json:"endBlock" bson:"endBlock"`
+
+	// TokenSymbol and TokenDecimals are taken from the transfers, empty and 0 if there are none
+	TokenSymbol   string `json:"tokenSymbol" bson:"tokenSymbol"`
+	TokenDecimals int64  `json:"tokenDecimals" bson:"tokenDecimals"`
+
+	// TotalInflow, TotalOutflow and Net cover every counterparty, including those cut by TopN
+	TotalInflow  string `json:"totalInflow" bson:"totalInflow"`
+	TotalOutflow string `json:"totalOutflow" bson:"totalOutflow"`
+	Net          string `json:"net" bson:"net"`
+
+	// Counterparties are ranked by total volume (inflow plus outflow), largest first
+	Counterparties []CounterpartyFlow `json:"counterparties" bson:"counterparties"`
+
+	// CounterpartyCount is the number of counterparties before TopN was applied
+	CounterpartyCount int `json:"counterpartyCount" bson:"counterpartyCount"`
+}
+
+// TokenFlowByCounterpartyOpts contains optional parameters for TokenFlowByCounterparty
+type TokenFlowByCounterpartyOpts struct {
+	// TopN keeps only the counterparties with the largest volume
+	// Default: 0 (keep all counterparties)
+	TopN int `default:"0"`
+
+	// ChainID specifies which blockchain network to query
+	// Default: empty (uses client default)
+	ChainID int64
+
+	// OnLimitExceeded specifies behavior when rate limit is exceeded
+	// Default: RateLimitBlock (wait until a token is available)
+	OnLimitExceeded RateLimitBehavior `default:""`
+}
+
+// TokenFlowByCounterparty aggregates the ERC-20 transfers of an address into inflow and outflow per counterparty
+//
+// The time window is resolved to blocks with GetBlockNumberByTimestamp, every
+// transfer of token involving address in that range is fetched, and the
+// amounts are summed per counterparty and direction with the token's decimals
+// applied. Counterparties are ranked by total volume.
+//
+// Args:
+//   - ctx: Context for request cancellation and timeout
+//   - address: The address whose token flows are aggregated
+//   - token: ERC-20 token contract address
+//   - from: Start of the time window
+//   - to: End of the time window
+//   - opts: Optional parameters (can be nil)
+//
+// Returns:
+//   - *TokenFlowReport: Totals and per-counterparty flows
+//   - error: Error if any request fails or a transfer is malformed; with
+//     ErrBudgetExhausted the report covers the transfers fetched before it
+//
+// Example:
+//
+//	to := time.Now()
+//	report, err := client.TokenFlowByCounterparty(ctx, hotWallet, usdc, to.AddDate(0, 0, -7), to, &TokenFlowByCounterpartyOpts{
+//	    TopN: 10,
+//	})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, flow := range report.Counterparties {
+//	    fmt.Printf("%s in %s out %s net %s %s\n", flow.Counterparty, flow.Inflow, flow.Outflow, flow.Net, report.TokenSymbol)
+//	}
+//
+// Note:
+//   - Self-transfers are ignored
+//   - Costs two block lookups and one call per 1000 transfers
+func (c *HTTPClient) TokenFlowByCounterparty(ctx context.Context, address, token string, from, to time.Time, opts *TokenFlowByCounterpartyOpts) (*TokenFlowReport, error) {
+	if opts == nil {
+		opts = &TokenFlowByCounterpartyOpts{}
+	}
+	if err := ApplyDefaults(opts); err != nil {
+		return nil, err
+	}
+	if to.Before(from) {
+		return nil, fmt.Errorf("etherscan: invalid time window, %s is after %s", from, to)
+	}
+	if opts.TopN < 0 {
+		return nil, fmt.Errorf("etherscan: invalid top N %d", opts.TopN)
+	}
+
+	startBlock, err := c.GetBlockNumberByTimestamp(ctx, from.Unix(), ClosestAfter, &GetBlockNumberByTimestampOpts{
+		ChainID:         opts.ChainID,
+		OnLimitExceeded: opts.OnLimitExceeded,
+	})
+	if err != nil {
+		return nil, err
+	}
+	endBlock, err := c.GetBlockNumberByTimestamp(ctx, to.Unix(), ClosestBefore, &GetBlockNumberByTimestampOpts{
+		ChainID:         opts.ChainID,
+		OnLimitExceeded: opts.OnLimitExceeded,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	address = strings.ToLower(address)
+	report := &TokenFlowReport{
+		Address:    address,
+		Token:      strings.ToLower(token),
+		StartBlock: int64(startBlock),
+		EndBlock:   int64(endBlock),
+	}
+	transfers := NewSpillBuffer[RespERC20TokenTransfer](c.resultMemoryBudget, c.spillDir)
+	if startBlock >= 0 && endBlock >= 0 && startBlock <= endBlock {
+		transfers, err = spillLogsByRange(ctx, c, report.StartBlock, report.EndBlock, func(fromBlock, toBlock, page int64) ([]RespERC20TokenTransfer, error) {
+			return c.GetERC20TokenTransfers(ctx, &GetERC20TokenTransfersOpts{
+				Address:         address,
+				ContractAddress: token,
+				StartBlock:      fromBlock,
+				EndBlock:        toBlock,
+				Page:            page,
+				Offset:          logsPerCall,
+				Sort:            SortAsc,
+				ChainID:         opts.ChainID,
+				OnLimitExceeded: opts.OnLimitExceeded,
+			})
+		})
+		defer transfers.Close()
+		// An exhausted WithMaxRequests budget still reports the transfers fetched so far
+		if err != nil && !errors.Is(err, ErrBudgetExhausted) {
+			return nil, err
+		}
+	}
+	if err := aggregateTokenFlows(report, transfers, opts.TopN); err != nil {
+		return nil, err
+	}
+	return report, err
+}
+
+// aggregateTokenFlows fills in the totals and ranked counterparties of report from transfers
+func aggregateTokenFlows(report *TokenFlowReport, transfers *SpillBuffer[RespERC20TokenTransfer], topN int) error {
+	flows := make(map[string]*CounterpartyFlow)
+	totalIn, totalOut := new(big.Int), new(big.Int)
+	first := true
+	err := transfers.Each(func(t RespERC20TokenTransfer) error {
+		if first {
+			first = false
+			decimals, err := strconv.ParseInt(strings.TrimSpace(t.TokenDecimal), 10, 64)
+			if err != nil || decimals < 0 {
+				return fmt.Errorf("etherscan: invalid token decimals %q in tx %s", t.TokenDecimal, t.Hash)
+			}
+			report.TokenSymbol = t.TokenSymbol
+			report.TokenDecimals = decimals
+		}
+		value, ok := new(big.Int).SetString(strings.TrimSpace(t.Value), 10)
+		if !ok {
+			return fmt.Errorf("etherscan: invalid transfer value %q in tx %s", t.Value, t.Hash)
+		}
+
+		direction, peer := TransferDirectionOf(report.Address, t.From, t.To)
+		if direction == TransferSelf || direction == TransferUnrelated {
+			return nil
+		}
+		incoming := direction == TransferIn
+
+		flow, ok := flows[peer]
+		if !ok {
+			flow = &CounterpartyFlow{Counterparty: peer, InflowRaw: new(big.Int), OutflowRaw: new(big.Int)}
+			flows[peer] = flow
+		}
+		if incoming {
+			flow.InflowRaw.Add(flow.InflowRaw, value)
+			flow.InCount++
+			totalIn.Add(totalIn, value)
+		} else {
+			flow.OutflowRaw.Add(flow.OutflowRaw, value)
+			flow.OutCount++
+			totalOut.Add(totalOut, value)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	scale := func(raw *big.Int) string {
+		divisor := new(big.Int).Exp(big.NewInt(10), big.NewInt(report.TokenDecimals), nil)
+		return formatRat(new(big.Rat).SetFrac(raw, divisor))
+	}
+	report.TotalInflow = scale(totalIn)
+	report.TotalOutflow = scale(totalOut)
+	report.Net = scale(new(big.Int).Sub(totalIn, totalOut))
+
+	volume := func(f *CounterpartyFlow) *big.Int {
+		return new(big.Int).Add(f.InflowRaw, f.OutflowRaw)
+	}
+	report.Counterparties = make([]CounterpartyFlow, 0, len(flows))
+	for _, flow := range flows {
+		flow.Inflow = scale(flow.InflowRaw)
+		flow.Outflow = scale(flow.OutflowRaw)
+		flow.Net = scale(new(big.Int).Sub(flow.InflowRaw, flow.OutflowRaw))
+		report.Counterparties = append(report.Counterparties, *flow)
+	}
+	slices.SortFunc(report.Counterparties, func(a, b CounterpartyFlow) int {
+		if cmp := volume(&b).Cmp(volume(&a)); cmp != 0 {
+			return cmp
+		}
+		return strings.Compare(a.Counterparty, b.Counterparty)
+	})
+	report.CounterpartyCount = len(report.Counterparties)
+	if topN > 0 && len(report.Counterparties) > topN {
+		report.Counterparties = report.Counterparties[:topN]
+	}
+	return nil
+}
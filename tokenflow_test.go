@@ -0,0 +1,85 @@
+package etherscan
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestTokenFlowByCounterparty(t *testing.T) {
+	var tokenQuery string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		switch query.Get("action") {
+		case "getblocknobytime":
+			if query.Get("closest") == "after" {
+				w.Write([]byte(`{"status":"1","message":"OK","result":"100"}`))
+			} else {
+				w.Write([]byte(`{"status":"1","message":"OK","result":"200"}`))
+			}
+		case "tokentx":
+			tokenQuery = query.Get("contractaddress") + "/" + query.Get("startblock") + "-" + query.Get("endblock")
+			w.Write([]byte(`{"status":"1","message":"OK","result":[
+				{"hash":"0x1","from":"0xEEEEEEEEEEEEEEEEEEEEEEEEEEEEEEEEEEEEEEEE","to":"0xAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA","value":"1500000","tokenSymbol":"USDC","tokenDecimal":"6"},
+				{"hash":"0x2","from":"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa","to":"0xeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee","value":"500000","tokenSymbol":"USDC","tokenDecimal":"6"},
+				{"hash":"0x3","from":"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa","to":"0xffffffffffffffffffffffffffffffffffffffff","value":"4000000","tokenSymbol":"USDC","tokenDecimal":"6"},
+				{"hash":"0x4","from":"0xdddddddddddddddddddddddddddddddddddddddd","to":"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa","value":"1","tokenSymbol":"USDC","tokenDecimal":"6"},
+				{"hash":"0x5","from":"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa","to":"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa","value":"7000000","tokenSymbol":"USDC","tokenDecimal":"6"}]}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+	})
+	to := time.Unix(1704153600, 0)
+	report, err := client.TokenFlowByCounterparty(context.Background(), "0xAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA", testContract, to.AddDate(0, 0, -1), to, &TokenFlowByCounterpartyOpts{TopN: 2})
+	if err != nil {
+		t.Fatalf("TokenFlowByCounterparty failed: %v", err)
+	}
+	if tokenQuery != testContract+"/100-200" {
+		t.Errorf("unexpected tokentx query %s", tokenQuery)
+	}
+	if report.TokenSymbol != "USDC" || report.TokenDecimals != 6 {
+		t.Errorf("unexpected token %s with %d decimals", report.TokenSymbol, report.TokenDecimals)
+	}
+	if report.TotalInflow != "1.500001" || report.TotalOutflow != "4.5" || report.Net != "-2.999999" {
+		t.Errorf("unexpected totals: in %s out %s net %s", report.TotalInflow, report.TotalOutflow, report.Net)
+	}
+	if report.CounterpartyCount != 3 || len(report.Counterparties) != 2 {
+		t.Fatalf("expected the top 2 of 3 counterparties, got %d of %d", len(report.Counterparties), report.CounterpartyCount)
+	}
+
+	friend, exchange := report.Counterparties[0], report.Counterparties[1]
+	if friend.Counterparty != "0xffffffffffffffffffffffffffffffffffffffff" || friend.Outflow != "4" || friend.Net != "-4" || friend.OutCount != 1 {
+		t.Errorf("unexpected first counterparty: %+v", friend)
+	}
+	if exchange.Counterparty != "0xeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee" || exchange.Inflow != "1.5" || exchange.Outflow != "0.5" || exchange.Net != "1" ||
+		exchange.InCount != 1 || exchange.OutCount != 1 {
+		t.Errorf("unexpected second counterparty: %+v", exchange)
+	}
+
+	// Spilling every transfer to disk aggregates to the same report
+	spillDir := t.TempDir()
+	spilling := NewHTTPClient(HTTPClientConfig{
+		APIVersion:         APIVersionV1,
+		V1BaseURLs:         map[int]string{EthereumMainnet: server.URL},
+		ResultMemoryBudget: 1,
+		SpillDir:           spillDir,
+	})
+	spilled, err := spilling.TokenFlowByCounterparty(context.Background(), "0xAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA", testContract, to.AddDate(0, 0, -1), to, &TokenFlowByCounterpartyOpts{TopN: 2})
+	if err != nil {
+		t.Fatalf("TokenFlowByCounterparty with spilling failed: %v", err)
+	}
+	if !reflect.DeepEqual(spilled, report) {
+		t.Errorf("expected the spilled report to match, got %+v and %+v", spilled, report)
+	}
+	if entries, _ := os.ReadDir(spillDir); len(entries) != 0 {
+		t.Errorf("expected the spill segments to be removed, got %d entries", len(entries))
+	}
+}
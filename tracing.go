@@ -0,0 +1,105 @@
+package etherscan
+
+import (
+	"context"
+	"time"
+)
+
+// ============================================================================
+// Tracing
+// ============================================================================
+
+// Span attribute keys set on every API call span
+const (
+	SpanAttrModule        = "etherscan.module"
+	SpanAttrAction        = "etherscan.action"
+	SpanAttrChainID       = "etherscan.chainid"
+	SpanAttrPage          = "etherscan.page"
+	SpanAttrAttempt       = "etherscan.attempt"
+	SpanAttrRateLimitWait = "etherscan.ratelimit_wait_ms"
+	SpanAttrHTTPStatus    = "http.response.status_code"
+
+	// SpanAttrRPCFallback is only set, to true, on proxy calls routed to HTTPClientConfig.RPCURLs
+	SpanAttrRPCFallback = "etherscan.rpc_fallback"
+
+	// SpanAttrRequestTag is only set on calls made with a WithRequestTag context
+	SpanAttrRequestTag = "etherscan.request_tag"
+)
+
+// SpanAttribute is a key/value pair attached to a span
+type SpanAttribute struct {
+	Key   string
+	Value any // string, int64 or bool
+}
+
+// Span is the subset of a tracing span used by the client
+type Span interface {
+	SetAttributes(attrs ...SpanAttribute)
+	RecordError(err error)
+	End()
+}
+
+// Tracer starts a span per API call
+//
+// The interface is intentionally small so the client does not depend on a
+// tracing SDK. An OpenTelemetry tracer is adapted in a few lines:
+//
+//	type otelTracer struct{ tracer trace.Tracer }
+//
+//	func (t otelTracer) Start(ctx context.Context, name string) (context.Context, etherscan.Span) {
+//	    ctx, span := t.tracer.Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient))
+//	    return ctx, otelSpan{span}
+//	}
+//
+//	type otelSpan struct{ trace.Span }
+//
+//	func (s otelSpan) SetAttributes(attrs ...etherscan.SpanAttribute) {
+//	    for _, a := range attrs {
+//	        switch v := a.Value.(type) {
+//	        case string:
+//	            s.Span.SetAttributes(attribute.String(a.Key, v))
+//	        case int64:
+//	            s.Span.SetAttributes(attribute.Int64(a.Key, v))
+//	        case bool:
+//	            s.Span.SetAttributes(attribute.Bool(a.Key, v))
+//	        }
+//	    }
+//	}
+//
+//	func (s otelSpan) RecordError(err error) {
+//	    s.Span.RecordError(err)
+//	    s.Span.SetStatus(codes.Error, err.Error())
+//	}
+//
+//	func (s otelSpan) End() { s.Span.End() }
+//
+//	client := etherscan.NewHTTPClient(etherscan.HTTPClientConfig{
+//	    APIKey: "YOUR_API_KEY",
+//	    Tracer: otelTracer{otel.Tracer("etherscan")},
+//	})
+//
+// The context returned by Start is used for the HTTP request, so combining this
+// with an instrumented http.Client (e.g. otelhttp) propagates the trace upstream.
+type Tracer interface {
+	Start(ctx context.Context, spanName string) (context.Context, Span)
+}
+
+// noopSpan is used when no tracer is configured
+type noopSpan struct{}
+
+func (noopSpan) SetAttributes(...SpanAttribute) {}
+func (noopSpan) RecordError(error)              {}
+func (noopSpan) End()                           {}
+
+// startSpan starts a span for one request attempt, or returns a no-op span without a tracer
+func (c *HTTPClient) startSpan(ctx context.Context, module, action string) (context.Context, Span) {
+	if c.tracer == nil {
+		return ctx, noopSpan{}
+	}
+	return c.tracer.Start(ctx, "etherscan "+module+"."+action)
+}
+
+// rateLimitWaitAttr builds the rate-limit wait attribute in milliseconds
+func rateLimitWaitAttr(wait time.Duration) SpanAttribute {
+	return SpanAttribute{Key: SpanAttrRateLimitWait, Value: wait.Milliseconds()}
+}
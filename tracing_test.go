@@ -0,0 +1,80 @@
+package etherscan
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+type recordingSpan struct {
+	mu    sync.Mutex
+	name  string
+	attrs map[string]any
+	err   error
+	ended bool
+}
+
+func (s *recordingSpan) SetAttributes(attrs ...SpanAttribute) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, a := range attrs {
+		s.attrs[a.Key] = a.Value
+	}
+}
+
+func (s *recordingSpan) RecordError(err error) { s.err = err }
+func (s *recordingSpan) End()                  { s.ended = true }
+
+type recordingTracer struct {
+	spans []*recordingSpan
+}
+
+func (t *recordingTracer) Start(ctx context.Context, name string) (context.Context, Span) {
+	span := &recordingSpan{name: name, attrs: make(map[string]any)}
+	t.spans = append(t.spans, span)
+	return ctx, span
+}
+
+func TestHTTPClient_Tracer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":"1","message":"OK","result":[]}`))
+	}))
+	defer server.Close()
+
+	tracer := &recordingTracer{}
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+		Tracer:     tracer,
+	})
+
+	if _, err := client.GetNormalTxs(context.Background(), TestAddresses.VitalikButerin, &GetNormalTxsOpts{Page: 2}); err != nil {
+		t.Fatalf("GetNormalTxs failed: %v", err)
+	}
+
+	if len(tracer.spans) != 1 {
+		t.Fatalf("expected 1 span, got %d", len(tracer.spans))
+	}
+	span := tracer.spans[0]
+	if span.name != "etherscan account.txlist" || !span.ended || span.err != nil {
+		t.Errorf("unexpected span: %+v", span)
+	}
+	expected := map[string]any{
+		SpanAttrModule:     "account",
+		SpanAttrAction:     "txlist",
+		SpanAttrChainID:    "1",
+		SpanAttrPage:       "2",
+		SpanAttrAttempt:    int64(1),
+		SpanAttrHTTPStatus: int64(200),
+	}
+	for key, want := range expected {
+		if got := span.attrs[key]; got != want {
+			t.Errorf("attribute %s: expected %v, got %v", key, want, got)
+		}
+	}
+	if _, ok := span.attrs[SpanAttrRateLimitWait]; !ok {
+		t.Error("rate limit wait attribute missing")
+	}
+}
@@ -2,7 +2,10 @@ package etherscan
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"strconv"
+	"strings"
 )
 
 // GetNormalTxsOpts contains optional parameters for GetNormalTxs
@@ -33,7 +36,23 @@ type GetNormalTxsOpts struct {
 	// Options:
 	//   - "asc": Sort by block number in ascending order (oldest first)
 	//   - "desc": Sort by block number in descending order (newest first)
-	Sort string `default:"asc" json:"sort"`
+	Sort SortOrder `default:"asc" json:"sort"`
+
+	// OnlyErrors keeps transactions with isError = "1"
+	// Default: false
+	// Filtered client-side, so a filtered page can be shorter than Offset
+	OnlyErrors bool `json:"-"`
+
+	// OnlyWithValue keeps transactions transferring a non-zero value
+	// Default: false
+	// Filtered client-side, so a filtered page can be shorter than Offset
+	OnlyWithValue bool `json:"-"`
+
+	// VerifyNonEmpty cross-checks an empty full-history first page against the address's nonce,
+	// retrying once after the client's RetryDelay if the nonce says it has sent transactions
+	// Default: false
+	// A result still empty after the retry fails with ErrUnexpectedlyEmpty
+	VerifyNonEmpty bool `json:"-"`
 
 	// ChainID specifies which blockchain network to query
 	// Default: empty (uses client default)
@@ -47,6 +66,14 @@ type GetNormalTxsOpts struct {
 	//   - RateLimitRaise: Return an error when rate limit is exceeded
 	//   - RateLimitSkip: Return false without executing when rate limit is exceeded
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
+
+	// PageInfo, if set, receives the pagination state of the returned page, see PageInfo
+	// Default: nil
+	PageInfo *PageInfo `json:"-"`
 }
 
 // GetNormalTxs returns list of 'Normal' transactions by address
@@ -114,8 +141,12 @@ func (c *HTTPClient) GetNormalTxs(ctx context.Context, address string, opts *Get
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
+	var pageInfo *PageInfo
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
+		pageInfo = opts.PageInfo
 	}
 
 	data, err := c.request(requestParams{
@@ -125,15 +156,33 @@ func (c *HTTPClient) GetNormalTxs(ctx context.Context, address string, opts *Get
 		params:          params,
 		noFoundReturn:   []RespNormalTx{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result []RespNormalTx
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
+	recordPageInfo(pageInfo, params, result)
+	if opts != nil && opts.VerifyNonEmpty && len(result) == 0 && opts.Page <= 1 &&
+		opts.StartBlock == 0 && opts.EndBlock == defaultEndBlock && !opts.OnlyErrors && !opts.OnlyWithValue {
+		return verifyNonEmpty(ctx, c, "txlist", func() (string, error) {
+			return c.nonceCheck(ctx, address, opts.ChainID, opts.OnLimitExceeded)
+		}, func() ([]RespNormalTx, error) {
+			retry := *opts
+			retry.VerifyNonEmpty = false
+			return c.GetNormalTxs(ctx, address, &retry)
+		})
+	}
+	if opts != nil {
+		filter := InternalTxFilter{OnlyWithValue: opts.OnlyWithValue, OnlyErrors: opts.OnlyErrors}
+		result = filterInternalTxs(result, filter, func(tx RespNormalTx) (string, string, string) {
+			return "", tx.Value, tx.IsError
+		})
+	}
 	return result, nil
 }
 
@@ -162,6 +211,14 @@ type GetBridgeTxsOpts struct {
 	//   - RateLimitRaise: Return an error when rate limit is exceeded
 	//   - RateLimitSkip: Return false without executing when rate limit is exceeded
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
+
+	// PageInfo, if set, receives the pagination state of the returned page, see PageInfo
+	// Default: nil
+	PageInfo *PageInfo `json:"-"`
 }
 
 // GetBridgeTxs returns bridge transactions for an address
@@ -220,8 +277,12 @@ func (c *HTTPClient) GetBridgeTxs(ctx context.Context, address string, opts *Get
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
+	var pageInfo *PageInfo
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
+		pageInfo = opts.PageInfo
 	}
 
 	data, err := c.request(requestParams{
@@ -231,15 +292,17 @@ func (c *HTTPClient) GetBridgeTxs(ctx context.Context, address string, opts *Get
 		params:          params,
 		noFoundReturn:   []RespBridgeTx{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result []RespBridgeTx
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
+	recordPageInfo(pageInfo, params, result)
 	return result, nil
 }
 
@@ -261,6 +324,10 @@ type GetContractExecutionStatusOpts struct {
 	//   - RateLimitRaise: Return an error when rate limit is exceeded
 	//   - RateLimitSkip: Return false without executing when rate limit is exceeded
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // GetContractExecutionStatus returns the status code of a contract execution
@@ -316,8 +383,10 @@ func (c *HTTPClient) GetContractExecutionStatus(ctx context.Context, txHash stri
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -327,13 +396,14 @@ func (c *HTTPClient) GetContractExecutionStatus(ctx context.Context, txHash stri
 		params:          params,
 		noFoundReturn:   RespContractExecutionStatus{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result RespContractExecutionStatus
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
@@ -353,6 +423,10 @@ type GetTxReceiptStatusOpts struct {
 	//   - RateLimitRaise: Return an error when rate limit is exceeded
 	//   - RateLimitSkip: Return false without executing when rate limit is exceeded
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // GetTxReceiptStatus returns the status code of a transaction execution
@@ -408,8 +482,10 @@ func (c *HTTPClient) GetTxReceiptStatus(ctx context.Context, txHash string, opts
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -419,13 +495,14 @@ func (c *HTTPClient) GetTxReceiptStatus(ctx context.Context, txHash string, opts
 		params:          params,
 		noFoundReturn:   RespCheckTxReceiptStatus{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result RespCheckTxReceiptStatus
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
@@ -435,6 +512,63 @@ func (c *HTTPClient) GetTxReceiptStatus(ctx context.Context, txHash string, opts
 // Account Module - Internal Transactions
 // ============================================================================
 
+// InternalTxFilter selects a subset of internal transactions on the client side
+//
+// The API cannot filter internal transactions by type, so the filter is applied
+// to each returned page. Type flags (OnlyCreations, OnlySelfDestructs) are OR-ed
+// together; the remaining flags are AND-ed with them. The zero value keeps every
+// transaction.
+//
+// Because filtering happens after the page is fetched, a filtered page can be
+// shorter than Offset even when more pages exist.
+type InternalTxFilter struct {
+	// OnlyCreations keeps "create" and "create2" traces
+	OnlyCreations bool
+
+	// OnlySelfDestructs keeps "suicide" / "selfdestruct" traces
+	OnlySelfDestructs bool
+
+	// OnlyWithValue keeps traces transferring a non-zero value
+	OnlyWithValue bool
+
+	// OnlyErrors keeps traces with isError = "1"
+	OnlyErrors bool
+}
+
+// IsZero reports whether the filter keeps every transaction
+func (f InternalTxFilter) IsZero() bool {
+	return f == InternalTxFilter{}
+}
+
+// match reports whether a trace with the given fields passes the filter
+func (f InternalTxFilter) match(txType, value, isError string) bool {
+	if f.OnlyCreations || f.OnlySelfDestructs {
+		txType = strings.ToLower(txType)
+		isCreation := txType == "create" || txType == "create2"
+		isSelfDestruct := txType == "suicide" || txType == "selfdestruct"
+		if !(f.OnlyCreations && isCreation) && !(f.OnlySelfDestructs && isSelfDestruct) {
+			return false
+		}
+	}
+	if f.OnlyWithValue && (value == "" || strings.TrimLeft(value, "0") == "") {
+		return false
+	}
+	if f.OnlyErrors && isError != "1" {
+		return false
+	}
+	return true
+}
+
+// filterInternalTxs applies filter to txs using fields to read type, value and isError
+func filterInternalTxs[T any](txs []T, filter InternalTxFilter, fields func(T) (txType, value, isError string)) []T {
+	if filter.IsZero() {
+		return txs
+	}
+	return FilterTxs(txs, func(tx T) bool {
+		return filter.match(fields(tx))
+	})
+}
+
 // GetInternalTxsByAddressOpts contains optional parameters for GetInternalTxsByAddress
 type GetInternalTxsByAddressOpts struct {
 	// StartBlock is the starting block number to search from
@@ -462,7 +596,11 @@ type GetInternalTxsByAddressOpts struct {
 	// Options:
 	//   - "asc" (default): Sort by block number in ascending order (oldest first)
 	//   - "desc": Sort by block number in descending order (newest first)
-	Sort string `default:"asc" json:"sort"`
+	Sort SortOrder `default:"asc" json:"sort"`
+
+	// Filter selects internal transaction types client-side (see InternalTxFilter)
+	// Default: zero value (no filtering)
+	Filter InternalTxFilter `json:"-"`
 
 	// ChainID specifies which blockchain network to query
 	// If 0, uses the client's default chain ID (EthereumMainnet = 1)
@@ -476,6 +614,14 @@ type GetInternalTxsByAddressOpts struct {
 	//   - RateLimitRaise: Return an error when rate limit is exceeded
 	//   - RateLimitSkip: Return false without executing when rate limit is exceeded
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
+
+	// PageInfo, if set, receives the pagination state of the returned page, see PageInfo
+	// Default: nil
+	PageInfo *PageInfo `json:"-"`
 }
 
 // GetInternalTxsByAddress returns list of 'Internal' transactions by address
@@ -544,8 +690,12 @@ func (c *HTTPClient) GetInternalTxsByAddress(ctx context.Context, address string
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
+	var pageInfo *PageInfo
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
+		pageInfo = opts.PageInfo
 	}
 
 	data, err := c.request(requestParams{
@@ -555,20 +705,31 @@ func (c *HTTPClient) GetInternalTxsByAddress(ctx context.Context, address string
 		params:          params,
 		noFoundReturn:   []RespInternalTxByAddress{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result []RespInternalTxByAddress
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
+	recordPageInfo(pageInfo, params, result)
+	if opts != nil {
+		result = filterInternalTxs(result, opts.Filter, func(tx RespInternalTxByAddress) (string, string, string) {
+			return tx.Type, tx.Value, tx.IsError
+		})
+	}
 	return result, nil
 }
 
 // GetInternalTxsByHashOpts contains optional parameters for GetInternalTxsByHash
 type GetInternalTxsByHashOpts struct {
+	// Filter selects internal transaction types client-side (see InternalTxFilter)
+	// Default: zero value (no filtering)
+	Filter InternalTxFilter `json:"-"`
+
 	// ChainID specifies which blockchain network to query
 	// If 0, uses the client's default chain ID (EthereumMainnet = 1)
 	// Supported chains: EthereumMainnet, PolygonMainnet, ArbitrumOneMainnet, etc.
@@ -581,6 +742,10 @@ type GetInternalTxsByHashOpts struct {
 	//   - RateLimitRaise: Return an error when rate limit is exceeded
 	//   - RateLimitSkip: Return false without executing when rate limit is exceeded
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
 }
 
 // GetInternalTxsByHash returns list of internal transactions by transaction hash
@@ -636,8 +801,10 @@ func (c *HTTPClient) GetInternalTxsByHash(ctx context.Context, txHash string, op
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
 	}
 
 	data, err := c.request(requestParams{
@@ -647,15 +814,21 @@ func (c *HTTPClient) GetInternalTxsByHash(ctx context.Context, txHash string, op
 		params:          params,
 		noFoundReturn:   []RespInternalTxByHash{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result []RespInternalTxByHash
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
+	if opts != nil {
+		result = filterInternalTxs(result, opts.Filter, func(tx RespInternalTxByHash) (string, string, string) {
+			return tx.Type, tx.Value, tx.IsError
+		})
+	}
 	return result, nil
 }
 
@@ -676,7 +849,16 @@ type GetInternalTxsByBlockRangeOpts struct {
 	// Options:
 	//   - "asc" (default): Sort by block number in ascending order (oldest first)
 	//   - "desc": Sort by block number in descending order (newest first)
-	Sort string `default:"asc" json:"sort"`
+	Sort SortOrder `default:"asc" json:"sort"`
+
+	// Filter selects internal transaction types client-side (see InternalTxFilter)
+	// Default: zero value (no filtering)
+	Filter InternalTxFilter `json:"-"`
+
+	// MaxBlockSpan is the largest accepted endBlock-startBlock, checked before any request is made
+	// Default: DefaultMaxBlockSpan
+	// Use a negative value to disable the check
+	MaxBlockSpan int64 `default:"100000" json:"-"`
 
 	// ChainID specifies which blockchain network to query
 	// If 0, uses the client's default chain ID (EthereumMainnet = 1)
@@ -690,6 +872,82 @@ type GetInternalTxsByBlockRangeOpts struct {
 	//   - RateLimitRaise: Return an error when rate limit is exceeded
 	//   - RateLimitSkip: Return false without executing when rate limit is exceeded
 	OnLimitExceeded RateLimitBehavior `default:"" json:"on_limit_exceeded"`
+
+	// Meta, if set, receives the HTTP response metadata of the call, see CallMeta
+	// Default: nil
+	Meta *CallMeta `json:"-"`
+
+	// PageInfo, if set, receives the pagination state of the returned page, see PageInfo
+	// Default: nil
+	PageInfo *PageInfo `json:"-"`
+}
+
+// DefaultMaxBlockSpan is the default block span limit of address-less block range queries
+//
+// Without an address Etherscan scans every block in the range, and wide ranges
+// time out or return a truncated window silently.
+const DefaultMaxBlockSpan = 100_000
+
+// ErrInvalidBlockRange is returned when a block range is rejected before being sent
+var ErrInvalidBlockRange = errors.New("invalid block range")
+
+// BlockRangeError describes a rejected block range
+//
+// It matches ErrInvalidBlockRange with errors.Is.
+type BlockRangeError struct {
+	StartBlock int64
+	EndBlock   int64
+	Reason     string
+}
+
+// Error implements the error interface
+func (e *BlockRangeError) Error() string {
+	return fmt.Sprintf("etherscan: invalid block range [%d, %d]: %s", e.StartBlock, e.EndBlock, e.Reason)
+}
+
+// Unwrap returns ErrInvalidBlockRange
+func (e *BlockRangeError) Unwrap() error {
+	return ErrInvalidBlockRange
+}
+
+// defaultEndBlock is the endblock/toblock default of the list calls, meaning the latest block
+const defaultEndBlock = 999999999999
+
+// blockRangeParams lists the start and end parameter names of the list calls
+var blockRangeParams = [][2]string{
+	{"startblock", "endblock"},
+	{"fromblock", "toblock"},
+}
+
+// checkExplicitBlockRange rejects request parameters whose block range is the default genesis-to-latest scan
+//
+// Single-row probes (offset 1), such as the first/last transaction lookups of
+// IsAddressActive, cost one row whatever the range and are let through.
+func checkExplicitBlockRange(params map[string]string) error {
+	if params["offset"] == "1" {
+		return nil
+	}
+	for _, names := range blockRangeParams {
+		start, hasStart := params[names[0]]
+		end, hasEnd := params[names[1]]
+		if hasStart && hasEnd && start == "0" && end == strconv.FormatInt(defaultEndBlock, 10) {
+			return &BlockRangeError{StartBlock: 0, EndBlock: defaultEndBlock, Reason: "explicit block range required, set the start or end block"}
+		}
+	}
+	return nil
+}
+
+// validateBlockRange checks that 0 <= startBlock <= endBlock and, if maxSpan >= 0, that the span is at most maxSpan
+func validateBlockRange(startBlock, endBlock, maxSpan int64) error {
+	switch {
+	case startBlock < 0 || endBlock < 0:
+		return &BlockRangeError{StartBlock: startBlock, EndBlock: endBlock, Reason: "block numbers must not be negative"}
+	case startBlock > endBlock:
+		return &BlockRangeError{StartBlock: startBlock, EndBlock: endBlock, Reason: "start block is after end block"}
+	case maxSpan >= 0 && endBlock-startBlock > maxSpan:
+		return &BlockRangeError{StartBlock: startBlock, EndBlock: endBlock, Reason: fmt.Sprintf("span exceeds %d blocks", maxSpan)}
+	}
+	return nil
 }
 
 // GetInternalTxsByBlockRange returns list of internal transactions within a block range
@@ -706,7 +964,7 @@ type GetInternalTxsByBlockRangeOpts struct {
 //
 // Returns:
 //   - []RespInternalTxByBlockRange: List of internal transactions within the block range
-//   - error: Error if the request fails
+//   - error: *BlockRangeError (matching ErrInvalidBlockRange) if the range is rejected, or an error if the request fails
 //
 // Example:
 //
@@ -735,24 +993,38 @@ type GetInternalTxsByBlockRangeOpts struct {
 //   - This endpoint returns max 10000 records per call
 //   - Use Page and Offset parameters to paginate through results
 //   - Block range should be reasonable to avoid timeout (recommended: < 1000 blocks)
+//   - Ranges wider than MaxBlockSpan (default DefaultMaxBlockSpan) are rejected without a request
 //   - Internal transactions include contract calls, contract creation, and self-destruct operations
 //   - All values are returned as strings in Wei
 //   - TraceID field helps identify which internal transactions belong to the same execution trace
-func (c *HTTPClient) GetInternalTxsByBlockRange(ctx context.Context, startBlock, endBlock int, opts *GetInternalTxsByBlockRangeOpts) ([]RespInternalTxByBlockRange, error) {
+func (c *HTTPClient) GetInternalTxsByBlockRange(ctx context.Context, startBlock, endBlock int64, opts *GetInternalTxsByBlockRangeOpts) ([]RespInternalTxByBlockRange, error) {
 	// Apply defaults and extract API parameters
 	params, err := ApplyDefaultsAndExtractParams(opts)
 	if err != nil {
 		return nil, err
 	}
 
+	// Validate the block range
+	maxSpan := int64(DefaultMaxBlockSpan)
+	if opts != nil {
+		maxSpan = opts.MaxBlockSpan
+	}
+	if err := validateBlockRange(startBlock, endBlock, maxSpan); err != nil {
+		return nil, err
+	}
+
 	// Add required parameters
-	params["startblock"] = strconv.Itoa(startBlock)
-	params["endblock"] = strconv.Itoa(endBlock)
+	params["startblock"] = strconv.FormatInt(startBlock, 10)
+	params["endblock"] = strconv.FormatInt(endBlock, 10)
 
 	// Handle rate limiting
 	var onLimitExceeded RateLimitBehavior
+	var meta *CallMeta
+	var pageInfo *PageInfo
 	if opts != nil {
 		onLimitExceeded = opts.OnLimitExceeded
+		meta = opts.Meta
+		pageInfo = opts.PageInfo
 	}
 
 	data, err := c.request(requestParams{
@@ -762,14 +1034,21 @@ func (c *HTTPClient) GetInternalTxsByBlockRange(ctx context.Context, startBlock,
 		params:          params,
 		noFoundReturn:   []RespInternalTxByBlockRange{},
 		onLimitExceeded: onLimitExceeded,
+		meta:            meta,
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	var result []RespInternalTxByBlockRange
-	if err := unmarshalResponse(data, &result); err != nil {
+	if err := c.unmarshalResponse(ctx, data, &result); err != nil {
 		return nil, err
 	}
+	recordPageInfo(pageInfo, params, result)
+	if opts != nil {
+		result = filterInternalTxs(result, opts.Filter, func(tx RespInternalTxByBlockRange) (string, string, string) {
+			return tx.Type, tx.Value, tx.IsError
+		})
+	}
 	return result, nil
 }
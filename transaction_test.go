@@ -2,6 +2,10 @@ package etherscan
 
 import (
 	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
 	"time"
 )
@@ -121,7 +125,7 @@ func TestGetInternalTxsByBlockRange(t *testing.T) {
 	defer cancel()
 
 	// Test with a recent block range
-	transactions, err := config.Client.GetInternalTxsByBlockRange(ctx, int(TestBlocks.RecentBlock), int(TestBlocks.RecentBlock+10), &GetInternalTxsByBlockRangeOpts{
+	transactions, err := config.Client.GetInternalTxsByBlockRange(ctx, TestBlocks.RecentBlock, TestBlocks.RecentBlock+10, &GetInternalTxsByBlockRangeOpts{
 		Page:    1,
 		Offset:  10,
 		Sort:    "asc",
@@ -387,3 +391,184 @@ func TestGetInternalTxsByAddressWithDescSort(t *testing.T) {
 		t.Logf("Found %d internal transactions (desc sort)", len(transactions))
 	}
 }
+
+func TestInternalTxFilter(t *testing.T) {
+	txs := []RespInternalTxByAddress{
+		{Hash: "0x1", Type: "call", Value: "0", IsError: "0"},
+		{Hash: "0x2", Type: "call", Value: "1000", IsError: "0"},
+		{Hash: "0x3", Type: "create", Value: "0", IsError: "0"},
+		{Hash: "0x4", Type: "create2", Value: "0", IsError: "1"},
+		{Hash: "0x5", Type: "suicide", Value: "500", IsError: "0"},
+	}
+	fields := func(tx RespInternalTxByAddress) (string, string, string) {
+		return tx.Type, tx.Value, tx.IsError
+	}
+
+	cases := []struct {
+		name   string
+		filter InternalTxFilter
+		want   []string
+	}{
+		{"none", InternalTxFilter{}, []string{"0x1", "0x2", "0x3", "0x4", "0x5"}},
+		{"creations", InternalTxFilter{OnlyCreations: true}, []string{"0x3", "0x4"}},
+		{"creations or self-destructs", InternalTxFilter{OnlyCreations: true, OnlySelfDestructs: true}, []string{"0x3", "0x4", "0x5"}},
+		{"with value", InternalTxFilter{OnlyWithValue: true}, []string{"0x2", "0x5"}},
+		{"failed creations", InternalTxFilter{OnlyCreations: true, OnlyErrors: true}, []string{"0x4"}},
+	}
+	for _, tc := range cases {
+		got := filterInternalTxs(txs, tc.filter, fields)
+		if len(got) != len(tc.want) {
+			t.Errorf("%s: expected %d txs, got %d", tc.name, len(tc.want), len(got))
+			continue
+		}
+		for i, tx := range got {
+			if tx.Hash != tc.want[i] {
+				t.Errorf("%s: expected %s at %d, got %s", tc.name, tc.want[i], i, tx.Hash)
+			}
+		}
+	}
+}
+
+func TestTxFiltersClientSide(t *testing.T) {
+	var queries []url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.Query())
+		w.Write([]byte(`{"status":"1","message":"OK","result":[
+			{"hash":"0x1","type":"call","value":"0","isError":"1"},
+			{"hash":"0x2","type":"call","value":"7","isError":"1"},
+			{"hash":"0x3","type":"call","value":"7","isError":"0"}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+	})
+	ctx := context.Background()
+
+	internal, err := client.GetInternalTxsByAddress(ctx, testAddr, &GetInternalTxsByAddressOpts{Filter: InternalTxFilter{OnlyErrors: true, OnlyWithValue: true}})
+	if err != nil || len(internal) != 1 || internal[0].Hash != "0x2" {
+		t.Fatalf("expected only 0x2: %+v, %v", internal, err)
+	}
+	normal, err := client.GetNormalTxs(ctx, testAddr, &GetNormalTxsOpts{OnlyErrors: true})
+	if err != nil || len(normal) != 2 {
+		t.Fatalf("expected the 2 failed txs: %+v, %v", normal, err)
+	}
+
+	// No API parameter is documented for these filters, so none is sent
+	for _, query := range queries {
+		if query.Has("onlyError") || query.Has("excludeZeroValue") {
+			t.Errorf("expected no server-side filter parameters, got %v", query)
+		}
+	}
+}
+
+func TestGetInternalTxsByBlockRangeValidation(t *testing.T) {
+	// No server: rejected ranges must fail before any request is made
+	client := NewHTTPClient(HTTPClientConfig{APIKey: "test"})
+	ctx := context.Background()
+
+	cases := []struct {
+		name       string
+		start, end int64
+		opts       *GetInternalTxsByBlockRangeOpts
+	}{
+		{"negative", -1, 10, nil},
+		{"reversed", 200, 100, nil},
+		{"too wide", 0, DefaultMaxBlockSpan + 1, nil},
+		{"custom span", 100, 200, &GetInternalTxsByBlockRangeOpts{MaxBlockSpan: 50}},
+	}
+	for _, tc := range cases {
+		_, err := client.GetInternalTxsByBlockRange(ctx, tc.start, tc.end, tc.opts)
+		var rangeErr *BlockRangeError
+		if !errors.Is(err, ErrInvalidBlockRange) || !errors.As(err, &rangeErr) {
+			t.Errorf("%s: expected BlockRangeError, got %v", tc.name, err)
+			continue
+		}
+		if rangeErr.StartBlock != tc.start || rangeErr.EndBlock != tc.end {
+			t.Errorf("%s: unexpected range in error: %+v", tc.name, rangeErr)
+		}
+	}
+
+	if err := validateBlockRange(100, DefaultMaxBlockSpan*10, -1); err != nil {
+		t.Errorf("negative span limit should disable the check, got %v", err)
+	}
+}
+
+func TestRequireExplicitBlockRange(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(`{"status":"1","message":"OK","result":[]}`))
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion:                APIVersionV1,
+		V1BaseURLs:                map[int]string{EthereumMainnet: server.URL},
+		RequireExplicitBlockRange: true,
+	})
+	ctx := context.Background()
+
+	rejected := map[string]error{}
+	_, rejected["GetNormalTxs"] = client.GetNormalTxs(ctx, testAddr, nil)
+	_, rejected["GetERC20TokenTransfers"] = client.GetERC20TokenTransfers(ctx, &GetERC20TokenTransfersOpts{Address: testAddr})
+	_, rejected["GetEventLogsByAddress"] = client.GetEventLogsByAddress(ctx, testContract, nil)
+	_, rejected["GetAllEventLogs"] = client.GetAllEventLogs(ctx, testContract, nil)
+	for name, err := range rejected {
+		if !errors.Is(err, ErrInvalidBlockRange) {
+			t.Errorf("%s: expected ErrInvalidBlockRange, got %v", name, err)
+		}
+	}
+	if requests != 0 {
+		t.Errorf("expected no requests for rejected calls, got %d", requests)
+	}
+
+	if _, err := client.GetNormalTxs(ctx, testAddr, &GetNormalTxsOpts{StartBlock: 18000000}); err != nil {
+		t.Errorf("open-ended range from an explicit start should pass, got %v", err)
+	}
+	if _, err := client.GetEventLogsByAddress(ctx, testContract, &GetEventLogsByAddressOpts{ToBlock: 100}); err != nil {
+		t.Errorf("range with an explicit end should pass, got %v", err)
+	}
+	if requests != 2 {
+		t.Errorf("expected 2 requests, got %d", requests)
+	}
+}
+
+func TestRequireExplicitBlockRangeProbes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("action") {
+		case "eth_getTransactionCount":
+			w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x0"}`))
+		case "eth_getCode":
+			w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x"}`))
+		case "balance":
+			w.Write([]byte(`{"status":"1","message":"OK","result":"0"}`))
+		default:
+			if r.URL.Query().Get("offset") != "1" {
+				t.Errorf("unexpected list call %s", r.URL.RawQuery)
+			}
+			w.Write([]byte(`{"status":"1","message":"OK","result":[]}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion:                APIVersionV1,
+		V1BaseURLs:                map[int]string{EthereumMainnet: server.URL},
+		RequireExplicitBlockRange: true,
+	})
+	ctx := context.Background()
+
+	if _, err := client.IsAddressActive(ctx, testAddr, &IsAddressActiveOpts{Full: true}); err != nil {
+		t.Errorf("IsAddressActive failed: %v", err)
+	}
+	activity, err := client.CompareAddressAcrossChains(ctx, testAddr, []int64{EthereumMainnet}, nil)
+	if err != nil {
+		t.Fatalf("CompareAddressAcrossChains failed: %v", err)
+	}
+	if err := activity.Chains[0].Err; err != nil {
+		t.Errorf("CompareAddressAcrossChains probe failed: %v", err)
+	}
+}
@@ -0,0 +1,164 @@
+package etherscan
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+// ============================================================================
+// Token Transfer Direction
+// ============================================================================
+
+// TransferDirection is which way a transfer moved relative to an address
+type TransferDirection string
+
+const (
+	// TransferIn was received by the address
+	TransferIn TransferDirection = "in"
+
+	// TransferOut was sent by the address
+	TransferOut TransferDirection = "out"
+
+	// TransferSelf was sent by the address to itself
+	TransferSelf TransferDirection = "self"
+
+	// TransferUnrelated neither came from nor went to the address
+	TransferUnrelated TransferDirection = ""
+)
+
+// errDirectionAddress is returned by the Directed transfer calls without an address filter
+var errDirectionAddress = errors.New("etherscan: Address is required to compute transfer directions")
+
+// TransferDirectionOf returns how a transfer from from to to moved relative to address
+//
+// Addresses are compared case-insensitively. The counterparty is the other
+// side of the transfer in lowercase: the sender of incoming transfers, the
+// recipient of outgoing ones, and address itself for self-transfers. It is ""
+// if the transfer is unrelated to address.
+//
+// Example:
+//
+//	direction, peer := etherscan.TransferDirectionOf(wallet, t.From, t.To)
+//	if direction == etherscan.TransferOut {
+//	    fmt.Printf("sent %s %s to %s\n", t.Value, t.TokenSymbol, peer)
+//	}
+func TransferDirectionOf(address, from, to string) (TransferDirection, string) {
+	address, from, to = strings.ToLower(address), strings.ToLower(from), strings.ToLower(to)
+	switch {
+	case from == address && to == address:
+		return TransferSelf, address
+	case to == address:
+		return TransferIn, from
+	case from == address:
+		return TransferOut, to
+	default:
+		return TransferUnrelated, ""
+	}
+}
+
+// DirectedERC20Transfer is an ERC-20 transfer annotated relative to the queried address
+type DirectedERC20Transfer struct {
+	RespERC20TokenTransfer
+
+	Direction    TransferDirection `json:"direction" bson:"direction"`
+	Counterparty string            `json:"counterparty" bson:"counterparty"`
+}
+
+// DirectedERC721Transfer is an ERC-721 transfer annotated relative to the queried address
+type DirectedERC721Transfer struct {
+	RespERC721TokenTransfer
+
+	Direction    TransferDirection `json:"direction" bson:"direction"`
+	Counterparty string            `json:"counterparty" bson:"counterparty"`
+}
+
+// DirectedERC1155Transfer is an ERC-1155 transfer annotated relative to the queried address
+type DirectedERC1155Transfer struct {
+	RespERC1155TokenTransfer
+
+	Direction    TransferDirection `json:"direction" bson:"direction"`
+	Counterparty string            `json:"counterparty" bson:"counterparty"`
+}
+
+// GetERC20TokenTransfersDirected is GetERC20TokenTransfers with each transfer's direction and counterparty
+//
+// opts.Address is required: directions are relative to it.
+//
+// Example:
+//
+//	transfers, err := client.GetERC20TokenTransfersDirected(ctx, &etherscan.GetERC20TokenTransfersOpts{Address: wallet})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, t := range transfers {
+//	    fmt.Printf("%-4s %s %s %s\n", t.Direction, t.Counterparty, t.Value, t.TokenSymbol)
+//	}
+func (c *HTTPClient) GetERC20TokenTransfersDirected(ctx context.Context, opts *GetERC20TokenTransfersOpts) ([]DirectedERC20Transfer, error) {
+	if opts == nil || opts.Address == "" {
+		return nil, errDirectionAddress
+	}
+	transfers, err := c.GetERC20TokenTransfers(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return DirectERC20Transfers(opts.Address, transfers), nil
+}
+
+// GetERC721TokenTransfersDirected is GetERC721TokenTransfers with each transfer's direction and counterparty
+//
+// opts.Address is required: directions are relative to it.
+func (c *HTTPClient) GetERC721TokenTransfersDirected(ctx context.Context, opts *GetERC721TokenTransfersOpts) ([]DirectedERC721Transfer, error) {
+	if opts == nil || opts.Address == "" {
+		return nil, errDirectionAddress
+	}
+	transfers, err := c.GetERC721TokenTransfers(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return DirectERC721Transfers(opts.Address, transfers), nil
+}
+
+// GetERC1155TokenTransfersDirected is GetERC1155TokenTransfers with each transfer's direction and counterparty
+//
+// opts.Address is required: directions are relative to it.
+func (c *HTTPClient) GetERC1155TokenTransfersDirected(ctx context.Context, opts *GetERC1155TokenTransfersOpts) ([]DirectedERC1155Transfer, error) {
+	if opts == nil || opts.Address == "" {
+		return nil, errDirectionAddress
+	}
+	transfers, err := c.GetERC1155TokenTransfers(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return DirectERC1155Transfers(opts.Address, transfers), nil
+}
+
+// DirectERC20Transfers annotates already fetched ERC-20 transfers relative to address
+func DirectERC20Transfers(address string, transfers []RespERC20TokenTransfer) []DirectedERC20Transfer {
+	directed := make([]DirectedERC20Transfer, len(transfers))
+	for i, t := range transfers {
+		direction, peer := TransferDirectionOf(address, t.From, t.To)
+		directed[i] = DirectedERC20Transfer{RespERC20TokenTransfer: t, Direction: direction, Counterparty: peer}
+	}
+	return directed
+}
+
+// DirectERC721Transfers annotates already fetched ERC-721 transfers relative to address
+func DirectERC721Transfers(address string, transfers []RespERC721TokenTransfer) []DirectedERC721Transfer {
+	directed := make([]DirectedERC721Transfer, len(transfers))
+	for i, t := range transfers {
+		direction, peer := TransferDirectionOf(address, t.From, t.To)
+		directed[i] = DirectedERC721Transfer{RespERC721TokenTransfer: t, Direction: direction, Counterparty: peer}
+	}
+	return directed
+}
+
+// DirectERC1155Transfers annotates already fetched ERC-1155 transfers relative to address
+func DirectERC1155Transfers(address string, transfers []RespERC1155TokenTransfer) []DirectedERC1155Transfer {
+	directed := make([]DirectedERC1155Transfer, len(transfers))
+	for i, t := range transfers {
+		direction, peer := TransferDirectionOf(address, t.From, t.To)
+		directed[i] = DirectedERC1155Transfer{RespERC1155TokenTransfer: t, Direction: direction, Counterparty: peer}
+	}
+	return directed
+}
@@ -0,0 +1,69 @@
+package etherscan
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestTransferDirectionOf(t *testing.T) {
+	me, peer := "0xAbC0000000000000000000000000000000000001", "0xdef0000000000000000000000000000000000002"
+	cases := []struct {
+		from, to  string
+		direction TransferDirection
+		peer      string
+	}{
+		{peer, me, TransferIn, peer},
+		{me, peer, TransferOut, peer},
+		{me, "0xabc0000000000000000000000000000000000001", TransferSelf, "0xabc0000000000000000000000000000000000001"},
+		{peer, peer, TransferUnrelated, ""},
+	}
+	for _, tc := range cases {
+		direction, counterparty := TransferDirectionOf(me, tc.from, tc.to)
+		if direction != tc.direction || counterparty != tc.peer {
+			t.Errorf("%s -> %s: expected %q %s, got %q %s", tc.from, tc.to, tc.direction, tc.peer, direction, counterparty)
+		}
+	}
+}
+
+func TestGetERC20TokenTransfersDirected(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":"1","message":"OK","result":[
+			{"hash":"0x1","from":"0x1111111111111111111111111111111111111111","to":"0xd8da6bf26964af9d7eed9e03e53415d37aa96045","value":"5"},
+			{"hash":"0x2","from":"0xd8da6bf26964af9d7eed9e03e53415d37aa96045","to":"0x2222222222222222222222222222222222222222","value":"3"},
+			{"hash":"0x3","from":"0xD8DA6BF26964AF9D7EED9E03E53415D37AA96045","to":"0xd8da6bf26964af9d7eed9e03e53415d37aa96045","value":"1"}]}`))
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+		MaxRetries: -1,
+	})
+	ctx := context.Background()
+	if _, err := client.GetERC20TokenTransfersDirected(ctx, &GetERC20TokenTransfersOpts{ContractAddress: "0x1111111111111111111111111111111111111111"}); err == nil {
+		t.Error("expected an error without an address filter")
+	}
+
+	transfers, err := client.GetERC20TokenTransfersDirected(ctx, &GetERC20TokenTransfersOpts{Address: TestAddresses.VitalikButerin})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []struct {
+		direction TransferDirection
+		peer      string
+	}{
+		{TransferIn, "0x1111111111111111111111111111111111111111"},
+		{TransferOut, "0x2222222222222222222222222222222222222222"},
+		{TransferSelf, "0xd8da6bf26964af9d7eed9e03e53415d37aa96045"},
+	}
+	if len(transfers) != len(want) {
+		t.Fatalf("expected %d transfers, got %d", len(want), len(transfers))
+	}
+	for i, w := range want {
+		if transfers[i].Direction != w.direction || transfers[i].Counterparty != w.peer || transfers[i].Hash == "" {
+			t.Errorf("transfer %d: expected %q %s, got %+v", i, w.direction, w.peer, transfers[i])
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package etherscan
+
+import (
+	"math/big"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ============================================================================
+// Transaction List Helpers
+// ============================================================================
+
+// FilterTxs returns the elements of txs for which pred returns true
+//
+// The input slice is not modified. Works with any response list, e.g.
+// []RespNormalTx or []RespERC20TokenTransfer.
+//
+// Example:
+//
+//	failed := FilterTxs(txs, TxFailed)
+//	large := FilterTxs(txs, AllTx(TxTo(router), TxValueGreaterThan(oneEth)))
+func FilterTxs[T any](txs []T, pred func(T) bool) []T {
+	result := make([]T, 0, len(txs))
+	for _, tx := range txs {
+		if pred(tx) {
+			result = append(result, tx)
+		}
+	}
+	return result
+}
+
+// SortTxsBy returns a copy of txs sorted by less
+//
+// The sort is stable, so equal elements keep the order returned by the API.
+//
+// Example:
+//
+//	byValue := SortTxsBy(txs, func(a, b RespNormalTx) bool {
+//	    return TxValue(a).Cmp(TxValue(b)) > 0
+//	})
+func SortTxsBy[T any](txs []T, less func(a, b T) bool) []T {
+	result := slices.Clone(txs)
+	slices.SortStableFunc(result, func(a, b T) int {
+		switch {
+		case less(a, b):
+			return -1
+		case less(b, a):
+			return 1
+		default:
+			return 0
+		}
+	})
+	return result
+}
+
+// AllTx combines predicates with logical AND
+func AllTx[T any](preds ...func(T) bool) func(T) bool {
+	return func(tx T) bool {
+		for _, pred := range preds {
+			if !pred(tx) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// AnyTx combines predicates with logical OR
+func AnyTx[T any](preds ...func(T) bool) func(T) bool {
+	return func(tx T) bool {
+		for _, pred := range preds {
+			if pred(tx) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// NotTx negates a predicate
+func NotTx[T any](pred func(T) bool) func(T) bool {
+	return func(tx T) bool {
+		return !pred(tx)
+	}
+}
+
+// TxFailed reports whether a normal transaction reverted
+func TxFailed(tx RespNormalTx) bool {
+	return tx.IsError == "1" || tx.TxReceiptStatus == "0"
+}
+
+// TxContractCreation reports whether a normal transaction deploys a contract, i.e. has no recipient
+func TxContractCreation(tx RespNormalTx) bool {
+	return tx.To == ""
+}
+
+// TxValue returns the value of a normal transaction in wei (0 if unparsable)
+func TxValue(tx RespNormalTx) *big.Int {
+	value, ok := new(big.Int).SetString(tx.Value, 10)
+	if !ok {
+		return new(big.Int)
+	}
+	return value
+}
+
+// TxValueGreaterThan matches transactions transferring more than wei
+func TxValueGreaterThan(wei *big.Int) func(RespNormalTx) bool {
+	return func(tx RespNormalTx) bool {
+		return TxValue(tx).Cmp(wei) > 0
+	}
+}
+
+// TxTo matches transactions sent to address (case-insensitive)
+func TxTo(address string) func(RespNormalTx) bool {
+	return func(tx RespNormalTx) bool {
+		return strings.EqualFold(tx.To, address)
+	}
+}
+
+// TxFrom matches transactions sent from address (case-insensitive)
+func TxFrom(address string) func(RespNormalTx) bool {
+	return func(tx RespNormalTx) bool {
+		return strings.EqualFold(tx.From, address)
+	}
+}
+
+// TxMethodID matches transactions calling the given 4-byte selector (e.g., "0xa9059cbb")
+//
+// Falls back to the first 4 bytes of Input when the API leaves methodId empty.
+func TxMethodID(methodID string) func(RespNormalTx) bool {
+	return func(tx RespNormalTx) bool {
+		id := tx.MethodID
+		if id == "" && len(tx.Input) >= 10 {
+			id = tx.Input[:10]
+		}
+		return strings.EqualFold(id, methodID)
+	}
+}
+
+// TxBlockNumber returns the block number of a normal transaction (0 if unparsable)
+func TxBlockNumber(tx RespNormalTx) int64 {
+	blockNo, _ := strconv.ParseInt(tx.BlockNumber, 10, 64)
+	return blockNo
+}
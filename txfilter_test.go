@@ -0,0 +1,38 @@
+package etherscan
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestFilterAndSortTxs(t *testing.T) {
+	router := "0x7a250d5630B4cF539739dF2C5dAcb4c659F2488D"
+	txs := []RespNormalTx{
+		{Hash: "0x1", BlockNumber: "30", To: router, Value: "5", IsError: "0", TxReceiptStatus: "1", MethodID: "0x7ff36ab5"},
+		{Hash: "0x2", BlockNumber: "10", To: "0xabc", Value: "100", IsError: "1", TxReceiptStatus: "0"},
+		{Hash: "0x3", BlockNumber: "20", To: router, Value: "50", IsError: "0", TxReceiptStatus: "1", Input: "0xa9059cbb0000"},
+	}
+
+	if got := FilterTxs(txs, TxFailed); len(got) != 1 || got[0].Hash != "0x2" {
+		t.Errorf("TxFailed: unexpected result %v", got)
+	}
+	if got := FilterTxs(txs, AllTx(TxTo(router), TxValueGreaterThan(big.NewInt(10)))); len(got) != 1 || got[0].Hash != "0x3" {
+		t.Errorf("AllTx: unexpected result %v", got)
+	}
+	if got := FilterTxs(txs, TxMethodID("0xA9059CBB")); len(got) != 1 || got[0].Hash != "0x3" {
+		t.Errorf("TxMethodID: unexpected result %v", got)
+	}
+	if got := FilterTxs(txs, NotTx(TxFailed)); len(got) != 2 {
+		t.Errorf("NotTx: expected 2 results, got %d", len(got))
+	}
+
+	sorted := SortTxsBy(txs, func(a, b RespNormalTx) bool {
+		return TxBlockNumber(a) < TxBlockNumber(b)
+	})
+	if sorted[0].Hash != "0x2" || sorted[1].Hash != "0x3" || sorted[2].Hash != "0x1" {
+		t.Errorf("SortTxsBy: unexpected order %v", sorted)
+	}
+	if txs[0].Hash != "0x1" {
+		t.Error("SortTxsBy modified its input")
+	}
+}
@@ -0,0 +1,337 @@
+package etherscan
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ============================================================================
+// Transaction Hydration
+// ============================================================================
+
+// ErrTxNotFound is returned when a transaction hash is unknown to the chain
+var ErrTxNotFound = errors.New("transaction not found")
+
+// Topics of commonly emitted events decoded by DecodeLog
+const (
+	// TopicTransfer is Transfer(address,address,uint256), shared by ERC-20 and ERC-721
+	TopicTransfer = "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"
+	// TopicApproval is Approval(address,address,uint256), shared by ERC-20 and ERC-721
+	TopicApproval = "0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925"
+	// TopicApprovalForAll is ApprovalForAll(address,address,bool) of ERC-721 and ERC-1155
+	TopicApprovalForAll = "0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31"
+	// TopicTransferSingle is TransferSingle(address,address,address,uint256,uint256) of ERC-1155
+	TopicTransferSingle = "0xc3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f62"
+	// TopicTransferBatch is TransferBatch(address,address,address,uint256[],uint256[]) of ERC-1155
+	TopicTransferBatch = "0x4a39dc06d4c0dbc64b70af90fd698a233a518aa5d07e595d983b8c0526c8f7fb"
+	// TopicDeposit is Deposit(address,uint256) of WETH
+	TopicDeposit = "0xe1fffcc4923d04b559f4d29a8bfc6cda04eb5b0d3c460751c2402c5c5cc9109c"
+	// TopicWithdrawal is Withdrawal(address,uint256) of WETH
+	TopicWithdrawal = "0x7fcf532c15f0a6db0bd6d0e038bea71d30d808c7d98cb3bf7268a95bf5081b65"
+	// TopicUserOperationEvent is UserOperationEvent(bytes32,address,address,uint256,bool,uint256,uint256)
+	// of the ERC-4337 EntryPoint
+	TopicUserOperationEvent = "0x49628fd1471006c1482da88028e9ce4dbb080b815c9b0344d39e5a8e6ec1419f"
+)
+
+// DecodedLog is a receipt log with its event decoded, if the event is known
+type DecodedLog struct {
+	RespEthTxReceiptLog
+
+	// Event is the event name (e.g., "Transfer"), empty if the topic is unknown
+	Event string
+
+	// Standard is the token standard the event was matched against (e.g., "ERC-20")
+	Standard string
+
+	// Args holds the decoded arguments: addresses as lowercase hex, integers in decimal,
+	// arrays (the ids and values of TransferBatch) as comma-separated decimals
+	Args map[string]string
+}
+
+// DecodeLog decodes the well-known token events and the ERC-4337 UserOperationEvent of log
+//
+// ERC-20 and ERC-721 Transfer/Approval share a topic and are told apart by the
+// number of indexed topics. Unknown events are returned with an empty Event
+// and the raw topics and data untouched.
+func DecodeLog(log RespEthTxReceiptLog) DecodedLog {
+	decoded := DecodedLog{RespEthTxReceiptLog: log}
+	if len(log.Topics) == 0 {
+		return decoded
+	}
+
+	topics := log.Topics[1:]
+	words := splitWords(log.Data)
+	address := func(topic string) string {
+		if len(topic) < 40 {
+			return ""
+		}
+		return "0x" + strings.ToLower(topic[len(topic)-40:])
+	}
+	uint256 := func(word string) string {
+		n, ok := new(big.Int).SetString(strings.TrimPrefix(word, "0x"), 16)
+		if !ok {
+			return ""
+		}
+		return n.String()
+	}
+
+	switch strings.ToLower(log.Topics[0]) {
+	case TopicTransfer, TopicApproval:
+		event, from, to := "Transfer", "from", "to"
+		if strings.EqualFold(log.Topics[0], TopicApproval) {
+			event, from, to = "Approval", "owner", "spender"
+		}
+		switch {
+		case len(topics) == 2 && len(words) >= 1:
+			decoded.Event, decoded.Standard = event, "ERC-20"
+			decoded.Args = map[string]string{from: address(topics[0]), to: address(topics[1]), "value": uint256(words[0])}
+		case len(topics) == 3:
+			if event == "Approval" {
+				to = "approved"
+			}
+			decoded.Event, decoded.Standard = event, "ERC-721"
+			decoded.Args = map[string]string{from: address(topics[0]), to: address(topics[1]), "tokenId": uint256(topics[2])}
+		}
+	case TopicApprovalForAll:
+		if len(topics) == 2 && len(words) >= 1 {
+			decoded.Event, decoded.Standard = "ApprovalForAll", "ERC-721"
+			decoded.Args = map[string]string{
+				"owner":    address(topics[0]),
+				"operator": address(topics[1]),
+				"approved": strconv.FormatBool(uint256(words[0]) != "0"),
+			}
+		}
+	case TopicTransferSingle:
+		if len(topics) == 3 && len(words) >= 2 {
+			decoded.Event, decoded.Standard = "TransferSingle", "ERC-1155"
+			decoded.Args = map[string]string{
+				"operator": address(topics[0]),
+				"from":     address(topics[1]),
+				"to":       address(topics[2]),
+				"id":       uint256(words[0]),
+				"value":    uint256(words[1]),
+			}
+		}
+	case TopicTransferBatch:
+		ids, okIDs := uint256Array(words, 0)
+		values, okValues := uint256Array(words, 1)
+		if len(topics) == 3 && okIDs && okValues && len(ids) == len(values) {
+			decoded.Event, decoded.Standard = "TransferBatch", "ERC-1155"
+			decoded.Args = map[string]string{
+				"operator": address(topics[0]),
+				"from":     address(topics[1]),
+				"to":       address(topics[2]),
+				"ids":      strings.Join(ids, ","),
+				"values":   strings.Join(values, ","),
+			}
+		}
+	case TopicDeposit, TopicWithdrawal:
+		if len(topics) == 1 && len(words) >= 1 {
+			event, who := "Deposit", "dst"
+			if strings.EqualFold(log.Topics[0], TopicWithdrawal) {
+				event, who = "Withdrawal", "src"
+			}
+			decoded.Event, decoded.Standard = event, "WETH"
+			decoded.Args = map[string]string{who: address(topics[0]), "wad": uint256(words[0])}
+		}
+	case TopicUserOperationEvent:
+		if len(topics) == 3 && len(words) >= 4 {
+			decoded.Event, decoded.Standard = "UserOperationEvent", "ERC-4337"
+			decoded.Args = map[string]string{
+				"userOpHash":    strings.ToLower(topics[0]),
+				"sender":        address(topics[1]),
+				"paymaster":     address(topics[2]),
+				"nonce":         uint256(words[0]),
+				"success":       strconv.FormatBool(uint256(words[1]) != "0"),
+				"actualGasCost": uint256(words[2]),
+				"actualGasUsed": uint256(words[3]),
+			}
+		}
+	}
+	return decoded
+}
+
+// TxFull is a transaction with everything the explorer's transaction page shows
+type TxFull struct {
+	Tx          *RespEthTxInfo
+	Receipt     *RespEthTxReceiptInfo
+	Logs        []DecodedLog
+	InternalTxs []RespInternalTxByHash
+
+	// Pending reports whether the transaction is not mined yet; Receipt is nil then
+	Pending bool
+
+	// Success reports whether the transaction executed without reverting
+	Success bool
+
+	// ErrDescription is Etherscan's description of the failure, e.g. "Reverted"
+	ErrDescription string
+}
+
+// GetTxFullOpts contains optional parameters for GetTxFull
+type GetTxFullOpts struct {
+	// ChainID specifies which blockchain network to query
+	// Default: empty (uses client default)
+	ChainID int64
+
+	// OnLimitExceeded specifies behavior when rate limit is exceeded
+	// Default: RateLimitBlock (wait until a token is available)
+	OnLimitExceeded RateLimitBehavior `default:""`
+}
+
+// GetTxFull returns a transaction together with its receipt, decoded logs, internal transactions and status
+//
+// The four underlying calls (eth_getTransactionByHash, eth_getTransactionReceipt,
+// txlistinternal and getstatus) run concurrently and share the client's rate
+// limiter, so hydrating a tx costs one round trip of wall-clock time.
+//
+// Args:
+//   - ctx: Context for request cancellation and timeout
+//   - txHash: Transaction hash
+//   - opts: Optional parameters (can be nil)
+//
+// Returns:
+//   - *TxFull: The hydrated transaction
+//   - error: ErrTxNotFound if the hash is unknown, or the first request error
+//
+// Example:
+//
+//	full, err := client.GetTxFull(ctx, txHash, nil)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, l := range full.Logs {
+//	    if l.Event == "Transfer" {
+//	        fmt.Printf("%s %s -> %s: %s\n", l.Standard, l.Args["from"], l.Args["to"], l.Args["value"])
+//	    }
+//	}
+//
+// Note:
+//   - Costs 4 API calls
+func (c *HTTPClient) GetTxFull(ctx context.Context, txHash string, opts *GetTxFullOpts) (*TxFull, error) {
+	if opts == nil {
+		opts = &GetTxFullOpts{}
+	}
+	if err := ApplyDefaults(opts); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		full     TxFull
+		status   *RespContractExecutionStatus
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+	run := func(fetch func() error) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := fetch(); err != nil {
+				errOnce.Do(func() {
+					firstErr = err
+					cancel()
+				})
+			}
+		}()
+	}
+
+	run(func() (err error) {
+		full.Tx, err = c.RpcEthTxByHash(ctx, txHash, &RpcEthTxByHashOpts{
+			ChainID:         opts.ChainID,
+			OnLimitExceeded: opts.OnLimitExceeded,
+		})
+		return err
+	})
+	run(func() (err error) {
+		full.Receipt, err = c.RpcEthTxReceipt(ctx, txHash, &RpcEthTxReceiptOpts{
+			ChainID:         opts.ChainID,
+			OnLimitExceeded: opts.OnLimitExceeded,
+		})
+		return err
+	})
+	run(func() (err error) {
+		full.InternalTxs, err = c.GetInternalTxsByHash(ctx, txHash, &GetInternalTxsByHashOpts{
+			ChainID:         opts.ChainID,
+			OnLimitExceeded: opts.OnLimitExceeded,
+		})
+		return err
+	})
+	run(func() (err error) {
+		status, err = c.GetContractExecutionStatus(ctx, txHash, &GetContractExecutionStatusOpts{
+			ChainID:         opts.ChainID,
+			OnLimitExceeded: opts.OnLimitExceeded,
+		})
+		return err
+	})
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if full.Tx == nil || full.Tx.Hash == "" {
+		return nil, ErrTxNotFound
+	}
+
+	if full.Receipt == nil || full.Receipt.TransactionHash == "" {
+		full.Receipt = nil
+		full.Pending = true
+		return &full, nil
+	}
+
+	full.Logs = make([]DecodedLog, len(full.Receipt.Logs))
+	for i, log := range full.Receipt.Logs {
+		full.Logs[i] = DecodeLog(log)
+	}
+	full.Success = full.Receipt.Status == "0x1"
+	if status != nil && !full.Success {
+		full.ErrDescription = status.ErrDescription
+	}
+	return &full, nil
+}
+
+// splitWords splits hex ABI data into 32-byte words
+func splitWords(data string) []string {
+	data = strings.TrimPrefix(data, "0x")
+	words := make([]string, 0, len(data)/64)
+	for len(data) >= 64 {
+		words = append(words, data[:64])
+		data = data[64:]
+	}
+	return words
+}
+
+// uint256Array decodes the dynamic uint256[] whose offset is in words[head] as decimals
+func uint256Array(words []string, head int) ([]string, bool) {
+	if head >= len(words) {
+		return nil, false
+	}
+	offset, ok := new(big.Int).SetString(words[head], 16)
+	if !ok || !offset.IsInt64() || offset.Int64()%32 != 0 {
+		return nil, false
+	}
+	start := int(offset.Int64() / 32)
+	if start >= len(words) {
+		return nil, false
+	}
+	length, ok := new(big.Int).SetString(words[start], 16)
+	if !ok || !length.IsInt64() || length.Int64() > int64(len(words)-start-1) {
+		return nil, false
+	}
+	items := make([]string, length.Int64())
+	for i := range items {
+		n, ok := new(big.Int).SetString(words[start+1+i], 16)
+		if !ok {
+			return nil, false
+		}
+		items[i] = n.String()
+	}
+	return items, true
+}
@@ -0,0 +1,100 @@
+package etherscan
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const (
+	testTopicAlice = "0x000000000000000000000000aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	testTopicBob   = "0x000000000000000000000000bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"
+)
+
+func TestDecodeLog(t *testing.T) {
+	erc20 := DecodeLog(RespEthTxReceiptLog{
+		Topics: []string{TopicTransfer, testTopicAlice, testTopicBob},
+		Data:   "0x00000000000000000000000000000000000000000000000000000000000003e8",
+	})
+	if erc20.Event != "Transfer" || erc20.Standard != "ERC-20" || erc20.Args["value"] != "1000" ||
+		erc20.Args["from"] != "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa" {
+		t.Errorf("unexpected ERC-20 transfer: %+v", erc20)
+	}
+
+	erc721 := DecodeLog(RespEthTxReceiptLog{
+		Topics: []string{TopicTransfer, testTopicAlice, testTopicBob,
+			"0x0000000000000000000000000000000000000000000000000000000000000007"},
+		Data: "0x",
+	})
+	if erc721.Standard != "ERC-721" || erc721.Args["tokenId"] != "7" || erc721.Args["to"] != "0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb" {
+		t.Errorf("unexpected ERC-721 transfer: %+v", erc721)
+	}
+
+	unknown := DecodeLog(RespEthTxReceiptLog{Topics: []string{"0x1234"}, Data: "0x"})
+	if unknown.Event != "" || unknown.Args != nil {
+		t.Errorf("expected unknown event to stay undecoded, got %+v", unknown)
+	}
+}
+
+func newTxFullTestClient(t *testing.T, responses map[string]string) *HTTPClient {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if body, ok := responses[r.URL.Query().Get("action")]; ok {
+			w.Write([]byte(body))
+			return
+		}
+		w.Write([]byte(`{"status":"0","message":"No transactions found","result":[]}`))
+	}))
+	t.Cleanup(server.Close)
+
+	return NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+	})
+}
+
+func TestGetTxFull(t *testing.T) {
+	client := newTxFullTestClient(t, map[string]string{
+		"eth_getTransactionByHash":  `{"jsonrpc":"2.0","id":1,"result":{"hash":"0xabc","from":"0xaaa","to":"0xccc"}}`,
+		"eth_getTransactionReceipt": `{"jsonrpc":"2.0","id":1,"result":{"transactionHash":"0xabc","status":"0x0","logs":[{"topics":["` + TopicTransfer + `","` + testTopicAlice + `","` + testTopicBob + `"],"data":"0x01"}]}}`,
+		"txlistinternal":            `{"status":"1","message":"OK","result":[{"from":"0xccc","to":"0xaaa","value":"5"}]}`,
+		"getstatus":                 `{"status":"1","message":"OK","result":{"isError":"1","errDescription":"Reverted"}}`,
+	})
+
+	full, err := client.GetTxFull(context.Background(), "0xabc", nil)
+	if err != nil {
+		t.Fatalf("GetTxFull failed: %v", err)
+	}
+	if full.Tx.Hash != "0xabc" || full.Pending || full.Success || full.ErrDescription != "Reverted" {
+		t.Errorf("unexpected tx: %+v", full)
+	}
+	if len(full.InternalTxs) != 1 || len(full.Logs) != 1 {
+		t.Errorf("expected 1 internal tx and 1 log, got %d and %d", len(full.InternalTxs), len(full.Logs))
+	}
+}
+
+func TestGetTxFullPendingAndNotFound(t *testing.T) {
+	pending := newTxFullTestClient(t, map[string]string{
+		"eth_getTransactionByHash":  `{"jsonrpc":"2.0","id":1,"result":{"hash":"0xabc"}}`,
+		"eth_getTransactionReceipt": `{"jsonrpc":"2.0","id":1,"result":null}`,
+		"getstatus":                 `{"status":"1","message":"OK","result":{"isError":"0","errDescription":""}}`,
+	})
+	full, err := pending.GetTxFull(context.Background(), "0xabc", nil)
+	if err != nil {
+		t.Fatalf("GetTxFull failed: %v", err)
+	}
+	if !full.Pending || full.Receipt != nil {
+		t.Errorf("expected pending tx without receipt, got %+v", full)
+	}
+
+	missing := newTxFullTestClient(t, map[string]string{
+		"eth_getTransactionByHash":  `{"jsonrpc":"2.0","id":1,"result":null}`,
+		"eth_getTransactionReceipt": `{"jsonrpc":"2.0","id":1,"result":null}`,
+		"getstatus":                 `{"status":"1","message":"OK","result":{"isError":"0","errDescription":""}}`,
+	})
+	if _, err := missing.GetTxFull(context.Background(), "0xabc", nil); !errors.Is(err, ErrTxNotFound) {
+		t.Errorf("expected ErrTxNotFound, got %v", err)
+	}
+}
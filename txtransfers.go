@@ -0,0 +1,82 @@
+package etherscan
+
+import "context"
+
+// ============================================================================
+// Token Transfers of a Transaction
+// ============================================================================
+
+// GetTokenTransfersInTxOpts contains optional parameters for GetTokenTransfersInTx
+type GetTokenTransfersInTxOpts struct {
+	// ChainID specifies which blockchain network to query
+	// Default: empty (uses client default)
+	ChainID int64
+
+	// OnLimitExceeded specifies behavior when rate limit is exceeded
+	// Default: RateLimitBlock (wait until a token is available)
+	OnLimitExceeded RateLimitBehavior `default:""`
+}
+
+// GetTokenTransfersInTx returns the token transfers that happened inside a transaction
+//
+// The receipt is fetched with RpcEthTxReceipt and its ERC-20 and ERC-721
+// Transfer and ERC-1155 TransferSingle and TransferBatch events are decoded
+// into TokenTransfers, in log order. A TransferBatch yields one transfer per
+// token ID. The account module's transfer endpoints are keyed by address, so
+// this is the way to list every token movement of one transaction, whoever the
+// parties are.
+//
+// Args:
+//   - ctx: Context for request cancellation and timeout
+//   - txHash: Transaction hash
+//   - opts: Optional parameters (can be nil)
+//
+// Returns:
+//   - []TokenTransfer: The transfers, empty for reverted transactions
+//   - error: ErrTxNotFound if the transaction is unknown or not mined yet, or a request error
+//
+// Example:
+//
+//	transfers, err := client.GetTokenTransfersInTx(ctx, txHash, nil)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, t := range transfers {
+//	    fmt.Printf("%s %s: %s -> %s %s (id %s)\n", t.Standard, t.Token, t.From, t.To, t.Value, t.TokenID)
+//	}
+//
+// Note:
+//   - Costs 1 API call
+//   - Values are in the token's smallest unit; ScaleAmount applies the decimals from GetTokenInfo
+func (c *HTTPClient) GetTokenTransfersInTx(ctx context.Context, txHash string, opts *GetTokenTransfersInTxOpts) ([]TokenTransfer, error) {
+	if opts == nil {
+		opts = &GetTokenTransfersInTxOpts{}
+	}
+	if err := ApplyDefaults(opts); err != nil {
+		return nil, err
+	}
+
+	receipt, err := c.RpcEthTxReceipt(ctx, txHash, &RpcEthTxReceiptOpts{
+		ChainID:         opts.ChainID,
+		OnLimitExceeded: opts.OnLimitExceeded,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if receipt == nil || receipt.TransactionHash == "" {
+		return nil, ErrTxNotFound
+	}
+
+	transfers := []TokenTransfer{}
+	// Reverted transactions have no logs, but some nodes keep them in the receipt
+	if receipt.Status != "0x1" {
+		return transfers, nil
+	}
+	for _, log := range receipt.Logs {
+		for _, transfer := range tokenTransfersFromLog(DecodeLog(log)) {
+			transfer.TxHash = receipt.TransactionHash
+			transfers = append(transfers, transfer)
+		}
+	}
+	return transfers, nil
+}
@@ -0,0 +1,57 @@
+package etherscan
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestGetTokenTransfersInTx(t *testing.T) {
+	if got := "0x" + hex.EncodeToString(Keccak256([]byte("TransferBatch(address,address,address,uint256[],uint256[])"))); got != TopicTransferBatch {
+		t.Fatalf("TopicTransferBatch is %s, want %s", TopicTransferBatch, got)
+	}
+
+	word := func(n string) string { return strings.Repeat("0", 64-len(n)) + n }
+	// ids [5, 6] and values [10, 20]
+	batchData := "0x" + word("40") + word("a0") + word("2") + word("5") + word("6") + word("2") + word("a") + word("14")
+	logs := `[` +
+		`{"address":"0xusdc","logIndex":"0x0","topics":["` + TopicTransfer + `","` + testTopicAlice + `","` + testTopicBob + `"],"data":"0x` + word("3e8") + `"},` +
+		`{"address":"0xpunks","logIndex":"0x1","topics":["` + TopicTransfer + `","` + testTopicAlice + `","` + testTopicBob + `","0x` + word("7") + `"],"data":"0x"},` +
+		`{"address":"0xother","logIndex":"0x2","topics":["0x1234"],"data":"0x"},` +
+		`{"address":"0xitems","logIndex":"0x3","topics":["` + TopicTransferBatch + `","` + testTopicAlice + `","` + testTopicAlice + `","` + testTopicBob + `"],"data":"` + batchData + `"}]`
+	client := newTxFullTestClient(t, map[string]string{
+		"eth_getTransactionReceipt": `{"jsonrpc":"2.0","id":1,"result":{"transactionHash":"0xabc","status":"0x1","logs":` + logs + `}}`,
+	})
+
+	transfers, err := client.GetTokenTransfersInTx(context.Background(), "0xabc", nil)
+	if err != nil {
+		t.Fatalf("GetTokenTransfersInTx failed: %v", err)
+	}
+	if len(transfers) != 4 {
+		t.Fatalf("expected 4 transfers, got %+v", transfers)
+	}
+	if erc20 := transfers[0]; erc20.Standard != "ERC-20" || erc20.Value != "1000" || erc20.TxHash != "0xabc" || erc20.Token != "0xusdc" {
+		t.Errorf("unexpected ERC-20 transfer: %+v", erc20)
+	}
+	if erc721 := transfers[1]; erc721.Standard != "ERC-721" || erc721.TokenID != "7" || erc721.Value != "1" || erc721.LogIndex != 1 {
+		t.Errorf("unexpected ERC-721 transfer: %+v", erc721)
+	}
+	for i, want := range [][2]string{{"5", "10"}, {"6", "20"}} {
+		batch := transfers[2+i]
+		if batch.Standard != "ERC-1155" || batch.TokenID != want[0] || batch.Value != want[1] || batch.LogIndex != 3 ||
+			batch.To != "0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb" {
+			t.Errorf("unexpected batch transfer %d: %+v", i, batch)
+		}
+	}
+}
+
+func TestGetTokenTransfersInTxNotFound(t *testing.T) {
+	client := newTxFullTestClient(t, map[string]string{
+		"eth_getTransactionReceipt": `{"jsonrpc":"2.0","id":1,"result":null}`,
+	})
+	if _, err := client.GetTokenTransfersInTx(context.Background(), "0xabc", nil); !errors.Is(err, ErrTxNotFound) {
+		t.Errorf("expected ErrTxNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,136 @@
+package etherscan
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// ============================================================================
+// Unknown Response Fields
+// ============================================================================
+
+// UnknownFields holds the JSON fields of a response record that its struct does not declare
+//
+// Every Resp* record has an UnknownFields field. It stays nil unless the client
+// was created with HTTPClientConfig.CaptureUnknownFields, in which case it
+// receives any field Etherscan adds before this package knows about it.
+//
+// Example:
+//
+//	client := NewHTTPClient(HTTPClientConfig{APIKey: key, CaptureUnknownFields: true})
+//	txs, _ := client.GetNormalTxs(ctx, addr, nil)
+//	var txType string
+//	if found, _ := txs[0].UnknownFields.Get("txType", &txType); found {
+//	    fmt.Println("tx type:", txType)
+//	}
+type UnknownFields map[string]json.RawMessage
+
+// Get decodes the unknown field name into v, reporting whether the field was present
+func (f UnknownFields) Get(name string, v any) (bool, error) {
+	raw, ok := f[name]
+	if !ok {
+		return false, nil
+	}
+	return true, json.Unmarshal(raw, v)
+}
+
+// unknownFieldsType is the reflect type of UnknownFields
+var unknownFieldsType = reflect.TypeOf(UnknownFields(nil))
+
+// jsonStructInfo describes how a struct type maps to JSON object keys
+type jsonStructInfo struct {
+	// fields maps lowercased JSON names to field index paths; encoding/json matches names case-insensitively
+	fields map[string][]int
+
+	// unknown is the index of the UnknownFields field, nil if the struct has none
+	unknown []int
+}
+
+// jsonStructInfos caches jsonStructInfo by reflect.Type
+var jsonStructInfos sync.Map
+
+// structInfo returns the cached jsonStructInfo of struct type t
+func structInfo(t reflect.Type) *jsonStructInfo {
+	if info, ok := jsonStructInfos.Load(t); ok {
+		return info.(*jsonStructInfo)
+	}
+
+	info := &jsonStructInfo{fields: make(map[string][]int)}
+	for _, field := range reflect.VisibleFields(t) {
+		if !field.IsExported() && !field.Anonymous {
+			continue
+		}
+		if field.Type == unknownFieldsType {
+			info.unknown = field.Index
+			continue
+		}
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			// Promoted fields are listed by VisibleFields themselves
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		key := strings.ToLower(name)
+		if _, dup := info.fields[key]; !dup || len(field.Index) < len(info.fields[key]) {
+			info.fields[key] = field.Index
+		}
+	}
+
+	actual, _ := jsonStructInfos.LoadOrStore(t, info)
+	return actual.(*jsonStructInfo)
+}
+
+// fillUnknownFields walks v alongside its JSON encoding raw and stores undeclared fields in UnknownFields
+func fillUnknownFields(raw json.RawMessage, v reflect.Value) {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		var object map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &object); err != nil {
+			return
+		}
+		info := structInfo(v.Type())
+
+		var unknown UnknownFields
+		for key, value := range object {
+			index, ok := info.fields[strings.ToLower(key)]
+			if !ok {
+				if unknown == nil {
+					unknown = make(UnknownFields)
+				}
+				unknown[key] = value
+				continue
+			}
+			fillUnknownFields(value, v.FieldByIndex(index))
+		}
+		if info.unknown != nil && unknown != nil {
+			v.FieldByIndex(info.unknown).Set(reflect.ValueOf(unknown))
+		}
+
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return
+		}
+		var items []json.RawMessage
+		if err := json.Unmarshal(raw, &items); err != nil {
+			return
+		}
+		for i := 0; i < len(items) && i < v.Len(); i++ {
+			fillUnknownFields(items[i], v.Index(i))
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package etherscan
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newUnknownFieldsTestClient(t *testing.T, capture bool) *HTTPClient {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("action") {
+		case "txlist":
+			w.Write([]byte(`{"status":"1","message":"OK","result":[
+				{"hash":"0x01","blockNumber":"1","txType":"0x4","authorizationList":[{"address":"0xabc"}]},
+				{"hash":"0x02","blockNumber":"2"}]}`))
+		case "eth_getTransactionReceipt":
+			w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":{"transactionHash":"0x01","status":"0x1","blobGasUsed":"0x20000",
+				"logs":[{"address":"0xtoken","logIndex":"0x0","blockTimestamp":"0x6500"}]}}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return NewHTTPClient(HTTPClientConfig{
+		APIVersion:           APIVersionV1,
+		V1BaseURLs:           map[int]string{EthereumMainnet: server.URL},
+		CaptureUnknownFields: capture,
+	})
+}
+
+func TestCaptureUnknownFields(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("disabled", func(t *testing.T) {
+		client := newUnknownFieldsTestClient(t, false)
+		txs, err := client.GetNormalTxs(ctx, testAddr, nil)
+		if err != nil {
+			t.Fatalf("GetNormalTxs failed: %v", err)
+		}
+		if txs[0].UnknownFields != nil {
+			t.Errorf("expected no unknown fields by default, got %v", txs[0].UnknownFields)
+		}
+	})
+
+	t.Run("slice of records", func(t *testing.T) {
+		client := newUnknownFieldsTestClient(t, true)
+		txs, err := client.GetNormalTxs(ctx, testAddr, nil)
+		if err != nil {
+			t.Fatalf("GetNormalTxs failed: %v", err)
+		}
+		if len(txs[0].UnknownFields) != 2 {
+			t.Fatalf("expected txType and authorizationList, got %v", txs[0].UnknownFields)
+		}
+		var txType string
+		if found, err := txs[0].UnknownFields.Get("txType", &txType); !found || err != nil || txType != "0x4" {
+			t.Errorf("Get(txType) = %v, %v, %q", found, err, txType)
+		}
+		if found, _ := txs[0].UnknownFields.Get("hash", &txType); found {
+			t.Error("declared fields must not be reported as unknown")
+		}
+		if txs[1].UnknownFields != nil {
+			t.Errorf("expected no unknown fields on second tx, got %v", txs[1].UnknownFields)
+		}
+	})
+
+	t.Run("nested records", func(t *testing.T) {
+		client := newUnknownFieldsTestClient(t, true)
+		receipt, err := client.RpcEthTxReceipt(ctx, "0x01", nil)
+		if err != nil {
+			t.Fatalf("RpcEthTxReceipt failed: %v", err)
+		}
+		if _, ok := receipt.UnknownFields["blobGasUsed"]; !ok {
+			t.Errorf("expected blobGasUsed on receipt, got %v", receipt.UnknownFields)
+		}
+		if _, ok := receipt.Logs[0].UnknownFields["blockTimestamp"]; !ok {
+			t.Errorf("expected blockTimestamp on log, got %v", receipt.Logs[0].UnknownFields)
+		}
+	})
+}
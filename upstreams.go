@@ -0,0 +1,337 @@
+package etherscan
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// ============================================================================
+// Upstream Failover
+// ============================================================================
+
+const (
+	// defaultUpstreamFailureThreshold is the consecutive failures marking an upstream down when HTTPClientConfig.UpstreamFailureThreshold is zero
+	defaultUpstreamFailureThreshold = 3
+
+	// defaultUpstreamRetryInterval is how long a down upstream is skipped when HTTPClientConfig.UpstreamRetryInterval is zero
+	defaultUpstreamRetryInterval = 30 * time.Second
+)
+
+// UpstreamKind selects the URL and parameter conventions of an upstream
+type UpstreamKind string
+
+const (
+	// UpstreamV2 is the unified Etherscan V2 endpoint, selecting the chain with chainid
+	UpstreamV2 UpstreamKind = "v2"
+
+	// UpstreamV1 is a legacy per-chain Etherscan domain
+	UpstreamV1 UpstreamKind = "v1"
+
+	// UpstreamBlockscout is the Etherscan-compatible /api of a Blockscout explorer
+	UpstreamBlockscout UpstreamKind = "blockscout"
+)
+
+// Upstream is one API serving a chain, see HTTPClientConfig.Upstreams
+type Upstream struct {
+	Kind UpstreamKind
+
+	// URL is the API endpoint
+	// Default: BaseURL for UpstreamV2, the chain's V1 base URL for UpstreamV1; required for UpstreamBlockscout
+	URL string
+
+	// APIKey overrides the chain's API key
+	// The Etherscan key of the chain is only ever sent to UpstreamV2 and
+	// UpstreamV1; other kinds, such as a third-party Blockscout explorer,
+	// get APIKey or no apikey parameter at all.
+	// Default: empty (the key of the chain, see HTTPClientConfig.ChainAPIKeys, for Etherscan upstreams only)
+	APIKey string
+}
+
+// apiKey returns the key to send to u, given the Etherscan key of the chain
+func (u *Upstream) apiKey(chainKey string) string {
+	if u.APIKey != "" {
+		return u.APIKey
+	}
+	switch u.Kind {
+	case UpstreamV2, UpstreamV1:
+		return chainKey
+	}
+	return ""
+}
+
+// UpstreamStatus is the health of one upstream of a chain
+type UpstreamStatus struct {
+	Upstream Upstream
+
+	// Healthy is false while the upstream is skipped, until DownUntil
+	Healthy   bool
+	Failures  int
+	DownUntil time.Time
+
+	// Active reports whether the upstream served the last successful call of the chain
+	Active bool
+}
+
+// UpstreamEvent reports that another upstream served a chain, after a failover or a failback
+type UpstreamEvent struct {
+	ChainID int
+	From    Upstream
+	To      Upstream
+
+	// Err is the last failure of From, nil on failback
+	Err error
+
+	At time.Time
+}
+
+// upstreamRouter tracks the health of the upstreams of each chain; a nil router routes nothing
+type upstreamRouter struct {
+	threshold int
+	retry     time.Duration
+	onChange  func(UpstreamEvent)
+	now       func() time.Time
+
+	mu     sync.Mutex
+	chains map[int]*upstreamChain
+}
+
+// upstreamChain is the state of the upstreams of one chain, in priority order
+type upstreamChain struct {
+	upstreams []Upstream
+	failures  []int
+	downUntil []time.Time
+	lastErr   []error
+	active    int
+}
+
+// newUpstreamRouter returns a router over the upstreams of each chain, nil if there are none
+//
+// Default URLs are filled in; upstreams without a URL are dropped with a log line.
+func newUpstreamRouter(upstreams map[int][]Upstream, v1BaseURLs map[int]string, threshold int, retry time.Duration, onChange func(UpstreamEvent)) *upstreamRouter {
+	if threshold <= 0 {
+		threshold = defaultUpstreamFailureThreshold
+	}
+	if retry <= 0 {
+		retry = defaultUpstreamRetryInterval
+	}
+	router := &upstreamRouter{threshold: threshold, retry: retry, onChange: onChange, now: time.Now, chains: make(map[int]*upstreamChain)}
+	for chainID, list := range upstreams {
+		chain := &upstreamChain{}
+		for _, upstream := range list {
+			if upstream.URL == "" {
+				switch upstream.Kind {
+				case UpstreamV2:
+					upstream.URL = BaseURL
+				case UpstreamV1:
+					upstream.URL = v1BaseURLs[chainID]
+				}
+			}
+			if upstream.URL == "" {
+				log.Printf("etherscan: %s upstream of chain %d has no URL, ignoring it", upstream.Kind, chainID)
+				continue
+			}
+			chain.upstreams = append(chain.upstreams, upstream)
+		}
+		if len(chain.upstreams) == 0 {
+			continue
+		}
+		chain.failures = make([]int, len(chain.upstreams))
+		chain.downUntil = make([]time.Time, len(chain.upstreams))
+		chain.lastErr = make([]error, len(chain.upstreams))
+		router.chains[chainID] = chain
+	}
+	if len(router.chains) == 0 {
+		return nil
+	}
+	return router
+}
+
+// order returns the upstreams of chainID to try, healthy ones and those due for a retry first, in priority order
+//
+// Down upstreams follow, so a call still has somewhere to go when every upstream is down.
+func (r *upstreamRouter) order(chainID int) []Upstream {
+	if r == nil {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	chain, ok := r.chains[chainID]
+	if !ok {
+		return nil
+	}
+	now := r.now()
+	order := make([]Upstream, 0, len(chain.upstreams))
+	var down []Upstream
+	for i, upstream := range chain.upstreams {
+		if now.Before(chain.downUntil[i]) {
+			down = append(down, upstream)
+		} else {
+			order = append(order, upstream)
+		}
+	}
+	return append(order, down...)
+}
+
+// record counts the outcome of a call of chainID sent to upstream; a probe does not change the active upstream
+func (r *upstreamRouter) record(chainID int, upstream Upstream, err error, probe bool) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	chain, ok := r.chains[chainID]
+	if !ok {
+		r.mu.Unlock()
+		return
+	}
+	i := chain.index(upstream)
+	if i < 0 {
+		r.mu.Unlock()
+		return
+	}
+	var event *UpstreamEvent
+	switch {
+	case err == nil:
+		chain.failures[i] = 0
+		chain.downUntil[i] = time.Time{}
+		chain.lastErr[i] = nil
+		if chain.active != i && !probe {
+			event = &UpstreamEvent{ChainID: chainID, From: chain.upstreams[chain.active], To: upstream, Err: chain.lastErr[chain.active], At: r.now()}
+			chain.active = i
+		}
+	case circuitFailure(err):
+		chain.failures[i]++
+		chain.lastErr[i] = err
+		if chain.failures[i] >= r.threshold {
+			// A retry after the interval that fails again keeps the upstream down for another interval
+			chain.downUntil[i] = r.now().Add(r.retry)
+		}
+	}
+	r.mu.Unlock()
+	if event != nil && r.onChange != nil {
+		r.onChange(*event)
+	}
+}
+
+// index returns the position of upstream in the chain, -1 if it is not one of its upstreams
+func (chain *upstreamChain) index(upstream Upstream) int {
+	for i, u := range chain.upstreams {
+		if u == upstream {
+			return i
+		}
+	}
+	return -1
+}
+
+// statuses returns the health of the upstreams of chainID, nil if it has none
+func (r *upstreamRouter) statuses(chainID int) []UpstreamStatus {
+	if r == nil {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	chain, ok := r.chains[chainID]
+	if !ok {
+		return nil
+	}
+	now := r.now()
+	statuses := make([]UpstreamStatus, len(chain.upstreams))
+	for i, upstream := range chain.upstreams {
+		statuses[i] = UpstreamStatus{
+			Upstream:  upstream,
+			Healthy:   !now.Before(chain.downUntil[i]),
+			Failures:  chain.failures[i],
+			DownUntil: chain.downUntil[i],
+			Active:    chain.active == i,
+		}
+	}
+	return statuses
+}
+
+// routeRequest sends params to the upstreams of its chain in order, failing over on upstream failures
+//
+// Calls of chains without upstreams, and proxy calls served by RPCURLs, go
+// straight to doRequest.
+func (c *HTTPClient) routeRequest(params requestParams, span Span) (any, error) {
+	if c.upstreams == nil || params.upstream != nil || params.baseURL != "" {
+		return c.doRequest(params, span)
+	}
+	chainID := c.defaultChainID
+	if id, err := strconv.Atoi(params.params["chainid"]); err == nil && id != 0 {
+		chainID = id
+	}
+	if _, ok := c.rpcURLs[chainID]; ok && params.module == "proxy" {
+		return c.doRequest(params, span)
+	}
+	order := c.upstreams.order(chainID)
+	if order == nil {
+		return c.doRequest(params, span)
+	}
+
+	var data any
+	var err error
+	for i, upstream := range order {
+		params.upstream = &upstream
+		data, err = c.doRequest(params, span)
+		c.upstreams.record(chainID, upstream, err, false)
+		if err == nil || !circuitFailure(err) {
+			return data, err
+		}
+		if i+1 < len(order) {
+			log.Printf("%s%s %s failed on %s upstream %s, failing over: %v", logPrefix(params.ctx), params.module, params.action, upstream.Kind, upstream.URL, err)
+		}
+	}
+	return nil, err
+}
+
+// UpstreamStatuses returns the health of the upstreams of chainID, nil if HTTPClientConfig.Upstreams has none for it
+func (c *HTTPClient) UpstreamStatuses(chainID int) []UpstreamStatus {
+	return c.upstreams.statuses(chainID)
+}
+
+// CheckUpstreams probes every upstream of a chain and returns their health
+//
+// Each upstream is sent one block number request, eth_blockNumber on
+// Etherscan and eth_block_number on Blockscout, and the outcome counts like
+// that of any call: a failing upstream is marked down once it reaches the
+// failure threshold, and a down upstream that answers is healthy again. Run
+// it periodically to fail back without waiting for the retry interval.
+//
+// Args:
+//   - ctx: Context for request cancellation and timeout
+//   - chainID: The chain whose upstreams to probe
+//
+// Returns:
+//   - []UpstreamStatus: The health of the upstreams after the probes, nil if the chain has none
+//
+// Example:
+//
+//	for _, status := range client.CheckUpstreams(ctx, etherscan.EthereumMainnet) {
+//	    fmt.Printf("%s %s healthy=%v active=%v\n", status.Upstream.Kind, status.Upstream.URL, status.Healthy, status.Active)
+//	}
+//
+// Note:
+//   - Costs one call per upstream
+func (c *HTTPClient) CheckUpstreams(ctx context.Context, chainID int) []UpstreamStatus {
+	for _, status := range c.upstreams.statuses(chainID) {
+		upstream := status.Upstream
+		module, action := "proxy", "eth_blockNumber"
+		if upstream.Kind == UpstreamBlockscout {
+			module, action = "block", "eth_block_number"
+		}
+		_, err := c.request(requestParams{
+			ctx:      ctx,
+			module:   module,
+			action:   action,
+			params:   map[string]string{"chainid": strconv.Itoa(chainID)},
+			upstream: &upstream,
+		})
+		if ctx.Err() != nil {
+			break
+		}
+		c.upstreams.record(chainID, upstream, err, true)
+	}
+	return c.upstreams.statuses(chainID)
+}
@@ -0,0 +1,130 @@
+package etherscan
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestUpstreamFailover(t *testing.T) {
+	var primaryDown atomic.Bool
+	primaryDown.Store(true)
+	var primaryCalls, mirrorCalls atomic.Int64
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		primaryCalls.Add(1)
+		if r.URL.Query().Get("chainid") != "1" {
+			t.Errorf("expected chainid on the V2 upstream, got %q", r.URL.RawQuery)
+		}
+		if primaryDown.Load() {
+			w.WriteHeader(http.StatusBadGateway)
+			w.Write([]byte(`{"status":"0","message":"NOTOK","result":"Bad gateway"}`))
+			return
+		}
+		w.Write([]byte(`{"status":"1","message":"OK","result":"1"}`))
+	}))
+	defer primary.Close()
+	mirror := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mirrorCalls.Add(1)
+		if r.URL.Query().Has("chainid") {
+			t.Errorf("expected no chainid on the Blockscout upstream, got %q", r.URL.RawQuery)
+		}
+		if r.URL.Query().Get("action") == "eth_block_number" {
+			w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x10"}`))
+			return
+		}
+		w.Write([]byte(`{"status":"1","message":"OK","result":"2"}`))
+	}))
+	defer mirror.Close()
+
+	v2 := Upstream{Kind: UpstreamV2, URL: primary.URL}
+	blockscout := Upstream{Kind: UpstreamBlockscout, URL: mirror.URL}
+	var events []UpstreamEvent
+	client := NewHTTPClient(HTTPClientConfig{
+		Upstreams:                map[int][]Upstream{EthereumMainnet: {v2, blockscout}},
+		UpstreamFailureThreshold: 1,
+		UpstreamRetryInterval:    time.Minute,
+		OnUpstreamChange:         func(e UpstreamEvent) { events = append(events, e) },
+		MaxRetries:               -1,
+	})
+	now := time.Unix(1700000000, 0)
+	client.upstreams.now = func() time.Time { return now }
+	ctx := context.Background()
+	wallet := TestAddresses.VitalikButerin
+
+	// The failing primary is failed over within the call, and skipped by the next one
+	for i := 0; i < 2; i++ {
+		balance, err := client.GetEthBalance(ctx, wallet, &GetEthBalanceOpts{ChainID: EthereumMainnet})
+		if err != nil || balance != "2" {
+			t.Fatalf("expected the mirror's balance, got %q, %v", balance, err)
+		}
+	}
+	if primaryCalls.Load() != 1 || mirrorCalls.Load() != 2 {
+		t.Errorf("expected the down primary to be skipped, got %d primary and %d mirror calls", primaryCalls.Load(), mirrorCalls.Load())
+	}
+	if len(events) != 1 || events[0].From != v2 || events[0].To != blockscout || events[0].Err == nil {
+		t.Fatalf("expected one failover event, got %+v", events)
+	}
+	statuses := client.UpstreamStatuses(EthereumMainnet)
+	if statuses[0].Healthy || !statuses[0].DownUntil.Equal(now.Add(time.Minute)) || !statuses[1].Active {
+		t.Errorf("unexpected statuses: %+v", statuses)
+	}
+
+	// Probes mark a recovered upstream healthy without making it active
+	primaryDown.Store(false)
+	statuses = client.CheckUpstreams(ctx, EthereumMainnet)
+	if !statuses[0].Healthy || statuses[0].Active || !statuses[1].Healthy {
+		t.Errorf("unexpected statuses after probing: %+v", statuses)
+	}
+
+	// The next call fails back to the primary
+	balance, err := client.GetEthBalance(ctx, wallet, &GetEthBalanceOpts{ChainID: EthereumMainnet})
+	if err != nil || balance != "1" {
+		t.Fatalf("expected the primary's balance, got %q, %v", balance, err)
+	}
+	if len(events) != 2 || events[1].To != v2 || events[1].Err != nil {
+		t.Errorf("expected a failback event, got %+v", events)
+	}
+
+	// Chains without upstreams are not routed
+	if client.UpstreamStatuses(PolygonMainnet) != nil || client.CheckUpstreams(ctx, PolygonMainnet) != nil {
+		t.Error("expected no upstreams for Polygon")
+	}
+}
+
+func TestUpstreamAPIKey(t *testing.T) {
+	keys := make(chan string, 1)
+	mirror := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Has("apikey") {
+			keys <- r.URL.Query().Get("apikey")
+		} else {
+			keys <- "<none>"
+		}
+		w.Write([]byte(`{"status":"1","message":"OK","result":"2"}`))
+	}))
+	defer mirror.Close()
+
+	ctx := context.Background()
+	for _, tc := range []struct {
+		upstream Upstream
+		want     string
+	}{
+		{Upstream{Kind: UpstreamBlockscout, URL: mirror.URL}, "<none>"},
+		{Upstream{Kind: UpstreamBlockscout, URL: mirror.URL, APIKey: "mirror-key"}, "mirror-key"},
+		{Upstream{Kind: UpstreamV1, URL: mirror.URL}, "etherscan-secret"},
+	} {
+		client := NewHTTPClient(HTTPClientConfig{
+			APIKey:     "etherscan-secret",
+			Upstreams:  map[int][]Upstream{EthereumMainnet: {tc.upstream}},
+			MaxRetries: -1,
+		})
+		if _, err := client.GetEthBalance(ctx, TestAddresses.VitalikButerin, &GetEthBalanceOpts{ChainID: EthereumMainnet}); err != nil {
+			t.Fatalf("%s upstream: %v", tc.upstream.Kind, err)
+		}
+		if got := <-keys; got != tc.want {
+			t.Errorf("%s upstream with key %q: expected apikey %s, got %s", tc.upstream.Kind, tc.upstream.APIKey, tc.want, got)
+		}
+	}
+}
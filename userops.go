@@ -0,0 +1,228 @@
+package etherscan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ============================================================================
+// ERC-4337 User Operations
+// ============================================================================
+
+// Canonical ERC-4337 EntryPoint deployments, at the same address on every chain
+const (
+	EntryPointV06 = "0x5ff137d4b0fdcd49dca30c7cf57e578a026d2789"
+	EntryPointV07 = "0x0000000071727de22e5e9d8baf0edac6f37da032"
+)
+
+// UserOperation is an ERC-4337 user operation executed by an EntryPoint
+//
+// Smart contract wallets do not send transactions themselves: a bundler
+// packs their user operations into a handleOps transaction to the EntryPoint,
+// which emits one UserOperationEvent per operation. The wallet therefore never
+// appears as the sender in normal transaction lists.
+type UserOperation struct {
+	UserOpHash string `json:"userOpHash" bson:"userOpHash"`
+	Sender     string `json:"sender" bson:"sender"`
+
+	// Paymaster sponsored the gas, the zero address if the sender paid
+	Paymaster string `json:"paymaster" bson:"paymaster"`
+
+	Nonce   *big.Int `json:"nonce" bson:"nonce"`
+	Success bool     `json:"success" bson:"success"`
+
+	// ActualGasCost is the fee charged in wei; ActualGasUsed the gas it covers
+	ActualGasCost *big.Int `json:"actualGasCost" bson:"actualGasCost"`
+	ActualGasUsed *big.Int `json:"actualGasUsed" bson:"actualGasUsed"`
+
+	// EntryPoint is the lowercased EntryPoint contract that emitted the event
+	EntryPoint string `json:"entryPoint" bson:"entryPoint"`
+
+	// TxHash is the bundle transaction the operation was included in
+	TxHash      string    `json:"txHash" bson:"txHash"`
+	BlockNumber int64     `json:"blockNumber" bson:"blockNumber"`
+	Time        time.Time `json:"time" bson:"time"`
+	LogIndex    int64     `json:"logIndex" bson:"logIndex"`
+
+	// Bundler is the address that sent the bundle transaction, set with IncludeBundler
+	Bundler string `json:"bundler,omitempty" bson:"bundler,omitempty"`
+}
+
+// GetUserOpsForSenderOpts contains optional parameters for GetUserOpsForSender
+type GetUserOpsForSenderOpts struct {
+	// EntryPoints are the EntryPoint contracts searched
+	// Default: nil (EntryPointV06 and EntryPointV07)
+	EntryPoints []string
+
+	// FromBlock is the first block searched
+	// Default: 0 (genesis block)
+	FromBlock int64 `default:"0"`
+
+	// ToBlock is the last block searched
+	// Default: 999999999999 (latest block)
+	ToBlock int64 `default:"999999999999"`
+
+	// IncludeBundler fetches each bundle transaction to fill in UserOperation.Bundler
+	// Default: false
+	IncludeBundler bool
+
+	// ChainID specifies which blockchain network to query
+	// Default: empty (uses client default)
+	ChainID int64
+
+	// OnLimitExceeded specifies behavior when rate limit is exceeded
+	// Default: RateLimitBlock (wait until a token is available)
+	OnLimitExceeded RateLimitBehavior `default:""`
+}
+
+// GetUserOpsForSender returns the ERC-4337 user operations of a smart contract wallet
+//
+// The UserOperationEvent logs of each EntryPoint are filtered on their
+// indexed sender topic, so the wallet's whole history is found without
+// scanning bundle transactions, and decoded into UserOperations sorted by
+// block and log index. With IncludeBundler, each bundle transaction is fetched
+// once to record which bundler submitted it.
+//
+// Args:
+//   - ctx: Context for request cancellation and timeout
+//   - sender: The smart contract wallet address
+//   - opts: Optional parameters (can be nil)
+//
+// Returns:
+//   - []UserOperation: The user operations, empty if the address never used an EntryPoint
+//   - error: Error if any request fails; with ErrBudgetExhausted the operations fetched before it
+//
+// Example:
+//
+//	ops, err := client.GetUserOpsForSender(ctx, wallet, &GetUserOpsForSenderOpts{IncludeBundler: true})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, op := range ops {
+//	    fmt.Printf("nonce %s in %s by %s: success=%v, paid %s wei\n", op.Nonce, op.TxHash, op.Bundler, op.Success, op.ActualGasCost)
+//	}
+//
+// Note:
+//   - Costs one call per 1000 operations and EntryPoint, plus one per bundle with IncludeBundler
+//   - Operations of EntryPoints other than the canonical ones need EntryPoints set
+func (c *HTTPClient) GetUserOpsForSender(ctx context.Context, sender string, opts *GetUserOpsForSenderOpts) ([]UserOperation, error) {
+	if opts == nil {
+		opts = &GetUserOpsForSenderOpts{}
+	}
+	if err := ApplyDefaults(opts); err != nil {
+		return nil, err
+	}
+	if opts.FromBlock > opts.ToBlock {
+		return nil, fmt.Errorf("etherscan: invalid block range %d-%d", opts.FromBlock, opts.ToBlock)
+	}
+	entryPoints := opts.EntryPoints
+	if len(entryPoints) == 0 {
+		entryPoints = []string{EntryPointV06, EntryPointV07}
+	}
+	senderTopic := "0x000000000000000000000000" + strings.ToLower(strings.TrimPrefix(sender, "0x"))
+
+	ops := []UserOperation{}
+	var fetchErr error
+	for _, entryPoint := range entryPoints {
+		logs, err := collectLogsByRange(ctx, opts.FromBlock, opts.ToBlock, func(fromBlock, toBlock, page int64) ([]RespEventLogByAddressFilteredByTopics, error) {
+			return c.GetEventLogsByAddressFilteredByTopics(ctx, entryPoint, &GetEventLogsByAddressFilteredByTopicsOpts{
+				FromBlock:       fromBlock,
+				ToBlock:         toBlock,
+				Page:            page,
+				Offset:          logsPerCall,
+				Topic0:          TopicUserOperationEvent,
+				Topic2:          senderTopic,
+				Topic0_2_Opr:    TopicOpAnd,
+				ChainID:         opts.ChainID,
+				OnLimitExceeded: opts.OnLimitExceeded,
+			})
+		})
+		for _, log := range logs {
+			if op, ok := decodeUserOperation(log); ok {
+				ops = append(ops, op)
+			}
+		}
+		if err != nil {
+			fetchErr = err
+			break
+		}
+	}
+	// An exhausted WithMaxRequests budget still reports the operations fetched so far
+	if fetchErr != nil && !errors.Is(fetchErr, ErrBudgetExhausted) {
+		return nil, fetchErr
+	}
+	sort.SliceStable(ops, func(i, j int) bool {
+		if ops[i].BlockNumber != ops[j].BlockNumber {
+			return ops[i].BlockNumber < ops[j].BlockNumber
+		}
+		return ops[i].LogIndex < ops[j].LogIndex
+	})
+
+	if opts.IncludeBundler && fetchErr == nil {
+		bundlers := make(map[string]string)
+		for i := range ops {
+			bundler, ok := bundlers[ops[i].TxHash]
+			if !ok {
+				tx, err := c.RpcEthTxByHash(ctx, ops[i].TxHash, &RpcEthTxByHashOpts{
+					ChainID:         opts.ChainID,
+					OnLimitExceeded: opts.OnLimitExceeded,
+				})
+				if err != nil {
+					if errors.Is(err, ErrBudgetExhausted) {
+						return ops, err
+					}
+					return nil, err
+				}
+				if tx != nil {
+					bundler = strings.ToLower(tx.From)
+				}
+				bundlers[ops[i].TxHash] = bundler
+			}
+			ops[i].Bundler = bundler
+		}
+	}
+	return ops, fetchErr
+}
+
+// decodeUserOperation decodes a UserOperationEvent log, reporting false if it is not one
+func decodeUserOperation(log RespEventLogByAddressFilteredByTopics) (UserOperation, bool) {
+	decoded := DecodeLog(RespEthTxReceiptLog{
+		Address:          log.Address,
+		Topics:           log.Topics,
+		Data:             log.Data,
+		BlockNumber:      log.BlockNumber,
+		TransactionHash:  log.TransactionHash,
+		TransactionIndex: log.TransactionIndex,
+		LogIndex:         log.LogIndex,
+	})
+	if decoded.Event != "UserOperationEvent" {
+		return UserOperation{}, false
+	}
+
+	op := UserOperation{
+		UserOpHash: decoded.Args["userOpHash"],
+		Sender:     decoded.Args["sender"],
+		Paymaster:  decoded.Args["paymaster"],
+		Success:    decoded.Args["success"] == "true",
+		EntryPoint: strings.ToLower(log.Address),
+		TxHash:     log.TransactionHash,
+	}
+	op.Nonce, _ = new(big.Int).SetString(decoded.Args["nonce"], 10)
+	op.ActualGasCost, _ = new(big.Int).SetString(decoded.Args["actualGasCost"], 10)
+	op.ActualGasUsed, _ = new(big.Int).SetString(decoded.Args["actualGasUsed"], 10)
+	if block, err := parseHexUint64(log.BlockNumber); err == nil {
+		op.BlockNumber = int64(block)
+	}
+	if index, err := parseHexUint64(log.LogIndex); err == nil {
+		op.LogIndex = int64(index)
+	}
+	if at, err := ParseTimestamp(log.TimeStamp); err == nil {
+		op.Time = at
+	}
+	return op, true
+}
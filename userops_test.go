@@ -0,0 +1,72 @@
+package etherscan
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// userOpLog encodes a UserOperationEvent log of sender 0xaaaa… as returned by getLogs
+func userOpLog(entryPoint, userOpHash, txHash, block, logIndex, nonce, success string) string {
+	word := func(hex string) string { return strings.Repeat("0", 64-len(hex)) + hex }
+	return `{"address":"` + entryPoint + `","topics":["` + TopicUserOperationEvent + `","0x` + word(userOpHash) + `","` + testTopicAlice + `","0x` + word("") + `"],` +
+		`"data":"0x` + word(nonce) + word(success) + word("5af3107a4000") + word("186a0") + `",` +
+		`"blockNumber":"` + block + `","timeStamp":"0x6553f100","logIndex":"` + logIndex + `","transactionHash":"` + txHash + `"}`
+}
+
+func TestGetUserOpsForSender(t *testing.T) {
+	const sender = "0xAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA"
+	var txLookups int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		switch query.Get("action") {
+		case "getLogs":
+			if query.Get("topic0") != TopicUserOperationEvent || query.Get("topic2") != testTopicAlice || query.Get("topic0_2_opr") != "and" {
+				t.Errorf("unexpected topic filter %v", query)
+			}
+			switch query.Get("address") {
+			case EntryPointV06:
+				w.Write([]byte(`{"status":"1","message":"OK","result":[` +
+					userOpLog(EntryPointV06, "01", "0xb1", "0x64", "0x2", "1", "1") + `,` +
+					userOpLog(EntryPointV06, "02", "0xb1", "0x64", "0x5", "2", "0") + `]}`))
+			case EntryPointV07:
+				w.Write([]byte(`{"status":"1","message":"OK","result":[` + userOpLog(EntryPointV07, "03", "0xb0", "0x10", "0x0", "0", "1") + `]}`))
+			}
+		case "eth_getTransactionByHash":
+			txLookups++
+			w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":{"hash":"` + query.Get("txhash") + `","from":"0xBUNDLER"}}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+	})
+	ops, err := client.GetUserOpsForSender(context.Background(), sender, &GetUserOpsForSenderOpts{IncludeBundler: true})
+	if err != nil {
+		t.Fatalf("GetUserOpsForSender failed: %v", err)
+	}
+	if len(ops) != 3 {
+		t.Fatalf("expected 3 user operations, got %+v", ops)
+	}
+	if ops[0].EntryPoint != EntryPointV07 || ops[1].Nonce.Int64() != 1 || ops[2].Nonce.Int64() != 2 {
+		t.Errorf("expected the operations in block and log order, got %+v", ops)
+	}
+
+	op := ops[1]
+	if op.Sender != strings.ToLower(sender) || op.Paymaster != "0x0000000000000000000000000000000000000000" || !op.Success || ops[2].Success {
+		t.Errorf("unexpected decoded operation %+v", op)
+	}
+	if op.ActualGasCost.String() != "100000000000000" || op.ActualGasUsed.Int64() != 100000 || op.BlockNumber != 100 || op.LogIndex != 2 {
+		t.Errorf("unexpected gas or position %+v", op)
+	}
+	if op.UserOpHash != "0x"+strings.Repeat("0", 62)+"01" || op.Time.Unix() != 1700000000 {
+		t.Errorf("unexpected hash or time %+v", op)
+	}
+	if op.Bundler != "0xbundler" || txLookups != 2 {
+		t.Errorf("expected one lookup per bundle, got %d lookups and bundler %q", txLookups, op.Bundler)
+	}
+}
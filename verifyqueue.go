@@ -0,0 +1,350 @@
+package etherscan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ============================================================================
+// Bulk Contract Verification
+// ============================================================================
+
+// VerificationState is the outcome of one verification in a VerificationQueue
+type VerificationState string
+
+const (
+	// VerificationPending has been submitted but not resolved, e.g. when the context ended
+	VerificationPending VerificationState = "pending"
+
+	// VerificationVerified passed verification
+	VerificationVerified VerificationState = "verified"
+
+	// VerificationAlreadyVerified was verified before it was submitted
+	VerificationAlreadyVerified VerificationState = "already_verified"
+
+	// VerificationFailed was rejected, or kept failing transiently past MaxRetries
+	VerificationFailed VerificationState = "failed"
+)
+
+// VerificationRequest is one contract to verify with a VerificationQueue
+//
+// The fields mirror the arguments of VerifySourceCode. Submit replaces them
+// for other languages, e.g. a closure over VerifyVyperSourceCode.
+type VerificationRequest struct {
+	// Name identifies the request in the summary, e.g. the deployment name
+	Name string
+
+	SourceCode      string
+	ContractAddress string
+	ContractName    string
+	CompilerVersion string
+	CodeFormat      CodeFormat
+	Opts            *VerifySourceCodeOpts
+
+	// Submit, if set, submits the verification and returns its GUID
+	Submit func(ctx context.Context) (string, error)
+}
+
+// VerificationResult is the outcome of one VerificationRequest
+type VerificationResult struct {
+	Name            string            `json:"name" bson:"name"`
+	ContractAddress string            `json:"contractAddress" bson:"contractAddress"`
+	GUID            string            `json:"guid" bson:"guid"`
+	State           VerificationState `json:"state" bson:"state"`
+
+	// Message is the last status text returned by the API
+	Message string `json:"message" bson:"message"`
+
+	// Submissions counts submit attempts, including retries
+	Submissions int `json:"submissions" bson:"submissions"`
+
+	// Err is the error that failed the verification, nil unless State is VerificationFailed
+	Err error `json:"-" bson:"-"`
+}
+
+// VerificationSummary reports the outcome of a VerificationQueue run, in submission order
+type VerificationSummary struct {
+	Results         []VerificationResult `json:"results" bson:"results"`
+	Verified        int                  `json:"verified" bson:"verified"`
+	AlreadyVerified int                  `json:"alreadyVerified" bson:"alreadyVerified"`
+	Failed          int                  `json:"failed" bson:"failed"`
+	Pending         int                  `json:"pending" bson:"pending"`
+}
+
+// OK reports whether every contract ended up verified
+func (s *VerificationSummary) OK() bool {
+	return s.Failed == 0 && s.Pending == 0
+}
+
+// VerificationQueueOpts contains optional parameters for NewVerificationQueue
+type VerificationQueueOpts struct {
+	// MaxConcurrent is the number of verifications awaiting a result at once
+	// Default: 3
+	// Etherscan limits the verifications an API key can have in its queue
+	MaxConcurrent int `default:"3"`
+
+	// MaxRetries is the number of times a transient failure is retried per verification
+	// Default: 3
+	MaxRetries int `default:"3"`
+
+	// PollInterval is the pause between status checks
+	// Default: 0 (5 seconds)
+	PollInterval time.Duration
+
+	// RetryDelay is the pause before retrying a transient failure
+	// Default: 0 (10 seconds)
+	RetryDelay time.Duration
+
+	// ChainID specifies which blockchain network the status checks query
+	// Default: empty (uses client default)
+	ChainID int64
+
+	// OnLimitExceeded specifies behavior when rate limit is exceeded
+	// Default: RateLimitBlock (wait until a token is available)
+	OnLimitExceeded RateLimitBehavior `default:""`
+}
+
+// VerificationQueue submits many contract verifications and waits for their results
+//
+// CI pipelines deploying dozens of contracts cannot fire every verification at
+// once: Etherscan caps the verifications pending per API key. The queue
+// submits one request at a time, keeps at most MaxConcurrent awaiting a
+// result, polls their statuses, retries transient failures (rate limits, 5xx
+// responses and contracts the explorer has not indexed yet) and reports a
+// summary once every request is resolved.
+//
+// A VerificationQueue is not safe for concurrent use.
+type VerificationQueue struct {
+	client   *HTTPClient
+	opts     VerificationQueueOpts
+	requests []VerificationRequest
+}
+
+// NewVerificationQueue returns an empty verification queue using c
+//
+// Args:
+//   - opts: Optional parameters (can be nil)
+//
+// Returns:
+//   - *VerificationQueue: The empty queue
+//   - error: Error if opts hold invalid defaults or a negative MaxConcurrent
+//
+// Example:
+//
+//	queue, err := client.NewVerificationQueue(&etherscan.VerificationQueueOpts{ChainID: etherscan.BaseMainnet})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, d := range deployments {
+//	    queue.Add(etherscan.VerificationRequest{
+//	        Name:            d.Name,
+//	        SourceCode:      d.StandardJSON,
+//	        ContractAddress: d.Address,
+//	        ContractName:    d.FullyQualifiedName,
+//	        CompilerVersion: "v0.8.24+commit.e11b9ed9",
+//	        CodeFormat:      etherscan.CodeFormatSolidityStandardJSON,
+//	        Opts:            &etherscan.VerifySourceCodeOpts{ChainID: etherscan.BaseMainnet},
+//	    })
+//	}
+//	summary, err := queue.Run(ctx)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, r := range summary.Results {
+//	    fmt.Printf("%s %s: %s %s\n", r.Name, r.ContractAddress, r.State, r.Message)
+//	}
+//	if !summary.OK() {
+//	    os.Exit(1)
+//	}
+func (c *HTTPClient) NewVerificationQueue(opts *VerificationQueueOpts) (*VerificationQueue, error) {
+	q := &VerificationQueue{client: c}
+	if opts != nil {
+		q.opts = *opts
+	}
+	if err := ApplyDefaults(&q.opts); err != nil {
+		return nil, err
+	}
+	if q.opts.MaxConcurrent < 0 {
+		return nil, fmt.Errorf("etherscan: MaxConcurrent must be positive, got %d", q.opts.MaxConcurrent)
+	}
+	if q.opts.PollInterval <= 0 {
+		q.opts.PollInterval = 5 * time.Second
+	}
+	if q.opts.RetryDelay <= 0 {
+		q.opts.RetryDelay = 10 * time.Second
+	}
+	return q, nil
+}
+
+// Add appends verification requests to the queue
+func (q *VerificationQueue) Add(requests ...VerificationRequest) {
+	q.requests = append(q.requests, requests...)
+}
+
+// Run submits every queued request and waits until each one is resolved
+//
+// Rejected verifications do not stop the run: they are reported in the
+// summary, which only counts as OK if every contract got verified.
+//
+// Returns:
+//   - *VerificationSummary: The outcome of every request, in the order they were added
+//   - error: The context's error if it ended first, with the requests left unresolved as VerificationPending
+func (q *VerificationQueue) Run(ctx context.Context) (*VerificationSummary, error) {
+	results := make([]VerificationResult, len(q.requests))
+	for i, req := range q.requests {
+		results[i] = VerificationResult{Name: req.Name, ContractAddress: req.ContractAddress, State: VerificationPending}
+	}
+
+	next := 0
+	var inflight []int
+	transientErrors := make([]int, len(q.requests))
+	for (next < len(q.requests) || len(inflight) > 0) && ctx.Err() == nil {
+		// Submissions are serialized and capped by the pending limit
+		for len(inflight) < q.opts.MaxConcurrent && next < len(q.requests) && ctx.Err() == nil {
+			if q.submit(ctx, q.requests[next], &results[next]) {
+				inflight = append(inflight, next)
+			}
+			next++
+		}
+		if len(inflight) == 0 || !q.sleep(ctx, q.opts.PollInterval) {
+			continue
+		}
+
+		waiting := inflight[:0]
+		for _, i := range inflight {
+			if !q.poll(ctx, &results[i], &transientErrors[i]) {
+				waiting = append(waiting, i)
+			}
+		}
+		inflight = waiting
+	}
+
+	summary := &VerificationSummary{Results: results}
+	for _, r := range results {
+		switch r.State {
+		case VerificationVerified:
+			summary.Verified++
+		case VerificationAlreadyVerified:
+			summary.AlreadyVerified++
+		case VerificationFailed:
+			summary.Failed++
+		default:
+			summary.Pending++
+		}
+	}
+	return summary, ctx.Err()
+}
+
+// submit sends req, retrying transient failures, and reports whether its result must be polled
+func (q *VerificationQueue) submit(ctx context.Context, req VerificationRequest, result *VerificationResult) bool {
+	for {
+		result.Submissions++
+		var guid string
+		var err error
+		if req.Submit != nil {
+			guid, err = req.Submit(ctx)
+		} else {
+			guid, err = q.client.VerifySourceCode(ctx, req.SourceCode, req.ContractAddress, req.ContractName, req.CompilerVersion, req.CodeFormat, req.Opts)
+		}
+		if err == nil {
+			result.GUID = guid
+			return true
+		}
+
+		result.Message = verificationMessage(err)
+		switch {
+		case ctx.Err() != nil:
+			return false
+		case isAlreadyVerified(result.Message):
+			result.State = VerificationAlreadyVerified
+			return false
+		case (IsTransientError(err) || isNotYetIndexed(result.Message)) &&
+			result.Submissions <= q.opts.MaxRetries && q.sleep(ctx, q.opts.RetryDelay):
+			continue
+		}
+		if ctx.Err() == nil {
+			result.State = VerificationFailed
+			result.Err = err
+		}
+		return false
+	}
+}
+
+// poll checks the status of a submitted verification and reports whether it is resolved
+func (q *VerificationQueue) poll(ctx context.Context, result *VerificationResult, transientErrors *int) bool {
+	status, err := q.client.CheckSourceCodeVerificationStatus(ctx, result.GUID, &CheckSourceCodeVerificationStatusOpts{
+		ChainID:         q.opts.ChainID,
+		OnLimitExceeded: q.opts.OnLimitExceeded,
+	})
+	if err == nil {
+		result.Message = status
+		result.State = VerificationVerified
+		if isAlreadyVerified(status) {
+			result.State = VerificationAlreadyVerified
+		}
+		return true
+	}
+	if ctx.Err() != nil {
+		return false
+	}
+
+	result.Message = verificationMessage(err)
+	switch {
+	case isVerificationPending(result.Message):
+		return false
+	case isAlreadyVerified(result.Message):
+		result.State = VerificationAlreadyVerified
+		return true
+	case IsTransientError(err):
+		// Past MaxRetries transient errors the verification is given up
+		*transientErrors++
+		if *transientErrors <= q.opts.MaxRetries {
+			return false
+		}
+	}
+	result.State = VerificationFailed
+	result.Err = err
+	return true
+}
+
+// sleep pauses for d, reporting false if ctx ended first
+func (q *VerificationQueue) sleep(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// verificationMessage returns the status text of a failed verification call
+func verificationMessage(err error) string {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		if text, ok := apiErr.Result.(string); ok && text != "" {
+			return text
+		}
+		return apiErr.Message
+	}
+	return err.Error()
+}
+
+// isVerificationPending reports whether a status text means the verification is still queued
+func isVerificationPending(message string) bool {
+	return strings.Contains(strings.ToLower(message), "pending")
+}
+
+// isAlreadyVerified reports whether a status text means the contract was verified before
+func isAlreadyVerified(message string) bool {
+	return strings.Contains(strings.ToLower(message), "already verified")
+}
+
+// isNotYetIndexed reports whether a submission failed because the explorer has not indexed the contract yet
+func isNotYetIndexed(message string) bool {
+	return strings.Contains(strings.ToLower(message), "unable to locate contractcode")
+}
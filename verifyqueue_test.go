@@ -0,0 +1,131 @@
+package etherscan
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestVerificationQueue(t *testing.T) {
+	// The contracts are named after how the explorer treats them
+	const (
+		first = "0x000000000000000000000000000000000000000a"
+		fresh = "0x000000000000000000000000000000000000000f"
+		done  = "0x000000000000000000000000000000000000000d"
+		bad   = "0x00000000000000000000000000000000000000ba"
+		last  = "0x000000000000000000000000000000000000000b"
+	)
+	var mu sync.Mutex
+	polls := map[string]int{}
+	submits := map[string]int{}
+	inQueue, maxInQueue := 0, 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch r.URL.Query().Get("action") {
+		case "verifysourcecode":
+			var body map[string]string
+			json.NewDecoder(r.Body).Decode(&body)
+			addr := body["contractaddress"]
+			submits[addr]++
+			switch {
+			case addr == fresh && submits[addr] == 1:
+				w.Write([]byte(`{"status":"0","message":"NOTOK","result":"Unable to locate ContractCode at ` + fresh + `"}`))
+			case addr == done:
+				w.Write([]byte(`{"status":"0","message":"NOTOK","result":"Contract source code already verified"}`))
+			default:
+				inQueue++
+				maxInQueue = max(maxInQueue, inQueue)
+				w.Write([]byte(`{"status":"1","message":"OK","result":"guid-` + addr + `"}`))
+			}
+		case "checkverifystatus":
+			guid := r.URL.Query().Get("guid")
+			polls[guid]++
+			switch {
+			case polls[guid] < 2:
+				w.Write([]byte(`{"status":"0","message":"NOTOK","result":"Pending in queue"}`))
+			case guid == "guid-"+bad:
+				inQueue--
+				w.Write([]byte(`{"status":"0","message":"NOTOK","result":"Fail - Unable to verify"}`))
+			default:
+				inQueue--
+				w.Write([]byte(`{"status":"1","message":"OK","result":"Pass - Verified"}`))
+			}
+		}
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+	})
+	if _, err := client.NewVerificationQueue(&VerificationQueueOpts{MaxConcurrent: -1}); err == nil {
+		t.Error("expected an error for a negative MaxConcurrent")
+	}
+	queue, err := client.NewVerificationQueue(&VerificationQueueOpts{
+		MaxConcurrent: 2,
+		PollInterval:  time.Millisecond,
+		RetryDelay:    time.Millisecond,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, addr := range []string{first, fresh, done, bad, last} {
+		queue.Add(VerificationRequest{Name: addr, ContractAddress: addr})
+	}
+
+	summary, err := queue.Run(context.Background())
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	want := []VerificationState{VerificationVerified, VerificationVerified, VerificationAlreadyVerified, VerificationFailed, VerificationVerified}
+	for i, r := range summary.Results {
+		if r.State != want[i] {
+			t.Errorf("%s: expected %s, got %s (%s)", r.Name, want[i], r.State, r.Message)
+		}
+	}
+	if summary.Verified != 3 || summary.AlreadyVerified != 1 || summary.Failed != 1 || summary.OK() {
+		t.Errorf("unexpected summary: %+v", summary)
+	}
+	if summary.Results[1].Submissions != 2 {
+		t.Errorf("expected the unindexed contract to be resubmitted once, got %d submissions", summary.Results[1].Submissions)
+	}
+	if summary.Results[3].Err == nil || summary.Results[3].Message != "Fail - Unable to verify" {
+		t.Errorf("expected the rejection to be reported: %+v", summary.Results[3])
+	}
+	if maxInQueue > 2 {
+		t.Errorf("expected at most 2 pending verifications, got %d", maxInQueue)
+	}
+}
+
+func TestVerificationQueueContextEnd(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("action") == "verifysourcecode" {
+			w.Write([]byte(`{"status":"1","message":"OK","result":"guid"}`))
+			return
+		}
+		w.Write([]byte(`{"status":"0","message":"NOTOK","result":"Pending in queue"}`))
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+	})
+	queue, err := client.NewVerificationQueue(&VerificationQueueOpts{PollInterval: time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	queue.Add(VerificationRequest{Name: "a", ContractAddress: testContract})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	summary, err := queue.Run(ctx)
+	if err == nil || summary.Pending != 1 || summary.Results[0].GUID != "guid" {
+		t.Errorf("expected the verification to stay pending: %+v, %v", summary, err)
+	}
+}
@@ -0,0 +1,258 @@
+package etherscan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// ============================================================================
+// Watch Subscriptions
+// ============================================================================
+
+// defaultWatchPollInterval separates the polls of a watcher when WatchEventLogsOpts.PollInterval is zero
+const defaultWatchPollInterval = 15 * time.Second
+
+// errWatchSubscription is returned by the watchers and cursor stores without a subscription ID
+var errWatchSubscription = errors.New("etherscan: subscription ID is required")
+
+// CursorStore persists the cursor of each watch subscription
+//
+// A cursor is a Checkpoint: the next block to read and the keys
+// (transaction hash and log index) of the logs of that block already
+// delivered. Watchers save the cursor after every batch handed to the
+// handler and load it when they start, so a restarted watcher resumes
+// exactly where the previous one stopped.
+//
+// Implementations must be safe for concurrent use when several watchers
+// share one store. FileCursorStore and MemoryCursorStore cover the common
+// cases; implement the interface to keep cursors in a database, ideally in
+// the same transaction as the handler's own writes.
+type CursorStore interface {
+	// Load returns the cursor saved for subscription, nil and no error if there is none
+	Load(ctx context.Context, subscription string) (*Checkpoint, error)
+
+	// Save replaces the cursor of subscription
+	Save(ctx context.Context, subscription string, cursor Checkpoint) error
+}
+
+// FileCursorStore keeps one JSON checkpoint file per subscription in Dir
+//
+// Files are written atomically with SaveCheckpoint; the subscription ID is
+// path-escaped into the file name, so any ID is safe to use.
+type FileCursorStore struct {
+	Dir string
+}
+
+// path returns the file holding the cursor of subscription
+func (s FileCursorStore) path(subscription string) string {
+	return filepath.Join(s.Dir, url.PathEscape(subscription)+".json")
+}
+
+// Load implements CursorStore
+func (s FileCursorStore) Load(_ context.Context, subscription string) (*Checkpoint, error) {
+	if subscription == "" {
+		return nil, errWatchSubscription
+	}
+	return LoadCheckpoint(s.path(subscription))
+}
+
+// Save implements CursorStore, creating Dir if needed
+func (s FileCursorStore) Save(_ context.Context, subscription string, cursor Checkpoint) error {
+	if subscription == "" {
+		return errWatchSubscription
+	}
+	if err := os.MkdirAll(s.Dir, 0o755); err != nil {
+		return fmt.Errorf("etherscan: save cursor: %w", err)
+	}
+	return SaveCheckpoint(s.path(subscription), cursor)
+}
+
+// MemoryCursorStore keeps cursors in memory, for tests and watchers that need not survive restarts
+//
+// The zero value is ready to use.
+type MemoryCursorStore struct {
+	mu      sync.Mutex
+	cursors map[string]Checkpoint
+}
+
+// Load implements CursorStore
+func (s *MemoryCursorStore) Load(_ context.Context, subscription string) (*Checkpoint, error) {
+	if subscription == "" {
+		return nil, errWatchSubscription
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cursor, ok := s.cursors[subscription]
+	if !ok {
+		return nil, nil
+	}
+	cursor.LastKeys = append([]string(nil), cursor.LastKeys...)
+	return &cursor, nil
+}
+
+// Save implements CursorStore
+func (s *MemoryCursorStore) Save(_ context.Context, subscription string, cursor Checkpoint) error {
+	if subscription == "" {
+		return errWatchSubscription
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.cursors == nil {
+		s.cursors = make(map[string]Checkpoint)
+	}
+	cursor.LastKeys = append([]string(nil), cursor.LastKeys...)
+	s.cursors[subscription] = cursor
+	return nil
+}
+
+// WatchEventLogsOpts contains optional parameters for WatchEventLogs
+type WatchEventLogsOpts struct {
+	// FromBlock is where a subscription without a saved cursor starts
+	// Default: 0 (the first block within Confirmations of the head at start)
+	FromBlock int64
+
+	// Confirmations keeps the watcher this many blocks behind the head, so
+	// delivered logs are unlikely to be reorganized away
+	// Default: 0 (read up to the latest block)
+	Confirmations int64
+
+	// PollInterval is the wait between polls once the watcher has caught up
+	// Default: 15s
+	PollInterval time.Duration
+
+	// ChainID specifies which blockchain network to query
+	// Default: empty (uses client default)
+	ChainID int64
+
+	// OnLimitExceeded specifies behavior when rate limit is exceeded
+	// Default: RateLimitBlock (wait until a token is available)
+	OnLimitExceeded RateLimitBehavior `default:""`
+}
+
+// WatchEventLogs polls for new event logs of an address and hands them to handler in block order
+//
+// The subscription's cursor is loaded from store when the watcher starts and
+// saved after every batch the handler accepts, so after a restart, a crash
+// or a cancelled context the watcher resumes with the first log the handler
+// has not returned from: nothing is missed, and nothing is delivered twice
+// unless the process dies between the handler returning and the cursor
+// being saved. Each poll reads up to the latest block minus Confirmations
+// with GetAllEventLogs, so busy ranges are bisected past the 1000-record cap.
+//
+// WatchEventLogs blocks until ctx is done, a request fails or handler
+// returns an error, and returns that error.
+//
+// Args:
+//   - ctx: Context for cancellation; cancel it to stop the watcher
+//   - subscription: The ID the cursor is stored under, unique per watched stream
+//   - address: The contract address to watch
+//   - store: Where the cursor is persisted
+//   - handler: Receives each non-empty batch of new logs
+//   - opts: Optional parameters (can be nil)
+//
+// Returns:
+//   - error: The error that stopped the watcher, ctx.Err() if it was cancelled
+//
+// Example:
+//
+//	store := etherscan.FileCursorStore{Dir: "cursors"}
+//	err := client.WatchEventLogs(ctx, "usdc-logs", usdc, store,
+//	    func(logs []etherscan.RespEventLogByAddress) error {
+//	        return db.InsertLogs(logs)
+//	    }, &etherscan.WatchEventLogsOpts{FromBlock: 19000000, Confirmations: 12})
+//	if err != nil && !errors.Is(err, context.Canceled) {
+//	    log.Fatal(err)
+//	}
+//
+// Note:
+//   - Costs one eth_blockNumber call per poll, plus the getLogs calls for new blocks
+//   - For exactly-once delivery across crashes, implement CursorStore so the
+//     cursor is saved in the same transaction as the handler's writes
+func (c *HTTPClient) WatchEventLogs(ctx context.Context, subscription, address string, store CursorStore, handler func(logs []RespEventLogByAddress) error, opts *WatchEventLogsOpts) error {
+	if opts == nil {
+		opts = &WatchEventLogsOpts{}
+	}
+	if err := ApplyDefaults(opts); err != nil {
+		return err
+	}
+	if subscription == "" {
+		return errWatchSubscription
+	}
+	if store == nil || handler == nil {
+		return errors.New("etherscan: store and handler are required")
+	}
+	if opts.FromBlock < 0 || opts.Confirmations < 0 || opts.PollInterval < 0 {
+		return fmt.Errorf("etherscan: invalid watch options %+v", *opts)
+	}
+	interval := opts.PollInterval
+	if interval == 0 {
+		interval = defaultWatchPollInterval
+	}
+
+	cursor, err := store.Load(ctx, subscription)
+	if err != nil {
+		return err
+	}
+
+	for {
+		latest, err := c.RpcEthBlockNumber(ctx, &RpcEthBlockNumberOpts{
+			ChainID:         opts.ChainID,
+			OnLimitExceeded: opts.OnLimitExceeded,
+		})
+		if err != nil {
+			return err
+		}
+		number, err := parseHexUint64(latest)
+		if err != nil {
+			return fmt.Errorf("etherscan: invalid block number %q: %w", latest, err)
+		}
+		head := int64(number) - opts.Confirmations
+
+		if cursor == nil {
+			start := opts.FromBlock
+			if start == 0 {
+				start = max(head, 0)
+			}
+			cursor = &Checkpoint{Block: start}
+		}
+
+		if head > 0 && cursor.Block <= head {
+			_, err := c.GetAllEventLogs(ctx, address, &GetAllEventLogsOpts{
+				FromBlock: cursor.Block,
+				ToBlock:   head,
+				Resume:    cursor,
+				OnBatch: func(logs []RespEventLogByAddress, next Checkpoint) error {
+					if len(logs) > 0 {
+						if err := handler(logs); err != nil {
+							return err
+						}
+					}
+					if err := store.Save(ctx, subscription, next); err != nil {
+						return err
+					}
+					cursor = &next
+					return nil
+				},
+				ChainID:         opts.ChainID,
+				OnLimitExceeded: opts.OnLimitExceeded,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
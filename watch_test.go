@@ -0,0 +1,125 @@
+package etherscan
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWatchEventLogsResume(t *testing.T) {
+	// The head advances by 5 blocks per eth_blockNumber call, each block has 2 logs
+	var mu sync.Mutex
+	head := int64(15)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if query.Get("action") == "eth_blockNumber" {
+			mu.Lock()
+			head += 5
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":"0x%x"}`, head)
+			mu.Unlock()
+			return
+		}
+		from, _ := strconv.ParseInt(query.Get("fromblock"), 10, 64)
+		to, _ := strconv.ParseInt(query.Get("toblock"), 10, 64)
+		var logs []RespEventLogByAddress
+		for b := from; b <= to; b++ {
+			for i := range 2 {
+				logs = append(logs, RespEventLogByAddress{
+					BlockNumber:     fmt.Sprintf("0x%x", b),
+					TransactionHash: fmt.Sprintf("0xtx%d", b),
+					LogIndex:        fmt.Sprintf("0x%x", i),
+				})
+			}
+		}
+		result, _ := json.Marshal(logs)
+		fmt.Fprintf(w, `{"status":"1","message":"OK","result":%s}`, result)
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIVersion: APIVersionV1,
+		V1BaseURLs: map[int]string{EthereumMainnet: server.URL},
+		MaxRetries: -1,
+	})
+	store := FileCursorStore{Dir: t.TempDir()}
+	opts := &WatchEventLogsOpts{FromBlock: 1, Confirmations: 2, PollInterval: time.Millisecond}
+	errCrash := errors.New("crash")
+
+	seen := make(map[string]int)
+	accept := func(logs []RespEventLogByAddress) {
+		for _, log := range logs {
+			seen[log.TransactionHash+":"+log.LogIndex]++
+		}
+	}
+
+	// The first watcher accepts blocks 1-18, then crashes on the batch of blocks 19-23
+	calls := 0
+	err := client.WatchEventLogs(context.Background(), "contract/transfers", testContract, store, func(logs []RespEventLogByAddress) error {
+		calls++
+		if calls == 2 {
+			return errCrash
+		}
+		accept(logs)
+		return nil
+	}, opts)
+	if !errors.Is(err, errCrash) {
+		t.Fatalf("expected the handler error, got %v", err)
+	}
+	cursor, err := store.Load(context.Background(), "contract/transfers")
+	if err != nil || cursor == nil || cursor.Block != 19 {
+		t.Fatalf("expected the cursor after block 18, got %+v, %v", cursor, err)
+	}
+
+	// The restarted watcher picks up at block 19 and is stopped once it reaches block 28
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	err = client.WatchEventLogs(ctx, "contract/transfers", testContract, store, func(logs []RespEventLogByAddress) error {
+		accept(logs)
+		if last, _ := parseHexUint64(logs[len(logs)-1].BlockNumber); last >= 28 {
+			cancel()
+		}
+		return nil
+	}, opts)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected the watcher to stop on cancel, got %v", err)
+	}
+
+	if len(seen) != 28*2 {
+		t.Errorf("expected every log of blocks 1-28, got %d", len(seen))
+	}
+	for key, n := range seen {
+		if n != 1 {
+			t.Errorf("log %s delivered %d times", key, n)
+		}
+	}
+	if cursor, _ := store.Load(context.Background(), "contract/transfers"); cursor == nil || cursor.Block != 29 {
+		t.Errorf("expected the cursor after block 28, got %+v", cursor)
+	}
+}
+
+func TestMemoryCursorStore(t *testing.T) {
+	var store MemoryCursorStore
+	ctx := context.Background()
+	if cursor, err := store.Load(ctx, "a"); cursor != nil || err != nil {
+		t.Fatalf("expected no cursor for a new subscription, got %+v, %v", cursor, err)
+	}
+	keys := []string{"0xtx:0x0"}
+	if err := store.Save(ctx, "a", Checkpoint{Block: 7, LastKeys: keys}); err != nil {
+		t.Fatal(err)
+	}
+	keys[0] = "changed"
+	cursor, err := store.Load(ctx, "a")
+	if err != nil || cursor.Block != 7 || cursor.LastKeys[0] != "0xtx:0x0" {
+		t.Errorf("expected the saved cursor, got %+v, %v", cursor, err)
+	}
+	if err := store.Save(ctx, "", Checkpoint{}); err == nil {
+		t.Error("expected an empty subscription ID to be rejected")
+	}
+}